@@ -0,0 +1,51 @@
+// Package apis lists the runtime APIs implemented by the runtime.
+//
+// The functions exported by the runtime for the methods of the APIs, their registration and
+// their runtime version entries are generated from the lists with `go generate ./runtime`.
+package apis
+
+import (
+	"github.com/LimeChain/gosemble/frame/account_nonce"
+	"github.com/LimeChain/gosemble/frame/aura"
+	"github.com/LimeChain/gosemble/frame/benchmarking"
+	blockbuilder "github.com/LimeChain/gosemble/frame/block_builder"
+	"github.com/LimeChain/gosemble/frame/core"
+	"github.com/LimeChain/gosemble/frame/genesis_builder"
+	"github.com/LimeChain/gosemble/frame/grandpa"
+	"github.com/LimeChain/gosemble/frame/metadata"
+	"github.com/LimeChain/gosemble/frame/offchain_worker"
+	"github.com/LimeChain/gosemble/frame/session_keys"
+	"github.com/LimeChain/gosemble/frame/state_trie_migration"
+	taggedtransactionqueue "github.com/LimeChain/gosemble/frame/tagged_transaction_queue"
+	"github.com/LimeChain/gosemble/frame/transaction_payment"
+	"github.com/LimeChain/gosemble/frame/try_runtime"
+	"github.com/LimeChain/gosemble/primitives/api"
+)
+
+// Apis are the runtime APIs implemented by the runtime, in the order in which they are listed
+// in the runtime version.
+var Apis = []api.Api{
+	core.Api,
+	metadata.Api,
+	blockbuilder.Api,
+	taggedtransactionqueue.Api,
+	offchain_worker.Api,
+	aura.Api,
+	session_keys.Api,
+	grandpa.Api,
+	account_nonce.Api,
+	transaction_payment.Api,
+	transaction_payment.CallApi,
+	genesis_builder.Api,
+	state_trie_migration.Api,
+}
+
+// BenchmarkApis are the runtime APIs implemented only by runtimes built with the `benchmarks` tag.
+var BenchmarkApis = []api.Api{
+	benchmarking.Api,
+}
+
+// TryRuntimeApis are the runtime APIs implemented only by runtimes built with the `tryruntime` tag.
+var TryRuntimeApis = []api.Api{
+	try_runtime.Api,
+}
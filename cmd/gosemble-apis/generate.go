@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/LimeChain/gosemble/primitives/api"
+	"golang.org/x/crypto/blake2b"
+)
+
+var fileTemplate = template.Must(template.New("apis").Parse(`// Code generated by gosemble-apis. DO NOT EDIT.
+{{if .BuildTag}}
+//go:build {{.BuildTag}}
+{{end}}
+package main
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/apis"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/api"
+)
+
+func init() {
+	api.Register(apis.{{.List}}...)
+
+	constants.RuntimeVersion.Apis = append(constants.RuntimeVersion.Apis,
+{{- range $i, $api := .Apis}}
+		apis.{{$.List}}[{{$i}}].Item(sc.NewFixedSequence[sc.U8](8, {{$api.Id}})), // {{$api.Name}}
+{{- end}}
+	)
+}
+{{range $i, $api := .Apis}}{{range $j, $method := $api.Methods}}
+//go:export {{$method.ExportName}}
+func {{$method.FuncName}}(dataPtr int32, dataLen int32) int64 {
+	return apis.{{$.List}}[{{$i}}].Methods[{{$j}}].Execute(dataPtr, dataLen)
+}
+{{end}}{{end}}`))
+
+type fileData struct {
+	BuildTag string
+	List     string
+	Apis     []apiData
+}
+
+type apiData struct {
+	Name    string
+	Id      string
+	Methods []methodData
+}
+
+type methodData struct {
+	ExportName string
+	FuncName   string
+}
+
+// generate returns the source of the file exporting the methods of `apis`, which is the list
+// `list` of the apis package. The file is built only with `buildTag`, if it is not empty.
+func generate(apis []api.Api, list string, buildTag string) ([]byte, error) {
+	data := fileData{
+		BuildTag: buildTag,
+		List:     list,
+	}
+	for _, a := range apis {
+		id, err := apiId(a.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		methods := make([]methodData, 0, len(a.Methods))
+		for _, method := range a.Methods {
+			exportName := a.ExportName(method)
+			methods = append(methods, methodData{
+				ExportName: exportName,
+				FuncName:   funcName(exportName),
+			})
+		}
+
+		data.Apis = append(data.Apis, apiData{
+			Name:    a.Name,
+			Id:      id,
+			Methods: methods,
+		})
+	}
+
+	var buffer bytes.Buffer
+	if err := fileTemplate.Execute(&buffer, data); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buffer.Bytes())
+}
+
+// apiId returns the Blake2b-8 hash of the name of an API as comma-separated bytes.
+func apiId(name string) (string, error) {
+	hasher, err := blake2b.New(8, nil)
+	if err != nil {
+		return "", err
+	}
+	hasher.Write([]byte(name))
+
+	id := hasher.Sum(nil)
+	values := make([]string, len(id))
+	for i, b := range id {
+		values[i] = strconv.Itoa(int(b))
+	}
+
+	return strings.Join(values, ", "), nil
+}
+
+// funcName returns the name of the Go function exported as `exportName`,
+// e.g. `BlockBuilderApplyExtrinsic` for `BlockBuilder_apply_extrinsic`.
+func funcName(exportName string) string {
+	var name strings.Builder
+	for _, part := range strings.Split(exportName, "_") {
+		if part == "" {
+			continue
+		}
+		name.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+
+	return name.String()
+}
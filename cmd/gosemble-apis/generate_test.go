@@ -0,0 +1,52 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/LimeChain/gosemble/apis"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_generate_UpToDate(t *testing.T) {
+	expect, err := os.ReadFile("../../runtime/apis.go")
+	assert.NoError(t, err)
+
+	result, err := generate(apis.Apis, "Apis", "")
+	assert.NoError(t, err)
+
+	assert.Equal(t, string(expect), string(result), "runtime/apis.go is outdated, run `make generate-apis`")
+}
+
+func Test_generate_Benchmarks_UpToDate(t *testing.T) {
+	expect, err := os.ReadFile("../../runtime/apis_benchmarks.go")
+	assert.NoError(t, err)
+
+	result, err := generate(apis.BenchmarkApis, "BenchmarkApis", "benchmarks")
+	assert.NoError(t, err)
+
+	assert.Equal(t, string(expect), string(result), "runtime/apis_benchmarks.go is outdated, run `make generate-apis`")
+}
+
+func Test_generate_TryRuntime_UpToDate(t *testing.T) {
+	expect, err := os.ReadFile("../../runtime/apis_try_runtime.go")
+	assert.NoError(t, err)
+
+	result, err := generate(apis.TryRuntimeApis, "TryRuntimeApis", "tryruntime")
+	assert.NoError(t, err)
+
+	assert.Equal(t, string(expect), string(result), "runtime/apis_try_runtime.go is outdated, run `make generate-apis`")
+}
+
+func Test_apiId(t *testing.T) {
+	result, err := apiId("Core")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "223, 106, 203, 104, 153, 7, 96, 155", result)
+}
+
+func Test_funcName(t *testing.T) {
+	assert.Equal(t, "CoreVersion", funcName("Core_version"))
+	assert.Equal(t, "BlockBuilderApplyExtrinsic", funcName("BlockBuilder_apply_extrinsic"))
+	assert.Equal(t, "TransactionPaymentCallApiQueryCallFeeDetails", funcName("TransactionPaymentCallApi_query_call_fee_details"))
+}
@@ -0,0 +1,45 @@
+/*
+Generates the functions exported by the runtime for the runtime APIs listed in `apis.Apis`, in
+apis.go, for the APIs listed in `apis.BenchmarkApis`, in apis_benchmarks.go, which is built only
+with the `benchmarks` tag, and for the APIs listed in `apis.TryRuntimeApis`, in apis_try_runtime.go,
+which is built only with the `tryruntime` tag.
+
+For each method of an API, a function exported as `<Api>_<method>` is generated, which executes
+the method with its SCALE-encoded arguments in the Wasm memory. The generated file also sets the
+runtime version entries of the APIs, whose ids are the Blake2b-8 hashes of their names.
+
+Usage:
+
+	go run -tags nonwasmenv ./cmd/gosemble-apis -dir runtime
+*/
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/LimeChain/gosemble/apis"
+	"github.com/LimeChain/gosemble/primitives/api"
+)
+
+func main() {
+	dir := flag.String("dir", "runtime", "output directory of the generated files")
+	flag.Parse()
+
+	write(filepath.Join(*dir, "apis.go"), apis.Apis, "Apis", "")
+	write(filepath.Join(*dir, "apis_benchmarks.go"), apis.BenchmarkApis, "BenchmarkApis", "benchmarks")
+	write(filepath.Join(*dir, "apis_try_runtime.go"), apis.TryRuntimeApis, "TryRuntimeApis", "tryruntime")
+}
+
+func write(path string, list []api.Api, name string, buildTag string) {
+	source, err := generate(list, name, buildTag)
+	if err != nil {
+		log.Fatalf("failed to generate the runtime APIs: %v", err)
+	}
+
+	if err := os.WriteFile(path, source, 0644); err != nil {
+		log.Fatalf("failed to write the runtime APIs: %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+var fileTemplate = template.Must(template.New("weights").Parse(`// Code generated by gosemble-benchmark. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- if .Components}}
+	sc "github.com/LimeChain/goscale"
+{{- end}}
+{{- if .Storage}}
+	"github.com/LimeChain/gosemble/constants"
+{{- end}}
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+{{range .Functions}}
+// {{.Name}} is the weight of the ` + "`{{.Benchmark}}`" + ` benchmark.
+{{- range .Ranges}}
+// {{.}}
+{{- end}}
+func {{.Name}}({{.Params}}) types.Weight {
+	return {{.Body}}
+}
+{{end}}`))
+
+type fileData struct {
+	Package    string
+	Components bool
+	Storage    bool
+	Functions  []functionData
+}
+
+type functionData struct {
+	Name      string
+	Benchmark string
+	Ranges    []string
+	Params    string
+	Body      string
+}
+
+// generate returns the source of the weights of the benchmarks of a module, in package `pkg`.
+func generate(pkg string, weights []weight) ([]byte, error) {
+	data := fileData{Package: pkg}
+
+	for _, w := range weights {
+		var ranges, params []string
+		for _, component := range w.Components {
+			ranges = append(ranges, fmt.Sprintf("The range of component `%s` is `[%d, %d]`.", component.Name, component.Min, component.Max))
+			params = append(params, component.Name)
+		}
+		if len(params) != 0 {
+			data.Components = true
+		}
+
+		terms := []string{fmt.Sprintf("types.WeightFromParts(%s, %s)", number(w.Base), number(w.ProofSize))}
+		for i, component := range w.Components {
+			if w.Slopes[i] != 0 || w.ProofSizeSlopes[i] != 0 {
+				terms = append(terms, fmt.Sprintf("SaturatingAdd(types.WeightFromParts(%s, %s).SaturatingMul(%s))", number(w.Slopes[i]), number(w.ProofSizeSlopes[i]), component.Name))
+			}
+		}
+		storage := append(
+			storageTerms("Reads", w.Reads, w.ReadSlopes, w.Components),
+			storageTerms("Writes", w.Writes, w.WriteSlopes, w.Components)...)
+		if len(storage) != 0 {
+			data.Storage = true
+		}
+		terms = append(terms, storage...)
+
+		paramList := ""
+		if len(params) != 0 {
+			paramList = strings.Join(params, ", ") + " sc.U64"
+		}
+
+		data.Functions = append(data.Functions, functionData{
+			Name:      "weight" + camelCase(w.Benchmark),
+			Benchmark: w.Benchmark,
+			Ranges:    ranges,
+			Params:    paramList,
+			Body:      strings.Join(terms, ".\n\t\t"),
+		})
+	}
+
+	var buffer bytes.Buffer
+	if err := fileTemplate.Execute(&buffer, data); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buffer.Bytes())
+}
+
+// storageTerms returns the terms of the weight of the storage reads or writes.
+func storageTerms(kind string, base uint64, slopes []uint64, components []Component) []string {
+	var terms []string
+	if base != 0 {
+		terms = append(terms, fmt.Sprintf("SaturatingAdd(constants.DbWeight.%s(%d))", kind, base))
+	}
+
+	for i, component := range components {
+		if slopes[i] != 0 {
+			terms = append(terms, fmt.Sprintf("SaturatingAdd(constants.DbWeight.%s(sc.U64(%d).SaturatingMul(%s)))", kind, slopes[i], component.Name))
+		}
+	}
+
+	return terms
+}
+
+// number formats `value` as a Go literal, with digit separators.
+func number(value uint64) string {
+	digits := strconv.FormatUint(value, 10)
+
+	var result strings.Builder
+	for i, digit := range digits {
+		if i != 0 && (len(digits)-i)%3 == 0 {
+			result.WriteByte('_')
+		}
+		result.WriteRune(digit)
+	}
+
+	return result.String()
+}
+
+// camelCase returns the camel case form of a snake case name, e.g. `TransferKeepAlive` for
+// `transfer_keep_alive`.
+func camelCase(name string) string {
+	var result strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		result.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+
+	return result.String()
+}
+
+// snakeCase returns the snake case form of a camel case name, e.g. `transaction_payment` for
+// `TransactionPayment`.
+func snakeCase(name string) string {
+	var result strings.Builder
+	for i, r := range name {
+		if r >= 'A' && r <= 'Z' {
+			if i != 0 {
+				result.WriteByte('_')
+			}
+			r += 'a' - 'A'
+		}
+		result.WriteRune(r)
+	}
+
+	return result.String()
+}
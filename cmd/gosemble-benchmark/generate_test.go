@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_generate(t *testing.T) {
+	weights := []weight{
+		{
+			Benchmark:       "remark",
+			Components:      []Component{{Name: "b", Min: 0, Max: 3932160}},
+			Base:            2_091_000,
+			Slopes:          []uint64{362},
+			ProofSizeSlopes: []uint64{0},
+			ReadSlopes:      []uint64{0},
+			WriteSlopes:     []uint64{0},
+		},
+		{
+			Benchmark: "transfer_keep_alive",
+			Base:      49_250_000,
+			ProofSize: 3_593,
+			Reads:     1,
+			Writes:    1,
+		},
+	}
+
+	result, err := generate("dispatchables", weights)
+	assert.NoError(t, err)
+
+	assert.Equal(t, `// Code generated by gosemble-benchmark. DO NOT EDIT.
+
+package dispatchables
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// weightRemark is the weight of the `+"`remark`"+` benchmark.
+// The range of component `+"`b`"+` is `+"`[0, 3932160]`"+`.
+func weightRemark(b sc.U64) types.Weight {
+	return types.WeightFromParts(2_091_000, 0).
+		SaturatingAdd(types.WeightFromParts(362, 0).SaturatingMul(b))
+}
+
+// weightTransferKeepAlive is the weight of the `+"`transfer_keep_alive`"+` benchmark.
+func weightTransferKeepAlive() types.Weight {
+	return types.WeightFromParts(49_250_000, 3_593).
+		SaturatingAdd(constants.DbWeight.Reads(1)).
+		SaturatingAdd(constants.DbWeight.Writes(1))
+}
+`, string(result))
+}
+
+func Test_componentValues(t *testing.T) {
+	components := []Component{{Name: "a", Min: 0, Max: 10}, {Name: "b", Min: 1, Max: 3}}
+
+	assert.Equal(t, [][]uint32{{0, 3}, {5, 3}, {10, 3}, {10, 1}, {10, 2}, {10, 3}}, componentValues(components, 3))
+	assert.Equal(t, [][]uint32{{}}, componentValues(nil, 3))
+}
+
+func Test_number(t *testing.T) {
+	assert.Equal(t, "0", number(0))
+	assert.Equal(t, "362", number(362))
+	assert.Equal(t, "2_091_000", number(2091000))
+}
+
+func Test_snakeCase(t *testing.T) {
+	assert.Equal(t, "balances", snakeCase("Balances"))
+	assert.Equal(t, "transaction_payment", snakeCase("TransactionPayment"))
+}
@@ -0,0 +1,82 @@
+/*
+Benchmarks the dispatchables of the Go runtime and generates their weights.
+
+The runtime must be built with the `benchmarks` tag, which exports the Benchmark API. Each
+benchmark is run for a number of values of each of its components, from its minimum to its
+maximum, with the other components at their maximum. The time of a run is the time of the whole
+benchmark less the time of its setup. The time and the storage reads and writes are fitted with
+linear regressions of the values of the components, from which a weights.go is generated for each
+module.
+
+Usage:
+
+	TAGS="benchmarks" make build
+	go run ./cmd/gosemble-benchmark -runtime build/runtime.wasm -steps 10 -repeat 20
+*/
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	runtimePath := flag.String("runtime", "build/runtime.wasm", "path to the Wasm runtime, built with the benchmarks tag")
+	module := flag.String("module", "", "name of the module to benchmark, all modules if empty")
+	steps := flag.Int("steps", 10, "number of values of each component")
+	repeat := flag.Int("repeat", 20, "number of runs for each value")
+	output := flag.String("output", "frame/{module}/dispatchables/weights.go", "output path of the weights of each module, where {module} is the snake case name of the module")
+	flag.Parse()
+
+	code, err := os.ReadFile(*runtimePath)
+	if err != nil {
+		log.Fatalf("failed to read the runtime: %v", err)
+	}
+
+	runner, err := newRunner(code)
+	if err != nil {
+		log.Fatalf("failed to instantiate the runtime: %v", err)
+	}
+	defer runner.stop()
+
+	benchmarks, err := runner.benchmarks()
+	if err != nil {
+		log.Fatalf("failed to list the benchmarks: %v", err)
+	}
+
+	modules := map[string][]weight{}
+	var names []string
+	for _, benchmark := range benchmarks {
+		if *module != "" && benchmark.ModuleName != *module {
+			continue
+		}
+
+		log.Printf("benchmarking %s %s", benchmark.ModuleName, benchmark.Benchmark)
+		samples, err := runner.run(benchmark, *steps, *repeat)
+		if err != nil {
+			log.Fatalf("failed to run benchmark %s %s: %v", benchmark.ModuleName, benchmark.Benchmark, err)
+		}
+
+		if modules[benchmark.ModuleName] == nil {
+			names = append(names, benchmark.ModuleName)
+		}
+		modules[benchmark.ModuleName] = append(modules[benchmark.ModuleName], fit(benchmark, samples))
+	}
+
+	for _, name := range names {
+		path := strings.ReplaceAll(*output, "{module}", snakeCase(name))
+
+		source, err := generate(filepath.Base(filepath.Dir(path)), modules[name])
+		if err != nil {
+			log.Fatalf("failed to generate the weights of %s: %v", name, err)
+		}
+
+		if err := os.WriteFile(path, source, 0644); err != nil {
+			log.Fatalf("failed to write the weights of %s: %v", name, err)
+		}
+		log.Printf("wrote the weights of %s to %s", name, path)
+	}
+}
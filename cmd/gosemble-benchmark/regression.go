@@ -0,0 +1,165 @@
+package main
+
+import "math"
+
+// weight is the fitted weight of a benchmark: a base and a slope for each component, for the
+// time, in reference time units, for the proof size, in bytes, and for the storage reads and writes.
+type weight struct {
+	Benchmark       string
+	Components      []Component
+	Base            uint64
+	Slopes          []uint64
+	ProofSize       uint64
+	ProofSizeSlopes []uint64
+	Reads           uint64
+	ReadSlopes      []uint64
+	Writes          uint64
+	WriteSlopes     []uint64
+}
+
+// refTimePerNanos is the reference time of a nanosecond.
+const refTimePerNanos = 1_000
+
+// fit fits the weight of `benchmark` from its samples.
+func fit(benchmark Benchmark, samples []sample) weight {
+	xs := make([][]float64, len(samples))
+	times := make([]float64, len(samples))
+	reads := make([]float64, len(samples))
+	writes := make([]float64, len(samples))
+	proofSizes := make([]float64, len(samples))
+	for i, s := range samples {
+		xs[i] = make([]float64, len(s.values))
+		for j, value := range s.values {
+			xs[i][j] = float64(value)
+		}
+		times[i] = s.time * refTimePerNanos
+		reads[i] = float64(s.reads)
+		writes[i] = float64(s.writes)
+		proofSizes[i] = float64(s.proofSize)
+	}
+
+	base, slopes := regress(xs, times, len(benchmark.Components))
+	readsBase, readSlopes := regress(xs, reads, len(benchmark.Components))
+	writesBase, writeSlopes := regress(xs, writes, len(benchmark.Components))
+	proofSizeBase, proofSizeSlopes := regress(xs, proofSizes, len(benchmark.Components))
+
+	return weight{
+		Benchmark:       benchmark.Benchmark,
+		Components:      benchmark.Components,
+		Base:            round(base),
+		Slopes:          roundAll(slopes),
+		ProofSize:       round(proofSizeBase),
+		ProofSizeSlopes: roundAll(proofSizeSlopes),
+		Reads:           round(readsBase),
+		ReadSlopes:      roundAll(readSlopes),
+		Writes:          round(writesBase),
+		WriteSlopes:     roundAll(writeSlopes),
+	}
+}
+
+// regress fits ys = intercept + sum(slopes[j] * xs[j]) with least squares. The slope of a
+// component whose values do not vary is zero.
+func regress(xs [][]float64, ys []float64, components int) (float64, []float64) {
+	slopes := make([]float64, components)
+	if len(ys) == 0 {
+		return 0, slopes
+	}
+
+	// The columns of the components which vary, preceded by the intercept.
+	columns := []int{-1}
+	for j := 0; j < components; j++ {
+		for i := range xs {
+			if xs[i][j] != xs[0][j] {
+				columns = append(columns, j)
+				break
+			}
+		}
+	}
+
+	at := func(i, column int) float64 {
+		if column < 0 {
+			return 1
+		}
+		return xs[i][column]
+	}
+
+	// Normal equations (XᵀX) b = Xᵀy, as an augmented matrix.
+	n := len(columns)
+	matrix := make([][]float64, n)
+	for r := 0; r < n; r++ {
+		matrix[r] = make([]float64, n+1)
+		for c := 0; c < n; c++ {
+			for i := range ys {
+				matrix[r][c] += at(i, columns[r]) * at(i, columns[c])
+			}
+		}
+		for i := range ys {
+			matrix[r][n] += at(i, columns[r]) * ys[i]
+		}
+	}
+
+	coefficients := solve(matrix)
+	for k, column := range columns[1:] {
+		slopes[column] = coefficients[k+1]
+	}
+
+	return coefficients[0], slopes
+}
+
+// solve solves the augmented matrix with Gaussian elimination with partial pivoting.
+func solve(matrix [][]float64) []float64 {
+	n := len(matrix)
+	for c := 0; c < n; c++ {
+		pivot := c
+		for r := c + 1; r < n; r++ {
+			if math.Abs(matrix[r][c]) > math.Abs(matrix[pivot][c]) {
+				pivot = r
+			}
+		}
+		matrix[c], matrix[pivot] = matrix[pivot], matrix[c]
+
+		if matrix[c][c] == 0 {
+			continue
+		}
+
+		for r := c + 1; r < n; r++ {
+			factor := matrix[r][c] / matrix[c][c]
+			for k := c; k <= n; k++ {
+				matrix[r][k] -= factor * matrix[c][k]
+			}
+		}
+	}
+
+	result := make([]float64, n)
+	for r := n - 1; r >= 0; r-- {
+		if matrix[r][r] == 0 {
+			continue
+		}
+
+		sum := matrix[r][n]
+		for k := r + 1; k < n; k++ {
+			sum -= matrix[r][k] * result[k]
+		}
+		result[r] = sum / matrix[r][r]
+	}
+
+	return result
+}
+
+// round rounds a fitted coefficient to a weight, with negative coefficients as zero.
+func round(value float64) uint64 {
+	if value <= 0 {
+		return 0
+	}
+
+	return uint64(math.Round(value))
+}
+
+func roundAll(values []float64) []uint64 {
+	result := make([]uint64, len(values))
+	for i, value := range values {
+		result[i] = round(value)
+	}
+
+	return result
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_regress(t *testing.T) {
+	xs := [][]float64{{0, 10}, {5, 10}, {10, 10}, {10, 0}, {10, 5}}
+	ys := []float64{100 + 0 + 30, 100 + 10 + 30, 100 + 20 + 30, 100 + 20 + 0, 100 + 20 + 15}
+
+	intercept, slopes := regress(xs, ys, 2)
+
+	assert.InDelta(t, 100, intercept, 1e-6)
+	assert.InDelta(t, 2, slopes[0], 1e-6)
+	assert.InDelta(t, 3, slopes[1], 1e-6)
+}
+
+func Test_regress_ConstantComponent(t *testing.T) {
+	xs := [][]float64{{7}, {7}, {7}}
+	ys := []float64{10, 12, 14}
+
+	intercept, slopes := regress(xs, ys, 1)
+
+	assert.InDelta(t, 12, intercept, 1e-6)
+	assert.Equal(t, []float64{0}, slopes)
+}
+
+func Test_fit(t *testing.T) {
+	benchmark := Benchmark{Benchmark: "remark", Components: []Component{{Name: "b", Min: 0, Max: 100}}}
+	samples := []sample{
+		{values: []uint32{0}, time: 2_000, reads: 1, writes: 0, proofSize: 503},
+		{values: []uint32{50}, time: 2_050, reads: 1, writes: 0, proofSize: 553},
+		{values: []uint32{100}, time: 2_100, reads: 1, writes: 0, proofSize: 603},
+	}
+
+	result := fit(benchmark, samples)
+
+	assert.Equal(t, uint64(2_000_000), result.Base)
+	assert.Equal(t, []uint64{1_000}, result.Slopes)
+	assert.Equal(t, uint64(503), result.ProofSize)
+	assert.Equal(t, []uint64{1}, result.ProofSizeSlopes)
+	assert.Equal(t, uint64(1), result.Reads)
+	assert.Equal(t, []uint64{0}, result.ReadSlopes)
+	assert.Equal(t, uint64(0), result.Writes)
+}
+
+func Test_round_Negative(t *testing.T) {
+	assert.Equal(t, uint64(0), round(-3.2))
+	assert.Equal(t, uint64(4), round(3.5))
+}
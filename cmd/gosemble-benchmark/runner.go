@@ -0,0 +1,215 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ChainSafe/gossamer/lib/keystore"
+	"github.com/ChainSafe/gossamer/lib/runtime/storage"
+	"github.com/ChainSafe/gossamer/lib/runtime/wasmer"
+	"github.com/ChainSafe/gossamer/lib/trie"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+)
+
+// Component is a parameter of a benchmark, as returned by `Benchmark_benchmark_metadata`.
+type Component struct {
+	Name string
+	Min  uint32
+	Max  uint32
+}
+
+// Benchmark is a benchmark of a module, as returned by `Benchmark_benchmark_metadata`.
+type Benchmark struct {
+	Module     uint8
+	ModuleName string
+	Benchmark  string
+	Components []Component
+}
+
+// BenchmarkConfig is the argument of `Benchmark_dispatch_benchmark`.
+type BenchmarkConfig struct {
+	Module    uint8
+	Benchmark string
+	Values    []uint32
+	SetupOnly bool
+}
+
+// BenchmarkResult is the storage reads and writes and the estimated proof size of a benchmarked call.
+type BenchmarkResult struct {
+	Reads     uint32
+	Writes    uint32
+	ProofSize uint64
+}
+
+// sample is the median time, in nanoseconds, the storage accesses and the proof size of the
+// benchmarked call for the values of the components.
+type sample struct {
+	values    []uint32
+	time      float64
+	reads     uint32
+	writes    uint32
+	proofSize uint64
+}
+
+type runner struct {
+	instance *wasmer.Instance
+}
+
+func newRunner(code []byte) (*runner, error) {
+	instance, err := wasmer.NewInstance(code, wasmer.Config{
+		Storage:  storage.NewTrieState(trie.NewEmptyTrie()),
+		Keystore: keystore.NewGlobalKeystore(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &runner{instance: instance}, nil
+}
+
+func (r *runner) stop() {
+	r.instance.Stop()
+}
+
+// benchmarks returns the benchmarks of the runtime.
+func (r *runner) benchmarks() ([]Benchmark, error) {
+	result, err := r.instance.Exec("Benchmark_benchmark_metadata", []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var benchmarks []Benchmark
+	if err := scale.Unmarshal(result, &benchmarks); err != nil {
+		return nil, err
+	}
+
+	return benchmarks, nil
+}
+
+// run runs `benchmark` `repeat` times for `steps` values of each of its components.
+func (r *runner) run(benchmark Benchmark, steps int, repeat int) ([]sample, error) {
+	var samples []sample
+	for _, values := range componentValues(benchmark.Components, steps) {
+		times := make([]float64, 0, repeat)
+		var result BenchmarkResult
+
+		for i := 0; i < repeat; i++ {
+			setupTime, _, err := r.dispatch(benchmark, values, true)
+			if err != nil {
+				return nil, err
+			}
+
+			totalTime, res, err := r.dispatch(benchmark, values, false)
+			if err != nil {
+				return nil, err
+			}
+
+			times = append(times, float64(totalTime-setupTime)/float64(time.Nanosecond))
+			result = res
+		}
+
+		samples = append(samples, sample{
+			values:    values,
+			time:      median(times),
+			reads:     result.Reads,
+			writes:    result.Writes,
+			proofSize: result.ProofSize,
+		})
+	}
+
+	return samples, nil
+}
+
+// dispatch runs `benchmark` once. Returns the time of the run and the storage accesses of the
+// benchmarked call.
+func (r *runner) dispatch(benchmark Benchmark, values []uint32, setupOnly bool) (time.Duration, BenchmarkResult, error) {
+	args, err := scale.Marshal(BenchmarkConfig{
+		Module:    benchmark.Module,
+		Benchmark: benchmark.Benchmark,
+		Values:    values,
+		SetupOnly: setupOnly,
+	})
+	if err != nil {
+		return 0, BenchmarkResult{}, err
+	}
+
+	start := time.Now()
+	result, err := r.instance.Exec("Benchmark_dispatch_benchmark", args)
+	elapsed := time.Since(start)
+	if err != nil {
+		return 0, BenchmarkResult{}, err
+	}
+
+	benchmarkResult, err := decodeResult(result)
+
+	return elapsed, benchmarkResult, err
+}
+
+// decodeResult decodes the `Result<BenchmarkResult, String>` returned by
+// `Benchmark_dispatch_benchmark`.
+func decodeResult(result []byte) (BenchmarkResult, error) {
+	if len(result) == 0 {
+		return BenchmarkResult{}, errors.New("empty benchmark result")
+	}
+
+	if result[0] == 1 {
+		var message string
+		if err := scale.Unmarshal(result[1:], &message); err != nil {
+			return BenchmarkResult{}, err
+		}
+
+		return BenchmarkResult{}, fmt.Errorf("benchmark failed: %s", message)
+	}
+
+	var benchmarkResult BenchmarkResult
+	err := scale.Unmarshal(result[1:], &benchmarkResult)
+
+	return benchmarkResult, err
+}
+
+// componentValues returns the values of the components for each run. Each component takes
+// `steps` values from its minimum to its maximum, while the other components are at their
+// maximum. A benchmark without components is run with no values.
+func componentValues(components []Component, steps int) [][]uint32 {
+	if len(components) == 0 {
+		return [][]uint32{{}}
+	}
+
+	if steps < 2 {
+		steps = 2
+	}
+
+	var result [][]uint32
+	for i, component := range components {
+		for step := 0; step < steps; step++ {
+			values := make([]uint32, len(components))
+			for j, other := range components {
+				values[j] = other.Max
+			}
+
+			span := uint64(component.Max - component.Min)
+			values[i] = component.Min + uint32(span*uint64(step)/uint64(steps-1))
+
+			result = append(result, values)
+		}
+	}
+
+	return result
+}
+
+func median(values []float64) float64 {
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	n := len(sorted)
+	if n == 0 {
+		return 0
+	}
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
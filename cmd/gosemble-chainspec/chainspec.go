@@ -0,0 +1,201 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ChainSafe/gossamer/lib/keystore"
+	"github.com/ChainSafe/gossamer/lib/runtime/storage"
+	"github.com/ChainSafe/gossamer/lib/runtime/wasmer"
+	"github.com/ChainSafe/gossamer/lib/trie"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+)
+
+// ChainSpec is a chain specification, as loaded by Substrate and Gossamer nodes.
+type ChainSpec struct {
+	Name               string                 `json:"name"`
+	Id                 string                 `json:"id"`
+	ChainType          string                 `json:"chainType"`
+	BootNodes          []string               `json:"bootNodes"`
+	TelemetryEndpoints []interface{}          `json:"telemetryEndpoints"`
+	ProtocolId         string                 `json:"protocolId"`
+	Properties         map[string]interface{} `json:"properties"`
+	ForkBlocks         []string               `json:"forkBlocks"`
+	BadBlocks          []string               `json:"badBlocks"`
+	CodeSubstitutes    map[string]string      `json:"codeSubstitutes"`
+	Genesis            Genesis                `json:"genesis"`
+}
+
+// Genesis is the genesis state of a chain, either as the runtime code and genesis config (plain form)
+// or as the storage built from them (raw form).
+type Genesis struct {
+	RuntimeGenesis *RuntimeGenesis `json:"runtimeGenesis,omitempty"`
+	Raw            *RawGenesis     `json:"raw,omitempty"`
+}
+
+// RuntimeGenesis is the hex encoded Wasm code of the runtime and its genesis config.
+type RuntimeGenesis struct {
+	Code   string                     `json:"code"`
+	Config map[string]json.RawMessage `json:"config"`
+}
+
+// RawGenesis is the genesis storage, with hex encoded keys and values.
+type RawGenesis struct {
+	Top             map[string]string            `json:"top"`
+	ChildrenDefault map[string]map[string]string `json:"childrenDefault"`
+}
+
+// Options are the chain properties which are not part of the genesis state.
+type Options struct {
+	Name       string
+	Id         string
+	ChainType  string
+	ProtocolId string
+	Properties map[string]interface{}
+}
+
+// withCode returns the runtime genesis config with the Wasm `code` of the runtime set as the
+// code of the system module.
+func withCode(gc map[string]json.RawMessage, code []byte) (map[string]json.RawMessage, error) {
+	system := map[string]json.RawMessage{}
+	if gc["system"] != nil {
+		if err := json.Unmarshal(gc["system"], &system); err != nil {
+			return nil, err
+		}
+	}
+
+	codeJson, err := json.Marshal(hexCode(code))
+	if err != nil {
+		return nil, err
+	}
+	system["code"] = codeJson
+
+	systemJson, err := json.Marshal(system)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]json.RawMessage{}
+	for module, config := range gc {
+		result[module] = config
+	}
+	result["system"] = systemJson
+
+	return result, nil
+}
+
+// hexCode returns the hex encoded Wasm `code` of the runtime.
+func hexCode(code []byte) string {
+	return "0x" + hex.EncodeToString(code)
+}
+
+// buildStorage builds the genesis storage from the runtime genesis config by calling the
+// genesis builder of the runtime.
+func buildStorage(code []byte, gc map[string]json.RawMessage) (map[string][]byte, error) {
+	trieState := storage.NewTrieState(trie.NewEmptyTrie())
+
+	instance, err := wasmer.NewInstance(code, wasmer.Config{
+		Storage:  trieState,
+		Keystore: keystore.NewGlobalKeystore(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer instance.Stop()
+
+	gcJson, err := json.Marshal(gc)
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := scale.Marshal(gcJson)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := instance.Exec("GenesisBuilder_build_config", args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decodeBuildResult(result); err != nil {
+		return nil, err
+	}
+
+	return trieState.TrieEntries(), nil
+}
+
+// decodeBuildResult decodes the `Result<(), String>` returned by `GenesisBuilder_build_config`.
+func decodeBuildResult(result []byte) error {
+	if len(result) == 0 {
+		return errors.New("empty genesis builder result")
+	}
+
+	if result[0] == 0 {
+		return nil
+	}
+
+	var message string
+	if err := scale.Unmarshal(result[1:], &message); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("invalid genesis config: %s", message)
+}
+
+// rawGenesis returns the raw form of the genesis storage.
+func rawGenesis(entries map[string][]byte) *RawGenesis {
+	top := map[string]string{}
+	for key, value := range entries {
+		top["0x"+hex.EncodeToString([]byte(key))] = "0x" + hex.EncodeToString(value)
+	}
+
+	return &RawGenesis{
+		Top:             top,
+		ChildrenDefault: map[string]map[string]string{},
+	}
+}
+
+// newChainSpec returns a chain spec with the given genesis state.
+func newChainSpec(options Options, genesis Genesis) ChainSpec {
+	return ChainSpec{
+		Name:               options.Name,
+		Id:                 options.Id,
+		ChainType:          options.ChainType,
+		BootNodes:          []string{},
+		TelemetryEndpoints: nil,
+		ProtocolId:         options.ProtocolId,
+		Properties:         options.Properties,
+		ForkBlocks:         nil,
+		BadBlocks:          nil,
+		CodeSubstitutes:    map[string]string{},
+		Genesis:            genesis,
+	}
+}
+
+// Generate returns the chain spec of the runtime `code` with the runtime genesis config `gcJson`,
+// in plain and raw form. The plain form holds the code next to the genesis config, as in
+// `genesis.runtimeGenesis`, and the code is set in the system module config only to build the raw form.
+func Generate(code []byte, gcJson []byte, options Options) (plain ChainSpec, raw ChainSpec, err error) {
+	gc := map[string]json.RawMessage{}
+	if err := json.Unmarshal(gcJson, &gc); err != nil {
+		return plain, raw, err
+	}
+
+	gcWithCode, err := withCode(gc, code)
+	if err != nil {
+		return plain, raw, err
+	}
+
+	entries, err := buildStorage(code, gcWithCode)
+	if err != nil {
+		return plain, raw, err
+	}
+
+	plain = newChainSpec(options, Genesis{RuntimeGenesis: &RuntimeGenesis{Code: hexCode(code), Config: gc}})
+	raw = newChainSpec(options, Genesis{Raw: rawGenesis(entries)})
+
+	return plain, raw, nil
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_withCode(t *testing.T) {
+	gc := map[string]json.RawMessage{
+		"system":   json.RawMessage(`{"code":"0x"}`),
+		"balances": json.RawMessage(`{"balances":[]}`),
+	}
+
+	result, err := withCode(gc, []byte{0, 97, 115, 109})
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `{"code":"0x0061736d"}`, string(result["system"]))
+	assert.JSONEq(t, `{"balances":[]}`, string(result["balances"]))
+	assert.JSONEq(t, `{"code":"0x"}`, string(gc["system"]))
+}
+
+func Test_withCode_NoSystem(t *testing.T) {
+	result, err := withCode(map[string]json.RawMessage{}, []byte{1, 2})
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `{"code":"0x0102"}`, string(result["system"]))
+}
+
+func Test_withCode_InvalidSystem(t *testing.T) {
+	_, err := withCode(map[string]json.RawMessage{"system": json.RawMessage(`[]`)}, []byte{1, 2})
+	assert.Error(t, err)
+}
+
+func Test_decodeBuildResult(t *testing.T) {
+	assert.NoError(t, decodeBuildResult([]byte{0}))
+
+	message, err := scale.Marshal("unknown module in genesis config: foo")
+	assert.NoError(t, err)
+
+	err = decodeBuildResult(append([]byte{1}, message...))
+	assert.EqualError(t, err, "invalid genesis config: unknown module in genesis config: foo")
+
+	assert.Error(t, decodeBuildResult([]byte{}))
+}
+
+func Test_rawGenesis(t *testing.T) {
+	raw := rawGenesis(map[string][]byte{
+		":code": {0, 97, 115, 109},
+		"key":   {},
+	})
+
+	expected := &RawGenesis{
+		Top: map[string]string{
+			"0x3a636f6465": "0x0061736d",
+			"0x6b6579":     "0x",
+		},
+		ChildrenDefault: map[string]map[string]string{},
+	}
+	assert.Equal(t, expected, raw)
+}
+
+func Test_Generate(t *testing.T) {
+	code, err := os.ReadFile("../../build/runtime.wasm")
+	assert.NoError(t, err)
+
+	plain, raw, err := Generate(code, []byte(`{"balances":{"balances":[]}}`), Options{Name: "Development"})
+	assert.NoError(t, err)
+
+	assert.Nil(t, plain.Genesis.Raw)
+	assert.Equal(t, hexCode(code), plain.Genesis.RuntimeGenesis.Code)
+	assert.Equal(t, map[string]json.RawMessage{"balances": json.RawMessage(`{"balances":[]}`)}, plain.Genesis.RuntimeGenesis.Config)
+
+	assert.Nil(t, raw.Genesis.RuntimeGenesis)
+	assert.Equal(t, hexCode(code), raw.Genesis.Raw.Top["0x3a636f6465"])
+}
+
+func Test_Generate_InvalidConfig(t *testing.T) {
+	_, _, err := Generate([]byte{}, []byte(`[]`), Options{})
+	assert.Error(t, err)
+}
+
+func Test_newChainSpec(t *testing.T) {
+	options := Options{
+		Name:       "Development",
+		Id:         "dev",
+		ChainType:  "Development",
+		Properties: map[string]interface{}{"tokenSymbol": "UNIT"},
+	}
+
+	chainSpec := newChainSpec(options, Genesis{Raw: rawGenesis(map[string][]byte{"key": {1}})})
+
+	b, err := json.Marshal(chainSpec)
+	assert.NoError(t, err)
+
+	expected := `{
+		"name": "Development",
+		"id": "dev",
+		"chainType": "Development",
+		"bootNodes": [],
+		"telemetryEndpoints": null,
+		"protocolId": "",
+		"properties": {"tokenSymbol": "UNIT"},
+		"forkBlocks": null,
+		"badBlocks": null,
+		"codeSubstitutes": {},
+		"genesis": {"raw": {"top": {"0x6b6579": "0x01"}, "childrenDefault": {}}}
+	}`
+	assert.JSONEq(t, expected, string(b))
+}
+
+func Test_newChainSpec_Plain(t *testing.T) {
+	genesis := Genesis{
+		RuntimeGenesis: &RuntimeGenesis{
+			Code:   hexCode([]byte{0, 97, 115, 109}),
+			Config: map[string]json.RawMessage{"balances": json.RawMessage(`{"balances":[]}`)},
+		},
+	}
+
+	b, err := json.Marshal(newChainSpec(Options{}, genesis).Genesis)
+	assert.NoError(t, err)
+
+	expected := `{"runtimeGenesis": {"code": "0x0061736d", "config": {"balances": {"balances": []}}}}`
+	assert.JSONEq(t, expected, string(b))
+}
@@ -0,0 +1,77 @@
+/*
+Generates a chain spec from the Go runtime, in plain and raw form.
+
+The genesis storage is built by calling the GenesisBuilder API of the runtime with the given
+runtime genesis config, which holds the genesis config of each module under its camel case name.
+The Wasm code of the runtime is set as the code of the system module. The plain chain spec holds
+the code and the genesis config under genesis.runtimeGenesis.
+
+Usage:
+
+	go run ./cmd/gosemble-chainspec -runtime build/runtime.wasm -genesis genesis.json
+*/
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+)
+
+func main() {
+	runtimePath := flag.String("runtime", "build/runtime.wasm", "path to the Wasm runtime")
+	genesisPath := flag.String("genesis", "", "path to the runtime genesis config JSON, the default genesis config of the runtime if empty")
+	plainPath := flag.String("plain", "build/chain-spec.json", "output path of the plain chain spec")
+	rawPath := flag.String("raw", "build/chain-spec-raw.json", "output path of the raw chain spec")
+	name := flag.String("name", "Development", "name of the chain")
+	id := flag.String("id", "dev", "id of the chain")
+	chainType := flag.String("chain-type", "Development", "type of the chain: Development, Local or Live")
+	protocolId := flag.String("protocol-id", "", "network protocol id of the chain")
+	tokenSymbol := flag.String("token-symbol", "UNIT", "symbol of the native token")
+	tokenDecimals := flag.Int("token-decimals", 10, "decimals of the native token")
+	flag.Parse()
+
+	code, err := os.ReadFile(*runtimePath)
+	if err != nil {
+		log.Fatalf("failed to read the runtime: %v", err)
+	}
+
+	gcJson := []byte("{}")
+	if *genesisPath != "" {
+		gcJson, err = os.ReadFile(*genesisPath)
+		if err != nil {
+			log.Fatalf("failed to read the genesis config: %v", err)
+		}
+	}
+
+	options := Options{
+		Name:       *name,
+		Id:         *id,
+		ChainType:  *chainType,
+		ProtocolId: *protocolId,
+		Properties: map[string]interface{}{
+			"tokenSymbol":   *tokenSymbol,
+			"tokenDecimals": *tokenDecimals,
+		},
+	}
+
+	plain, raw, err := Generate(code, gcJson, options)
+	if err != nil {
+		log.Fatalf("failed to generate the chain spec: %v", err)
+	}
+
+	write(*plainPath, plain)
+	write(*rawPath, raw)
+}
+
+func write(path string, chainSpec ChainSpec) {
+	b, err := json.MarshalIndent(chainSpec, "", "  ")
+	if err != nil {
+		log.Fatalf("failed to encode the chain spec: %v", err)
+	}
+
+	if err := os.WriteFile(path, b, 0644); err != nil {
+		log.Fatalf("failed to write the chain spec: %v", err)
+	}
+}
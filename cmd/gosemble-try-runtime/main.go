@@ -0,0 +1,93 @@
+/*
+Tests a runtime upgrade or the execution of a block of the Go runtime against a state snapshot.
+
+The runtime must be built with the `tryruntime` tag, which exports the TryRuntime API. The state
+snapshot is a JSON file of the key/value pairs of the state, as hex strings, either an array of
+[key, value] pairs, as returned by the `state_getPairs` RPC, or an object of keys to values.
+
+	on-runtime-upgrade  executes the migrations of the runtime, with their pre and post upgrade
+	                    checks, and reports the weight consumed
+	execute-block       executes a block on the state of its parent, with or without checking its
+	                    state root, and reports the weight consumed
+
+Usage:
+
+	TAGS="tryruntime" make build
+	go run ./cmd/gosemble-try-runtime on-runtime-upgrade -runtime build/runtime.wasm -snapshot state.json
+	go run ./cmd/gosemble-try-runtime execute-block -runtime build/runtime.wasm -snapshot state.json -block block.hex
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal("expected a command: on-runtime-upgrade or execute-block")
+	}
+
+	flags := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	runtimePath := flags.String("runtime", "build/runtime.wasm", "path to the Wasm runtime, built with the tryruntime tag")
+	snapshotPath := flags.String("snapshot", "", "path to the JSON state snapshot")
+
+	switch os.Args[1] {
+	case "on-runtime-upgrade":
+		checks := flags.Bool("checks", true, "execute the pre and post upgrade checks of the migrations")
+		_ = flags.Parse(os.Args[2:])
+
+		instance := newInstanceOrExit(*runtimePath, *snapshotPath)
+		defer instance.stop()
+
+		used, max, err := instance.onRuntimeUpgrade(*checks)
+		if err != nil {
+			log.Fatalf("runtime upgrade failed: %v", err)
+		}
+
+		fmt.Printf("runtime upgrade weight: %s\n", used.describe(max))
+	case "execute-block":
+		blockPath := flags.String("block", "", "path to the hex SCALE-encoded block")
+		stateRootCheck := flags.Bool("state-root-check", true, "check the state root of the executed block")
+		_ = flags.Parse(os.Args[2:])
+
+		block, err := readHexFile(*blockPath)
+		if err != nil {
+			log.Fatalf("failed to read the block: %v", err)
+		}
+
+		instance := newInstanceOrExit(*runtimePath, *snapshotPath)
+		defer instance.stop()
+
+		used, err := instance.executeBlock(block, *stateRootCheck)
+		if err != nil {
+			log.Fatalf("block execution failed: %v", err)
+		}
+
+		fmt.Printf("block weight: ref time %d, proof size %d\n", used.RefTime, used.ProofSize)
+	default:
+		log.Fatalf("unknown command %s, expected on-runtime-upgrade or execute-block", os.Args[1])
+	}
+}
+
+func newInstanceOrExit(runtimePath string, snapshotPath string) *instance {
+	code, err := os.ReadFile(runtimePath)
+	if err != nil {
+		log.Fatalf("failed to read the runtime: %v", err)
+	}
+
+	pairs, err := loadSnapshot(snapshotPath)
+	if err != nil {
+		log.Fatalf("failed to load the state snapshot: %v", err)
+	}
+	log.Printf("loaded %d keys from the state snapshot", len(pairs))
+
+	instance, err := newInstance(code, pairs)
+	if err != nil {
+		log.Fatalf("failed to instantiate the runtime: %v", err)
+	}
+
+	return instance
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ChainSafe/gossamer/lib/keystore"
+	"github.com/ChainSafe/gossamer/lib/runtime/storage"
+	"github.com/ChainSafe/gossamer/lib/runtime/wasmer"
+	"github.com/ChainSafe/gossamer/lib/trie"
+)
+
+// instance is an instance of the runtime on the state of a snapshot.
+type instance struct {
+	wasmer *wasmer.Instance
+}
+
+// newInstance instantiates the runtime `code` on the in-memory trie of the snapshot `pairs`.
+func newInstance(code []byte, pairs map[string][]byte) (*instance, error) {
+	trieState := storage.NewTrieState(trie.NewEmptyTrie())
+	for key, value := range pairs {
+		if err := trieState.Put([]byte(key), value); err != nil {
+			return nil, err
+		}
+	}
+
+	wasmerInstance, err := wasmer.NewInstance(code, wasmer.Config{
+		Storage:  trieState,
+		Keystore: keystore.NewGlobalKeystore(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &instance{wasmer: wasmerInstance}, nil
+}
+
+func (i *instance) stop() {
+	i.wasmer.Stop()
+}
+
+// onRuntimeUpgrade calls `TryRuntime_on_runtime_upgrade`. Returns the weight consumed by the
+// upgrade and the maximum weight of a block.
+func (i *instance) onRuntimeUpgrade(checks bool) (weight, weight, error) {
+	result, err := i.wasmer.Exec("TryRuntime_on_runtime_upgrade", []byte{encodeBool(checks)})
+	if err != nil {
+		return weight{}, weight{}, err
+	}
+
+	used, rest, err := decodeWeight(result)
+	if err != nil {
+		return weight{}, weight{}, err
+	}
+
+	max, _, err := decodeWeight(rest)
+
+	return used, max, err
+}
+
+// executeBlock calls `TryRuntime_execute_block` with the SCALE-encoded `block`. Returns the
+// weight consumed by the block.
+func (i *instance) executeBlock(block []byte, stateRootCheck bool) (weight, error) {
+	args := append(append([]byte{}, block...), encodeBool(stateRootCheck))
+
+	result, err := i.wasmer.Exec("TryRuntime_execute_block", args)
+	if err != nil {
+		return weight{}, err
+	}
+
+	used, _, err := decodeWeight(result)
+
+	return used, err
+}
+
+// weight is a two-dimensional weight, as returned by the TryRuntime API.
+type weight struct {
+	RefTime   uint64
+	ProofSize uint64
+}
+
+// describe describes the weight as a portion of the maximum weight of a block.
+func (w weight) describe(max weight) string {
+	return fmt.Sprintf("ref time %d (%s of the block), proof size %d (%s of the block)",
+		w.RefTime, percent(w.RefTime, max.RefTime), w.ProofSize, percent(w.ProofSize, max.ProofSize))
+}
+
+func percent(value uint64, max uint64) string {
+	if max == 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%.2f%%", float64(value)/float64(max)*100)
+}
+
+// decodeWeight decodes a weight, whose parts are compact encoded. Returns the remaining bytes.
+func decodeWeight(data []byte) (weight, []byte, error) {
+	refTime, data, err := decodeCompact(data)
+	if err != nil {
+		return weight{}, nil, err
+	}
+
+	proofSize, data, err := decodeCompact(data)
+	if err != nil {
+		return weight{}, nil, err
+	}
+
+	return weight{RefTime: refTime, ProofSize: proofSize}, data, nil
+}
+
+// decodeCompact decodes a SCALE compact encoded integer of up to 64 bits. Returns the remaining bytes.
+func decodeCompact(data []byte) (uint64, []byte, error) {
+	if len(data) == 0 {
+		return 0, nil, errors.New("unexpected end of compact integer")
+	}
+
+	var length int
+	switch data[0] & 0b11 {
+	case 0b00:
+		return uint64(data[0] >> 2), data[1:], nil
+	case 0b01:
+		length = 2
+	case 0b10:
+		length = 4
+	default:
+		length = int(data[0]>>2) + 4 + 1
+		if length > 9 {
+			return 0, nil, errors.New("compact integer exceeds 64 bits")
+		}
+	}
+
+	if len(data) < length {
+		return 0, nil, errors.New("unexpected end of compact integer")
+	}
+
+	var value uint64
+	start := 0
+	if length > 4 {
+		start = 1
+	}
+	for j := length - 1; j >= start; j-- {
+		value = value<<8 | uint64(data[j])
+	}
+	if length <= 4 {
+		value >>= 2
+	}
+
+	return value, data[length:], nil
+}
+
+func encodeBool(value bool) byte {
+	if value {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_decodeCompact(t *testing.T) {
+	for _, tc := range []struct {
+		encoded  []byte
+		expected uint64
+	}{
+		{[]byte{0x00}, 0},
+		{[]byte{0xfc}, 63},
+		{[]byte{0x01, 0x01}, 64},
+		{[]byte{0xfe, 0xff, 0xff, 0xff}, 1<<30 - 1},
+		{[]byte{0x03, 0x00, 0x00, 0x00, 0x40}, 1 << 30},
+		{[]byte{0x13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, 1<<64 - 1},
+	} {
+		value, rest, err := decodeCompact(append(tc.encoded, 0xaa))
+
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, value)
+		assert.Equal(t, []byte{0xaa}, rest)
+	}
+}
+
+func Test_decodeCompact_Invalid(t *testing.T) {
+	_, _, err := decodeCompact([]byte{})
+	assert.Error(t, err)
+
+	_, _, err = decodeCompact([]byte{0x01})
+	assert.Error(t, err)
+
+	_, _, err = decodeCompact([]byte{0x17, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+	assert.Error(t, err)
+}
+
+func Test_decodeWeight(t *testing.T) {
+	used, rest, err := decodeWeight([]byte{0x01, 0x01, 0x00, 0x04})
+
+	assert.NoError(t, err)
+	assert.Equal(t, weight{RefTime: 64, ProofSize: 0}, used)
+	assert.Equal(t, []byte{0x04}, rest)
+}
+
+func Test_weight_describe(t *testing.T) {
+	max := weight{RefTime: 200, ProofSize: 0}
+
+	assert.Equal(t, "ref time 50 (25.00% of the block), proof size 3 (- of the block)", weight{RefTime: 50, ProofSize: 3}.describe(max))
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// loadSnapshot reads the key/value pairs of a JSON state snapshot.
+func loadSnapshot(path string) (map[string][]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeSnapshot(data)
+}
+
+// decodeSnapshot decodes the key/value pairs of a state snapshot, either an array of [key, value]
+// pairs or an object of keys to values, as hex strings.
+func decodeSnapshot(data []byte) (map[string][]byte, error) {
+	var hexPairs [][2]string
+	if err := json.Unmarshal(data, &hexPairs); err != nil {
+		var hexMap map[string]string
+		if err := json.Unmarshal(data, &hexMap); err != nil {
+			return nil, fmt.Errorf("expected an array of [key, value] pairs or an object of keys to values: %v", err)
+		}
+
+		for key, value := range hexMap {
+			hexPairs = append(hexPairs, [2]string{key, value})
+		}
+	}
+
+	pairs := make(map[string][]byte, len(hexPairs))
+	for _, pair := range hexPairs {
+		key, err := decodeHex(pair[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %v", pair[0], err)
+		}
+
+		value, err := decodeHex(pair[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of key %s: %v", pair[0], err)
+		}
+
+		pairs[string(key)] = value
+	}
+
+	return pairs, nil
+}
+
+// readHexFile reads a file of hex encoded bytes.
+func readHexFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeHex(strings.TrimSpace(string(data)))
+}
+
+func decodeHex(value string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(value, "0x"))
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_decodeSnapshot_Pairs(t *testing.T) {
+	pairs, err := decodeSnapshot([]byte(`[["0x0102", "0x03"], ["0x04", "0x"]]`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"\x01\x02": {3}, "\x04": {}}, pairs)
+}
+
+func Test_decodeSnapshot_Object(t *testing.T) {
+	pairs, err := decodeSnapshot([]byte(`{"0x0102": "0x03"}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"\x01\x02": {3}}, pairs)
+}
+
+func Test_decodeSnapshot_Invalid(t *testing.T) {
+	_, err := decodeSnapshot([]byte(`[["0xzz", "0x03"]]`))
+	assert.Error(t, err)
+
+	_, err = decodeSnapshot([]byte(`"0x01"`))
+	assert.Error(t, err)
+}
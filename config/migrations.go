@@ -0,0 +1,9 @@
+package config
+
+import "github.com/LimeChain/gosemble/frame/support"
+
+// Migrations contains the migrations executed when the runtime is upgraded, in order. The
+// migrations of a module are usually wrapped in a support.VersionedMigration, so that they are
+// executed once, when the on-chain storage version of the module matches. Migrations which have
+// been executed on chain may be removed in the next runtime upgrade.
+var Migrations = []support.Migration{}
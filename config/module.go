@@ -1,31 +1,90 @@
 package config
 
 import (
+	"sort"
+
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/asset_tx_payment"
+	"github.com/LimeChain/gosemble/constants/assets"
 	"github.com/LimeChain/gosemble/constants/aura"
+	"github.com/LimeChain/gosemble/constants/authorship"
 	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/constants/collective"
+	"github.com/LimeChain/gosemble/constants/democracy"
 	"github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/constants/im_online"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	"github.com/LimeChain/gosemble/constants/preimage"
+	"github.com/LimeChain/gosemble/constants/randomness_collective_flip"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	"github.com/LimeChain/gosemble/constants/scheduler"
+	"github.com/LimeChain/gosemble/constants/staking"
+	"github.com/LimeChain/gosemble/constants/state_trie_migration"
 	"github.com/LimeChain/gosemble/constants/system"
 	"github.com/LimeChain/gosemble/constants/testable"
 	"github.com/LimeChain/gosemble/constants/timestamp"
 	"github.com/LimeChain/gosemble/constants/transaction_payment"
+	"github.com/LimeChain/gosemble/constants/treasury"
+	atpm "github.com/LimeChain/gosemble/frame/asset_tx_payment/module"
+	asm "github.com/LimeChain/gosemble/frame/assets/module"
 	am "github.com/LimeChain/gosemble/frame/aura/module"
+	aum "github.com/LimeChain/gosemble/frame/authorship/module"
 	bm "github.com/LimeChain/gosemble/frame/balances/module"
+	cm "github.com/LimeChain/gosemble/frame/collective/module"
+	dm "github.com/LimeChain/gosemble/frame/democracy/module"
 	gm "github.com/LimeChain/gosemble/frame/grandpa/module"
+	idm "github.com/LimeChain/gosemble/frame/identity/module"
+	iom "github.com/LimeChain/gosemble/frame/im_online/module"
+	nm "github.com/LimeChain/gosemble/frame/nfts/module"
+	pm "github.com/LimeChain/gosemble/frame/preimage/module"
+	rcfm "github.com/LimeChain/gosemble/frame/randomness_collective_flip/module"
+	recm "github.com/LimeChain/gosemble/frame/recovery/module"
+	scm "github.com/LimeChain/gosemble/frame/scheduler/module"
+	stm "github.com/LimeChain/gosemble/frame/staking/module"
+	stmm "github.com/LimeChain/gosemble/frame/state_trie_migration/module"
 	sm "github.com/LimeChain/gosemble/frame/system/module"
 	tm "github.com/LimeChain/gosemble/frame/testable/module"
 	tsm "github.com/LimeChain/gosemble/frame/timestamp/module"
 	tpm "github.com/LimeChain/gosemble/frame/transaction_payment/module"
+	trm "github.com/LimeChain/gosemble/frame/treasury/module"
 	"github.com/LimeChain/gosemble/primitives/types"
 )
 
 // Modules contains all the modules used by the runtime.
 var Modules = map[sc.U8]types.Module{
-	system.ModuleIndex:              sm.NewSystemModule(),
-	timestamp.ModuleIndex:           tsm.NewTimestampModule(),
-	aura.ModuleIndex:                am.NewAuraModule(),
-	grandpa.ModuleIndex:             gm.NewGrandpaModule(),
-	balances.ModuleIndex:            bm.NewBalancesModule(),
-	transaction_payment.ModuleIndex: tpm.NewTransactionPaymentModule(),
-	testable.ModuleIndex:            tm.NewTestingModule(),
+	system.ModuleIndex:                     sm.NewSystemModule(),
+	timestamp.ModuleIndex:                  tsm.NewTimestampModule(),
+	aura.ModuleIndex:                       am.NewAuraModule(),
+	grandpa.ModuleIndex:                    gm.NewGrandpaModule(),
+	balances.ModuleIndex:                   bm.NewBalancesModule(),
+	transaction_payment.ModuleIndex:        tpm.NewTransactionPaymentModule(),
+	preimage.ModuleIndex:                   pm.NewPreimageModule(),
+	treasury.ModuleIndex:                   trm.NewTreasuryModule(),
+	authorship.ModuleIndex:                 aum.NewAuthorshipModule(),
+	scheduler.ModuleIndex:                  scm.NewSchedulerModule(),
+	collective.ModuleIndex:                 cm.NewCollectiveModule(),
+	democracy.ModuleIndex:                  dm.NewDemocracyModule(),
+	assets.ModuleIndex:                     asm.NewAssetsModule(),
+	asset_tx_payment.ModuleIndex:           atpm.NewAssetTxPaymentModule(),
+	nfts.ModuleIndex:                       nm.NewNftsModule(),
+	identity.ModuleIndex:                   idm.NewIdentityModule(),
+	staking.ModuleIndex:                    stm.NewStakingModule(),
+	im_online.ModuleIndex:                  iom.NewImOnlineModule(),
+	randomness_collective_flip.ModuleIndex: rcfm.NewRandomnessCollectiveFlipModule(),
+	recovery.ModuleIndex:                   recm.NewRecoveryModule(),
+	state_trie_migration.ModuleIndex:       stmm.NewStateTrieMigrationModule(),
+	testable.ModuleIndex:                   tm.NewTestingModule(),
+}
+
+// ModuleIndices returns the indices of the modules in ascending order, which is the order
+// in which the modules are declared.
+func ModuleIndices() []sc.U8 {
+	indices := make([]sc.U8, 0, len(Modules))
+	for index := range Modules {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+
+	return indices
 }
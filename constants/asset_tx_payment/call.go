@@ -0,0 +1,7 @@
+package asset_tx_payment
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex = sc.U8(13)
+)
@@ -0,0 +1,34 @@
+package assets
+
+import (
+	"math/big"
+
+	"github.com/LimeChain/gosemble/constants"
+)
+
+const (
+	// StringLimit is the maximum length of the name or symbol stored in the metadata of an asset.
+	StringLimit = 50
+	// RemoveItemsLimit is the maximum number of accounts or approvals removed in a single destroy call.
+	// Each removed item is proven along with its depositor's account, so the limit keeps the proof
+	// of a destroy call within that of a block.
+	RemoveItemsLimit = 500
+)
+
+var (
+	assetDeposit = 100 * constants.Dollar
+	// AssetDeposit is the amount reserved from the owner when creating an asset.
+	AssetDeposit = big.NewInt(0).SetUint64(assetDeposit)
+
+	metadataDepositBase = 10 * constants.Dollar
+	// MetadataDepositBase is the base amount reserved when setting the metadata of an asset.
+	MetadataDepositBase = big.NewInt(0).SetUint64(metadataDepositBase)
+
+	metadataDepositPerByte = 1 * constants.Dollar
+	// MetadataDepositPerByte is the additional amount reserved per byte of name and symbol in the metadata of an asset.
+	MetadataDepositPerByte = big.NewInt(0).SetUint64(metadataDepositPerByte)
+
+	approvalDeposit = 1 * constants.Dollar
+	// ApprovalDeposit is the amount reserved when creating a new approval.
+	ApprovalDeposit = big.NewInt(0).SetUint64(approvalDeposit)
+)
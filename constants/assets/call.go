@@ -0,0 +1,25 @@
+package assets
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex                    = sc.U8(12)
+	FunctionCreateIndex            = 0
+	FunctionForceCreateIndex       = 1
+	FunctionStartDestroyIndex      = 2
+	FunctionDestroyAccountsIndex   = 3
+	FunctionDestroyApprovalsIndex  = 4
+	FunctionFinishDestroyIndex     = 5
+	FunctionMintIndex              = 6
+	FunctionBurnIndex              = 7
+	FunctionTransferIndex          = 8
+	FunctionTransferKeepAliveIndex = 9
+	FunctionFreezeIndex            = 11
+	FunctionThawIndex              = 12
+	FunctionFreezeAssetIndex       = 13
+	FunctionThawAssetIndex         = 14
+	FunctionSetMetadataIndex       = 17
+	FunctionClearMetadataIndex     = 18
+	FunctionApproveTransferIndex   = 22
+	FunctionTransferApprovedIndex  = 25
+)
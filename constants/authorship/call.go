@@ -0,0 +1,7 @@
+package authorship
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex = sc.U8(8)
+)
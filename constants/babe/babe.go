@@ -0,0 +1,5 @@
+package babe
+
+var (
+	EngineId = [4]byte{'B', 'A', 'B', 'E'}
+)
@@ -9,6 +9,8 @@ import (
 const (
 	MaxLocks    = 50
 	MaxReserves = 50
+	// StorageVersion is the version of the storage layout of the module.
+	StorageVersion = 1
 )
 
 var (
@@ -0,0 +1,13 @@
+package collective
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex                     = sc.U8(10)
+	FunctionSetMembersIndex         = 0
+	FunctionExecuteIndex            = 1
+	FunctionProposeIndex            = 2
+	FunctionVoteIndex               = 3
+	FunctionDisapproveProposalIndex = 5
+	FunctionCloseIndex              = 6
+)
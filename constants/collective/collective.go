@@ -0,0 +1,12 @@
+package collective
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	// MotionDuration is the number of blocks a motion stays open for voting (five days of 2 second blocks).
+	MotionDuration = sc.U32(216_000)
+	// MaxProposals is the maximum number of proposals that can be open at the same time.
+	MaxProposals = 100
+	// MaxMembers is the maximum number of members of the collective.
+	MaxMembers = 100
+)
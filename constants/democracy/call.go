@@ -0,0 +1,17 @@
+package democracy
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex                          = sc.U8(11)
+	FunctionProposeIndex                 = 0
+	FunctionSecondIndex                  = 1
+	FunctionVoteIndex                    = 2
+	FunctionExternalProposeIndex         = 4
+	FunctionExternalProposeMajorityIndex = 5
+	FunctionCancelReferendumIndex        = 9
+	FunctionDelegateIndex                = 10
+	FunctionUndelegateIndex              = 11
+	FunctionUnlockIndex                  = 13
+	FunctionRemoveVoteIndex              = 14
+)
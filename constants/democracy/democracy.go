@@ -0,0 +1,34 @@
+package democracy
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+)
+
+const (
+	// LaunchPeriod is the number of blocks between the tabling of new referenda (28 days of 2 second blocks).
+	LaunchPeriod = sc.U32(1_209_600)
+	// VotingPeriod is the number of blocks a referendum stays open for voting (28 days of 2 second blocks).
+	VotingPeriod = sc.U32(1_209_600)
+	// EnactmentPeriod is the minimum number of blocks between the approval of a referendum and its enactment (30 days of 2 second blocks).
+	EnactmentPeriod = sc.U32(1_296_000)
+	// VoteLockingPeriod is the number of blocks the balance of a winning vote stays locked, multiplied by its conviction.
+	VoteLockingPeriod = sc.U32(1_296_000)
+	// MaxVotes is the maximum number of votes an account can have at the same time.
+	MaxVotes = 100
+	// MaxProposals is the maximum number of public proposals waiting to be tabled.
+	MaxProposals = 100
+	// MaxDeposits is the maximum number of deposits a public proposal can have.
+	MaxDeposits = 100
+)
+
+var (
+	// LockId is the identifier of the balance lock placed on voters and of the tasks scheduled for enactment.
+	LockId = [8]byte{'d', 'e', 'm', 'o', 'c', 'r', 'a', 'c'}
+
+	minimumDeposit = 100 * constants.Dollar
+	// MinimumDeposit is the minimum amount reserved when making or seconding a public proposal.
+	MinimumDeposit = big.NewInt(0).SetUint64(minimumDeposit)
+)
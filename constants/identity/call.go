@@ -0,0 +1,22 @@
+package identity
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex                   = sc.U8(15)
+	FunctionAddRegistrarIndex     = 0
+	FunctionSetIdentityIndex      = 1
+	FunctionSetSubsIndex          = 2
+	FunctionClearIdentityIndex    = 3
+	FunctionRequestJudgementIndex = 4
+	FunctionCancelRequestIndex    = 5
+	FunctionSetFeeIndex           = 6
+	FunctionSetAccountIdIndex     = 7
+	FunctionSetFieldsIndex        = 8
+	FunctionProvideJudgementIndex = 9
+	FunctionKillIdentityIndex     = 10
+	FunctionAddSubIndex           = 11
+	FunctionRenameSubIndex        = 12
+	FunctionRemoveSubIndex        = 13
+	FunctionQuitSubIndex          = 14
+)
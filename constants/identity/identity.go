@@ -0,0 +1,30 @@
+package identity
+
+import (
+	"math/big"
+
+	"github.com/LimeChain/gosemble/constants"
+)
+
+const (
+	// MaxSubAccounts is the maximum number of sub-accounts of an identity.
+	MaxSubAccounts = 100
+	// MaxAdditionalFields is the maximum number of additional fields of an identity.
+	MaxAdditionalFields = 100
+	// MaxRegistrars is the maximum number of registrars.
+	MaxRegistrars = 20
+)
+
+var (
+	basicDeposit = 10 * constants.Dollar
+	// BasicDeposit is the amount reserved when setting an identity, regardless of its size.
+	BasicDeposit = big.NewInt(0).SetUint64(basicDeposit)
+
+	byteDeposit = 1 * constants.Cents
+	// ByteDeposit is the additional amount reserved per encoded byte of an identity.
+	ByteDeposit = big.NewInt(0).SetUint64(byteDeposit)
+
+	subAccountDeposit = 2 * constants.Dollar
+	// SubAccountDeposit is the amount reserved per sub-account of an identity.
+	SubAccountDeposit = big.NewInt(0).SetUint64(subAccountDeposit)
+)
@@ -0,0 +1,8 @@
+package im_online
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex            = sc.U8(17)
+	FunctionHeartbeatIndex = 0
+)
@@ -0,0 +1,19 @@
+package im_online
+
+import (
+	"math"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/aura"
+)
+
+var (
+	// KeyTypeId is the type of the keystore key used to sign heartbeats. Authorities sign
+	// their heartbeats with their Aura session key.
+	KeyTypeId = aura.KeyTypeId
+)
+
+const (
+	// UnsignedPriority is the priority of the unsigned heartbeat transactions.
+	UnsignedPriority = sc.U64(math.MaxUint64)
+)
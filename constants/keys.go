@@ -1,32 +1,112 @@
 package constants
 
 var (
-	KeySystem             = []byte("System")
-	KeyAccount            = []byte("Account")
-	KeyAllExtrinsicsLen   = []byte("AllExtrinsicsLen")
-	KeyAura               = []byte("Aura")
-	KeyAuthorities        = []byte("Authorities")
-	KeyBalances           = []byte("Balances")
-	KeyBlockHash          = []byte("BlockHash")
-	KeyBlockWeight        = []byte("BlockWeight")
-	KeyCurrentSlot        = []byte("CurrentSlot")
-	KeyDidUpdate          = []byte("DidUpdate")
-	KeyDigest             = []byte("Digest")
-	KeyEventCount         = []byte("EventCount")
-	KeyEvents             = []byte("Events")
-	KeyEventTopics        = []byte("EventTopics")
-	KeyExecutionPhase     = []byte("ExecutionPhase")
-	KeyExtrinsicCount     = []byte("ExtrinsicCount")
-	KeyExtrinsicData      = []byte("ExtrinsicData")
-	KeyExtrinsicIndex     = []byte(":extrinsic_index")
-	KeyGrandpaAuthorities = []byte(":grandpa_authorities")
-	KeyLastRuntimeUpgrade = []byte("LastRuntimeUpgrade")
-	KeyNextFeeMultiplier  = []byte("NextFeeMultiplier")
-	KeyNow                = []byte("Now")
-	KeyNumber             = []byte("Number")
-	KeyParentHash         = []byte("ParentHash")
-	KeyTimestamp          = []byte("Timestamp")
-	KeyTotalIssuance      = []byte("TotalIssuance")
-	KeyTransactionPayment = []byte("TransactionPayment")
-	TransactionLevelKey   = []byte(":transaction_level:")
+	KeySystem                   = []byte("System")
+	KeyAccount                  = []byte("Account")
+	KeyActiveEra                = []byte("ActiveEra")
+	KeyActiveRecoveries         = []byte("ActiveRecoveries")
+	KeyAgenda                   = []byte("Agenda")
+	KeyAllExtrinsicsLen         = []byte("AllExtrinsicsLen")
+	KeyApprovals                = []byte("Approvals")
+	KeyAsset                    = []byte("Asset")
+	KeyAssets                   = []byte("Assets")
+	KeyAttribute                = []byte("Attribute")
+	KeyAura                     = []byte("Aura")
+	KeyAuthor                   = []byte("Author")
+	KeyAuthorVrfRandomness      = []byte("AuthorVrfRandomness")
+	KeyAuthorities              = []byte("Authorities")
+	KeyAuthorship               = []byte("Authorship")
+	KeyAutoLimits               = []byte("AutoLimits")
+	KeyBabe                     = []byte("Babe")
+	KeyBalances                 = []byte("Balances")
+	KeyBlockHash                = []byte("BlockHash")
+	KeyBlockWeight              = []byte("BlockWeight")
+	KeyBondedEras               = []byte("BondedEras")
+	KeyCode                     = []byte(":code")
+	KeyCollection               = []byte("Collection")
+	KeyCollectionMetadataOf     = []byte("CollectionMetadataOf")
+	KeyCouncil                  = []byte("Council")
+	KeyCurrentEra               = []byte("CurrentEra")
+	KeyCurrentPlannedSession    = []byte("CurrentPlannedSession")
+	KeyCurrentSlot              = []byte("CurrentSlot")
+	KeyDefaultChildStorage      = []byte(":child_storage:default:")
+	KeyDemocracy                = []byte("Democracy")
+	KeyDepositOf                = []byte("DepositOf")
+	KeyDidUpdate                = []byte("DidUpdate")
+	KeyDigest                   = []byte("Digest")
+	KeyErasRewardPoints         = []byte("ErasRewardPoints")
+	KeyErasStakers              = []byte("ErasStakers")
+	KeyErasStartSessionIndex    = []byte("ErasStartSessionIndex")
+	KeyErasTotalStake           = []byte("ErasTotalStake")
+	KeyErasValidatorPrefs       = []byte("ErasValidatorPrefs")
+	KeyErasValidatorReward      = []byte("ErasValidatorReward")
+	KeyEventCount               = []byte("EventCount")
+	KeyEventTopics              = []byte("EventTopics")
+	KeyEvents                   = []byte("Events")
+	KeyExecutionPhase           = []byte("ExecutionPhase")
+	KeyExtrinsicCount           = []byte("ExtrinsicCount")
+	KeyExtrinsicData            = []byte("ExtrinsicData")
+	KeyExtrinsicIndex           = []byte(":extrinsic_index")
+	KeyForceEra                 = []byte("ForceEra")
+	KeyGrandpaAuthorities       = []byte(":grandpa_authorities")
+	KeyHeartbeatAfter           = []byte("HeartbeatAfter")
+	KeyIdentity                 = []byte("Identity")
+	KeyIdentityOf               = []byte("IdentityOf")
+	KeyImOnline                 = []byte("ImOnline")
+	KeyItem                     = []byte("Item")
+	KeyItemMetadataOf           = []byte("ItemMetadataOf")
+	KeyKeys                     = []byte("Keys")
+	KeyLastRuntimeUpgrade       = []byte("LastRuntimeUpgrade")
+	KeyLastTabledWasExternal    = []byte("LastTabledWasExternal")
+	KeyLedger                   = []byte("Ledger")
+	KeyLocks                    = []byte("Locks")
+	KeyLookup                   = []byte("Lookup")
+	KeyLowestUnbaked            = []byte("LowestUnbaked")
+	KeyMembers                  = []byte("Members")
+	KeyMetadata                 = []byte("Metadata")
+	KeyMigrationProcess         = []byte("MigrationProcess")
+	KeyNextCollectionId         = []byte("NextCollectionId")
+	KeyNextExternal             = []byte("NextExternal")
+	KeyNextFeeMultiplier        = []byte("NextFeeMultiplier")
+	KeyNfts                     = []byte("Nfts")
+	KeyNominators               = []byte("Nominators")
+	KeyNow                      = []byte("Now")
+	KeyNumber                   = []byte("Number")
+	KeyParentHash               = []byte("ParentHash")
+	KeyPayee                    = []byte("Payee")
+	KeyPreimage                 = []byte("Preimage")
+	KeyPreimageFor              = []byte("PreimageFor")
+	KeyPrime                    = []byte("Prime")
+	KeyProposalCount            = []byte("ProposalCount")
+	KeyProposalOf               = []byte("ProposalOf")
+	KeyProposals                = []byte("Proposals")
+	KeyProxy                    = []byte("Proxy")
+	KeyPublicPropCount          = []byte("PublicPropCount")
+	KeyPublicProps              = []byte("PublicProps")
+	KeyRandomMaterial           = []byte("RandomMaterial")
+	KeyRandomnessCollectiveFlip = []byte("RandomnessCollectiveFlip")
+	KeyReceivedHeartbeats       = []byte("ReceivedHeartbeats")
+	KeyRecoverable              = []byte("Recoverable")
+	KeyRecovery                 = []byte("Recovery")
+	KeyReferendumCount          = []byte("ReferendumCount")
+	KeyReferendumInfoOf         = []byte("ReferendumInfoOf")
+	KeyRegistrars               = []byte("Registrars")
+	KeyScheduler                = []byte("Scheduler")
+	KeySignedMigrationMaxLimits = []byte("SignedMigrationMaxLimits")
+	KeyStaking                  = []byte("Staking")
+	KeyStateTrieMigration       = []byte("StateTrieMigration")
+	KeyStatusFor                = []byte("StatusFor")
+	KeyStorageVersion           = []byte(":__STORAGE_VERSION__:")
+	KeySubsOf                   = []byte("SubsOf")
+	KeySuperOf                  = []byte("SuperOf")
+	KeyTimestamp                = []byte("Timestamp")
+	KeyTotalIssuance            = []byte("TotalIssuance")
+	KeyTransactionPayment       = []byte("TransactionPayment")
+	KeyTreasury                 = []byte("Treasury")
+	KeyUnappliedSlashes         = []byte("UnappliedSlashes")
+	KeyValidatorCount           = []byte("ValidatorCount")
+	KeyValidators               = []byte("Validators")
+	KeyVoting                   = []byte("Voting")
+	KeyVotingOf                 = []byte("VotingOf")
+	TransactionLevelKey         = []byte(":transaction_level:")
 )
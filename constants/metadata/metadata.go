@@ -1,6 +1,8 @@
 package metadata
 
-// Metadata types and their corresponding type id.
+// Metadata types and their corresponding type id. The modules which do not describe their types
+// with TypeInfo yet, System, Grandpa, Aura, TransactionPayment and AssetTxPayment, and the types
+// shared by all modules refer to these ids.
 const (
 	PrimitiveTypesBool = iota
 	PrimitiveTypesChar
@@ -19,6 +21,7 @@ const (
 	PrimitiveTypesI256
 
 	TypesFixedSequence4U8
+	TypesFixedSequence8U8
 	TypesFixedSequence20U8
 	TypesFixedSequence32U8
 	TypesFixedSequence64U8
@@ -49,7 +52,6 @@ const (
 	TypesArithmeticError
 	TypesTransactionalError
 
-	TypesBalancesEvent
 	TypesBalanceStatus
 	TypesVecTopics
 	TypesLastRuntimeUpgradeInfo
@@ -68,17 +70,29 @@ const (
 	TypesSr25519PubKey
 	TypesAuraSlot
 
-	TypesBalancesErrors
+	TypesBalanceLock
+	TypesSequenceBalanceLock
+	TypesBalancesReasons
 
 	TypesTransactionPaymentReleases
 	TypesTransactionPaymentEvent
 
+	TypesAssetTxPaymentEvent
+
 	TypesEmptyTuple
 	TypesTupleU32U32
 	TypesTupleApiIdU32
+	TypesOptionTupleU32U32
+	TypesDispatchResult
+	TypesBounded
+	TypesRawOrigin
 
 	TypesAddress32
 	TypesMultiAddress
+	TypesSequenceAddress32
+	TypesOptionAddress32
+	TypesOptionU32
+	TypesSequenceH256
 
 	TypesAccountData
 	TypesAccountInfo
@@ -98,16 +112,16 @@ const (
 	TypesSignatureEd25519
 	TypesSignatureSr25519
 	TypesSignatureEcdsa
+	TypesSignatureEthereum
 	TypesMultiSignature
 
 	TypesRuntimeEvent
 	TypesRuntimeVersion
+	TypesOriginCaller
 	RuntimeCall
 
 	SystemCalls
-	TimestampCalls
 	GrandpaCalls
-	BalancesCalls
 
 	UncheckedExtrinsic
 	SignedExtra
@@ -120,6 +134,43 @@ const (
 	CheckNonce
 	CheckWeight
 	ChargeTransactionPayment
+	ChargeAssetTxPayment
 
 	Runtime
+	TypesRuntimeError
+
+	TypesHeader
+	TypesSequenceUncheckedExtrinsic
+	TypesBlock
+	TypesTupleFixedSequence8U8SequenceU8
+	TypesSequenceTupleFixedSequence8U8SequenceU8
+	TypesInherentData
+	TypesCheckInherentsResult
+	TypesInvalidTransaction
+	TypesUnknownTransaction
+	TypesTransactionValidityError
+	TypesApplyExtrinsicResult
+	TypesTransactionSource
+	TypesSequenceSequenceU8
+	TypesValidTransaction
+	TypesTransactionValidity
+	TypesOptionSequenceU8
+	TypesKeyTypeId
+	TypesTupleSequenceU8KeyTypeId
+	TypesSequenceTupleSequenceU8KeyTypeId
+	TypesOptionSequenceTupleSequenceU8KeyTypeId
+	TypesAuraSlotDuration
+	TypesEd25519PubKey
+	TypesGrandpaAuthorityId
+	TypesTupleGrandpaAuthorityIdU64
+	TypesSequenceTupleGrandpaAuthorityIdU64
+	TypesRuntimeDispatchInfo
+	TypesInclusionFee
+	TypesOptionInclusionFee
+	TypesFeeDetails
+	TypesResultEmptyTupleString
+
+	// FirstRegisteredType is the first id assigned by the metadata type registry. The types with ids
+	// from it on are generated from the modules.
+	FirstRegisteredType
 )
@@ -0,0 +1,26 @@
+package nfts
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex                            = sc.U8(14)
+	FunctionCreateIndex                    = 0
+	FunctionForceCreateIndex               = 1
+	FunctionDestroyIndex                   = 2
+	FunctionMintIndex                      = 3
+	FunctionBurnIndex                      = 5
+	FunctionTransferIndex                  = 6
+	FunctionLockItemTransferIndex          = 8
+	FunctionUnlockItemTransferIndex        = 9
+	FunctionTransferOwnershipIndex         = 11
+	FunctionSetTeamIndex                   = 12
+	FunctionApproveTransferIndex           = 15
+	FunctionCancelApprovalIndex            = 16
+	FunctionClearAllTransferApprovalsIndex = 17
+	FunctionSetAttributeIndex              = 19
+	FunctionClearAttributeIndex            = 21
+	FunctionSetMetadataIndex               = 24
+	FunctionClearMetadataIndex             = 25
+	FunctionSetCollectionMetadataIndex     = 26
+	FunctionClearCollectionMetadataIndex   = 27
+)
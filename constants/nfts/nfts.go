@@ -0,0 +1,40 @@
+package nfts
+
+import (
+	"math/big"
+
+	"github.com/LimeChain/gosemble/constants"
+)
+
+const (
+	// StringLimit is the maximum length of the metadata of a collection or an item.
+	StringLimit = 256
+	// KeyLimit is the maximum length of the key of an attribute.
+	KeyLimit = 64
+	// ValueLimit is the maximum length of the value of an attribute.
+	ValueLimit = 256
+	// ApprovalsLimit is the maximum number of delegates approved to transfer an item.
+	ApprovalsLimit = 20
+)
+
+var (
+	collectionDeposit = 100 * constants.Dollar
+	// CollectionDeposit is the amount reserved from the owner when creating a collection.
+	CollectionDeposit = big.NewInt(0).SetUint64(collectionDeposit)
+
+	itemDeposit = 1 * constants.Dollar
+	// ItemDeposit is the amount reserved from the owner of the collection when minting an item.
+	ItemDeposit = big.NewInt(0).SetUint64(itemDeposit)
+
+	metadataDepositBase = 10 * constants.Dollar
+	// MetadataDepositBase is the base amount reserved when setting the metadata of a collection or an item.
+	MetadataDepositBase = big.NewInt(0).SetUint64(metadataDepositBase)
+
+	attributeDepositBase = 10 * constants.Dollar
+	// AttributeDepositBase is the base amount reserved when setting an attribute.
+	AttributeDepositBase = big.NewInt(0).SetUint64(attributeDepositBase)
+
+	depositPerByte = 1 * constants.Dollar
+	// DepositPerByte is the additional amount reserved per byte of metadata or of the key and value of an attribute.
+	DepositPerByte = big.NewInt(0).SetUint64(depositPerByte)
+)
@@ -0,0 +1,11 @@
+package preimage
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex                    = sc.U8(6)
+	FunctionNotePreimageIndex      = 0
+	FunctionUnnotePreimageIndex    = 1
+	FunctionRequestPreimageIndex   = 2
+	FunctionUnrequestPreimageIndex = 3
+)
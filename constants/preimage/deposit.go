@@ -0,0 +1,20 @@
+package preimage
+
+import (
+	"math/big"
+
+	"github.com/LimeChain/gosemble/constants"
+)
+
+// MaxSize is the maximum size of a preimage in bytes (4 MiB).
+const MaxSize = 4 * 1024 * 1024
+
+var (
+	baseDeposit = 2 * constants.Dollar
+	byteDeposit = 1 * constants.Cents
+
+	// BaseDeposit is the base amount held for noting a preimage.
+	BaseDeposit = big.NewInt(0).SetUint64(baseDeposit)
+	// ByteDeposit is the amount held per byte of a noted preimage.
+	ByteDeposit = big.NewInt(0).SetUint64(byteDeposit)
+)
@@ -0,0 +1,7 @@
+package randomness_collective_flip
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex = sc.U8(18)
+)
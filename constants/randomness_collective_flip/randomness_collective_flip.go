@@ -0,0 +1,4 @@
+package randomness_collective_flip
+
+// RandomMaterialLen is the number of parent hashes kept to generate randomness.
+const RandomMaterialLen = 81
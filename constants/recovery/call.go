@@ -0,0 +1,16 @@
+package recovery
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex                   = sc.U8(19)
+	FunctionAsRecoveredIndex      = 0
+	FunctionSetRecoveredIndex     = 1
+	FunctionCreateRecoveryIndex   = 2
+	FunctionInitiateRecoveryIndex = 3
+	FunctionVouchRecoveryIndex    = 4
+	FunctionClaimRecoveryIndex    = 5
+	FunctionCloseRecoveryIndex    = 6
+	FunctionRemoveRecoveryIndex   = 7
+	FunctionCancelRecoveredIndex  = 8
+)
@@ -0,0 +1,25 @@
+package recovery
+
+import (
+	"math/big"
+
+	"github.com/LimeChain/gosemble/constants"
+)
+
+// MaxFriends is the maximum number of friends allowed in a recovery configuration.
+const MaxFriends = 9
+
+var (
+	configDepositBase = 5 * constants.Dollar
+	// ConfigDepositBase is the base amount reserved for creating a recovery configuration.
+	ConfigDepositBase = big.NewInt(0).SetUint64(configDepositBase)
+
+	friendDepositFactor = 50 * constants.Cents
+	// FriendDepositFactor is the additional amount reserved per friend of a recovery configuration.
+	FriendDepositFactor = big.NewInt(0).SetUint64(friendDepositFactor)
+
+	recoveryDeposit = 5 * constants.Dollar
+	// RecoveryDeposit is the amount reserved for starting a recovery. It is paid to the
+	// lost account if the recovery is closed.
+	RecoveryDeposit = big.NewInt(0).SetUint64(recoveryDeposit)
+)
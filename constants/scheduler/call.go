@@ -0,0 +1,11 @@
+package scheduler
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex                = sc.U8(9)
+	FunctionScheduleIndex      = 0
+	FunctionCancelIndex        = 1
+	FunctionScheduleNamedIndex = 2
+	FunctionCancelNamedIndex   = 3
+)
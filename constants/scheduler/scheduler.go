@@ -0,0 +1,6 @@
+package scheduler
+
+const (
+	// MaxScheduledPerBlock is the maximum number of scheduled calls in the queue for a single block.
+	MaxScheduledPerBlock = 50
+)
@@ -0,0 +1,19 @@
+package staking
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex                      = sc.U8(16)
+	FunctionBondIndex                = 0
+	FunctionBondExtraIndex           = 1
+	FunctionUnbondIndex              = 2
+	FunctionWithdrawUnbondedIndex    = 3
+	FunctionValidateIndex            = 4
+	FunctionNominateIndex            = 5
+	FunctionChillIndex               = 6
+	FunctionSetPayeeIndex            = 7
+	FunctionSetValidatorCountIndex   = 8
+	FunctionForceNewEraIndex         = 9
+	FunctionCancelDeferredSlashIndex = 10
+	FunctionPayoutStakersIndex       = 11
+)
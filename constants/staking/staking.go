@@ -0,0 +1,50 @@
+package staking
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// SessionPeriod is the number of blocks in a session (one hour of 2 second blocks).
+	SessionPeriod = sc.U32(1_800)
+	// SessionsPerEra is the number of sessions in an era.
+	SessionsPerEra = sc.U32(6)
+	// BondingDuration is the number of eras that unbonded funds stay locked.
+	BondingDuration = sc.U32(28)
+	// SlashDeferDuration is the number of eras that slashes are deferred by, after computation.
+	// It must be less than the bonding duration, so that slashes can be cancelled before the funds are unlocked.
+	SlashDeferDuration = sc.U32(27)
+	// HistoryDepth is the number of eras for which the exposures and rewards are kept, and can be paid out.
+	HistoryDepth = sc.U32(84)
+	// MaxNominations is the maximum number of validators a nominator can nominate.
+	MaxNominations = 16
+	// MaxUnlockingChunks is the maximum number of unbonding chunks of a ledger.
+	MaxUnlockingChunks = 32
+	// MaxNominatorRewardedPerValidator is the maximum number of nominators of a validator that are
+	// rewarded. Only the nominators with the highest stake are rewarded, but all of them are exposed.
+	MaxNominatorRewardedPerValidator = 256
+	// MinimumValidatorCount is the minimum number of validators that must be elected for a new era to start.
+	MinimumValidatorCount = 1
+	// RewardPointsPerBlock is the number of era reward points awarded to the author of a block.
+	RewardPointsPerBlock = sc.U32(20)
+	// MillisecondsPerYear is the duration of a year, used to compute the era payout.
+	MillisecondsPerYear = sc.U64(36_525 * 24 * 60 * 60 * 10)
+)
+
+var (
+	// LockId is the identifier of the balance lock placed on bonded funds.
+	LockId = [8]byte{'s', 't', 'a', 'k', 'i', 'n', 'g', ' '}
+
+	// YearlyInflation is the fraction of the total issuance paid out to validators and nominators per year.
+	YearlyInflation = types.NewPermillFromPercent(10)
+	// SlashRewardFraction is the fraction of a slash that is paid out to the reporters of the offence.
+	SlashRewardFraction = types.NewPermillFromPercent(10)
+
+	minimumBond = 1 * constants.Dollar
+	// MinimumBond is the minimum amount that can be bonded.
+	MinimumBond = big.NewInt(0).SetUint64(minimumBond)
+)
@@ -0,0 +1,13 @@
+package state_trie_migration
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex                       = sc.U8(20)
+	FunctionControlAutoMigrationIndex = 0
+	FunctionContinueMigrateIndex      = 1
+	FunctionMigrateCustomTopIndex     = 2
+	FunctionMigrateCustomChildIndex   = 3
+	FunctionSetSignedMaxLimitsIndex   = 4
+	FunctionForceSetProgressIndex     = 5
+)
@@ -0,0 +1,22 @@
+package state_trie_migration
+
+import (
+	"math/big"
+
+	"github.com/LimeChain/gosemble/constants"
+)
+
+// MaxKeyLen is the maximum length of a key recorded as the progress of the migration. The
+// migration halts when it reaches a longer key.
+const MaxKeyLen = 512
+
+var (
+	signedDepositPerItem = constants.Dollar
+	// SignedDepositPerItem is the amount reserved per key migrated by a signed call.
+	SignedDepositPerItem = big.NewInt(0).SetUint64(signedDepositPerItem)
+
+	signedDepositBase = 20 * constants.Dollar
+	// SignedDepositBase is the base amount reserved for a signed call migrating keys. The deposit is
+	// returned if the call migrates the keys it declares, and slashed otherwise.
+	SignedDepositBase = big.NewInt(0).SetUint64(signedDepositBase)
+)
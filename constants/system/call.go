@@ -6,3 +6,6 @@ const (
 	ModuleIndex         = sc.U8(0)
 	FunctionRemarkIndex = 0
 )
+
+// SS58Prefix is the prefix of the SS58 encoded addresses of the accounts.
+const SS58Prefix = sc.U16(42)
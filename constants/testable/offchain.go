@@ -0,0 +1,9 @@
+package testable
+
+var (
+	// OffchainKeyTypeId is the type of the keystore key that signs the remarks of the offchain worker.
+	OffchainKeyTypeId = [4]byte{'a', 'c', 'c', 'o'}
+	// OffchainRemarkKey is the key of the persistent offchain storage which holds the next remark
+	// that the offchain worker submits.
+	OffchainRemarkKey = []byte(":testable:remark")
+)
@@ -0,0 +1,10 @@
+package transaction_payment
+
+import "github.com/LimeChain/gosemble/primitives/types"
+
+var (
+	// FeesToTreasury is the fraction of transaction fees that goes to the treasury, the rest goes to the block author.
+	FeesToTreasury = types.NewPermillFromPercent(80)
+	// TipsToTreasury is the fraction of transaction tips that goes to the treasury, the rest goes to the block author.
+	TipsToTreasury = types.NewPermillFromPercent(0)
+)
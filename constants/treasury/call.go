@@ -0,0 +1,11 @@
+package treasury
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex                  = sc.U8(7)
+	FunctionProposeSpendIndex    = 0
+	FunctionRejectProposalIndex  = 1
+	FunctionApproveProposalIndex = 2
+	FunctionSpendIndex           = 3
+)
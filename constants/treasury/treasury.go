@@ -0,0 +1,30 @@
+package treasury
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// SpendPeriod is the number of blocks between treasury spends (one day of 2 second blocks).
+	SpendPeriod = sc.U32(43_200)
+	// MaxApprovals is the maximum number of approved proposals waiting to be paid out.
+	MaxApprovals = 100
+)
+
+var (
+	// PalletId is used to derive the account of the treasury pot.
+	PalletId = [8]byte{'p', 'y', '/', 't', 'r', 's', 'r', 'y'}
+
+	// ProposalBond is the fraction of a proposal's value that is reserved from the proposer.
+	ProposalBond = types.NewPermillFromPercent(5)
+	// Burn is the fraction of the remaining funds burned at the end of each spend period.
+	Burn = types.NewPermillFromPercent(50)
+
+	proposalBondMinimum = 1 * constants.Dollar
+	// ProposalBondMinimum is the minimum amount reserved when proposing a spend.
+	ProposalBondMinimum = big.NewInt(0).SetUint64(proposalBondMinimum)
+)
@@ -1,14 +1,10 @@
 package constants
 
 import (
-	"math"
-
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/primitives/types"
 )
 
-// TODO: needs to be benchmarked
-
 const FiveMbPerBlockPerExtrinsic sc.U32 = 5 * 1024 * 1024
 const WeightRefTimePerSecond sc.U64 = 1_000_000_000_000
 const WeightRefTimePerNanos sc.U64 = 1_000
@@ -69,8 +65,11 @@ var ExtrinsicBaseWeight types.Weight = types.WeightFromParts(WeightRefTimePerNan
 //	75th: 414_170
 var BlockExecutionWeight types.Weight = types.WeightFromParts(WeightRefTimePerNanos.SaturatingMul(412_772), 0)
 
-// MaximumBlockWeight is the maximum weight 2 seconds of compute with a 6 second average block time, with maximum proof size.
-var MaximumBlockWeight types.Weight = types.WeightFromParts(WeightRefTimePerSecond.SaturatingMul(2), math.MaxUint64)
+// MaximumProofSize is the maximum size, in bytes, of the proof of validity of a block.
+const MaximumProofSize sc.U64 = 5 * 1024 * 1024
+
+// MaximumBlockWeight is the maximum weight 2 seconds of compute with a 6 second average block time, with a proof size of up to MaximumProofSize.
+var MaximumBlockWeight types.Weight = types.WeightFromParts(WeightRefTimePerSecond.SaturatingMul(2), MaximumProofSize)
 
 // DbWeight for RocksDB, used throughout the runtime.
 var DbWeight types.RuntimeDbWeight = types.RuntimeDbWeight{
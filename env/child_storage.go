@@ -0,0 +1,23 @@
+//go:build !nonwasmenv
+
+package env
+
+/*
+	Default Child Storage: Interface for manipulating the default child tries from within the runtime.
+*/
+
+//go:wasm-module env
+//go:export ext_default_child_storage_clear_version_1
+func ExtDefaultChildStorageClearVersion1(storage_key int64, key int64)
+
+//go:wasm-module env
+//go:export ext_default_child_storage_get_version_1
+func ExtDefaultChildStorageGetVersion1(storage_key int64, key int64) int64
+
+//go:wasm-module env
+//go:export ext_default_child_storage_next_key_version_1
+func ExtDefaultChildStorageNextKeyVersion1(storage_key int64, key int64) int64
+
+//go:wasm-module env
+//go:export ext_default_child_storage_set_version_1
+func ExtDefaultChildStorageSetVersion1(storage_key int64, key int64, value int64)
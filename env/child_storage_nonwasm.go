@@ -0,0 +1,23 @@
+//go:build nonwasmenv
+
+package env
+
+/*
+	Default Child Storage: Interface for manipulating the default child tries from within the runtime.
+*/
+
+func ExtDefaultChildStorageClearVersion1(storage_key int64, key int64) {
+	panic("not implemented")
+}
+
+func ExtDefaultChildStorageGetVersion1(storage_key int64, key int64) int64 {
+	panic("not implemented")
+}
+
+func ExtDefaultChildStorageNextKeyVersion1(storage_key int64, key int64) int64 {
+	panic("not implemented")
+}
+
+func ExtDefaultChildStorageSetVersion1(storage_key int64, key int64, value int64) {
+	panic("not implemented")
+}
@@ -6,6 +6,14 @@ package env
 	Crypto: Interfaces for working with crypto related types from within the runtime.
 */
 
+//go:wasm-module env
+//go:export ext_crypto_ecdsa_public_keys_version_1
+func ExtCryptoEcdsaPublicKeysVersion1(key_type_id int32) int64
+
+//go:wasm-module env
+//go:export ext_crypto_ecdsa_sign_prehashed_version_1
+func ExtCryptoEcdsaSignPrehashedVersion1(key_type_id int32, key int32, msg int32) int64
+
 //go:wasm-module env
 //go:export ext_crypto_ed25519_generate_version_1
 func ExtCryptoEd25519GenerateVersion1(key_type_id int32, seed int64) int32
@@ -6,6 +6,14 @@ package env
 	Crypto: Interfaces for working with crypto related types from within the runtime.
 */
 
+func ExtCryptoEcdsaPublicKeysVersion1(key_type_id int32) int64 {
+	panic("not implemented")
+}
+
+func ExtCryptoEcdsaSignPrehashedVersion1(key_type_id int32, key int32, msg int32) int64 {
+	panic("not implemented")
+}
+
 func ExtCryptoEd25519GenerateVersion1(key_type_id int32, seed int64) int32 {
 	panic("not implemented")
 }
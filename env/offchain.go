@@ -0,0 +1,67 @@
+//go:build !nonwasmenv
+
+package env
+
+/*
+	Offchain: Interface that provides functions to access the offchain functionality, available only in the offchain worker context.
+*/
+
+//go:wasm-module env
+//go:export ext_offchain_http_request_add_header_version_1
+func ExtOffchainHttpRequestAddHeaderVersion1(request_id int32, name int64, value int64) int64
+
+//go:wasm-module env
+//go:export ext_offchain_http_request_start_version_1
+func ExtOffchainHttpRequestStartVersion1(method int64, uri int64, meta int64) int64
+
+//go:wasm-module env
+//go:export ext_offchain_http_request_write_body_version_1
+func ExtOffchainHttpRequestWriteBodyVersion1(request_id int32, chunk int64, deadline int64) int64
+
+//go:wasm-module env
+//go:export ext_offchain_http_response_headers_version_1
+func ExtOffchainHttpResponseHeadersVersion1(request_id int32) int64
+
+//go:wasm-module env
+//go:export ext_offchain_http_response_read_body_version_1
+func ExtOffchainHttpResponseReadBodyVersion1(request_id int32, buffer int64, deadline int64) int64
+
+//go:wasm-module env
+//go:export ext_offchain_http_response_wait_version_1
+func ExtOffchainHttpResponseWaitVersion1(ids int64, deadline int64) int64
+
+//go:wasm-module env
+//go:export ext_offchain_is_validator_version_1
+func ExtOffchainIsValidatorVersion1() int32
+
+//go:wasm-module env
+//go:export ext_offchain_local_storage_clear_version_1
+func ExtOffchainLocalStorageClearVersion1(kind int32, key int64)
+
+//go:wasm-module env
+//go:export ext_offchain_local_storage_compare_and_set_version_1
+func ExtOffchainLocalStorageCompareAndSetVersion1(kind int32, key int64, old_value int64, new_value int64) int32
+
+//go:wasm-module env
+//go:export ext_offchain_local_storage_get_version_1
+func ExtOffchainLocalStorageGetVersion1(kind int32, key int64) int64
+
+//go:wasm-module env
+//go:export ext_offchain_local_storage_set_version_1
+func ExtOffchainLocalStorageSetVersion1(kind int32, key int64, value int64)
+
+//go:wasm-module env
+//go:export ext_offchain_random_seed_version_1
+func ExtOffchainRandomSeedVersion1() int32
+
+//go:wasm-module env
+//go:export ext_offchain_sleep_until_version_1
+func ExtOffchainSleepUntilVersion1(deadline int64)
+
+//go:wasm-module env
+//go:export ext_offchain_submit_transaction_version_1
+func ExtOffchainSubmitTransactionVersion1(data int64) int64
+
+//go:wasm-module env
+//go:export ext_offchain_timestamp_version_1
+func ExtOffchainTimestampVersion1() int64
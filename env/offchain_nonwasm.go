@@ -0,0 +1,67 @@
+//go:build nonwasmenv
+
+package env
+
+/*
+	Offchain: Interface that provides functions to access the offchain functionality, available only in the offchain worker context.
+*/
+
+func ExtOffchainHttpRequestAddHeaderVersion1(request_id int32, name int64, value int64) int64 {
+	panic("not implemented")
+}
+
+func ExtOffchainHttpRequestStartVersion1(method int64, uri int64, meta int64) int64 {
+	panic("not implemented")
+}
+
+func ExtOffchainHttpRequestWriteBodyVersion1(request_id int32, chunk int64, deadline int64) int64 {
+	panic("not implemented")
+}
+
+func ExtOffchainHttpResponseHeadersVersion1(request_id int32) int64 {
+	panic("not implemented")
+}
+
+func ExtOffchainHttpResponseReadBodyVersion1(request_id int32, buffer int64, deadline int64) int64 {
+	panic("not implemented")
+}
+
+func ExtOffchainHttpResponseWaitVersion1(ids int64, deadline int64) int64 {
+	panic("not implemented")
+}
+
+func ExtOffchainIsValidatorVersion1() int32 {
+	panic("not implemented")
+}
+
+func ExtOffchainLocalStorageClearVersion1(kind int32, key int64) {
+	panic("not implemented")
+}
+
+func ExtOffchainLocalStorageCompareAndSetVersion1(kind int32, key int64, old_value int64, new_value int64) int32 {
+	panic("not implemented")
+}
+
+func ExtOffchainLocalStorageGetVersion1(kind int32, key int64) int64 {
+	panic("not implemented")
+}
+
+func ExtOffchainLocalStorageSetVersion1(kind int32, key int64, value int64) {
+	panic("not implemented")
+}
+
+func ExtOffchainRandomSeedVersion1() int32 {
+	panic("not implemented")
+}
+
+func ExtOffchainSleepUntilVersion1(deadline int64) {
+	panic("not implemented")
+}
+
+func ExtOffchainSubmitTransactionVersion1(data int64) int64 {
+	panic("not implemented")
+}
+
+func ExtOffchainTimestampVersion1() int64 {
+	panic("not implemented")
+}
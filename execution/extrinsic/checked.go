@@ -12,7 +12,7 @@ type Checked types.CheckedExtrinsic
 
 func (xt Checked) Validate(validator UnsignedValidator, source primitives.TransactionSource, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
 	if xt.Signed.HasValue {
-		id, extra := xt.Signed.Value.Address32, xt.Signed.Value.SignedExtra
+		id, extra := xt.Signed.Value.AccountId, xt.Signed.Value.SignedExtra
 		ok, err = system.Extra(extra).Validate(&id, &xt.Function, info, length)
 	} else {
 		valid, err := system.Extra(primitives.SignedExtra{}).ValidateUnsigned(&xt.Function, info, length)
@@ -33,17 +33,17 @@ func (xt Checked) Validate(validator UnsignedValidator, source primitives.Transa
 
 func (xt Checked) Apply(validator UnsignedValidator, info *primitives.DispatchInfo, length sc.Compact) (primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo], primitives.TransactionValidityError) {
 	var (
-		maybeWho sc.Option[primitives.Address32]
+		maybeWho sc.Option[primitives.AccountId]
 		maybePre sc.Option[primitives.Pre]
 	)
 
 	if xt.Signed.HasValue {
-		id, extra := xt.Signed.Value.Address32, xt.Signed.Value.SignedExtra
+		id, extra := xt.Signed.Value.AccountId, xt.Signed.Value.SignedExtra
 		pre, err := system.Extra(extra).PreDispatch(&id, &xt.Function, info, length)
 		if err != nil {
 			return primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo]{}, err
 		}
-		maybeWho, maybePre = sc.NewOption[primitives.Address32](id), sc.NewOption[primitives.Pre](pre)
+		maybeWho, maybePre = sc.NewOption[primitives.AccountId](id), sc.NewOption[primitives.Pre](pre)
 	} else {
 		// Do any pre-flight stuff for an unsigned transaction.
 		//
@@ -63,22 +63,10 @@ func (xt Checked) Apply(validator UnsignedValidator, info *primitives.DispatchIn
 			return primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo]{}, err
 		}
 
-		maybeWho, maybePre = sc.NewOption[primitives.Address32](nil), sc.NewOption[primitives.Pre](nil)
+		maybeWho, maybePre = sc.NewOption[primitives.AccountId](nil), sc.NewOption[primitives.Pre](nil)
 	}
 
-	var resWithInfo primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo]
-
-	support.WithStorageLayer(
-		func() (primitives.PostDispatchInfo, primitives.DispatchError) {
-			resWithInfo = xt.Function.Dispatch(primitives.RawOriginFrom(maybeWho), xt.Function.Args())
-
-			if resWithInfo.HasError {
-				return primitives.PostDispatchInfo{}, resWithInfo.Err.Error
-			}
-
-			return resWithInfo.Ok, nil
-		},
-	)
+	resWithInfo := support.DispatchCall(xt.Function, primitives.RuntimeOriginFrom(maybeWho))
 
 	var postInfo primitives.PostDispatchInfo
 	if resWithInfo.HasError {
@@ -31,7 +31,7 @@ func (uxt Unchecked) Check(lookup primitives.AccountIdLookup) (ok types.CheckedE
 		function, extra, _ := rawPayload.Call, rawPayload.Extra, rawPayload.AdditionalSigned
 
 		ok = types.CheckedExtrinsic{
-			Signed:   sc.NewOption[primitives.AccountIdExtra](primitives.AccountIdExtra{Address32: signedAddress, SignedExtra: extra}),
+			Signed:   sc.NewOption[primitives.AccountIdExtra](primitives.AccountIdExtra{AccountId: signedAddress, SignedExtra: extra}),
 			Function: function,
 		}
 	case false:
@@ -2,30 +2,59 @@ package types
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 
 	sc "github.com/LimeChain/goscale"
 
 	"github.com/LimeChain/gosemble/config"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
+var errCallIndexNotFound = errors.New("call index not found")
+
+func init() {
+	support.RegisterCallDecoder(decodeCall)
+}
+
 func DecodeCall(buffer *bytes.Buffer) primitives.Call {
+	call, err := TryDecodeCall(buffer)
+	if err != nil {
+		log.Critical(err.Error())
+	}
+
+	return call
+}
+
+// TryDecodeCall decodes a runtime call and returns an error, instead of aborting execution,
+// if the module or function index of the call, or of any call nested in it, is unknown.
+func TryDecodeCall(buffer *bytes.Buffer) (primitives.Call, error) {
+	return support.TryDecodeCall(buffer)
+}
+
+// decodeCall is the runtime call decoder registered in the support module.
+// Calls nested in the arguments of the call are decoded by the support module.
+func decodeCall(buffer *bytes.Buffer) (primitives.Call, error) {
+	if buffer.Len() < 2 {
+		return nil, errCallIndexNotFound
+	}
+
 	moduleIndex := sc.DecodeU8(buffer)
 	functionIndex := sc.DecodeU8(buffer)
 
 	module, ok := config.Modules[moduleIndex]
 	if !ok {
-		log.Critical(fmt.Sprintf("module with index [%d] not found", moduleIndex))
+		return nil, fmt.Errorf("module with index [%d] not found", moduleIndex)
 	}
 
 	function, ok := module.Functions()[functionIndex]
 	if !ok {
-		log.Critical(fmt.Sprintf("function index [%d] for module [%d] not found", functionIndex, moduleIndex))
+		return nil, fmt.Errorf("function index [%d] for module [%d] not found", functionIndex, moduleIndex)
 	}
 
 	function = function.DecodeArgs(buffer)
 
-	return function
+	return function, nil
 }
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/LimeChain/gosemble/constants/collective"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/stretchr/testify/assert"
+)
+
+// executeCallBytes returns the encoding of `call` nested in `depth` collective execute calls.
+func executeCallBytes(call []byte, depth int) []byte {
+	buffer := &bytes.Buffer{}
+	for i := 0; i < depth; i++ {
+		buffer.Write([]byte{byte(collective.ModuleIndex), collective.FunctionExecuteIndex})
+	}
+	buffer.Write(call)
+	for i := 0; i < depth; i++ {
+		buffer.WriteByte(0) // length bound
+	}
+
+	return buffer.Bytes()
+}
+
+func Test_TryDecodeCall(t *testing.T) {
+	call, err := TryDecodeCall(bytes.NewBuffer(remarkCall.Bytes()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, remarkCall.Bytes(), call.Bytes())
+}
+
+func Test_TryDecodeCall_Nested(t *testing.T) {
+	input := executeCallBytes(remarkCall.Bytes(), 2)
+
+	call, err := TryDecodeCall(bytes.NewBuffer(input))
+
+	assert.NoError(t, err)
+	assert.Equal(t, input, call.Bytes())
+}
+
+func Test_TryDecodeCall_MaxCallDepth(t *testing.T) {
+	input := executeCallBytes(remarkCall.Bytes(), support.MaxCallDepth-1)
+
+	call, err := TryDecodeCall(bytes.NewBuffer(input))
+
+	assert.NoError(t, err)
+	assert.Equal(t, input, call.Bytes())
+}
+
+func Test_TryDecodeCall_Errors(t *testing.T) {
+	var testExamples = []struct {
+		label string
+		input []byte
+	}{
+		{label: "empty", input: []byte{}},
+		{label: "missing function index", input: []byte{0x0}},
+		{label: "unknown module", input: []byte{0xfe, 0x0}},
+		{label: "unknown function", input: []byte{0x0, 0xff}},
+		{label: "unknown nested call", input: executeCallBytes([]byte{0xfe, 0x0}, 1)},
+		{label: "maximum call depth exceeded", input: executeCallBytes(remarkCall.Bytes(), support.MaxCallDepth)},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			call, err := TryDecodeCall(bytes.NewBuffer(testExample.input))
+
+			assert.Error(t, err)
+			assert.Nil(t, call)
+		})
+	}
+}
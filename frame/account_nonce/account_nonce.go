@@ -1,26 +1,23 @@
 package account_nonce
 
 import (
-	"bytes"
-
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/api"
 	"github.com/LimeChain/gosemble/primitives/types"
-	"github.com/LimeChain/gosemble/utils"
+)
+
+// Api is the `AccountNonceApi` runtime API, which queries the nonce of accounts.
+var Api = api.New("AccountNonceApi", 1, "The API to query account nonce.",
+	api.NewMethod1("account_nonce",
+		api.NewArg("account", types.TypeId(metadata.TypesAddress32), types.DecodePublicKey),
+		types.TypeInfoU32,
+		"Get current account nonce of given `AccountId`.",
+		AccountNonce),
 )
 
 // AccountNonce returns the account nonce of given AccountId.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded AccountId.
-// Returns a pointer-size of the SCALE-encoded nonce of the AccountId.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#sect-accountnonceapi-account-nonce)
-func AccountNonce(dataPtr int32, dataLen int32) int64 {
-	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(b)
-
-	publicKey := types.DecodePublicKey(buffer)
-	nonce := system.StorageGetAccount(publicKey).Nonce
-
-	return utils.BytesToOffsetAndSize(nonce.Bytes())
+func AccountNonce(publicKey types.PublicKey) types.AccountIndex {
+	return system.StorageGetAccount(publicKey).Nonce
 }
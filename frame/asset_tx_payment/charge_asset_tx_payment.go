@@ -0,0 +1,111 @@
+package asset_tx_payment
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/transaction_payment"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ChargeAssetTxPayment charges the transaction fee in the given asset,
+// or in the native currency through ChargeTransactionPayment if no asset is given.
+type ChargeAssetTxPayment struct {
+	Tip     primitives.Balance
+	AssetId sc.Option[primitives.AssetId]
+}
+
+func (catp ChargeAssetTxPayment) AdditionalSigned() (ok sc.Empty, err primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (catp ChargeAssetTxPayment) Validate(who *primitives.AccountId, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	if !catp.AssetId.HasValue {
+		return transaction_payment.ChargeTransactionPayment(catp.Tip).Validate(who, call, info, length)
+	}
+
+	fee, _, err := catp.withdrawFee(who, info, length)
+	if err != nil {
+		return primitives.ValidTransaction{}, err
+	}
+
+	validTransaction := primitives.DefaultValidTransaction()
+	validTransaction.Priority = transaction_payment.ChargeTransactionPayment(catp.Tip).GetPriority(info, length, catp.Tip, fee)
+
+	return validTransaction, nil
+}
+
+func (catp ChargeAssetTxPayment) PreDispatch(who *primitives.AccountId, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
+	if !catp.AssetId.HasValue {
+		return transaction_payment.ChargeTransactionPayment(catp.Tip).PreDispatch(who, call, info, length)
+	}
+
+	_, withdrawn, err := catp.withdrawFee(who, info, length)
+	return primitives.Pre{
+		Tip:       catp.Tip,
+		Who:       *who,
+		Imbalance: withdrawn,
+		AssetId:   catp.AssetId,
+	}, err
+}
+
+func (catp ChargeAssetTxPayment) PostDispatch(pre sc.Option[primitives.Pre], info *primitives.DispatchInfo, postInfo *primitives.PostDispatchInfo, length sc.Compact, result *primitives.DispatchResult) (primitives.Pre, primitives.TransactionValidityError) {
+	if !pre.HasValue || !pre.Value.AssetId.HasValue {
+		return transaction_payment.ChargeTransactionPayment(catp.Tip).PostDispatch(pre, info, postInfo, length, result)
+	}
+
+	preValue := pre.Value
+	assetId := preValue.AssetId.Value
+
+	actualFee := transaction_payment.ComputeActualFee(sc.U32(length.ToBigInt().Uint64()), *info, *postInfo, preValue.Tip)
+	convertedFee, err := Converter.ToAssetBalance(actualFee.ToBigInt(), assetId)
+	if err != nil {
+		return primitives.Pre{}, err
+	}
+
+	if preValue.Imbalance.HasValue {
+		paid := preValue.Imbalance.Value.ToBigInt()
+		if convertedFee.Cmp(paid) > 0 {
+			convertedFee = paid
+		}
+
+		refund := new(big.Int).Sub(paid, convertedFee)
+		if refund.Cmp(constants.Zero) > 0 {
+			if assets.Deposit(assetId, preValue.Who, refund) != nil {
+				return primitives.Pre{}, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionPayment())
+			}
+		}
+
+		OnChargeAssetTransaction.OnUnbalanced(assetId, convertedFee)
+	}
+
+	system.DepositEvent(NewEventAssetTxFeePaid(preValue.Who.FixedSequence, sc.NewU128FromBigInt(convertedFee), preValue.Tip, preValue.AssetId))
+
+	return primitives.Pre{}, nil
+}
+
+// withdrawFee computes the fee in the native currency and withdraws its equivalent in the asset.
+// At least one unit of the asset is withdrawn for a non-zero fee.
+func (catp ChargeAssetTxPayment) withdrawFee(who *primitives.AccountId, info *primitives.DispatchInfo, length sc.Compact) (primitives.Balance, sc.Option[primitives.Balance], primitives.TransactionValidityError) {
+	fee := transaction_payment.ComputeFee(sc.U32(length.ToBigInt().Uint64()), *info, catp.Tip)
+	if fee.ToBigInt().Cmp(constants.Zero) == 0 {
+		return fee, sc.NewOption[primitives.Balance](nil), nil
+	}
+
+	convertedFee, err := Converter.ToAssetBalance(fee.ToBigInt(), catp.AssetId.Value)
+	if err != nil {
+		return primitives.Balance{}, sc.NewOption[primitives.Balance](nil), err
+	}
+	if convertedFee.Cmp(constants.Zero) == 0 {
+		convertedFee = big.NewInt(1)
+	}
+
+	if assets.Withdraw(catp.AssetId.Value, *who, convertedFee) != nil {
+		return primitives.Balance{}, sc.NewOption[primitives.Balance](nil), primitives.NewTransactionValidityError(primitives.NewInvalidTransactionPayment())
+	}
+
+	return fee, sc.NewOption[primitives.Balance](sc.NewU128FromBigInt(convertedFee)), nil
+}
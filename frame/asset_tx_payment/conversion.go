@@ -0,0 +1,31 @@
+package asset_tx_payment
+
+import (
+	"math/big"
+
+	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/frame/assets"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// BalanceConversion converts an amount of the native currency into an amount of an asset.
+type BalanceConversion interface {
+	ToAssetBalance(balance *big.Int, assetId primitives.AssetId) (*big.Int, primitives.TransactionValidityError)
+}
+
+// Converter converts the transaction fees into the asset they are paid in.
+var Converter BalanceConversion = BalanceToAssetBalance{}
+
+// BalanceToAssetBalance converts using the ratio between the minimum balance of the asset
+// and the existential deposit of the native currency. Only sufficient assets can be converted.
+type BalanceToAssetBalance struct{}
+
+func (_ BalanceToAssetBalance) ToAssetBalance(balance *big.Int, assetId primitives.AssetId) (*big.Int, primitives.TransactionValidityError) {
+	details := assets.StorageGetAsset(assetId)
+	if !details.HasValue || !details.Value.IsSufficient {
+		return nil, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionPayment())
+	}
+
+	converted := new(big.Int).Mul(balance, details.Value.MinBalance.ToBigInt())
+	return converted.Quo(converted, balances.ExistentialDeposit), nil
+}
@@ -0,0 +1,41 @@
+package asset_tx_payment
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/asset_tx_payment"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// AssetTxPayment module events.
+const (
+	EventAssetTxFeePaid sc.U8 = iota
+)
+
+func NewEventAssetTxFeePaid(account types.PublicKey, actualFee types.Balance, tip types.Balance, assetId sc.Option[types.AssetId]) types.Event {
+	return types.NewEvent(asset_tx_payment.ModuleIndex, EventAssetTxFeePaid, account, actualFee, tip, assetId)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	module := sc.DecodeU8(buffer)
+	if module != asset_tx_payment.ModuleIndex {
+		log.Critical("invalid asset_tx_payment.Event module")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventAssetTxFeePaid:
+		account := types.DecodePublicKey(buffer)
+		actualFee := sc.DecodeU128(buffer)
+		tip := sc.DecodeU128(buffer)
+		assetId := sc.DecodeOption[types.AssetId](buffer)
+		return NewEventAssetTxFeePaid(account, actualFee, tip, assetId)
+	default:
+		log.Critical("invalid asset_tx_payment.Event type")
+	}
+
+	panic("unreachable")
+}
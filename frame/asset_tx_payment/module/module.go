@@ -0,0 +1,71 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/asset_tx_payment"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type AssetTxPaymentModule struct {
+}
+
+func NewAssetTxPaymentModule() AssetTxPaymentModule {
+	return AssetTxPaymentModule{}
+}
+
+func (atpm AssetTxPaymentModule) Functions() map[sc.U8]primitives.Call {
+	return map[sc.U8]primitives.Call{}
+}
+
+func (atpm AssetTxPaymentModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (atpm AssetTxPaymentModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (atpm AssetTxPaymentModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Allows paying the transaction fees in assets other than the native token."}
+}
+
+func (atpm AssetTxPaymentModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	registry.Add(atpm.metadataTypes()...)
+
+	return primitives.MetadataModule{
+		Name:      "AssetTxPayment",
+		Storage:   sc.NewOption[primitives.MetadataModuleStorage](nil),
+		Call:      sc.NewOption[sc.Compact](nil),
+		Event:     sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesAssetTxPaymentEvent)),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](nil),
+		Index:     asset_tx_payment.ModuleIndex,
+	}
+}
+
+func (atpm AssetTxPaymentModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithParam(metadata.TypesAssetTxPaymentEvent, "pallet_asset_tx_payment pallet Event", sc.Sequence[sc.Str]{"pallet_asset_tx_payment", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"AssetTxFeePaid",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "actual_fee", "AssetBalanceOf<T>"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "tip", "AssetBalanceOf<T>"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionU32, "asset_id", "Option<ChargeAssetIdOf<T>>"),
+					},
+					0,
+					"A transaction fee `actual_fee`, of which `tip` was added to the minimum inclusion fee, has been paid by `who` in an asset `asset_id`."),
+			}), primitives.NewMetadataEmptyTypeParameter("T")),
+
+		primitives.NewMetadataTypeWithParam(metadata.ChargeAssetTxPayment, "ChargeAssetTxPayment", sc.Sequence[sc.Str]{"pallet_asset_tx_payment", "ChargeAssetTxPayment"},
+			primitives.NewMetadataTypeDefinitionComposite(sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "tip", "BalanceOf<T>"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionU32, "asset_id", "Option<ChargeAssetIdOf<T>>"),
+			}),
+			primitives.NewMetadataEmptyTypeParameter("T"),
+		),
+	}
+}
@@ -0,0 +1,37 @@
+package asset_tx_payment
+
+import (
+	"math/big"
+
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/authorship"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OnUnbalancedAsset handles the fee withdrawn in an asset from the sender of a transaction,
+// once the actual fee is known and the excess has been refunded.
+type OnUnbalancedAsset interface {
+	OnUnbalanced(assetId primitives.AssetId, amount *big.Int)
+}
+
+// OnChargeAssetTransaction is the handler of the transaction fees paid in an asset.
+var OnChargeAssetTransaction OnUnbalancedAsset = CreditToBlockAuthor{}
+
+// CreditToBlockAuthor deposits the fee into the asset account of the block author.
+// The fee is burned if the author cannot be found or the deposit fails.
+type CreditToBlockAuthor struct{}
+
+func (_ CreditToBlockAuthor) OnUnbalanced(assetId primitives.AssetId, amount *big.Int) {
+	if amount.Cmp(constants.Zero) == 0 {
+		return
+	}
+
+	author := authorship.Author()
+	if !author.HasValue {
+		return
+	}
+
+	// The amount is already removed from the supply of the asset, so a failed deposit burns it.
+	_ = assets.Deposit(assetId, author.Value, amount)
+}
@@ -0,0 +1,759 @@
+package assets
+
+import (
+	"math/big"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	"github.com/LimeChain/gosemble/frame/assets/events"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Balance returns the balance of `who` in the asset with the given id.
+func Balance(id types.AssetId, who types.AccountId) *big.Int {
+	account := StorageGetAccount(id, who)
+	if !account.HasValue {
+		return big.NewInt(0)
+	}
+
+	return account.Value.Balance.ToBigInt()
+}
+
+// TotalSupply returns the total supply of the asset with the given id.
+func TotalSupply(id types.AssetId) *big.Int {
+	details := StorageGetAsset(id)
+	if !details.HasValue {
+		return big.NewInt(0)
+	}
+
+	return details.Value.Supply.ToBigInt()
+}
+
+// Create issues a new asset class, reserving the asset deposit from `owner`.
+// The `admin` account is set as issuer, admin and freezer of the asset.
+func Create(owner types.AccountId, id types.AssetId, admin types.AccountId, minBalance *big.Int) types.DispatchError {
+	if StorageGetAsset(id).HasValue {
+		return newAssetsError(errors.ErrorInUse)
+	}
+
+	if minBalance.Cmp(constants.Zero) == 0 {
+		return newAssetsError(errors.ErrorMinBalanceZero)
+	}
+
+	err := dispatchables.Reserve(owner, assets.AssetDeposit)
+	if err != nil {
+		return err
+	}
+
+	StorageSetAsset(id, types.AssetDetails{
+		Owner:      owner,
+		Issuer:     admin,
+		Admin:      admin,
+		Freezer:    admin,
+		Deposit:    sc.NewU128FromBigInt(assets.AssetDeposit),
+		MinBalance: sc.NewU128FromBigInt(minBalance),
+		Status:     types.AssetStatusLive,
+	})
+
+	system.DepositEvent(events.NewEventCreated(id, owner.FixedSequence, admin.FixedSequence))
+
+	return nil
+}
+
+// ForceCreate issues a new asset class without taking a deposit.
+// A sufficient asset does not require its holders to have a native balance.
+func ForceCreate(id types.AssetId, owner types.AccountId, isSufficient sc.Bool, minBalance *big.Int) types.DispatchError {
+	if StorageGetAsset(id).HasValue {
+		return newAssetsError(errors.ErrorInUse)
+	}
+
+	if minBalance.Cmp(constants.Zero) == 0 {
+		return newAssetsError(errors.ErrorMinBalanceZero)
+	}
+
+	StorageSetAsset(id, types.AssetDetails{
+		Owner:        owner,
+		Issuer:       owner,
+		Admin:        owner,
+		Freezer:      owner,
+		Deposit:      sc.NewU128FromBigInt(big.NewInt(0)),
+		MinBalance:   sc.NewU128FromBigInt(minBalance),
+		IsSufficient: isSufficient,
+		Status:       types.AssetStatusLive,
+	})
+
+	system.DepositEvent(events.NewEventForceCreated(id, owner.FixedSequence))
+
+	return nil
+}
+
+// StartDestroy starts the destruction of an asset. Afterwards, its accounts and approvals
+// are removed in batches with DestroyAccounts and DestroyApprovals, before FinishDestroy.
+// If `maybeCheckOwner` is set, it must be the owner of the asset.
+func StartDestroy(id types.AssetId, maybeCheckOwner sc.Option[types.AccountId]) types.DispatchError {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	if bool(maybeCheckOwner.HasValue) && !reflect.DeepEqual(details.Owner, maybeCheckOwner.Value) {
+		return newAssetsError(errors.ErrorNoPermission)
+	}
+
+	details.Status = types.AssetStatusDestroying
+	StorageSetAsset(id, details)
+
+	system.DepositEvent(events.NewEventDestructionStarted(id))
+
+	return nil
+}
+
+// DestroyAccounts removes up to `RemoveItemsLimit` accounts of an asset which is being destroyed.
+// Returns the number of removed accounts.
+func DestroyAccounts(id types.AssetId) (sc.U32, types.DispatchError) {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return 0, newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	if details.Status != types.AssetStatusDestroying {
+		return 0, newAssetsError(errors.ErrorIncorrectStatus)
+	}
+
+	removed := sc.U32(0)
+	for _, who := range StorageGetAccounts(id, assets.RemoveItemsLimit) {
+		account := StorageGetAccount(id, who).Value
+
+		StorageClearAccount(id, who)
+		deadAccount(who, &details, account.Reason)
+		details.Supply = sc.NewU128FromBigInt(new(big.Int).Sub(details.Supply.ToBigInt(), account.Balance.ToBigInt()))
+
+		removed++
+	}
+
+	StorageSetAsset(id, details)
+
+	system.DepositEvent(events.NewEventAccountsDestroyed(id, removed, details.Accounts))
+
+	return removed, nil
+}
+
+// DestroyApprovals removes up to `RemoveItemsLimit` approvals of an asset which is being destroyed,
+// returning their deposits. Returns the number of removed approvals.
+func DestroyApprovals(id types.AssetId) (sc.U32, types.DispatchError) {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return 0, newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	if details.Status != types.AssetStatusDestroying {
+		return 0, newAssetsError(errors.ErrorIncorrectStatus)
+	}
+
+	removed := sc.U32(0)
+	for _, pair := range StorageGetApprovals(id, assets.RemoveItemsLimit) {
+		owner, delegate := pair[0], pair[1]
+		approval := StorageGetApproval(id, owner, delegate).Value
+
+		dispatchables.Unreserve(owner, approval.Deposit.ToBigInt())
+		StorageClearApproval(id, owner, delegate)
+		details.Approvals--
+
+		removed++
+	}
+
+	StorageSetAsset(id, details)
+
+	system.DepositEvent(events.NewEventApprovalsDestroyed(id, removed, details.Approvals))
+
+	return removed, nil
+}
+
+// FinishDestroy removes an asset whose accounts and approvals have all been removed,
+// returning the asset and metadata deposits to the owner.
+func FinishDestroy(id types.AssetId) types.DispatchError {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	if details.Status != types.AssetStatusDestroying {
+		return newAssetsError(errors.ErrorIncorrectStatus)
+	}
+
+	if details.Accounts != 0 || details.Approvals != 0 {
+		return newAssetsError(errors.ErrorInUse)
+	}
+
+	metadata := StorageGetMetadata(id)
+	deposit := new(big.Int).Add(details.Deposit.ToBigInt(), metadata.Deposit.ToBigInt())
+	dispatchables.Unreserve(details.Owner, deposit)
+
+	StorageClearAsset(id)
+	StorageClearMetadata(id)
+
+	system.DepositEvent(events.NewEventDestroyed(id))
+
+	return nil
+}
+
+// Mint increases the balance of `beneficiary` by `amount`, creating its account if needed.
+// If `maybeCheckIssuer` is set, it must be the issuer of the asset.
+func Mint(id types.AssetId, beneficiary types.AccountId, amount *big.Int, maybeCheckIssuer sc.Option[types.AccountId]) types.DispatchError {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	if details.Status != types.AssetStatusLive {
+		return newAssetsError(errors.ErrorAssetNotLive)
+	}
+
+	if bool(maybeCheckIssuer.HasValue) && !reflect.DeepEqual(details.Issuer, maybeCheckIssuer.Value) {
+		return newAssetsError(errors.ErrorNoPermission)
+	}
+
+	err := increaseBalance(id, beneficiary, amount, &details)
+	if err != nil {
+		return err
+	}
+	StorageSetAsset(id, details)
+
+	system.DepositEvent(events.NewEventIssued(id, beneficiary.FixedSequence, sc.NewU128FromBigInt(amount)))
+
+	return nil
+}
+
+// Burn reduces the balance of `who` by up to `amount`. If the remaining balance falls below
+// the minimum balance, the whole balance is burned.
+// If `maybeCheckAdmin` is set, it must be the admin of the asset.
+func Burn(id types.AssetId, who types.AccountId, amount *big.Int, maybeCheckAdmin sc.Option[types.AccountId]) (*big.Int, types.DispatchError) {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return nil, newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	if bool(maybeCheckAdmin.HasValue) && !reflect.DeepEqual(details.Admin, maybeCheckAdmin.Value) {
+		return nil, newAssetsError(errors.ErrorNoPermission)
+	}
+
+	burned, err := decreaseBalance(id, who, amount, false, true, &details)
+	if err != nil {
+		return nil, err
+	}
+	StorageSetAsset(id, details)
+
+	system.DepositEvent(events.NewEventBurned(id, who.FixedSequence, sc.NewU128FromBigInt(burned)))
+
+	return burned, nil
+}
+
+// Transfer moves `amount` of an asset from `source` to `dest`. If the remaining balance of `source`
+// falls below the minimum balance, it fails with `WouldDie` if `keepAlive` is set, otherwise the
+// whole balance is transferred.
+func Transfer(id types.AssetId, source types.AccountId, dest types.AccountId, amount *big.Int, keepAlive bool) (*big.Int, types.DispatchError) {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return nil, newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	transferred, err := transfer(id, source, dest, amount, keepAlive, &details)
+	if err != nil {
+		return nil, err
+	}
+	StorageSetAsset(id, details)
+
+	return transferred, nil
+}
+
+// Withdraw removes exactly `amount` from the balance of `who`, keeping the account alive.
+// Unlike Burn, it does not deposit an event, as it is used for charging transaction fees.
+func Withdraw(id types.AssetId, who types.AccountId, amount *big.Int) types.DispatchError {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	_, err := decreaseBalance(id, who, amount, true, false, &details)
+	if err != nil {
+		return err
+	}
+	StorageSetAsset(id, details)
+
+	return nil
+}
+
+// Deposit increases the balance of `who` by `amount`, creating its account if needed.
+// Unlike Mint, it does not deposit an event, as it is used for refunding transaction fees.
+func Deposit(id types.AssetId, who types.AccountId, amount *big.Int) types.DispatchError {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	err := increaseBalance(id, who, amount, &details)
+	if err != nil {
+		return err
+	}
+	StorageSetAsset(id, details)
+
+	return nil
+}
+
+// Freeze disallows further transfers from the account of `who`. `origin` must be the freezer of the asset.
+func Freeze(id types.AssetId, origin types.AccountId, who types.AccountId) types.DispatchError {
+	return setAccountFrozen(id, origin, who, true)
+}
+
+// Thaw allows transfers from the account of `who` again. `origin` must be the admin of the asset.
+func Thaw(id types.AssetId, origin types.AccountId, who types.AccountId) types.DispatchError {
+	return setAccountFrozen(id, origin, who, false)
+}
+
+// FreezeAsset disallows further transfers of an asset. `origin` must be the freezer of the asset.
+func FreezeAsset(id types.AssetId, origin types.AccountId) types.DispatchError {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	if !reflect.DeepEqual(details.Freezer, origin) {
+		return newAssetsError(errors.ErrorNoPermission)
+	}
+
+	if details.Status != types.AssetStatusLive {
+		return newAssetsError(errors.ErrorAssetNotLive)
+	}
+
+	details.Status = types.AssetStatusFrozen
+	StorageSetAsset(id, details)
+
+	system.DepositEvent(events.NewEventAssetFrozen(id))
+
+	return nil
+}
+
+// ThawAsset allows transfers of a frozen asset again. `origin` must be the admin of the asset.
+func ThawAsset(id types.AssetId, origin types.AccountId) types.DispatchError {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	if !reflect.DeepEqual(details.Admin, origin) {
+		return newAssetsError(errors.ErrorNoPermission)
+	}
+
+	if details.Status != types.AssetStatusFrozen {
+		return newAssetsError(errors.ErrorNotFrozen)
+	}
+
+	details.Status = types.AssetStatusLive
+	StorageSetAsset(id, details)
+
+	system.DepositEvent(events.NewEventAssetThawed(id))
+
+	return nil
+}
+
+// SetMetadata sets the metadata of an asset. `origin` must be the owner of the asset.
+// The deposit for the metadata depends on the length of `name` and `symbol`.
+func SetMetadata(id types.AssetId, origin types.AccountId, name sc.Sequence[sc.U8], symbol sc.Sequence[sc.U8], decimals sc.U8) types.DispatchError {
+	if len(name) > assets.StringLimit || len(symbol) > assets.StringLimit {
+		return newAssetsError(errors.ErrorBadMetadata)
+	}
+
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	if details.Status != types.AssetStatusLive {
+		return newAssetsError(errors.ErrorAssetNotLive)
+	}
+
+	if !reflect.DeepEqual(details.Owner, origin) {
+		return newAssetsError(errors.ErrorNoPermission)
+	}
+
+	metadata := StorageGetMetadata(id)
+	if metadata.IsFrozen {
+		return newAssetsError(errors.ErrorNoPermission)
+	}
+
+	oldDeposit := metadata.Deposit.ToBigInt()
+	newDeposit := new(big.Int).Mul(assets.MetadataDepositPerByte, big.NewInt(int64(len(name)+len(symbol))))
+	newDeposit.Add(newDeposit, assets.MetadataDepositBase)
+
+	if newDeposit.Cmp(oldDeposit) > 0 {
+		err := dispatchables.Reserve(origin, new(big.Int).Sub(newDeposit, oldDeposit))
+		if err != nil {
+			return err
+		}
+	} else {
+		dispatchables.Unreserve(origin, new(big.Int).Sub(oldDeposit, newDeposit))
+	}
+
+	StorageSetMetadata(id, types.AssetMetadata{
+		Deposit:  sc.NewU128FromBigInt(newDeposit),
+		Name:     name,
+		Symbol:   symbol,
+		Decimals: decimals,
+		IsFrozen: false,
+	})
+
+	system.DepositEvent(events.NewEventMetadataSet(id, name, symbol, decimals, false))
+
+	return nil
+}
+
+// ClearMetadata removes the metadata of an asset and returns its deposit.
+// `origin` must be the owner of the asset.
+func ClearMetadata(id types.AssetId, origin types.AccountId) types.DispatchError {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	if details.Status != types.AssetStatusLive {
+		return newAssetsError(errors.ErrorAssetNotLive)
+	}
+
+	if !reflect.DeepEqual(details.Owner, origin) {
+		return newAssetsError(errors.ErrorNoPermission)
+	}
+
+	if !StorageExistsMetadata(id) {
+		return newAssetsError(errors.ErrorUnknown)
+	}
+	metadata := StorageGetMetadata(id)
+
+	dispatchables.Unreserve(details.Owner, metadata.Deposit.ToBigInt())
+	StorageClearMetadata(id)
+
+	system.DepositEvent(events.NewEventMetadataCleared(id))
+
+	return nil
+}
+
+// ApproveTransfer approves `delegate` to transfer an additional `amount` of the asset from the
+// account of `owner`. A deposit is reserved from `owner` for a new approval.
+func ApproveTransfer(id types.AssetId, owner types.AccountId, delegate types.AccountId, amount *big.Int) types.DispatchError {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	if details.Status != types.AssetStatusLive {
+		return newAssetsError(errors.ErrorAssetNotLive)
+	}
+
+	var approval types.AssetApproval
+	maybeApproval := StorageGetApproval(id, owner, delegate)
+	if maybeApproval.HasValue {
+		approval = maybeApproval.Value
+	} else {
+		err := dispatchables.Reserve(owner, assets.ApprovalDeposit)
+		if err != nil {
+			return err
+		}
+
+		approval = types.AssetApproval{
+			Amount:  sc.NewU128FromBigInt(big.NewInt(0)),
+			Deposit: sc.NewU128FromBigInt(assets.ApprovalDeposit),
+		}
+		details.Approvals++
+	}
+
+	approved := new(big.Int).Add(approval.Amount.ToBigInt(), amount)
+	if approved.BitLen() > 128 {
+		return types.NewDispatchErrorArithmetic(types.NewArithmeticErrorOverflow())
+	}
+	approval.Amount = sc.NewU128FromBigInt(approved)
+
+	StorageSetApproval(id, owner, delegate, approval)
+	StorageSetAsset(id, details)
+
+	system.DepositEvent(events.NewEventApprovedTransfer(id, owner.FixedSequence, delegate.FixedSequence, approval.Amount))
+
+	return nil
+}
+
+// TransferApproved transfers `amount` of the asset from `owner` to `destination`, using an
+// approval of `delegate`. The approval deposit is returned once the approval is used up.
+func TransferApproved(id types.AssetId, owner types.AccountId, delegate types.AccountId, destination types.AccountId, amount *big.Int) types.DispatchError {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	if details.Status != types.AssetStatusLive {
+		return newAssetsError(errors.ErrorAssetNotLive)
+	}
+
+	maybeApproval := StorageGetApproval(id, owner, delegate)
+	if !maybeApproval.HasValue {
+		return newAssetsError(errors.ErrorUnapproved)
+	}
+	approval := maybeApproval.Value
+
+	remaining := new(big.Int).Sub(approval.Amount.ToBigInt(), amount)
+	if remaining.Cmp(constants.Zero) < 0 {
+		return newAssetsError(errors.ErrorUnapproved)
+	}
+
+	_, err := transfer(id, owner, destination, amount, false, &details)
+	if err != nil {
+		return err
+	}
+
+	if remaining.Cmp(constants.Zero) == 0 {
+		dispatchables.Unreserve(owner, approval.Deposit.ToBigInt())
+		StorageClearApproval(id, owner, delegate)
+		details.Approvals--
+	} else {
+		approval.Amount = sc.NewU128FromBigInt(remaining)
+		StorageSetApproval(id, owner, delegate, approval)
+	}
+
+	StorageSetAsset(id, details)
+
+	system.DepositEvent(events.NewEventTransferredApproved(id, owner.FixedSequence, delegate.FixedSequence, destination.FixedSequence, sc.NewU128FromBigInt(amount)))
+
+	return nil
+}
+
+func setAccountFrozen(id types.AssetId, origin types.AccountId, who types.AccountId, frozen sc.Bool) types.DispatchError {
+	maybeDetails := StorageGetAsset(id)
+	if !maybeDetails.HasValue {
+		return newAssetsError(errors.ErrorUnknown)
+	}
+	details := maybeDetails.Value
+
+	if details.Status == types.AssetStatusDestroying {
+		return newAssetsError(errors.ErrorIncorrectStatus)
+	}
+
+	expected := details.Freezer
+	if !frozen {
+		expected = details.Admin
+	}
+	if !reflect.DeepEqual(expected, origin) {
+		return newAssetsError(errors.ErrorNoPermission)
+	}
+
+	maybeAccount := StorageGetAccount(id, who)
+	if !maybeAccount.HasValue {
+		return newAssetsError(errors.ErrorNoAccount)
+	}
+	account := maybeAccount.Value
+
+	account.IsFrozen = frozen
+	StorageSetAccount(id, who, account)
+
+	if frozen {
+		system.DepositEvent(events.NewEventFrozen(id, who.FixedSequence))
+	} else {
+		system.DepositEvent(events.NewEventThawed(id, who.FixedSequence))
+	}
+
+	return nil
+}
+
+// transfer moves `amount` from `source` to `dest`, updating `details`.
+func transfer(id types.AssetId, source types.AccountId, dest types.AccountId, amount *big.Int, keepAlive bool, details *types.AssetDetails) (*big.Int, types.DispatchError) {
+	if amount.Cmp(constants.Zero) == 0 {
+		return amount, nil
+	}
+
+	debit, _, err := prepareDebit(id, source, amount, keepAlive, false, details)
+	if err != nil {
+		return nil, err
+	}
+
+	if reflect.DeepEqual(source, dest) {
+		return debit, nil
+	}
+
+	_, err = decreaseBalance(id, source, amount, keepAlive, false, details)
+	if err != nil {
+		return nil, err
+	}
+
+	err = increaseBalance(id, dest, debit, details)
+	if err != nil {
+		return nil, err
+	}
+
+	system.DepositEvent(events.NewEventTransferred(id, source.FixedSequence, dest.FixedSequence, sc.NewU128FromBigInt(debit)))
+
+	return debit, nil
+}
+
+// increaseBalance adds `amount` to the balance of `who`, creating its account if needed.
+func increaseBalance(id types.AssetId, who types.AccountId, amount *big.Int, details *types.AssetDetails) types.DispatchError {
+	if amount.Cmp(constants.Zero) == 0 {
+		return nil
+	}
+
+	supply := new(big.Int).Add(details.Supply.ToBigInt(), amount)
+	if supply.BitLen() > 128 {
+		return types.NewDispatchErrorArithmetic(types.NewArithmeticErrorOverflow())
+	}
+
+	maybeAccount := StorageGetAccount(id, who)
+	if maybeAccount.HasValue {
+		account := maybeAccount.Value
+
+		balance := new(big.Int).Add(account.Balance.ToBigInt(), amount)
+		if balance.BitLen() > 128 {
+			return types.NewDispatchErrorArithmetic(types.NewArithmeticErrorOverflow())
+		}
+
+		account.Balance = sc.NewU128FromBigInt(balance)
+		StorageSetAccount(id, who, account)
+	} else {
+		if amount.Cmp(details.MinBalance.ToBigInt()) < 0 {
+			return types.NewDispatchErrorToken(types.NewTokenErrorBelowMinimum())
+		}
+
+		reason, err := newAccount(who, details)
+		if err != nil {
+			return err
+		}
+
+		StorageSetAccount(id, who, types.AssetAccount{
+			Balance: sc.NewU128FromBigInt(amount),
+			Reason:  reason,
+		})
+	}
+
+	details.Supply = sc.NewU128FromBigInt(supply)
+
+	return nil
+}
+
+// decreaseBalance removes up to `amount` from the balance of `who`, removing its account
+// if the remaining balance falls below the minimum balance.
+func decreaseBalance(id types.AssetId, who types.AccountId, amount *big.Int, keepAlive bool, bestEffort bool, details *types.AssetDetails) (*big.Int, types.DispatchError) {
+	debit, account, err := prepareDebit(id, who, amount, keepAlive, bestEffort, details)
+	if err != nil {
+		return nil, err
+	}
+
+	rest := new(big.Int).Sub(account.Balance.ToBigInt(), debit)
+	if rest.Cmp(constants.Zero) == 0 {
+		StorageClearAccount(id, who)
+		deadAccount(who, details, account.Reason)
+	} else {
+		account.Balance = sc.NewU128FromBigInt(rest)
+		StorageSetAccount(id, who, account)
+	}
+
+	details.Supply = sc.NewU128FromBigInt(new(big.Int).Sub(details.Supply.ToBigInt(), debit))
+
+	return debit, nil
+}
+
+// prepareDebit returns the amount which would be removed from the balance of `who`, without changing it.
+// The balance is removed completely, if the remaining balance would fall below the minimum balance.
+func prepareDebit(id types.AssetId, who types.AccountId, amount *big.Int, keepAlive bool, bestEffort bool, details *types.AssetDetails) (*big.Int, types.AssetAccount, types.DispatchError) {
+	switch details.Status {
+	case types.AssetStatusFrozen:
+		return nil, types.AssetAccount{}, newAssetsError(errors.ErrorFrozen)
+	case types.AssetStatusDestroying:
+		return nil, types.AssetAccount{}, newAssetsError(errors.ErrorAssetNotLive)
+	}
+
+	maybeAccount := StorageGetAccount(id, who)
+	if !maybeAccount.HasValue {
+		return nil, types.AssetAccount{}, newAssetsError(errors.ErrorNoAccount)
+	}
+	account := maybeAccount.Value
+
+	if account.IsFrozen {
+		return nil, types.AssetAccount{}, newAssetsError(errors.ErrorFrozen)
+	}
+
+	balance := account.Balance.ToBigInt()
+
+	debit := new(big.Int).Set(amount)
+	if balance.Cmp(amount) < 0 {
+		if !bestEffort {
+			return nil, types.AssetAccount{}, newAssetsError(errors.ErrorBalanceLow)
+		}
+		debit.Set(balance)
+	}
+
+	rest := new(big.Int).Sub(balance, debit)
+	if rest.Cmp(details.MinBalance.ToBigInt()) < 0 {
+		if keepAlive {
+			return nil, types.AssetAccount{}, newAssetsError(errors.ErrorWouldDie)
+		}
+		debit.Set(balance)
+	}
+
+	return debit, account, nil
+}
+
+// newAccount adds a reference for a new account of an asset to `who`.
+// Sufficient assets keep the account alive on their own, others require an existing native balance.
+func newAccount(who types.AccountId, details *types.AssetDetails) (types.ExistenceReason, types.DispatchError) {
+	var reason types.ExistenceReason
+
+	if details.IsSufficient {
+		system.IncSufficients(who)
+		details.Sufficients++
+		reason = types.ExistenceReasonSufficient
+	} else {
+		err := system.IncConsumers(who)
+		if err != nil {
+			return 0, newAssetsError(errors.ErrorUnavailableConsumer)
+		}
+		reason = types.ExistenceReasonConsumer
+	}
+
+	details.Accounts++
+
+	return reason, nil
+}
+
+// deadAccount removes the reference of a removed account of an asset from `who`.
+func deadAccount(who types.AccountId, details *types.AssetDetails, reason types.ExistenceReason) {
+	if reason == types.ExistenceReasonSufficient {
+		details.Sufficients--
+		system.DecSufficients(who)
+	} else {
+		system.DecConsumers(who)
+	}
+
+	details.Accounts--
+}
+
+func newAssetsError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   assets.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
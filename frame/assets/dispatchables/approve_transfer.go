@@ -0,0 +1,133 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ApproveTransferCall struct {
+	primitives.Callable
+}
+
+func NewApproveTransferCall(args sc.VaryingData) ApproveTransferCall {
+	call := ApproveTransferCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionApproveTransferIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ApproveTransferCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeMultiAddress(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c ApproveTransferCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ApproveTransferCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ApproveTransferCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ApproveTransferCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ApproveTransferCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "approve_transfer",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("delegate", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Approve an amount of asset for transfer by a delegated third-party account.",
+	}
+}
+
+func (c ApproveTransferCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ApproveTransferCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 31_360 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoAsset, pallet.StorageInfoApprovals, system.StorageInfoAccount)
+	return types.WeightFromParts(32_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ApproveTransferCall) IsInherent() bool {
+	return false
+}
+
+func (_ ApproveTransferCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ApproveTransferCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ApproveTransferCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ApproveTransferCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := approveTransfer(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(types.MultiAddress), sc.U128(args[2].(sc.Compact)))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// approveTransfer approves `delegate` to transfer an additional `amount` of an asset from the
+// account of the sender. A deposit is reserved from the sender for a new approval.
+func approveTransfer(origin types.RuntimeOrigin, id types.AssetId, delegate types.MultiAddress, amount types.Balance) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	delegateAccount, e := types.DefaultAccountIdLookup().Lookup(delegate)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.ApproveTransfer(id, origin.AsSigned(), delegateAccount, amount.ToBigInt())
+}
@@ -0,0 +1,133 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type BurnCall struct {
+	primitives.Callable
+}
+
+func NewBurnCall(args sc.VaryingData) BurnCall {
+	call := BurnCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionBurnIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c BurnCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeMultiAddress(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c BurnCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c BurnCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c BurnCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c BurnCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ BurnCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "burn",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("who", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Reduce the balance of `who` by as much as possible up to `amount` assets of `id`.",
+	}
+}
+
+func (c BurnCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ BurnCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 32_340 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoAsset, pallet.StorageInfoAccount, system.StorageInfoAccount)
+	return types.WeightFromParts(33_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ BurnCall) IsInherent() bool {
+	return false
+}
+
+func (_ BurnCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ BurnCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ BurnCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ BurnCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := burn(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(types.MultiAddress), sc.U128(args[2].(sc.Compact)))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// burn reduces the balance of `who` by up to `amount`. Must be called by the admin of the asset.
+func burn(origin types.RuntimeOrigin, id types.AssetId, who types.MultiAddress, amount types.Balance) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	target, e := types.DefaultAccountIdLookup().Lookup(who)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	_, err := pallet.Burn(id, target, amount.ToBigInt(), sc.NewOption[types.AccountId](origin.AsSigned()))
+	return err
+}
@@ -0,0 +1,133 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CreateCall struct {
+	primitives.Callable
+}
+
+func NewCreateCall(args sc.VaryingData) CreateCall {
+	call := CreateCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionCreateIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c CreateCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeMultiAddress(buffer),
+		sc.DecodeU128(buffer),
+	)
+	return c
+}
+
+func (c CreateCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c CreateCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c CreateCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c CreateCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ CreateCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "create",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("admin", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("min_balance", "T::Balance", primitives.TypeInfoU128),
+		},
+		Docs: "Issue a new class of fungible assets from a public origin.",
+	}
+}
+
+func (c CreateCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ CreateCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 26_460 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoAsset, system.StorageInfoAccount)
+	return types.WeightFromParts(27_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ CreateCall) IsInherent() bool {
+	return false
+}
+
+func (_ CreateCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ CreateCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ CreateCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ CreateCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := create(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(types.MultiAddress), args[2].(sc.U128))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// create issues a new class of fungible assets from a public origin.
+// The asset deposit is reserved from the sender, who becomes the owner of the asset.
+func create(origin types.RuntimeOrigin, id types.AssetId, admin types.MultiAddress, minBalance types.Balance) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	adminAccount, e := types.DefaultAccountIdLookup().Lookup(admin)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.Create(origin.AsSigned(), id, adminAccount, minBalance.ToBigInt())
+}
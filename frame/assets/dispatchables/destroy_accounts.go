@@ -0,0 +1,127 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type DestroyAccountsCall struct {
+	primitives.Callable
+}
+
+func NewDestroyAccountsCall(args sc.VaryingData) DestroyAccountsCall {
+	call := DestroyAccountsCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionDestroyAccountsIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c DestroyAccountsCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c DestroyAccountsCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c DestroyAccountsCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c DestroyAccountsCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c DestroyAccountsCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ DestroyAccountsCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "destroy_accounts",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+		},
+		Docs: "Destroy all accounts associated with a given asset.",
+	}
+}
+
+func (c DestroyAccountsCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ DestroyAccountsCall) BaseWeight(b ...any) types.Weight {
+	// Each removed item costs the execution time, reads, writes and proof below.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoAccount, system.StorageInfoAccount)
+	return types.ProofWeight(pallet.StorageInfoAsset).
+		SaturatingAdd(constants.DbWeight.ReadsWrites(1, 1)).
+		SaturatingAdd(types.WeightFromParts(15_018_000, 0).
+			SaturatingAdd(e).
+			SaturatingAdd(r).
+			SaturatingAdd(w).
+			SaturatingMul(assets.RemoveItemsLimit))
+}
+
+func (_ DestroyAccountsCall) IsInherent() bool {
+	return false
+}
+
+func (_ DestroyAccountsCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ DestroyAccountsCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ DestroyAccountsCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ DestroyAccountsCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := destroyAccounts(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// destroyAccounts removes up to `RemoveItemsLimit` accounts of an asset which is being destroyed.
+// It can be called by anyone and may need to be called multiple times.
+func destroyAccounts(origin types.RuntimeOrigin, id types.AssetId) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	_, err := pallet.DestroyAccounts(id)
+	return err
+}
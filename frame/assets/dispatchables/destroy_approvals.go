@@ -0,0 +1,128 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type DestroyApprovalsCall struct {
+	primitives.Callable
+}
+
+func NewDestroyApprovalsCall(args sc.VaryingData) DestroyApprovalsCall {
+	call := DestroyApprovalsCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionDestroyApprovalsIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c DestroyApprovalsCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c DestroyApprovalsCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c DestroyApprovalsCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c DestroyApprovalsCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c DestroyApprovalsCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ DestroyApprovalsCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "destroy_approvals",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+		},
+		Docs: "Destroy all approvals associated with a given asset up to the max (T::RemoveItemsLimit).",
+	}
+}
+
+func (c DestroyApprovalsCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ DestroyApprovalsCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 16_677_640 nanoseconds.
+	// Each removed item costs the execution time, reads, writes and proof below.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoApprovals, system.StorageInfoAccount)
+	return types.ProofWeight(pallet.StorageInfoAsset).
+		SaturatingAdd(constants.DbWeight.ReadsWrites(1, 1)).
+		SaturatingAdd(types.WeightFromParts(17_018_000, 0).
+			SaturatingAdd(e).
+			SaturatingAdd(r).
+			SaturatingAdd(w).
+			SaturatingMul(assets.RemoveItemsLimit))
+}
+
+func (_ DestroyApprovalsCall) IsInherent() bool {
+	return false
+}
+
+func (_ DestroyApprovalsCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ DestroyApprovalsCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ DestroyApprovalsCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ DestroyApprovalsCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := destroyApprovals(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// destroyApprovals removes up to `RemoveItemsLimit` approvals of an asset which is being destroyed.
+// It can be called by anyone and may need to be called multiple times.
+func destroyApprovals(origin types.RuntimeOrigin, id types.AssetId) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	_, err := pallet.DestroyApprovals(id)
+	return err
+}
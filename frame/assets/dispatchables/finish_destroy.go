@@ -0,0 +1,123 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type FinishDestroyCall struct {
+	primitives.Callable
+}
+
+func NewFinishDestroyCall(args sc.VaryingData) FinishDestroyCall {
+	call := FinishDestroyCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionFinishDestroyIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c FinishDestroyCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c FinishDestroyCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c FinishDestroyCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c FinishDestroyCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c FinishDestroyCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ FinishDestroyCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "finish_destroy",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+		},
+		Docs: "Complete destroying asset and unreserve currency.",
+	}
+}
+
+func (c FinishDestroyCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ FinishDestroyCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 13_720 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoAsset, pallet.StorageInfoMetadata, system.StorageInfoAccount)
+	return types.WeightFromParts(14_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ FinishDestroyCall) IsInherent() bool {
+	return false
+}
+
+func (_ FinishDestroyCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ FinishDestroyCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ FinishDestroyCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ FinishDestroyCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := finishDestroy(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// finishDestroy completes the destruction of an asset, once all of its accounts and approvals
+// have been removed. It can be called by anyone.
+func finishDestroy(origin types.RuntimeOrigin, id types.AssetId) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.FinishDestroy(id)
+}
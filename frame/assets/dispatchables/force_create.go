@@ -0,0 +1,134 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ForceCreateCall struct {
+	primitives.Callable
+}
+
+func NewForceCreateCall(args sc.VaryingData) ForceCreateCall {
+	call := ForceCreateCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionForceCreateIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ForceCreateCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeMultiAddress(buffer),
+		sc.DecodeBool(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c ForceCreateCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ForceCreateCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ForceCreateCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ForceCreateCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ForceCreateCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "force_create",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("owner", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("is_sufficient", "bool", primitives.TypeInfoBool),
+			primitives.NewFieldTypeInfo("min_balance", "T::Balance", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Issue a new class of fungible assets from a privileged origin.",
+	}
+}
+
+func (c ForceCreateCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ForceCreateCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 11_760 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoAsset)
+	return types.WeightFromParts(12_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ForceCreateCall) IsInherent() bool {
+	return false
+}
+
+func (_ ForceCreateCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ForceCreateCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ForceCreateCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ForceCreateCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := forceCreate(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(types.MultiAddress), args[2].(sc.Bool), sc.U128(args[3].(sc.Compact)))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// forceCreate issues a new class of fungible assets from a privileged origin, without a deposit.
+func forceCreate(origin types.RuntimeOrigin, id types.AssetId, owner types.MultiAddress, isSufficient sc.Bool, minBalance types.Balance) types.DispatchError {
+	err := pallet.ForceOrigin.EnsureOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	ownerAccount, e := types.DefaultAccountIdLookup().Lookup(owner)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.ForceCreate(id, ownerAccount, isSufficient, minBalance.ToBigInt())
+}
@@ -0,0 +1,130 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type FreezeCall struct {
+	primitives.Callable
+}
+
+func NewFreezeCall(args sc.VaryingData) FreezeCall {
+	call := FreezeCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionFreezeIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c FreezeCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c FreezeCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c FreezeCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c FreezeCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c FreezeCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ FreezeCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "freeze",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("who", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Disallow further unprivileged transfers of an asset `id` from an account `who`.",
+	}
+}
+
+func (c FreezeCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ FreezeCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 16_660 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoAsset, pallet.StorageInfoAccount)
+	return types.WeightFromParts(17_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ FreezeCall) IsInherent() bool {
+	return false
+}
+
+func (_ FreezeCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ FreezeCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ FreezeCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ FreezeCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := freeze(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// freeze disallows further transfers of an asset from the account of `who`.
+// Must be called by the freezer of the asset.
+func freeze(origin types.RuntimeOrigin, id types.AssetId, who types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	target, e := types.DefaultAccountIdLookup().Lookup(who)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.Freeze(id, origin.AsSigned(), target)
+}
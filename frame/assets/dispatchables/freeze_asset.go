@@ -0,0 +1,121 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type FreezeAssetCall struct {
+	primitives.Callable
+}
+
+func NewFreezeAssetCall(args sc.VaryingData) FreezeAssetCall {
+	call := FreezeAssetCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionFreezeAssetIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c FreezeAssetCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c FreezeAssetCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c FreezeAssetCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c FreezeAssetCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c FreezeAssetCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ FreezeAssetCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "freeze_asset",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+		},
+		Docs: "Disallow further unprivileged transfers for the asset class.",
+	}
+}
+
+func (c FreezeAssetCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ FreezeAssetCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 13_720 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoAsset)
+	return types.WeightFromParts(14_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ FreezeAssetCall) IsInherent() bool {
+	return false
+}
+
+func (_ FreezeAssetCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ FreezeAssetCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ FreezeAssetCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ FreezeAssetCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := freezeAsset(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// freezeAsset disallows further transfers of an asset. Must be called by the freezer of the asset.
+func freezeAsset(origin types.RuntimeOrigin, id types.AssetId) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.FreezeAsset(id, origin.AsSigned())
+}
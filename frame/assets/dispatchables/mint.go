@@ -0,0 +1,133 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type MintCall struct {
+	primitives.Callable
+}
+
+func NewMintCall(args sc.VaryingData) MintCall {
+	call := MintCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionMintIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c MintCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeMultiAddress(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c MintCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c MintCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c MintCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c MintCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ MintCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "mint",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("beneficiary", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Mint assets of a particular class.",
+	}
+}
+
+func (c MintCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ MintCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 25_480 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoAsset, pallet.StorageInfoAccount, system.StorageInfoAccount)
+	return types.WeightFromParts(26_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ MintCall) IsInherent() bool {
+	return false
+}
+
+func (_ MintCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ MintCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ MintCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ MintCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := mint(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(types.MultiAddress), sc.U128(args[2].(sc.Compact)))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// mint mints `amount` of an asset into the account of `beneficiary`.
+// Must be called by the issuer of the asset.
+func mint(origin types.RuntimeOrigin, id types.AssetId, beneficiary types.MultiAddress, amount types.Balance) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	beneficiaryAccount, e := types.DefaultAccountIdLookup().Lookup(beneficiary)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.Mint(id, beneficiaryAccount, amount.ToBigInt(), sc.NewOption[types.AccountId](origin.AsSigned()))
+}
@@ -0,0 +1,130 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetMetadataCall struct {
+	primitives.Callable
+}
+
+func NewSetMetadataCall(args sc.VaryingData) SetMetadataCall {
+	call := SetMetadataCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionSetMetadataIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetMetadataCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		sc.DecodeSequence[sc.U8](buffer),
+		sc.DecodeSequence[sc.U8](buffer),
+		sc.DecodeU8(buffer),
+	)
+	return c
+}
+
+func (c SetMetadataCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetMetadataCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetMetadataCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetMetadataCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetMetadataCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_metadata",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("name", "Vec<u8>", primitives.TypeId(metadata.TypesSequenceU8)),
+			primitives.NewFieldTypeInfo("symbol", "Vec<u8>", primitives.TypeId(metadata.TypesSequenceU8)),
+			primitives.NewFieldTypeInfo("decimals", "u8", primitives.TypeInfoU8),
+		},
+		Docs: "Set the metadata for an asset.",
+	}
+}
+
+func (c SetMetadataCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetMetadataCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 29_400 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoAsset, pallet.StorageInfoMetadata, system.StorageInfoAccount)
+	return types.WeightFromParts(30_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetMetadataCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetMetadataCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetMetadataCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetMetadataCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetMetadataCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setMetadata(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(sc.Sequence[sc.U8]), args[2].(sc.Sequence[sc.U8]), args[3].(sc.U8))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setMetadata sets the name, symbol and decimals of an asset. Must be called by the owner of the asset.
+// A deposit depending on the length of the name and symbol is reserved from the owner.
+func setMetadata(origin types.RuntimeOrigin, id types.AssetId, name sc.Sequence[sc.U8], symbol sc.Sequence[sc.U8], decimals sc.U8) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.SetMetadata(id, origin.AsSigned(), name, symbol, decimals)
+}
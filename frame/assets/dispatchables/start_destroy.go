@@ -0,0 +1,126 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type StartDestroyCall struct {
+	primitives.Callable
+}
+
+func NewStartDestroyCall(args sc.VaryingData) StartDestroyCall {
+	call := StartDestroyCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionStartDestroyIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c StartDestroyCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c StartDestroyCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c StartDestroyCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c StartDestroyCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c StartDestroyCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ StartDestroyCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "start_destroy",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+		},
+		Docs: "Start the process of destroying a fungible asset class.",
+	}
+}
+
+func (c StartDestroyCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ StartDestroyCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 13_720 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoAsset)
+	return types.WeightFromParts(14_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ StartDestroyCall) IsInherent() bool {
+	return false
+}
+
+func (_ StartDestroyCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ StartDestroyCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ StartDestroyCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ StartDestroyCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := startDestroy(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// startDestroy starts the destruction of an asset. Must be called by the owner of the asset
+// or the force origin. Transfers, minting and burning of the asset are no longer possible.
+func startDestroy(origin types.RuntimeOrigin, id types.AssetId) types.DispatchError {
+	maybeCheckOwner := sc.NewOption[types.AccountId](nil)
+	if pallet.ForceOrigin.EnsureOrigin(origin) != nil {
+		if !origin.IsSignedOrigin() {
+			return types.NewDispatchErrorBadOrigin()
+		}
+		maybeCheckOwner = sc.NewOption[types.AccountId](origin.AsSigned())
+	}
+
+	return pallet.StartDestroy(id, maybeCheckOwner)
+}
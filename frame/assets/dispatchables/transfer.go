@@ -0,0 +1,134 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type TransferCall struct {
+	primitives.Callable
+}
+
+func NewTransferCall(args sc.VaryingData) TransferCall {
+	call := TransferCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionTransferIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c TransferCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeMultiAddress(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c TransferCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c TransferCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c TransferCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c TransferCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ TransferCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "transfer",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("target", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Move some assets from the sender account to another.",
+	}
+}
+
+func (c TransferCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ TransferCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 44_100 nanoseconds.
+	r := constants.DbWeight.Reads(4)
+	w := constants.DbWeight.Writes(4)
+	e := types.ProofWeight(pallet.StorageInfoAsset, pallet.StorageInfoAccount, pallet.StorageInfoAccount, system.StorageInfoAccount, system.StorageInfoAccount)
+	return types.WeightFromParts(45_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ TransferCall) IsInherent() bool {
+	return false
+}
+
+func (_ TransferCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ TransferCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ TransferCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ TransferCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := transfer(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(types.MultiAddress), sc.U128(args[2].(sc.Compact)))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// transfer moves `amount` of an asset from the sender to `target`.
+// If the remaining balance of the sender falls below the minimum balance, the whole balance is transferred.
+func transfer(origin types.RuntimeOrigin, id types.AssetId, target types.MultiAddress, amount types.Balance) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	dest, e := types.DefaultAccountIdLookup().Lookup(target)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	_, err := pallet.Transfer(id, origin.AsSigned(), dest, amount.ToBigInt(), false)
+	return err
+}
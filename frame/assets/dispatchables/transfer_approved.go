@@ -0,0 +1,140 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type TransferApprovedCall struct {
+	primitives.Callable
+}
+
+func NewTransferApprovedCall(args sc.VaryingData) TransferApprovedCall {
+	call := TransferApprovedCall{
+		Callable: primitives.Callable{
+			ModuleId:   assets.ModuleIndex,
+			FunctionId: assets.FunctionTransferApprovedIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c TransferApprovedCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeMultiAddress(buffer),
+		types.DecodeMultiAddress(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c TransferApprovedCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c TransferApprovedCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c TransferApprovedCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c TransferApprovedCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ TransferApprovedCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "transfer_approved",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "T::AssetIdParameter", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("owner", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("destination", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Transfer some asset balance from a previously delegated account to some third-party account.",
+	}
+}
+
+func (c TransferApprovedCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ TransferApprovedCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 62_720 nanoseconds.
+	r := constants.DbWeight.Reads(5)
+	w := constants.DbWeight.Writes(5)
+	e := types.ProofWeight(pallet.StorageInfoAsset, pallet.StorageInfoApprovals, pallet.StorageInfoAccount, pallet.StorageInfoAccount, system.StorageInfoAccount, system.StorageInfoAccount)
+	return types.WeightFromParts(64_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ TransferApprovedCall) IsInherent() bool {
+	return false
+}
+
+func (_ TransferApprovedCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ TransferApprovedCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ TransferApprovedCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ TransferApprovedCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := transferApproved(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(types.MultiAddress), args[2].(types.MultiAddress), sc.U128(args[3].(sc.Compact)))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// transferApproved transfers `amount` of an asset from `owner` to `destination`, using an approval
+// given to the sender by `owner`.
+func transferApproved(origin types.RuntimeOrigin, id types.AssetId, owner types.MultiAddress, destination types.MultiAddress, amount types.Balance) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	ownerAccount, e := types.DefaultAccountIdLookup().Lookup(owner)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	destinationAccount, e := types.DefaultAccountIdLookup().Lookup(destination)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.TransferApproved(id, ownerAccount, origin.AsSigned(), destinationAccount, amount.ToBigInt())
+}
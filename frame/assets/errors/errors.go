@@ -0,0 +1,24 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Assets module errors.
+const (
+	ErrorBalanceLow sc.U8 = iota
+	ErrorNoAccount
+	ErrorNoPermission
+	ErrorUnknown
+	ErrorFrozen
+	ErrorInUse
+	ErrorMinBalanceZero
+	ErrorUnavailableConsumer
+	ErrorBadMetadata
+	ErrorUnapproved
+	ErrorWouldDie
+	ErrorAlreadyExists
+	ErrorNoDeposit
+	ErrorLiveAsset
+	ErrorAssetNotLive
+	ErrorIncorrectStatus
+	ErrorNotFrozen
+)
@@ -0,0 +1,193 @@
+package events
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Assets module events.
+const (
+	EventCreated sc.U8 = iota
+	EventIssued
+	EventTransferred
+	EventBurned
+	EventFrozen
+	EventThawed
+	EventAssetFrozen
+	EventAssetThawed
+	EventAccountsDestroyed
+	EventApprovalsDestroyed
+	EventDestructionStarted
+	EventDestroyed
+	EventForceCreated
+	EventMetadataSet
+	EventMetadataCleared
+	EventApprovedTransfer
+	EventTransferredApproved
+)
+
+func NewEventCreated(assetId types.AssetId, creator types.PublicKey, owner types.PublicKey) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventCreated, assetId, creator, owner)
+}
+
+func NewEventIssued(assetId types.AssetId, owner types.PublicKey, amount types.Balance) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventIssued, assetId, owner, amount)
+}
+
+func NewEventTransferred(assetId types.AssetId, from types.PublicKey, to types.PublicKey, amount types.Balance) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventTransferred, assetId, from, to, amount)
+}
+
+func NewEventBurned(assetId types.AssetId, owner types.PublicKey, balance types.Balance) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventBurned, assetId, owner, balance)
+}
+
+func NewEventFrozen(assetId types.AssetId, who types.PublicKey) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventFrozen, assetId, who)
+}
+
+func NewEventThawed(assetId types.AssetId, who types.PublicKey) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventThawed, assetId, who)
+}
+
+func NewEventAssetFrozen(assetId types.AssetId) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventAssetFrozen, assetId)
+}
+
+func NewEventAssetThawed(assetId types.AssetId) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventAssetThawed, assetId)
+}
+
+func NewEventAccountsDestroyed(assetId types.AssetId, accountsDestroyed sc.U32, accountsRemaining sc.U32) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventAccountsDestroyed, assetId, accountsDestroyed, accountsRemaining)
+}
+
+func NewEventApprovalsDestroyed(assetId types.AssetId, approvalsDestroyed sc.U32, approvalsRemaining sc.U32) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventApprovalsDestroyed, assetId, approvalsDestroyed, approvalsRemaining)
+}
+
+func NewEventDestructionStarted(assetId types.AssetId) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventDestructionStarted, assetId)
+}
+
+func NewEventDestroyed(assetId types.AssetId) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventDestroyed, assetId)
+}
+
+func NewEventForceCreated(assetId types.AssetId, owner types.PublicKey) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventForceCreated, assetId, owner)
+}
+
+func NewEventMetadataSet(assetId types.AssetId, name sc.Sequence[sc.U8], symbol sc.Sequence[sc.U8], decimals sc.U8, isFrozen sc.Bool) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventMetadataSet, assetId, name, symbol, decimals, isFrozen)
+}
+
+func NewEventMetadataCleared(assetId types.AssetId) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventMetadataCleared, assetId)
+}
+
+func NewEventApprovedTransfer(assetId types.AssetId, source types.PublicKey, delegate types.PublicKey, amount types.Balance) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventApprovedTransfer, assetId, source, delegate, amount)
+}
+
+func NewEventTransferredApproved(assetId types.AssetId, owner types.PublicKey, delegate types.PublicKey, destination types.PublicKey, amount types.Balance) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventTransferredApproved, assetId, owner, delegate, destination, amount)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	module := sc.DecodeU8(buffer)
+	if module != assets.ModuleIndex {
+		log.Critical("invalid assets.Event module")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventCreated:
+		assetId := sc.DecodeU32(buffer)
+		creator := types.DecodePublicKey(buffer)
+		owner := types.DecodePublicKey(buffer)
+		return NewEventCreated(assetId, creator, owner)
+	case EventIssued:
+		assetId := sc.DecodeU32(buffer)
+		owner := types.DecodePublicKey(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventIssued(assetId, owner, amount)
+	case EventTransferred:
+		assetId := sc.DecodeU32(buffer)
+		from := types.DecodePublicKey(buffer)
+		to := types.DecodePublicKey(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventTransferred(assetId, from, to, amount)
+	case EventBurned:
+		assetId := sc.DecodeU32(buffer)
+		owner := types.DecodePublicKey(buffer)
+		balance := sc.DecodeU128(buffer)
+		return NewEventBurned(assetId, owner, balance)
+	case EventFrozen:
+		assetId := sc.DecodeU32(buffer)
+		who := types.DecodePublicKey(buffer)
+		return NewEventFrozen(assetId, who)
+	case EventThawed:
+		assetId := sc.DecodeU32(buffer)
+		who := types.DecodePublicKey(buffer)
+		return NewEventThawed(assetId, who)
+	case EventAssetFrozen:
+		assetId := sc.DecodeU32(buffer)
+		return NewEventAssetFrozen(assetId)
+	case EventAssetThawed:
+		assetId := sc.DecodeU32(buffer)
+		return NewEventAssetThawed(assetId)
+	case EventAccountsDestroyed:
+		assetId := sc.DecodeU32(buffer)
+		accountsDestroyed := sc.DecodeU32(buffer)
+		accountsRemaining := sc.DecodeU32(buffer)
+		return NewEventAccountsDestroyed(assetId, accountsDestroyed, accountsRemaining)
+	case EventApprovalsDestroyed:
+		assetId := sc.DecodeU32(buffer)
+		approvalsDestroyed := sc.DecodeU32(buffer)
+		approvalsRemaining := sc.DecodeU32(buffer)
+		return NewEventApprovalsDestroyed(assetId, approvalsDestroyed, approvalsRemaining)
+	case EventDestructionStarted:
+		assetId := sc.DecodeU32(buffer)
+		return NewEventDestructionStarted(assetId)
+	case EventDestroyed:
+		assetId := sc.DecodeU32(buffer)
+		return NewEventDestroyed(assetId)
+	case EventForceCreated:
+		assetId := sc.DecodeU32(buffer)
+		owner := types.DecodePublicKey(buffer)
+		return NewEventForceCreated(assetId, owner)
+	case EventMetadataSet:
+		assetId := sc.DecodeU32(buffer)
+		name := sc.DecodeSequence[sc.U8](buffer)
+		symbol := sc.DecodeSequence[sc.U8](buffer)
+		decimals := sc.DecodeU8(buffer)
+		isFrozen := sc.DecodeBool(buffer)
+		return NewEventMetadataSet(assetId, name, symbol, decimals, isFrozen)
+	case EventMetadataCleared:
+		assetId := sc.DecodeU32(buffer)
+		return NewEventMetadataCleared(assetId)
+	case EventApprovedTransfer:
+		assetId := sc.DecodeU32(buffer)
+		source := types.DecodePublicKey(buffer)
+		delegate := types.DecodePublicKey(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventApprovedTransfer(assetId, source, delegate, amount)
+	case EventTransferredApproved:
+		assetId := sc.DecodeU32(buffer)
+		owner := types.DecodePublicKey(buffer)
+		delegate := types.DecodePublicKey(buffer)
+		destination := types.DecodePublicKey(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventTransferredApproved(assetId, owner, delegate, destination, amount)
+	default:
+		log.Critical("invalid assets.Event type")
+	}
+
+	panic("unreachable")
+}
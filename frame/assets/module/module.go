@@ -0,0 +1,255 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/assets/dispatchables"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	"github.com/LimeChain/gosemble/frame/assets/events"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type AssetsModule struct {
+	functions map[sc.U8]primitives.Call
+}
+
+func NewAssetsModule() AssetsModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[assets.FunctionCreateIndex] = dispatchables.NewCreateCall(nil)
+	functions[assets.FunctionForceCreateIndex] = dispatchables.NewForceCreateCall(nil)
+	functions[assets.FunctionStartDestroyIndex] = dispatchables.NewStartDestroyCall(nil)
+	functions[assets.FunctionDestroyAccountsIndex] = dispatchables.NewDestroyAccountsCall(nil)
+	functions[assets.FunctionDestroyApprovalsIndex] = dispatchables.NewDestroyApprovalsCall(nil)
+	functions[assets.FunctionFinishDestroyIndex] = dispatchables.NewFinishDestroyCall(nil)
+	functions[assets.FunctionMintIndex] = dispatchables.NewMintCall(nil)
+	functions[assets.FunctionBurnIndex] = dispatchables.NewBurnCall(nil)
+	functions[assets.FunctionTransferIndex] = dispatchables.NewTransferCall(nil)
+	functions[assets.FunctionTransferKeepAliveIndex] = dispatchables.NewTransferKeepAliveCall(nil)
+	functions[assets.FunctionFreezeIndex] = dispatchables.NewFreezeCall(nil)
+	functions[assets.FunctionThawIndex] = dispatchables.NewThawCall(nil)
+	functions[assets.FunctionFreezeAssetIndex] = dispatchables.NewFreezeAssetCall(nil)
+	functions[assets.FunctionThawAssetIndex] = dispatchables.NewThawAssetCall(nil)
+	functions[assets.FunctionSetMetadataIndex] = dispatchables.NewSetMetadataCall(nil)
+	functions[assets.FunctionClearMetadataIndex] = dispatchables.NewClearMetadataCall(nil)
+	functions[assets.FunctionApproveTransferIndex] = dispatchables.NewApproveTransferCall(nil)
+	functions[assets.FunctionTransferApprovedIndex] = dispatchables.NewTransferApprovedCall(nil)
+	return AssetsModule{
+		functions: functions,
+	}
+}
+
+func (am AssetsModule) Functions() map[sc.U8]primitives.Call {
+	return am.functions
+}
+
+func (am AssetsModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (am AssetsModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (am AssetsModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Issuance and management of fungible assets."}
+}
+
+func (am AssetsModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "Assets",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "Assets",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"Asset",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.PrimitiveTypesU32),
+						sc.ToCompact(registry.Register(pallet.AssetDetailsTypeInfo))),
+					"Details of an asset."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Account",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat, primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(registry.Register(primitives.NewTupleTypeInfo(primitives.TypeInfoU32, primitives.TypeId(metadata.TypesAddress32)))),
+						sc.ToCompact(registry.Register(pallet.AssetAccountTypeInfo))),
+					"The holdings of a specific account for a specific asset."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Approvals",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat, primitives.MetadataModuleStorageHashFuncMultiBlake128Concat, primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(registry.Register(primitives.NewTupleTypeInfo(primitives.TypeInfoU32, primitives.TypeId(metadata.TypesAddress32), primitives.TypeId(metadata.TypesAddress32)))),
+						sc.ToCompact(registry.Register(pallet.ApprovalTypeInfo))),
+					"Approved balance transfers. First balance is the amount approved for transfer. Second is the amount of `T::Currency` reserved for storing this."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Metadata",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.PrimitiveTypesU32),
+						sc.ToCompact(registry.Register(pallet.AssetMetadataTypeInfo))),
+					"Metadata of an asset."),
+			},
+		}),
+		Call:  sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(am.callsTypeInfo()))),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(eventTypeInfo()))),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"AssetDeposit",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(assets.AssetDeposit).Bytes()),
+				"The basic amount of funds that must be reserved for an asset.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MetadataDepositBase",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(assets.MetadataDepositBase).Bytes()),
+				"The basic amount of funds that must be reserved when adding metadata to your asset.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MetadataDepositPerByte",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(assets.MetadataDepositPerByte).Bytes()),
+				"The additional funds that must be reserved for the number of bytes you store in your metadata.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"ApprovalDeposit",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(assets.ApprovalDeposit).Bytes()),
+				"The amount of funds that must be reserved when creating a new approval.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"StringLimit",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(assets.StringLimit).Bytes()),
+				"The maximum length of a name or symbol stored on-chain.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"RemoveItemsLimit",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(assets.RemoveItemsLimit).Bytes()),
+				"Max number of items to destroy per `destroy_accounts` and `destroy_approvals` call.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(errorsTypeInfo()))),
+		Index: assets.ModuleIndex,
+	}
+}
+
+func (am AssetsModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_assets", "pallet", "Call"}, am.functions)
+}
+
+func eventTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_assets", "pallet", "Event"},
+		"The events of the module.",
+		primitives.NewVariantTypeInfo("Created", events.EventCreated, "Some asset class was created.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("creator", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("owner", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("Issued", events.EventIssued, "Some assets were issued.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("owner", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Transferred", events.EventTransferred, "Some assets were transferred.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("from", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("to", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Burned", events.EventBurned, "Some assets were destroyed.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("owner", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("balance", "T::Balance", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Frozen", events.EventFrozen, "Some account `who` was frozen.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("Thawed", events.EventThawed, "Some account `who` was thawed.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("AssetFrozen", events.EventAssetFrozen, "Some asset `asset_id` was frozen.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("AssetThawed", events.EventAssetThawed, "Some asset `asset_id` was thawed.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("AccountsDestroyed", events.EventAccountsDestroyed, "Accounts were destroyed for given asset.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("accounts_destroyed", "u32", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("accounts_remaining", "u32", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("ApprovalsDestroyed", events.EventApprovalsDestroyed, "Approvals were destroyed for given asset.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("approvals_destroyed", "u32", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("approvals_remaining", "u32", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("DestructionStarted", events.EventDestructionStarted, "An asset class is in the process of being destroyed.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("Destroyed", events.EventDestroyed, "An asset class was destroyed.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("ForceCreated", events.EventForceCreated, "Some asset class was force-created.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("owner", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("MetadataSet", events.EventMetadataSet, "New metadata has been set for an asset.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("name", "Vec<u8>", primitives.TypeId(metadata.TypesSequenceU8)),
+			primitives.NewFieldTypeInfo("symbol", "Vec<u8>", primitives.TypeId(metadata.TypesSequenceU8)),
+			primitives.NewFieldTypeInfo("decimals", "u8", primitives.TypeInfoU8),
+			primitives.NewFieldTypeInfo("is_frozen", "bool", primitives.TypeInfoBool),
+		),
+		primitives.NewVariantTypeInfo("MetadataCleared", events.EventMetadataCleared, "Metadata has been cleared for an asset.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("ApprovedTransfer", events.EventApprovedTransfer, "(Additional) funds have been approved for transfer to a destination account.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("source", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("delegate", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("TransferredApproved", events.EventTransferredApproved, "An `amount` was transferred in its entirety from `owner` to `destination` by the approved `delegate`.",
+			primitives.NewFieldTypeInfo("asset_id", "T::AssetId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("owner", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("delegate", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("destination", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.TypeInfoU128),
+		),
+	)
+}
+
+func errorsTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_assets", "pallet", "Error"},
+		"The errors of the module.",
+		primitives.NewVariantTypeInfo("BalanceLow", errors.ErrorBalanceLow, "Account balance must be greater than or equal to the transfer amount."),
+		primitives.NewVariantTypeInfo("NoAccount", errors.ErrorNoAccount, "The account to alter does not exist."),
+		primitives.NewVariantTypeInfo("NoPermission", errors.ErrorNoPermission, "The signing account has no permission to do the operation."),
+		primitives.NewVariantTypeInfo("Unknown", errors.ErrorUnknown, "The given asset ID is unknown."),
+		primitives.NewVariantTypeInfo("Frozen", errors.ErrorFrozen, "The origin account is frozen."),
+		primitives.NewVariantTypeInfo("InUse", errors.ErrorInUse, "The asset ID is already taken."),
+		primitives.NewVariantTypeInfo("MinBalanceZero", errors.ErrorMinBalanceZero, "Minimum balance should be non-zero."),
+		primitives.NewVariantTypeInfo("UnavailableConsumer", errors.ErrorUnavailableConsumer, "Unable to increment the consumer reference counters on the account."),
+		primitives.NewVariantTypeInfo("BadMetadata", errors.ErrorBadMetadata, "Invalid metadata given."),
+		primitives.NewVariantTypeInfo("Unapproved", errors.ErrorUnapproved, "No approval exists that would allow the transfer."),
+		primitives.NewVariantTypeInfo("WouldDie", errors.ErrorWouldDie, "The source account would not survive the transfer and it needs to stay alive."),
+		primitives.NewVariantTypeInfo("AlreadyExists", errors.ErrorAlreadyExists, "The asset-account already exists."),
+		primitives.NewVariantTypeInfo("NoDeposit", errors.ErrorNoDeposit, "The asset-account doesn't have an associated deposit."),
+		primitives.NewVariantTypeInfo("LiveAsset", errors.ErrorLiveAsset, "The asset is a live asset and is actively being used."),
+		primitives.NewVariantTypeInfo("AssetNotLive", errors.ErrorAssetNotLive, "The asset is not live, and likely being destroyed."),
+		primitives.NewVariantTypeInfo("IncorrectStatus", errors.ErrorIncorrectStatus, "The asset status is not the expected status."),
+		primitives.NewVariantTypeInfo("NotFrozen", errors.ErrorNotFrozen, "The asset should be frozen before the given operation."),
+	)
+}
@@ -0,0 +1,17 @@
+package module
+
+import (
+	pallet "github.com/LimeChain/gosemble/frame/assets"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageInfo describes the storage items of the module whose values have a maximum encoded length.
+// The sizes of the map entries include their hashed keys.
+func (am AssetsModule) StorageInfo() []primitives.StorageInfo {
+	return []primitives.StorageInfo{
+		pallet.StorageInfoAsset,
+		pallet.StorageInfoAccount,
+		pallet.StorageInfoApprovals,
+		pallet.StorageInfoMetadata,
+	}
+}
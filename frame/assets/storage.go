@@ -0,0 +1,173 @@
+package assets
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetAsset returns the details of an asset.
+func StorageGetAsset(id types.AssetId) sc.Option[types.AssetDetails] {
+	option := storage.Get(keyAsset(id))
+	if !option.HasValue {
+		return sc.NewOption[types.AssetDetails](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.AssetDetails](types.DecodeAssetDetails(buffer))
+}
+
+func StorageSetAsset(id types.AssetId, details types.AssetDetails) {
+	storage.Set(keyAsset(id), details.Bytes())
+}
+
+func StorageClearAsset(id types.AssetId) {
+	storage.Clear(keyAsset(id))
+}
+
+// StorageGetAccount returns the holdings of an account in an asset.
+func StorageGetAccount(id types.AssetId, who types.AccountId) sc.Option[types.AssetAccount] {
+	option := storage.Get(keyAccount(id, who))
+	if !option.HasValue {
+		return sc.NewOption[types.AssetAccount](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.AssetAccount](types.DecodeAssetAccount(buffer))
+}
+
+func StorageSetAccount(id types.AssetId, who types.AccountId, account types.AssetAccount) {
+	storage.Set(keyAccount(id, who), account.Bytes())
+}
+
+func StorageClearAccount(id types.AssetId, who types.AccountId) {
+	storage.Clear(keyAccount(id, who))
+}
+
+// StorageGetAccounts returns up to `limit` accounts holding an asset.
+func StorageGetAccounts(id types.AssetId, limit int) sc.Sequence[types.AccountId] {
+	prefix := prefixAccount(id)
+
+	accounts := sc.Sequence[types.AccountId]{}
+	for _, key := range iterKeys(prefix, limit) {
+		// blake2_128_concat(who)
+		accounts = append(accounts, types.DecodeAccountId(bytes.NewBuffer(key[len(prefix)+16:])))
+	}
+
+	return accounts
+}
+
+// StorageGetApproval returns the amount `delegate` is approved to transfer from the account of `owner`.
+func StorageGetApproval(id types.AssetId, owner types.AccountId, delegate types.AccountId) sc.Option[types.AssetApproval] {
+	option := storage.Get(keyApprovals(id, owner, delegate))
+	if !option.HasValue {
+		return sc.NewOption[types.AssetApproval](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.AssetApproval](types.DecodeAssetApproval(buffer))
+}
+
+func StorageSetApproval(id types.AssetId, owner types.AccountId, delegate types.AccountId, approval types.AssetApproval) {
+	storage.Set(keyApprovals(id, owner, delegate), approval.Bytes())
+}
+
+func StorageClearApproval(id types.AssetId, owner types.AccountId, delegate types.AccountId) {
+	storage.Clear(keyApprovals(id, owner, delegate))
+}
+
+// StorageGetApprovals returns up to `limit` owner and delegate pairs of the approvals of an asset.
+func StorageGetApprovals(id types.AssetId, limit int) [][2]types.AccountId {
+	prefix := prefixApprovals(id)
+
+	approvals := [][2]types.AccountId{}
+	for _, key := range iterKeys(prefix, limit) {
+		// blake2_128_concat(owner) ++ blake2_128_concat(delegate)
+		owner := types.DecodeAccountId(bytes.NewBuffer(key[len(prefix)+16:]))
+		delegate := types.DecodeAccountId(bytes.NewBuffer(key[len(prefix)+16+types.AccountIdLength+16:]))
+		approvals = append(approvals, [2]types.AccountId{owner, delegate})
+	}
+
+	return approvals
+}
+
+// StorageGetMetadata returns the metadata of an asset.
+func StorageGetMetadata(id types.AssetId) types.AssetMetadata {
+	return storage.GetDecode(keyMetadata(id), types.DecodeAssetMetadata)
+}
+
+func StorageExistsMetadata(id types.AssetId) bool {
+	return storage.Exists(keyMetadata(id)) != 0
+}
+
+func StorageSetMetadata(id types.AssetId, metadata types.AssetMetadata) {
+	storage.Set(keyMetadata(id), metadata.Bytes())
+}
+
+func StorageClearMetadata(id types.AssetId) {
+	storage.Clear(keyMetadata(id))
+}
+
+// iterKeys returns up to `limit` storage keys starting with `prefix`.
+func iterKeys(prefix []byte, limit int) [][]byte {
+	keys := [][]byte{}
+
+	current := prefix
+	for len(keys) < limit {
+		next := storage.NextKey(current)
+		if !next.HasValue {
+			break
+		}
+
+		key := sc.SequenceU8ToBytes(next.Value)
+		if !bytes.HasPrefix(key, prefix) {
+			break
+		}
+
+		keys = append(keys, key)
+		current = key
+	}
+
+	return keys
+}
+
+// blake2128Concat returns the key of `value` hashed with the blake2 128 concat hasher.
+func blake2128Concat(value []byte) []byte {
+	return append(hashing.Blake128(value), value...)
+}
+
+func keyAsset(id types.AssetId) []byte {
+	key := append(hashing.Twox128(constants.KeyAssets), hashing.Twox128(constants.KeyAsset)...)
+	return append(key, blake2128Concat(id.Bytes())...)
+}
+
+func prefixAccount(id types.AssetId) []byte {
+	key := append(hashing.Twox128(constants.KeyAssets), hashing.Twox128(constants.KeyAccount)...)
+	return append(key, blake2128Concat(id.Bytes())...)
+}
+
+func keyAccount(id types.AssetId, who types.AccountId) []byte {
+	return append(prefixAccount(id), blake2128Concat(sc.FixedSequenceU8ToBytes(who.FixedSequence))...)
+}
+
+func prefixApprovals(id types.AssetId) []byte {
+	key := append(hashing.Twox128(constants.KeyAssets), hashing.Twox128(constants.KeyApprovals)...)
+	return append(key, blake2128Concat(id.Bytes())...)
+}
+
+func keyApprovals(id types.AssetId, owner types.AccountId, delegate types.AccountId) []byte {
+	key := append(prefixApprovals(id), blake2128Concat(sc.FixedSequenceU8ToBytes(owner.FixedSequence))...)
+	return append(key, blake2128Concat(sc.FixedSequenceU8ToBytes(delegate.FixedSequence))...)
+}
+
+func keyMetadata(id types.AssetId) []byte {
+	key := append(hashing.Twox128(constants.KeyAssets), hashing.Twox128(constants.KeyMetadata)...)
+	return append(key, blake2128Concat(id.Bytes())...)
+}
@@ -0,0 +1,21 @@
+package assets
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// StorageInfoAsset describes the `Asset` map, whose entries are Blake2_128Concat(AssetId) ++ AssetDetails.
+	StorageInfoAsset = types.NewStorageMapInfo("Assets", "Asset", sc.NewOption[sc.U32](nil), 20+190)
+	// StorageInfoAccount describes the `Account` map, whose entries are
+	// Blake2_128Concat(AssetId) ++ Blake2_128Concat(AccountId) ++ AssetAccount.
+	StorageInfoAccount = types.NewStorageMapInfo("Assets", "Account", sc.NewOption[sc.U32](nil), 20+48+18)
+	// StorageInfoApprovals describes the `Approvals` map, whose entries are
+	// Blake2_128Concat(AssetId) ++ Blake2_128Concat(AccountId) ++ Blake2_128Concat(AccountId) ++ Approval.
+	StorageInfoApprovals = types.NewStorageMapInfo("Assets", "Approvals", sc.NewOption[sc.U32](nil), 20+48+48+32)
+	// StorageInfoMetadata describes the `Metadata` map, whose entries are
+	// Blake2_128Concat(AssetId) ++ AssetMetadata, with a name and symbol of at most StringLimit bytes.
+	StorageInfoMetadata = types.NewStorageMapInfo("Assets", "Metadata", sc.NewOption[sc.U32](nil), 20+16+2*(1+assets.StringLimit)+1+1)
+)
@@ -0,0 +1,61 @@
+package assets
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// AssetStatusTypeInfo is the type info of types.AssetStatus.
+	AssetStatusTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_assets", "types", "AssetStatus"},
+		"The status of an asset class.",
+		types.NewVariantTypeInfo("Live", types.AssetStatusLive, "AssetStatus.Live"),
+		types.NewVariantTypeInfo("Frozen", types.AssetStatusFrozen, "AssetStatus.Frozen"),
+		types.NewVariantTypeInfo("Destroying", types.AssetStatusDestroying, "AssetStatus.Destroying"),
+	)
+	// AssetDetailsTypeInfo is the type info of types.AssetDetails.
+	AssetDetailsTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_assets", "types", "AssetDetails"},
+		"The details of an asset class.",
+		types.NewFieldTypeInfo("owner", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("issuer", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("admin", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("freezer", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("supply", "Balance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("deposit", "DepositBalance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("min_balance", "Balance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("is_sufficient", "bool", types.TypeInfoBool),
+		types.NewFieldTypeInfo("accounts", "u32", types.TypeInfoU32),
+		types.NewFieldTypeInfo("sufficients", "u32", types.TypeInfoU32),
+		types.NewFieldTypeInfo("approvals", "u32", types.TypeInfoU32),
+		types.NewFieldTypeInfo("status", "AssetStatus", AssetStatusTypeInfo),
+	)
+	// ExistenceReasonTypeInfo is the type info of types.ExistenceReason.
+	ExistenceReasonTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_assets", "types", "ExistenceReason"},
+		"The reason an account holding an asset exists.",
+		types.NewVariantTypeInfo("Consumer", types.ExistenceReasonConsumer, "ExistenceReason.Consumer"),
+		types.NewVariantTypeInfo("Sufficient", types.ExistenceReasonSufficient, "ExistenceReason.Sufficient"),
+	)
+	// AssetAccountTypeInfo is the type info of types.AssetAccount.
+	AssetAccountTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_assets", "types", "AssetAccount"},
+		"The holdings of an account in an asset.",
+		types.NewFieldTypeInfo("balance", "Balance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("is_frozen", "bool", types.TypeInfoBool),
+		types.NewFieldTypeInfo("reason", "ExistenceReason", ExistenceReasonTypeInfo),
+	)
+	// ApprovalTypeInfo is the type info of types.AssetApproval.
+	ApprovalTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_assets", "types", "Approval"},
+		"Data concerning an approval.",
+		types.NewFieldTypeInfo("amount", "Balance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("deposit", "DepositBalance", types.TypeInfoU128),
+	)
+	// AssetMetadataTypeInfo is the type info of types.AssetMetadata.
+	AssetMetadataTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_assets", "types", "AssetMetadata"},
+		"The metadata of an asset.",
+		types.NewFieldTypeInfo("deposit", "DepositBalance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("name", "BoundedString", types.TypeId(metadata.TypesSequenceU8)),
+		types.NewFieldTypeInfo("symbol", "BoundedString", types.TypeId(metadata.TypesSequenceU8)),
+		types.NewFieldTypeInfo("decimals", "u8", types.TypeInfoU8),
+		types.NewFieldTypeInfo("is_frozen", "bool", types.TypeInfoBool),
+	)
+)
@@ -7,37 +7,48 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/aura"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/constants/timestamp"
 	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/api"
 	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/log"
 	"github.com/LimeChain/gosemble/primitives/storage"
 	"github.com/LimeChain/gosemble/primitives/types"
-	"github.com/LimeChain/gosemble/utils"
 )
 
 type Slot = sc.U64
 
+// Api is the `AuraApi` runtime API, necessary for block authorship with Aura.
+var Api = api.New("AuraApi", 1, "API necessary for block authorship with aura.",
+	api.NewMethod("slot_duration",
+		types.TypeId(metadata.TypesAuraSlotDuration),
+		"Returns the slot duration for Aura.",
+		SlotDuration),
+	api.NewMethod("authorities",
+		types.TypeId(metadata.TypesSequencePubKeys),
+		"Return the current set of authorities.",
+		Authorities),
+)
+
 // Authorities returns current set of AuRa (Authority Round) authorities.
-// Returns a pointer-size of the SCALE-encoded set of authorities.
-func Authorities() int64 {
+// Returns the SCALE-encoded set of authorities.
+func Authorities() sc.FixedSequence[sc.U8] {
 	auraHash := hashing.Twox128(constants.KeyAura)
 	authoritiesHash := hashing.Twox128(constants.KeyAuthorities)
 
 	authorities := storage.Get(append(auraHash, authoritiesHash...))
 
 	if !authorities.HasValue {
-		return utils.BytesToOffsetAndSize([]byte{0})
+		return sc.BytesToFixedSequenceU8([]byte{0})
 	}
 
-	return utils.BytesToOffsetAndSize(sc.SequenceU8ToBytes(authorities.Value))
+	return sc.BytesToFixedSequenceU8(sc.SequenceU8ToBytes(authorities.Value))
 }
 
 // SlotDuration returns the slot duration for AuRa.
-// Returns a pointer-size of the SCALE-encoded slot duration
-func SlotDuration() int64 {
-	slotDuration := sc.U64(slotDuration())
-	return utils.BytesToOffsetAndSize(slotDuration.Bytes())
+func SlotDuration() sc.U64 {
+	return sc.U64(slotDuration())
 }
 
 func OnGenesisSession() {
@@ -66,8 +77,11 @@ func OnTimestampSet(now sc.U64) {
 }
 
 func currentSlotFromDigests() sc.Option[Slot] {
-	digest := system.StorageGetDigest()
+	return slotFromDigest(system.StorageGetDigest())
+}
 
+// slotFromDigest returns the slot from the Aura pre-runtime digest item, if there is one.
+func slotFromDigest(digest types.Digest) sc.Option[Slot] {
 	for keyDigest, dig := range digest {
 		if keyDigest == types.DigestTypePreRuntime {
 			for _, digestItem := range dig {
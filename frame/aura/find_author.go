@@ -0,0 +1,49 @@
+package aura
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// AuthorFinder finds the author of a block from the slot in its Aura pre-runtime digest.
+// The author is the authority at index `slot % len(authorities)`. Authority keys are
+// sr25519 public keys, which are mapped to account ids with types.AccountIdFromPublicKey.
+type AuthorFinder struct{}
+
+func (_ AuthorFinder) FindAuthor(digest types.Digest) sc.Option[types.AccountId] {
+	slot := slotFromDigest(digest)
+	if !slot.HasValue {
+		return sc.NewOption[types.AccountId](nil)
+	}
+
+	authorities := StorageGetAuthorities()
+	if len(authorities) == 0 {
+		return sc.NewOption[types.AccountId](nil)
+	}
+
+	index := slot.Value % sc.U64(len(authorities))
+
+	return sc.NewOption[types.AccountId](types.AccountIdFromPublicKey(authorities[index]))
+}
+
+// StorageGetAuthorities returns the current set of Aura authorities.
+func StorageGetAuthorities() sc.Sequence[types.PublicKey] {
+	auraHash := hashing.Twox128(constants.KeyAura)
+	authoritiesHash := hashing.Twox128(constants.KeyAuthorities)
+
+	return storage.GetDecode(append(auraHash, authoritiesHash...), func(buffer *bytes.Buffer) sc.Sequence[types.PublicKey] {
+		return sc.DecodeSequenceWith(buffer, types.DecodePublicKey)
+	})
+}
+
+func StorageSetAuthorities(authorities sc.Sequence[types.PublicKey]) {
+	auraHash := hashing.Twox128(constants.KeyAura)
+	authoritiesHash := hashing.Twox128(constants.KeyAuthorities)
+
+	storage.Set(append(auraHash, authoritiesHash...), authorities.Bytes())
+}
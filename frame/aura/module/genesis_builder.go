@@ -0,0 +1,39 @@
+package module
+
+import (
+	"encoding/json"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/aura"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var errInvalidAuthority = errors.New("authorities must be 32 byte sr25519 public keys")
+
+type gcAura struct {
+	Authorities []primitives.HexBytes `json:"authorities"`
+}
+
+func (am AuraModule) CreateDefaultConfig() ([]byte, error) {
+	return json.Marshal(gcAura{Authorities: []primitives.HexBytes{}})
+}
+
+func (am AuraModule) BuildConfig(config []byte) error {
+	gc := gcAura{}
+	if err := json.Unmarshal(config, &gc); err != nil {
+		return err
+	}
+
+	authorities := sc.Sequence[primitives.PublicKey]{}
+	for _, authority := range gc.Authorities {
+		if len(authority) != 32 {
+			return errInvalidAuthority
+		}
+		authorities = append(authorities, sc.BytesToFixedSequenceU8(authority))
+	}
+
+	aura.StorageSetAuthorities(authorities)
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants/aura"
 	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/aura"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
@@ -26,8 +27,19 @@ func (am AuraModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitiv
 	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
 }
 
-func (am AuraModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
-	return am.metadataTypes(), primitives.MetadataModule{
+// OnInitialize updates the current slot from the Aura pre-runtime digest of the block.
+func (am AuraModule) OnInitialize(_ primitives.BlockNumber) primitives.Weight {
+	return pallet.OnInitialize()
+}
+
+func (am AuraModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Slot-based block authoring with a round-robin set of authorities."}
+}
+
+func (am AuraModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	registry.Add(am.metadataTypes()...)
+
+	return primitives.MetadataModule{
 		Name: "Aura",
 		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
 			Prefix: "Aura",
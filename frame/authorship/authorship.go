@@ -0,0 +1,64 @@
+package authorship
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/aura"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// FindAuthor finds the author of a block from the digest of its header.
+type FindAuthor interface {
+	FindAuthor(digest types.Digest) sc.Option[types.AccountId]
+}
+
+// EventHandler is notified about the author of each block.
+type EventHandler interface {
+	NoteAuthor(author types.AccountId)
+}
+
+var (
+	// Finder is used to find the author of the current block.
+	Finder FindAuthor = aura.AuthorFinder{}
+	// Handler is notified about the author of the current block in on_initialize.
+	// No one is notified if it is not set.
+	Handler EventHandler = staking.AuthorshipEventHandler{}
+)
+
+// Author returns the author of the current block, if it can be found.
+// Once found, the author is stored until the end of the block.
+func Author() sc.Option[types.AccountId] {
+	author := StorageGetAuthor()
+	if author.HasValue {
+		return author
+	}
+
+	author = Finder.FindAuthor(system.StorageGetDigest())
+	if author.HasValue {
+		StorageSetAuthor(author.Value)
+	}
+
+	return author
+}
+
+// OnUnbalanced deposits `amount` into the account of the block author.
+// The amount is burned if the author cannot be found or the deposit fails.
+func OnUnbalanced(amount types.Balance) {
+	if amount.ToBigInt().Cmp(constants.Zero) == 0 {
+		return
+	}
+
+	author := Author()
+	if !author.HasValue {
+		dispatchables.NewNegativeImbalance(amount).Drop()
+		return
+	}
+
+	deposited := dispatchables.DepositCreating(author.Value, amount)
+	if deposited.ToBigInt().Cmp(constants.Zero) == 0 {
+		dispatchables.NewNegativeImbalance(amount).Drop()
+	}
+}
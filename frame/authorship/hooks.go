@@ -0,0 +1,20 @@
+package authorship
+
+import (
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OnInitialize notes the author of the current block to the configured event handler.
+func OnInitialize() types.Weight {
+	author := Author()
+	if author.HasValue && Handler != nil {
+		Handler.NoteAuthor(author.Value)
+	}
+
+	return types.WeightZero()
+}
+
+// OnFinalize clears the author of the current block.
+func OnFinalize() {
+	StorageClearAuthor()
+}
@@ -0,0 +1,63 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/authorship"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/authorship"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type AuthorshipModule struct {
+}
+
+func NewAuthorshipModule() AuthorshipModule {
+	return AuthorshipModule{}
+}
+
+func (am AuthorshipModule) Functions() map[sc.U8]primitives.Call {
+	return map[sc.U8]primitives.Call{}
+}
+
+func (am AuthorshipModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (am AuthorshipModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// OnInitialize notes the author of the block.
+func (am AuthorshipModule) OnInitialize(_ primitives.BlockNumber) primitives.Weight {
+	return pallet.OnInitialize()
+}
+
+// OnFinalize clears the author of the block.
+func (am AuthorshipModule) OnFinalize(_ primitives.BlockNumber) {
+	pallet.OnFinalize()
+}
+
+func (am AuthorshipModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Tracks the author of the current block."}
+}
+
+func (am AuthorshipModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "Authorship",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "Authorship",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"Author",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesAddress32)),
+					"Author of current block."),
+			},
+		}),
+		Call:      sc.NewOption[sc.Compact](nil),
+		Event:     sc.NewOption[sc.Compact](nil),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](nil),
+		Index:     authorship.ModuleIndex,
+	}
+}
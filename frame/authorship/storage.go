@@ -0,0 +1,35 @@
+package authorship
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetAuthor returns the author of the current block, if it has been stored.
+func StorageGetAuthor() sc.Option[types.AccountId] {
+	option := storage.Get(keyAuthor())
+	if !option.HasValue {
+		return sc.NewOption[types.AccountId](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.AccountId](types.DecodeAccountId(buffer))
+}
+
+func StorageSetAuthor(author types.AccountId) {
+	storage.Set(keyAuthor(), author.Bytes())
+}
+
+func StorageClearAuthor() {
+	storage.Clear(keyAuthor())
+}
+
+func keyAuthor() []byte {
+	return append(hashing.Twox128(constants.KeyAuthorship), hashing.Twox128(constants.KeyAuthor)...)
+}
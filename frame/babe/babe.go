@@ -0,0 +1,58 @@
+package babe
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/babe"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	preDigestPrimary sc.U8 = iota + 1
+	preDigestSecondaryPlain
+	preDigestSecondaryVrf
+)
+
+// OnInitialize stores the randomness of the VRF output in the BABE pre-runtime digest of the
+// current block. It is not called by this runtime, whose blocks are authored with Aura and have
+// no BABE digest. Runtimes that use BABE must call it from the executive before the other hooks,
+// otherwise CurrentBlockRandomness returns a zero hash.
+func OnInitialize(digest types.Digest) types.Weight {
+	StorageSetAuthorVrfRandomness(vrfRandomnessFromDigest(digest))
+
+	return constants.DbWeight.Writes(1)
+}
+
+// vrfRandomnessFromDigest returns the hash of the VRF pre-output in the BABE pre-runtime digest,
+// if the block was authored in a primary or a secondary VRF slot.
+//
+// The pre-output is hashed with blake2b-256 instead of being expanded with the transcript of the
+// authority, which requires sr25519 VRF support in the runtime.
+func vrfRandomnessFromDigest(digest types.Digest) sc.Option[types.H256] {
+	for _, item := range digest[types.DigestTypePreRuntime] {
+		if !reflect.DeepEqual(sc.FixedSequenceU8ToBytes(item.Engine), babe.EngineId[:]) {
+			continue
+		}
+
+		buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(item.Payload))
+
+		kind := sc.DecodeU8(buffer)
+		if kind != preDigestPrimary && kind != preDigestSecondaryVrf {
+			return sc.NewOption[types.H256](nil)
+		}
+
+		// authority index and slot
+		sc.DecodeU32(buffer)
+		sc.DecodeU64(buffer)
+
+		preOutput := sc.DecodeFixedSequence[sc.U8](32, buffer)
+
+		return sc.NewOption[types.H256](types.NewH256(sc.BytesToSequenceU8(hashing.Blake256(sc.FixedSequenceU8ToBytes(preOutput)))...))
+	}
+
+	return sc.NewOption[types.H256](nil)
+}
@@ -0,0 +1,84 @@
+package babe
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/aura"
+	"github.com/LimeChain/gosemble/constants/babe"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var preOutput = bytes.Repeat([]byte{7}, 32)
+
+// preDigest returns a pre-runtime digest of `engine`, with a BABE pre-digest of the given kind.
+// The primary and the secondary VRF pre-digests carry the VRF pre-output and proof.
+func preDigest(engine [4]byte, kind sc.U8) types.Digest {
+	payload := &bytes.Buffer{}
+	kind.Encode(payload)
+	sc.U32(1).Encode(payload)
+	sc.U64(100).Encode(payload)
+	if kind != preDigestSecondaryPlain {
+		payload.Write(preOutput)
+		payload.Write(make([]byte, 64))
+	}
+
+	return types.Digest{
+		types.DigestTypePreRuntime: sc.FixedSequence[types.DigestItem]{
+			{
+				Engine:  sc.BytesToFixedSequenceU8(engine[:]),
+				Payload: sc.BytesToSequenceU8(payload.Bytes()),
+			},
+		},
+	}
+}
+
+func Test_OnInitialize_VrfRandomness(t *testing.T) {
+	for _, kind := range []sc.U8{preDigestPrimary, preDigestSecondaryVrf} {
+		OnInitialize(preDigest(babe.EngineId, kind))
+
+		expected := types.NewH256(sc.BytesToSequenceU8(hashing.Blake256(preOutput))...)
+		assert.Equal(t, sc.NewOption[types.H256](expected), StorageGetAuthorVrfRandomness())
+	}
+}
+
+func Test_OnInitialize_NoVrfRandomness(t *testing.T) {
+	digests := []types.Digest{
+		preDigest(babe.EngineId, preDigestSecondaryPlain),
+		preDigest(aura.EngineId, preDigestPrimary),
+		{},
+	}
+
+	for _, digest := range digests {
+		StorageSetAuthorVrfRandomness(sc.NewOption[types.H256](types.NewH256(make([]sc.U8, 32)...)))
+
+		OnInitialize(digest)
+
+		assert.Equal(t, sc.NewOption[types.H256](nil), StorageGetAuthorVrfRandomness())
+	}
+}
+
+func Test_CurrentBlockRandomness_Random(t *testing.T) {
+	system.StorageSetBlockNumber(5)
+	OnInitialize(preDigest(babe.EngineId, preDigestPrimary))
+
+	random, blockNumber := CurrentBlockRandomness{}.Random([]byte("subject"))
+
+	seed := append([]byte("subject"), hashing.Blake256(preOutput)...)
+	assert.Equal(t, types.NewH256(sc.BytesToSequenceU8(hashing.Blake256(seed))...), random)
+	assert.Equal(t, types.BlockNumber(5), blockNumber)
+}
+
+func Test_CurrentBlockRandomness_Random_NoVrfOutput(t *testing.T) {
+	system.StorageSetBlockNumber(5)
+	OnInitialize(preDigest(babe.EngineId, preDigestSecondaryPlain))
+
+	random, blockNumber := CurrentBlockRandomness{}.Random([]byte("subject"))
+
+	assert.Equal(t, types.NewH256(make([]sc.U8, 32)...), random)
+	assert.Equal(t, types.BlockNumber(5), blockNumber)
+}
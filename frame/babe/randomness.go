@@ -0,0 +1,29 @@
+package babe
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// CurrentBlockRandomness generates randomness from the VRF output of the author of the current block.
+//
+// The author cannot choose the value, as it is determined by the slot and its key, but it can
+// choose to not produce the block. The value is known only once the block is authored.
+type CurrentBlockRandomness struct{}
+
+// Random hashes the subject together with the VRF randomness of the current block. The hash is zero
+// if the block has no VRF output.
+func (_ CurrentBlockRandomness) Random(subject []byte) (types.H256, types.BlockNumber) {
+	blockNumber := system.StorageGetBlockNumber()
+
+	randomness := StorageGetAuthorVrfRandomness()
+	if !randomness.HasValue {
+		return types.NewH256(make([]sc.U8, 32)...), blockNumber
+	}
+
+	seed := append(append([]byte{}, subject...), sc.FixedSequenceU8ToBytes(randomness.Value.FixedSequence)...)
+
+	return types.NewH256(sc.BytesToSequenceU8(hashing.Blake256(seed))...), blockNumber
+}
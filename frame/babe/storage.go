@@ -0,0 +1,27 @@
+package babe
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetAuthorVrfRandomness returns the randomness derived from the VRF output of the author
+// of the current block. It is not set if the block was authored in a secondary plain slot.
+func StorageGetAuthorVrfRandomness() sc.Option[types.H256] {
+	return storage.GetDecode(keyAuthorVrfRandomness(), func(buffer *bytes.Buffer) sc.Option[types.H256] {
+		return sc.DecodeOptionWith(buffer, types.DecodeH256)
+	})
+}
+
+func StorageSetAuthorVrfRandomness(randomness sc.Option[types.H256]) {
+	storage.Set(keyAuthorVrfRandomness(), randomness.Bytes())
+}
+
+func keyAuthorVrfRandomness() []byte {
+	return append(hashing.Twox128(constants.KeyBabe), hashing.Twox128(constants.KeyAuthorVrfRandomness)...)
+}
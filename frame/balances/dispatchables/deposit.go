@@ -14,7 +14,7 @@ import (
 
 // DepositIntoExisting deposits `value` into the free balance of an existing target account `who`.
 // If `value` is 0, it does nothing.
-func DepositIntoExisting(who types.Address32, value sc.U128) (types.Balance, types.DispatchError) {
+func DepositIntoExisting(who types.AccountId, value sc.U128) (types.Balance, types.DispatchError) {
 	if value.ToBigInt().Cmp(constants.Zero) == 0 {
 		return sc.NewU128FromUint64(uint64(0)), nil
 	}
@@ -46,3 +46,38 @@ func DepositIntoExisting(who types.Address32, value sc.U128) (types.Balance, typ
 
 	return value, nil
 }
+
+// DepositCreating deposits `value` into the free balance of `who`, creating the account if needed.
+// If `value` is 0 or the account does not exist and `value` is below the existential deposit, it does nothing.
+func DepositCreating(who types.AccountId, value sc.U128) types.Balance {
+	if value.ToBigInt().Cmp(constants.Zero) == 0 {
+		return sc.NewU128FromUint64(uint64(0))
+	}
+
+	result := tryMutateAccount(who, func(account *types.AccountData, isNew bool) sc.Result[sc.Encodable] {
+		if isNew && value.ToBigInt().Cmp(balances.ExistentialDeposit) < 0 {
+			return sc.Result[sc.Encodable]{
+				HasError: true,
+				Value: types.NewDispatchErrorModule(types.CustomModuleError{
+					Index:   balances.ModuleIndex,
+					Error:   sc.U32(errors.ErrorExistentialDeposit),
+					Message: sc.NewOption[sc.Str](nil),
+				}),
+			}
+		}
+
+		sum := new(big.Int).Add(account.Free.ToBigInt(), value.ToBigInt())
+
+		account.Free = sc.NewU128FromBigInt(sum)
+
+		system.DepositEvent(events.NewEventDeposit(who.FixedSequence, value))
+
+		return sc.Result[sc.Encodable]{}
+	})
+
+	if result.HasError {
+		return sc.NewU128FromUint64(uint64(0))
+	}
+
+	return value
+}
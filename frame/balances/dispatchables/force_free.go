@@ -8,6 +8,7 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/frame/balances/events"
 	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/primitives/log"
@@ -62,6 +63,17 @@ func (c ForceFreeCall) Args() sc.VaryingData {
 	return c.Callable.Args()
 }
 
+func (_ ForceFreeCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "force_unreserve",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("who", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.TypeInfoU128),
+		},
+		Docs: "Unreserve some balance from a user by force.",
+	}
+}
+
 func (_ ForceFreeCall) BaseWeight(b ...any) types.Weight {
 	// Proof Size summary in bytes:
 	//  Measured:  `206`
@@ -81,7 +93,7 @@ func (_ ForceFreeCall) IsInherent() bool {
 }
 
 func (_ ForceFreeCall) WeightInfo(baseWeight types.Weight) types.Weight {
-	return types.WeightFromParts(baseWeight.RefTime, 0)
+	return baseWeight
 }
 
 func (_ ForceFreeCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
@@ -114,7 +126,7 @@ func (_ ForceFreeCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData)
 // forceFree frees some balance from a user by force.
 // Can only be called by ROOT.
 // Consider Substrate fn force_unreserve
-func forceFree(origin types.RawOrigin, who types.MultiAddress, amount *big.Int) types.DispatchError {
+func forceFree(origin types.RuntimeOrigin, who types.MultiAddress, amount *big.Int) types.DispatchError {
 	if !origin.IsRootOrigin() {
 		return types.NewDispatchErrorBadOrigin()
 	}
@@ -131,7 +143,7 @@ func forceFree(origin types.RawOrigin, who types.MultiAddress, amount *big.Int)
 }
 
 // forceFree frees some funds, returning the amount that has not been freed.
-func force(who types.Address32, value *big.Int) *big.Int {
+func force(who types.AccountId, value *big.Int) *big.Int {
 	if value.Cmp(constants.Zero) == 0 {
 		return big.NewInt(0)
 	}
@@ -6,6 +6,7 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/primitives/types"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
@@ -58,6 +59,18 @@ func (c ForceTransferCall) Args() sc.VaryingData {
 	return c.Callable.Args()
 }
 
+func (_ ForceTransferCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "force_transfer",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("source", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("dest", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("value", "T::Balance", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Exactly as `transfer`, except the origin must be root and the source account may be specified.",
+	}
+}
+
 func (_ ForceTransferCall) BaseWeight(b ...any) types.Weight {
 	// Proof Size summary in bytes:
 	//  Measured:  `135`
@@ -77,7 +90,7 @@ func (_ ForceTransferCall) IsInherent() bool {
 }
 
 func (_ ForceTransferCall) WeightInfo(baseWeight types.Weight) types.Weight {
-	return types.WeightFromParts(baseWeight.RefTime, 0)
+	return baseWeight
 }
 
 func (_ ForceTransferCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
@@ -109,7 +122,7 @@ func (_ ForceTransferCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingD
 
 // forceTransfer transfers liquid free balance from `source` to `dest`.
 // Can only be called by ROOT.
-func forceTransfer(origin types.RawOrigin, source types.MultiAddress, dest types.MultiAddress, value sc.U128) types.DispatchError {
+func forceTransfer(origin types.RuntimeOrigin, source types.MultiAddress, dest types.MultiAddress, value sc.U128) types.DispatchError {
 	if !origin.IsRootOrigin() {
 		return types.NewDispatchErrorBadOrigin()
 	}
@@ -0,0 +1,165 @@
+package dispatchables
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// SetLock creates a new balance lock on account `who`, replacing any existing lock with the same `id`.
+// The lock is removed if `amount` is zero.
+func SetLock(id types.LockIdentifier, who types.AccountId, amount types.Balance, reasons types.Reasons) {
+	if amount.ToBigInt().Cmp(constants.Zero) == 0 {
+		RemoveLock(id, who)
+		return
+	}
+
+	newLock := types.BalanceLock{Id: id, Amount: amount, Reasons: reasons}
+
+	locks := sc.Sequence[types.BalanceLock]{}
+	found := false
+	for _, lock := range StorageGetLocks(who) {
+		if reflect.DeepEqual(lock.Id, id) {
+			locks = append(locks, newLock)
+			found = true
+		} else {
+			locks = append(locks, lock)
+		}
+	}
+	if !found {
+		locks = append(locks, newLock)
+	}
+
+	updateLocks(who, locks)
+}
+
+// ExtendLock changes a balance lock on account `who`, so that it locks at least `amount` for at least `reasons`.
+// Creates the lock if it does not exist.
+func ExtendLock(id types.LockIdentifier, who types.AccountId, amount types.Balance, reasons types.Reasons) {
+	if amount.ToBigInt().Cmp(constants.Zero) == 0 {
+		return
+	}
+
+	newLock := types.BalanceLock{Id: id, Amount: amount, Reasons: reasons}
+
+	locks := sc.Sequence[types.BalanceLock]{}
+	found := false
+	for _, lock := range StorageGetLocks(who) {
+		if reflect.DeepEqual(lock.Id, id) {
+			if lock.Amount.ToBigInt().Cmp(amount.ToBigInt()) > 0 {
+				newLock.Amount = lock.Amount
+			}
+			if lock.Reasons != reasons {
+				newLock.Reasons = types.ReasonsAll
+			}
+			locks = append(locks, newLock)
+			found = true
+		} else {
+			locks = append(locks, lock)
+		}
+	}
+	if !found {
+		locks = append(locks, newLock)
+	}
+
+	updateLocks(who, locks)
+}
+
+// RemoveLock removes the balance lock with the given `id` from account `who`.
+func RemoveLock(id types.LockIdentifier, who types.AccountId) {
+	locks := sc.Sequence[types.BalanceLock]{}
+	for _, lock := range StorageGetLocks(who) {
+		if !reflect.DeepEqual(lock.Id, id) {
+			locks = append(locks, lock)
+		}
+	}
+
+	updateLocks(who, locks)
+}
+
+// updateLocks stores the given locks and recalculates the frozen balances of `who`.
+// A consumer reference is held for as long as the account has any locks.
+func updateLocks(who types.AccountId, locks sc.Sequence[types.BalanceLock]) {
+	if len(locks) > balances.MaxLocks {
+		log.Warn("Warning: A user has more currency locks than expected. A runtime configuration adjustment may be needed.")
+	}
+
+	miscFrozen := big.NewInt(0)
+	feeFrozen := big.NewInt(0)
+	for _, lock := range locks {
+		amount := lock.Amount.ToBigInt()
+		if lock.Reasons == types.ReasonsMisc || lock.Reasons == types.ReasonsAll {
+			if amount.Cmp(miscFrozen) > 0 {
+				miscFrozen = amount
+			}
+		}
+		if lock.Reasons == types.ReasonsFee || lock.Reasons == types.ReasonsAll {
+			if amount.Cmp(feeFrozen) > 0 {
+				feeFrozen = amount
+			}
+		}
+	}
+
+	existed := len(StorageGetLocks(who)) > 0
+	exists := len(locks) > 0
+
+	system.Mutate(who, func(account *types.AccountInfo) sc.Result[sc.Encodable] {
+		account.Data.MiscFrozen = sc.NewU128FromBigInt(miscFrozen)
+		account.Data.FeeFrozen = sc.NewU128FromBigInt(feeFrozen)
+
+		if !existed && exists {
+			account.Consumers += 1
+		} else if existed && !exists {
+			if account.Consumers == 0 {
+				log.Warn("Logic error: Unexpected underflow in reducing consumer")
+			} else {
+				account.Consumers -= 1
+			}
+		}
+
+		return sc.Result[sc.Encodable]{}
+	})
+
+	if exists {
+		StorageSetLocks(who, locks)
+	} else {
+		StorageClearLocks(who)
+	}
+}
+
+// StorageInfoLocks describes the `Locks` map, whose entries are
+// Blake2_128Concat(AccountId) ++ BoundedVec<BalanceLock, MaxLocks>.
+var StorageInfoLocks = types.NewStorageMapInfo("Balances", "Locks", sc.NewOption[sc.U32](nil), 48+1+balances.MaxLocks*25)
+
+// StorageGetLocks returns any liquidity locks on some account balances.
+func StorageGetLocks(who types.AccountId) sc.Sequence[types.BalanceLock] {
+	return storage.GetDecode(keyLocks(who), func(buffer *bytes.Buffer) sc.Sequence[types.BalanceLock] {
+		return sc.DecodeSequenceWith(buffer, types.DecodeBalanceLock)
+	})
+}
+
+func StorageSetLocks(who types.AccountId, locks sc.Sequence[types.BalanceLock]) {
+	storage.Set(keyLocks(who), locks.Bytes())
+}
+
+func StorageClearLocks(who types.AccountId) {
+	storage.Clear(keyLocks(who))
+}
+
+// keyLocks returns the storage key of `Locks`, which uses the blake2_128 concat hasher.
+func keyLocks(who types.AccountId) []byte {
+	whoBytes := sc.FixedSequenceU8ToBytes(who.FixedSequence)
+
+	key := append(hashing.Twox128(constants.KeyBalances), hashing.Twox128(constants.KeyLocks)...)
+	key = append(key, hashing.Blake128(whoBytes)...)
+	return append(key, whoBytes...)
+}
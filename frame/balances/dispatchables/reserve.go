@@ -0,0 +1,87 @@
+package dispatchables
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/frame/balances/errors"
+	"github.com/LimeChain/gosemble/frame/balances/events"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Reserve moves `value` from the free balance of `who` to its reserved balance.
+// Fails if the free balance is too low or a lock prevents the withdrawal.
+func Reserve(who types.AccountId, value *big.Int) types.DispatchError {
+	if value.Cmp(constants.Zero) == 0 {
+		return nil
+	}
+
+	result := mutateAccount(who, func(account *types.AccountData, _ bool) sc.Result[sc.Encodable] {
+		newFree := new(big.Int).Sub(account.Free.ToBigInt(), value)
+		if newFree.Cmp(constants.Zero) < 0 {
+			return sc.Result[sc.Encodable]{
+				HasError: true,
+				Value: types.NewDispatchErrorModule(types.CustomModuleError{
+					Index:   balances.ModuleIndex,
+					Error:   sc.U32(errors.ErrorInsufficientBalance),
+					Message: sc.NewOption[sc.Str](nil),
+				}),
+			}
+		}
+
+		err := ensureCanWithdraw(who, value, types.ReasonsAll, newFree)
+		if err != nil {
+			return sc.Result[sc.Encodable]{
+				HasError: true,
+				Value:    err,
+			}
+		}
+
+		account.Free = sc.NewU128FromBigInt(newFree)
+		account.Reserved = sc.NewU128FromBigInt(new(big.Int).Add(account.Reserved.ToBigInt(), value))
+
+		return sc.Result[sc.Encodable]{}
+	})
+
+	if result.HasError {
+		return result.Value.(types.DispatchError)
+	}
+
+	system.DepositEvent(events.NewEventReserved(who.FixedSequence, sc.NewU128FromBigInt(value)))
+	return nil
+}
+
+// Unreserve moves up to `value` from the reserved balance of `who` back to its free balance.
+// Returns the amount that could not be unreserved.
+func Unreserve(who types.AccountId, value *big.Int) *big.Int {
+	return force(who, value)
+}
+
+// SlashReserved deducts up to `value` from the reserved balance of `who`.
+// Returns the amount that was slashed and the amount that could not be slashed.
+// The total issuance is not changed, the caller is responsible for the slashed amount.
+func SlashReserved(who types.AccountId, value *big.Int) (*big.Int, *big.Int) {
+	if value.Cmp(constants.Zero) == 0 {
+		return big.NewInt(0), big.NewInt(0)
+	}
+
+	slashed := big.NewInt(0)
+	mutateAccount(who, func(account *types.AccountData, _ bool) sc.Result[sc.Encodable] {
+		reserved := account.Reserved.ToBigInt()
+		if reserved.Cmp(value) < 0 {
+			slashed = reserved
+		} else {
+			slashed = value
+		}
+
+		account.Reserved = sc.NewU128FromBigInt(new(big.Int).Sub(reserved, slashed))
+
+		return sc.Result[sc.Encodable]{}
+	})
+
+	system.DepositEvent(events.NewEventSlashed(who.FixedSequence, sc.NewU128FromBigInt(slashed)))
+	return slashed, new(big.Int).Sub(value, slashed)
+}
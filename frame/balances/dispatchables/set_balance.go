@@ -7,6 +7,7 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/frame/balances/events"
 	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/primitives/types"
@@ -61,6 +62,18 @@ func (c SetBalanceCall) Args() sc.VaryingData {
 	return c.Callable.Args()
 }
 
+func (_ SetBalanceCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_balance",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("who", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("new_free", "T::Balance", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+			primitives.NewFieldTypeInfo("new_reserved", "T::Balance", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Set the balances of a given account.",
+	}
+}
+
 func (_ SetBalanceCall) BaseWeight(b ...any) types.Weight {
 	// Proof Size summary in bytes:
 	//  Measured:  `206`
@@ -80,7 +93,7 @@ func (_ SetBalanceCall) IsInherent() bool {
 }
 
 func (_ SetBalanceCall) WeightInfo(baseWeight types.Weight) types.Weight {
-	return types.WeightFromParts(baseWeight.RefTime, 0)
+	return baseWeight
 }
 
 func (_ SetBalanceCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
@@ -115,7 +128,7 @@ func (_ SetBalanceCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData
 // Changes free and reserve balance of `who`,
 // including the total issuance.
 // Can only be called by ROOT.
-func setBalance(origin types.RawOrigin, who types.MultiAddress, newFree *big.Int, newReserved *big.Int) types.DispatchError {
+func setBalance(origin types.RuntimeOrigin, who types.MultiAddress, newFree *big.Int, newReserved *big.Int) types.DispatchError {
 	if !origin.IsRootOrigin() {
 		return types.NewDispatchErrorBadOrigin()
 	}
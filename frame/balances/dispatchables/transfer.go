@@ -8,6 +8,7 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/frame/balances/errors"
 	"github.com/LimeChain/gosemble/frame/balances/events"
 	"github.com/LimeChain/gosemble/frame/system"
@@ -62,22 +63,23 @@ func (c TransferCall) Args() sc.VaryingData {
 	return c.Callable.Args()
 }
 
+func (_ TransferCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "transfer",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("dest", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("value", "T::Balance", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Transfer some liquid free balance to another account.",
+	}
+}
+
 func (_ TransferCall) BaseWeight(b ...any) types.Weight {
-	// Proof Size summary in bytes:
-	//  Measured:  `0`
-	//  Estimated: `3593`
-	// Minimum execution time: 37_815 nanoseconds.
-	r := constants.DbWeight.Reads(1)
-	w := constants.DbWeight.Writes(1)
-	e := types.WeightFromParts(0, 3593)
-	return types.WeightFromParts(38_109_000, 0).
-		SaturatingAdd(e).
-		SaturatingAdd(r).
-		SaturatingAdd(w)
+	return weightTransfer()
 }
 
 func (_ TransferCall) WeightInfo(baseWeight types.Weight) types.Weight {
-	return types.WeightFromParts(baseWeight.RefTime, 0)
+	return baseWeight
 }
 
 func (_ TransferCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
@@ -114,7 +116,7 @@ func (_ TransferCall) IsInherent() bool {
 // transfer transfers liquid free balance from `source` to `dest`.
 // Increases the free balance of `dest` and decreases the free balance of `origin` transactor.
 // Must be signed by the transactor.
-func transfer(origin types.RawOrigin, dest types.MultiAddress, value sc.U128) types.DispatchError {
+func transfer(origin types.RuntimeOrigin, dest types.MultiAddress, value sc.U128) types.DispatchError {
 	if !origin.IsSignedOrigin() {
 		return types.NewDispatchErrorBadOrigin()
 	}
@@ -129,9 +131,14 @@ func transfer(origin types.RawOrigin, dest types.MultiAddress, value sc.U128) ty
 	return trans(transactor, to, value, types.ExistenceRequirementAllowDeath)
 }
 
+// Transfer transfers `value` free balance from `from` to `to`, respecting the existence requirement of `from`.
+func Transfer(from types.AccountId, to types.AccountId, value sc.U128, existenceRequirement types.ExistenceRequirement) types.DispatchError {
+	return trans(from, to, value, existenceRequirement)
+}
+
 // trans transfers `value` free balance from `from` to `to`.
 // Does not do anything if value is 0 or `from` and `to` are the same.
-func trans(from types.Address32, to types.Address32, value sc.U128, existenceRequirement types.ExistenceRequirement) types.DispatchError {
+func trans(from types.AccountId, to types.AccountId, value sc.U128, existenceRequirement types.ExistenceRequirement) types.DispatchError {
 	bnInt := value.ToBigInt()
 	if bnInt.Cmp(constants.Zero) == 0 || reflect.DeepEqual(from, to) {
 		return nil
@@ -203,7 +210,7 @@ func trans(from types.Address32, to types.Address32, value sc.U128, existenceReq
 }
 
 // ensureCanWithdraw checks that an account can withdraw from their balance given any existing withdraw restrictions.
-func ensureCanWithdraw(who types.Address32, amount *big.Int, reasons types.Reasons, newBalance *big.Int) types.DispatchError {
+func ensureCanWithdraw(who types.AccountId, amount *big.Int, reasons types.Reasons, newBalance *big.Int) types.DispatchError {
 	if amount.Cmp(constants.Zero) == 0 {
 		return nil
 	}
@@ -223,13 +230,13 @@ func ensureCanWithdraw(who types.Address32, amount *big.Int, reasons types.Reaso
 
 // mutateAccount mutates an account based on argument `f`. Does not change total issuance.
 // Does not do anything if `f` returns an error.
-func mutateAccount(who types.Address32, f func(who *types.AccountData, bool bool) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
+func mutateAccount(who types.AccountId, f func(who *types.AccountData, bool bool) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
 	return tryMutateAccount(who, f)
 }
 
 // tryMutateAccount mutates an account based on argument `f`. Does not change total issuance.
 // Does not do anything if `f` returns an error.
-func tryMutateAccount(who types.Address32, f func(who *types.AccountData, bool bool) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
+func tryMutateAccount(who types.AccountId, f func(who *types.AccountData, bool bool) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
 	result := tryMutateAccountWithDust(who, f)
 	if result.HasError {
 		return result
@@ -245,7 +252,7 @@ func tryMutateAccount(who types.Address32, f func(who *types.AccountData, bool b
 	return sc.Result[sc.Encodable]{HasError: false, Value: r[0].(sc.Encodable)}
 }
 
-func tryMutateAccountWithDust(who types.Address32, f func(who *types.AccountData, bool bool) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
+func tryMutateAccountWithDust(who types.AccountId, f func(who *types.AccountData, bool bool) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
 	result := system.TryMutateExists(who, func(maybeAccount *types.AccountData) sc.Result[sc.Encodable] {
 		account := &types.AccountData{}
 		isNew := true
@@ -316,11 +323,11 @@ func postMutation(
 }
 
 // totalBalance returns the total storage balance of an account id.
-func totalBalance(who types.Address32) *big.Int {
+func totalBalance(who types.AccountId) *big.Int {
 	return system.StorageGetAccount(who.FixedSequence).Data.Total()
 }
 
-func reducibleBalance(who types.Address32, keepAlive bool) types.Balance {
+func reducibleBalance(who types.AccountId, keepAlive bool) types.Balance {
 	accountData := system.StorageGetAccount(who.FixedSequence).Data
 
 	lockedOrFrozen := accountData.FeeFrozen
@@ -7,6 +7,7 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/primitives/log"
 	"github.com/LimeChain/gosemble/primitives/types"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
@@ -59,6 +60,17 @@ func (c TransferAllCall) Args() sc.VaryingData {
 	return c.Callable.Args()
 }
 
+func (_ TransferAllCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "transfer_all",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("dest", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("keep_alive", "bool", primitives.TypeInfoBool),
+		},
+		Docs: "Transfer the entire transferable balance from the caller account.",
+	}
+}
+
 func (_ TransferAllCall) IsInherent() bool {
 	return false
 }
@@ -78,7 +90,7 @@ func (_ TransferAllCall) BaseWeight(b ...any) types.Weight {
 }
 
 func (_ TransferAllCall) WeightInfo(baseWeight types.Weight) types.Weight {
-	return types.WeightFromParts(baseWeight.RefTime, 0)
+	return baseWeight
 }
 
 func (_ TransferAllCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
@@ -112,7 +124,7 @@ func (_ TransferAllCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingDat
 // the funds the account has, causing the sender account to be killed (false), or
 // transfer everything except at least the existential deposit, which will guarantee to
 // keep the sender account alive (true).
-func transferAll(origin types.RawOrigin, dest types.MultiAddress, keepAlive bool) types.DispatchError {
+func transferAll(origin types.RuntimeOrigin, dest types.MultiAddress, keepAlive bool) types.DispatchError {
 	if !origin.IsSignedOrigin() {
 		return types.NewDispatchErrorBadOrigin()
 	}
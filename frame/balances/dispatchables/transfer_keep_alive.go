@@ -4,8 +4,8 @@ import (
 	"bytes"
 
 	sc "github.com/LimeChain/goscale"
-	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/primitives/types"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
@@ -57,26 +57,27 @@ func (c TransferKeepAliveCall) Args() sc.VaryingData {
 	return c.Callable.Args()
 }
 
+func (_ TransferKeepAliveCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "transfer_keep_alive",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("dest", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("value", "T::Balance", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Same as the [`transfer`] call, but with a check that the transfer will not kill the origin account.",
+	}
+}
+
 func (_ TransferKeepAliveCall) IsInherent() bool {
 	return false
 }
 
 func (_ TransferKeepAliveCall) BaseWeight(b ...any) types.Weight {
-	// Proof Size summary in bytes:
-	//  Measured:  `0`
-	//  Estimated: `3593`
-	// Minimum execution time: 28_184 nanoseconds.
-	r := constants.DbWeight.Reads(1)
-	w := constants.DbWeight.Writes(1)
-	e := types.WeightFromParts(0, 3593)
-	return types.WeightFromParts(49_250_000, 0).
-		SaturatingAdd(e).
-		SaturatingAdd(r).
-		SaturatingAdd(w)
+	return weightTransferKeepAlive()
 }
 
 func (_ TransferKeepAliveCall) WeightInfo(baseWeight types.Weight) types.Weight {
-	return types.WeightFromParts(baseWeight.RefTime, 0)
+	return baseWeight
 }
 
 func (_ TransferKeepAliveCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
@@ -107,7 +108,7 @@ func (_ TransferKeepAliveCall) Dispatch(origin types.RuntimeOrigin, args sc.Vary
 }
 
 // transferKeepAlive is similar to transfer, but includes a check that the origin transactor will not be "killed".
-func transferKeepAlive(origin types.RawOrigin, dest types.MultiAddress, value sc.U128) types.DispatchError {
+func transferKeepAlive(origin types.RuntimeOrigin, dest types.MultiAddress, value sc.U128) types.DispatchError {
 	if !origin.IsSignedOrigin() {
 		return types.NewDispatchErrorBadOrigin()
 	}
@@ -59,8 +59,21 @@ func (pi PositiveImbalance) Drop() {
 	storage.Set(key, sc.NewU128FromBigInt(add).Bytes())
 }
 
+// StorageGetTotalIssuance returns the total units issued in the system.
+func StorageGetTotalIssuance() types.Balance {
+	key := append(hashing.Twox128(constants.KeyBalances), hashing.Twox128(constants.KeyTotalIssuance)...)
+
+	return storage.GetDecode(key, sc.DecodeU128)
+}
+
+func StorageSetTotalIssuance(issuance types.Balance) {
+	key := append(hashing.Twox128(constants.KeyBalances), hashing.Twox128(constants.KeyTotalIssuance)...)
+
+	storage.Set(key, issuance.Bytes())
+}
+
 type DustCleanerValue struct {
-	AccountId         types.Address32
+	AccountId         types.AccountId
 	NegativeImbalance NegativeImbalance
 }
 
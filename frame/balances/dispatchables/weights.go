@@ -0,0 +1,24 @@
+// The weights are the Substrate constants of the `transfer` and `transfer_keep_alive` benchmarks.
+// They have not been benchmarked against this runtime; running the benchmarks of the module
+// replaces this file with the generated weights.
+
+package dispatchables
+
+import (
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// weightTransfer is the weight of the `transfer` benchmark.
+func weightTransfer() types.Weight {
+	return types.WeightFromParts(38_109_000, 3_593).
+		SaturatingAdd(constants.DbWeight.Reads(1)).
+		SaturatingAdd(constants.DbWeight.Writes(1))
+}
+
+// weightTransferKeepAlive is the weight of the `transfer_keep_alive` benchmark.
+func weightTransferKeepAlive() types.Weight {
+	return types.WeightFromParts(49_250_000, 3_593).
+		SaturatingAdd(constants.DbWeight.Reads(1)).
+		SaturatingAdd(constants.DbWeight.Writes(1))
+}
@@ -0,0 +1,52 @@
+package module
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/primitives/benchmarking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+func (bm BalancesModule) Benchmarks() []benchmarking.Benchmark {
+	return []benchmarking.Benchmark{
+		benchmarking.New("transfer", benchmarkTransfer),
+		benchmarking.New("transfer_keep_alive", benchmarkTransferKeepAlive),
+	}
+}
+
+// benchmarkTransfer benchmarks the worst case of a transfer, which kills the caller and creates
+// the recipient.
+func benchmarkTransfer(b *benchmarking.B) error {
+	balance := new(big.Int).Mul(balances.ExistentialDeposit, big.NewInt(1000))
+
+	caller := benchmarking.Account("caller", 0)
+	dispatchables.DepositCreating(caller, sc.NewU128FromBigInt(balance))
+
+	// Leaves the caller with less than the existential deposit.
+	recipient := benchmarking.Account("recipient", 0)
+	value := new(big.Int).Sub(balance, balances.ExistentialDeposit)
+	value.Add(value, big.NewInt(1))
+
+	call := dispatchables.NewTransferCall(sc.NewVaryingData(primitives.NewMultiAddressId(recipient), sc.Compact(sc.NewU128FromBigInt(value))))
+
+	return b.Dispatch(primitives.NewRuntimeOriginSigned(caller), call)
+}
+
+// benchmarkTransferKeepAlive benchmarks a transfer which keeps the caller alive and creates the
+// recipient.
+func benchmarkTransferKeepAlive(b *benchmarking.B) error {
+	balance := new(big.Int).Mul(balances.ExistentialDeposit, big.NewInt(1000))
+
+	caller := benchmarking.Account("caller", 0)
+	dispatchables.DepositCreating(caller, sc.NewU128FromBigInt(balance))
+
+	recipient := benchmarking.Account("recipient", 0)
+	value := sc.Compact(sc.NewU128FromBigInt(balances.ExistentialDeposit))
+
+	call := dispatchables.NewTransferKeepAliveCall(sc.NewVaryingData(primitives.NewMultiAddressId(recipient), value))
+
+	return b.Dispatch(primitives.NewRuntimeOriginSigned(caller), call)
+}
@@ -0,0 +1,81 @@
+package module
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	errInvalidAccount          = errors.New("invalid account id")
+	errDuplicateAccount        = errors.New("duplicate balance for account")
+	errBelowExistentialDeposit = errors.New("balance is below the existential deposit")
+)
+
+type gcBalances struct {
+	Balances []gcBalance `json:"balances"`
+}
+
+// gcBalance is the free balance an account is endowed with, encoded as an `[account, amount]` tuple.
+type gcBalance struct {
+	Account primitives.HexBytes
+	Amount  *big.Int
+}
+
+func (b gcBalance) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{b.Account, b.Amount})
+}
+
+func (b *gcBalance) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &[]interface{}{&b.Account, &b.Amount})
+}
+
+func (bm BalancesModule) CreateDefaultConfig() ([]byte, error) {
+	return json.Marshal(gcBalances{Balances: []gcBalance{}})
+}
+
+// BuildConfig endows the accounts with their free balance and sets the total issuance to their sum.
+func (bm BalancesModule) BuildConfig(config []byte) error {
+	gc := gcBalances{}
+	if err := json.Unmarshal(config, &gc); err != nil {
+		return err
+	}
+
+	endowed := map[string]bool{}
+	totalIssuance := big.NewInt(0)
+
+	for _, b := range gc.Balances {
+		if len(b.Account) != primitives.AccountIdLength || b.Amount == nil {
+			return errInvalidAccount
+		}
+		if endowed[string(b.Account)] {
+			return errDuplicateAccount
+		}
+		if b.Amount.Cmp(balances.ExistentialDeposit) < 0 {
+			return errBelowExistentialDeposit
+		}
+		endowed[string(b.Account)] = true
+
+		totalIssuance.Add(totalIssuance, b.Amount)
+
+		system.StorageSetAccount(sc.BytesToFixedSequenceU8(b.Account), primitives.AccountInfo{
+			Providers: 1,
+			Data: primitives.AccountData{
+				Free:       sc.NewU128FromBigInt(b.Amount),
+				Reserved:   sc.NewU128FromUint64(0),
+				MiscFrozen: sc.NewU128FromUint64(0),
+				FeeFrozen:  sc.NewU128FromUint64(0),
+			},
+		})
+	}
+
+	dispatchables.StorageSetTotalIssuance(sc.NewU128FromBigInt(totalIssuance))
+
+	return nil
+}
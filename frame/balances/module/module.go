@@ -40,21 +40,35 @@ func (bm BalancesModule) ValidateUnsigned(_ primitives.TransactionSource, _ prim
 	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
 }
 
-func (bm BalancesModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
-	return bm.metadataTypes(), primitives.MetadataModule{
-		Name: "Balances",
+func (bm BalancesModule) Name() sc.Str {
+	return "Balances"
+}
+
+func (bm BalancesModule) StorageVersion() primitives.StorageVersion {
+	return balances.StorageVersion
+}
+
+func (bm BalancesModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Accounts and balances of the native token."}
+}
+
+func (bm BalancesModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	registry.Add(bm.metadataTypes()...)
+
+	return primitives.MetadataModule{
+		Name: bm.Name(),
 		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
 			Prefix: "Balances",
 			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
 				primitives.NewMetadataModuleStorageEntry(
 					"TotalIssuance",
 					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU128)),
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(primitives.TypeInfoU128))),
 					"The total units issued in the system."),
 				primitives.NewMetadataModuleStorageEntry(
 					"InactiveIssuance",
 					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU128)),
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(primitives.TypeInfoU128))),
 					"The total units of outstanding deactivated balance in the system."),
 				primitives.NewMetadataModuleStorageEntry(
 					"Account",
@@ -64,126 +78,46 @@ func (bm BalancesModule) Metadata() (sc.Sequence[primitives.MetadataType], primi
 						sc.ToCompact(metadata.TypesAddress32),
 						sc.ToCompact(metadata.TypesAccountData)),
 					"The Balances pallet example of storing the balance of an account."),
-				// TODO: Locks, Reserves, currently not used
+				primitives.NewMetadataModuleStorageEntry(
+					"Locks",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(metadata.TypesSequenceBalanceLock)),
+					"Any liquidity locks on some account balances."),
+				// TODO: Reserves, currently not used
 			},
 		}),
-		Call:  sc.NewOption[sc.Compact](sc.ToCompact(metadata.BalancesCalls)),
-		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesBalancesEvent)),
+		Call:  sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(bm.callsTypeInfo()))),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(eventTypeInfo()))),
 		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
 			primitives.NewMetadataModuleConstant(
 				"ExistentialDeposit",
-				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.ToCompact(registry.Register(primitives.TypeInfoU128)),
 				sc.BytesToSequenceU8(sc.NewU128FromBigInt(balances.ExistentialDeposit).Bytes()),
 				"The minimum amount required to keep an account open. MUST BE GREATER THAN ZERO!",
 			),
 			primitives.NewMetadataModuleConstant(
 				"MaxLocks",
-				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.ToCompact(registry.Register(primitives.TypeInfoU32)),
 				sc.BytesToSequenceU8(sc.U32(balances.MaxLocks).Bytes()),
 				"The maximum number of locks that should exist on an account.  Not strictly enforced, but used for weight estimation.",
 			),
 			primitives.NewMetadataModuleConstant(
 				"MaxReserves",
-				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.ToCompact(registry.Register(primitives.TypeInfoU32)),
 				sc.BytesToSequenceU8(sc.U32(balances.MaxReserves).Bytes()),
 				"The maximum number of named reserves that can exist on an account.",
 			),
 		}, // TODO:
-		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesBalancesErrors)),
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(errorsTypeInfo()))),
 		Index: balances.ModuleIndex,
 	}
 }
 
 func (bm BalancesModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
 	return sc.Sequence[primitives.MetadataType]{
-		primitives.NewMetadataTypeWithPath(metadata.TypesBalancesEvent, "pallet_balances pallet Event", sc.Sequence[sc.Str]{"pallet_balances", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
-			sc.Sequence[primitives.MetadataDefinitionVariant]{
-				primitives.NewMetadataDefinitionVariant(
-					"Endowed",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "free_balance", "T::Balance"),
-					},
-					events.EventEndowed,
-					"Event.Endowed"),
-				primitives.NewMetadataDefinitionVariant(
-					"DustLost",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventDustLost,
-					"Events.DustLost"),
-				primitives.NewMetadataDefinitionVariant(
-					"Transfer",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "from", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "to", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventTransfer,
-					"Events.Transfer"),
-				primitives.NewMetadataDefinitionVariant(
-					"BalanceSet",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "free", "T::Balance"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "reserved", "T::Balance"),
-					},
-					events.EventBalanceSet,
-					"Events.BalanceSet"),
-				primitives.NewMetadataDefinitionVariant(
-					"Reserved",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventReserved,
-					"Events.Reserved"),
-				primitives.NewMetadataDefinitionVariant(
-					"Unreserved",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventUnreserved,
-					"Events.Unreserved"),
-				primitives.NewMetadataDefinitionVariant(
-					"ReserveRepatriated",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "from", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "to", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBalanceStatus, "destination_status", "Status"),
-					},
-					events.EventReserveRepatriated,
-					"Events.ReserveRepatriated"),
-				primitives.NewMetadataDefinitionVariant(
-					"Deposit",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventDeposit,
-					"Event.Deposit"),
-				primitives.NewMetadataDefinitionVariant(
-					"Withdraw",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventWithdraw,
-					"Event.Withdraw"),
-				primitives.NewMetadataDefinitionVariant(
-					"Slashed",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventSlashed,
-					"Event.Slashed"),
-			},
-		)),
 		primitives.NewMetadataTypeWithPath(metadata.TypesBalanceStatus,
 			"BalanceStatus",
 			sc.Sequence[sc.Str]{"frame_support", "traits", "tokens", "misc", "BalanceStatus"}, primitives.NewMetadataTypeDefinitionVariant(
@@ -200,113 +134,108 @@ func (bm BalancesModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
 						"BalanceStatus.Reserved"),
 				})),
 
-		primitives.NewMetadataTypeWithParams(metadata.TypesBalancesErrors,
-			"pallet_balances pallet Error",
-			sc.Sequence[sc.Str]{"pallet_balances", "pallet", "Error"},
-			primitives.NewMetadataTypeDefinitionVariant(
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalancesReasons,
+			"Reasons",
+			sc.Sequence[sc.Str]{"pallet_balances", "Reasons"}, primitives.NewMetadataTypeDefinitionVariant(
 				sc.Sequence[primitives.MetadataDefinitionVariant]{
 					primitives.NewMetadataDefinitionVariant(
-						"VestingBalance",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorVestingBalance,
-						"Vesting balance too high to send value"),
-					primitives.NewMetadataDefinitionVariant(
-						"LiquidityRestrictions",
+						"Fee",
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorLiquidityRestrictions,
-						"Account liquidity restrictions prevent withdrawal"),
+						sc.U8(primitives.ReasonsFee),
+						"Reasons.Fee"),
 					primitives.NewMetadataDefinitionVariant(
-						"InsufficientBalance",
+						"Misc",
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorInsufficientBalance,
-						"Balance too low to send value."),
+						sc.U8(primitives.ReasonsMisc),
+						"Reasons.Misc"),
 					primitives.NewMetadataDefinitionVariant(
-						"ExistentialDeposit",
+						"All",
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorExistentialDeposit,
-						"Value too low to create account due to existential deposit"),
-					primitives.NewMetadataDefinitionVariant(
-						"KeepAlive",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorKeepAlive,
-						"Transfer/payment would kill account"),
-					primitives.NewMetadataDefinitionVariant(
-						"ExistingVestingSchedule",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorExistingVestingSchedule,
-						"A vesting schedule already exists for this account"),
-					primitives.NewMetadataDefinitionVariant(
-						"DeadAccount",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorDeadAccount,
-						"Beneficiary account must pre-exist"),
-					primitives.NewMetadataDefinitionVariant(
-						"TooManyReserves",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorTooManyReserves,
-						"Number of named reserves exceed MaxReserves"),
+						sc.U8(primitives.ReasonsAll),
+						"Reasons.All"),
+				})),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesBalanceLock,
+			"BalanceLock",
+			sc.Sequence[sc.Str]{"pallet_balances", "BalanceLock"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence8U8, "id", "LockIdentifier"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBalancesReasons, "reasons", "Reasons"),
 				}),
-			sc.Sequence[primitives.MetadataTypeParameter]{
-				primitives.NewMetadataEmptyTypeParameter("T"),
-				primitives.NewMetadataEmptyTypeParameter("I"),
-			}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance")),
 
-		primitives.NewMetadataTypeWithParams(metadata.BalancesCalls, "Balances calls", sc.Sequence[sc.Str]{"pallet_balances", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
-			sc.Sequence[primitives.MetadataDefinitionVariant]{
-				primitives.NewMetadataDefinitionVariant(
-					"transfer",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "dest", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "value", "T::Balance"),
-					},
-					balances.FunctionTransferIndex,
-					"Transfer some liquid free balance to another account."),
-				primitives.NewMetadataDefinitionVariant(
-					"set_balance",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "who", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "new_free", "T::Balance"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "new_reserved", "T::Balance"),
-					},
-					balances.FunctionSetBalanceIndex,
-					"Set the balances of a given account."),
-				primitives.NewMetadataDefinitionVariant(
-					"force_transfer",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "source", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "dest", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "value", "T::Balance"),
-					},
-					balances.FunctionForceTransferIndex,
-					"Exactly as `transfer`, except the origin must be root and the source account may be specified."),
-				primitives.NewMetadataDefinitionVariant(
-					"transfer_keep_alive",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "dest", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "value", "T::Balance"),
-					},
-					balances.FunctionTransferKeepAliveIndex,
-					"Same as the [`transfer`] call, but with a check that the transfer will not kill the origin account."),
-				primitives.NewMetadataDefinitionVariant(
-					"transfer_all",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "dest", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "keep_alive", "bool"),
-					},
-					balances.FunctionTransferAllIndex,
-					"Transfer the entire transferable balance from the caller account."),
-				primitives.NewMetadataDefinitionVariant(
-					"force_unreserve",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "who", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					balances.FunctionForceFreeIndex,
-					"Unreserve some balance from a user by force."),
-			}),
-			sc.Sequence[primitives.MetadataTypeParameter]{
-				primitives.NewMetadataEmptyTypeParameter("T"),
-				primitives.NewMetadataEmptyTypeParameter("I"),
-			}),
+		primitives.NewMetadataType(metadata.TypesSequenceBalanceLock, "[]BalanceLock", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesBalanceLock))),
 	}
 }
+
+func (bm BalancesModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_balances", "pallet", "Call"}, bm.functions)
+}
+
+func eventTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_balances", "pallet", "Event"},
+		"The events of the module.",
+		primitives.NewVariantTypeInfo("Endowed", events.EventEndowed, "Event.Endowed",
+			primitives.NewFieldTypeInfo("account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("free_balance", "T::Balance", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("DustLost", events.EventDustLost, "Events.DustLost",
+			primitives.NewFieldTypeInfo("account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Transfer", events.EventTransfer, "Events.Transfer",
+			primitives.NewFieldTypeInfo("from", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("to", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("BalanceSet", events.EventBalanceSet, "Events.BalanceSet",
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("free", "T::Balance", primitives.TypeInfoU128),
+			primitives.NewFieldTypeInfo("reserved", "T::Balance", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Reserved", events.EventReserved, "Events.Reserved",
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Unreserved", events.EventUnreserved, "Events.Unreserved",
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("ReserveRepatriated", events.EventReserveRepatriated, "Events.ReserveRepatriated",
+			primitives.NewFieldTypeInfo("from", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("to", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.TypeInfoU128),
+			primitives.NewFieldTypeInfo("destination_status", "Status", primitives.TypeId(metadata.TypesBalanceStatus)),
+		),
+		primitives.NewVariantTypeInfo("Deposit", events.EventDeposit, "Event.Deposit",
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Withdraw", events.EventWithdraw, "Event.Withdraw",
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Slashed", events.EventSlashed, "Event.Slashed",
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "T::Balance", primitives.TypeInfoU128),
+		),
+	)
+}
+
+func errorsTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_balances", "pallet", "Error"},
+		"The errors of the module.",
+		primitives.NewVariantTypeInfo("VestingBalance", errors.ErrorVestingBalance, "Vesting balance too high to send value"),
+		primitives.NewVariantTypeInfo("LiquidityRestrictions", errors.ErrorLiquidityRestrictions, "Account liquidity restrictions prevent withdrawal"),
+		primitives.NewVariantTypeInfo("InsufficientBalance", errors.ErrorInsufficientBalance, "Balance too low to send value."),
+		primitives.NewVariantTypeInfo("ExistentialDeposit", errors.ErrorExistentialDeposit, "Value too low to create account due to existential deposit"),
+		primitives.NewVariantTypeInfo("KeepAlive", errors.ErrorKeepAlive, "Transfer/payment would kill account"),
+		primitives.NewVariantTypeInfo("ExistingVestingSchedule", errors.ErrorExistingVestingSchedule, "A vesting schedule already exists for this account"),
+		primitives.NewVariantTypeInfo("DeadAccount", errors.ErrorDeadAccount, "Beneficiary account must pre-exist"),
+		primitives.NewVariantTypeInfo("TooManyReserves", errors.ErrorTooManyReserves, "Number of named reserves exceed MaxReserves"),
+	)
+}
@@ -0,0 +1,19 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageInfo describes the storage items of the module whose values have a maximum encoded length.
+// The sizes of the map entries include their hashed keys.
+func (bm BalancesModule) StorageInfo() []primitives.StorageInfo {
+	return []primitives.StorageInfo{
+		primitives.NewStorageValueInfo("Balances", "TotalIssuance", 16),
+		primitives.NewStorageValueInfo("Balances", "InactiveIssuance", 16),
+		// Blake2_128Concat(AccountId) ++ AccountData
+		primitives.NewStorageMapInfo("Balances", "Account", sc.NewOption[sc.U32](nil), 48+64),
+		dispatchables.StorageInfoLocks,
+	}
+}
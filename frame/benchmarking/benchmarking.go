@@ -0,0 +1,163 @@
+package benchmarking
+
+import (
+	"bytes"
+	"fmt"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
+	"github.com/LimeChain/gosemble/primitives/api"
+	"github.com/LimeChain/gosemble/primitives/benchmarking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	componentTypeInfo = primitives.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"frame_benchmarking", "Component"}, "",
+		primitives.NewFieldTypeInfo("name", "String", primitives.TypeInfoString),
+		primitives.NewFieldTypeInfo("min", "u32", primitives.TypeInfoU32),
+		primitives.NewFieldTypeInfo("max", "u32", primitives.TypeInfoU32),
+	)
+	benchmarkListTypeInfo = primitives.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"frame_benchmarking", "BenchmarkList"}, "",
+		primitives.NewFieldTypeInfo("module", "u8", primitives.TypeInfoU8),
+		primitives.NewFieldTypeInfo("module_name", "String", primitives.TypeInfoString),
+		primitives.NewFieldTypeInfo("benchmark", "String", primitives.TypeInfoString),
+		primitives.NewFieldTypeInfo("components", "Vec<Component>", primitives.NewSequenceTypeInfo(componentTypeInfo)),
+	)
+	benchmarkConfigTypeInfo = primitives.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"frame_benchmarking", "BenchmarkConfig"}, "",
+		primitives.NewFieldTypeInfo("module", "u8", primitives.TypeInfoU8),
+		primitives.NewFieldTypeInfo("benchmark", "String", primitives.TypeInfoString),
+		primitives.NewFieldTypeInfo("values", "Vec<u32>", primitives.NewSequenceTypeInfo(primitives.TypeInfoU32)),
+		primitives.NewFieldTypeInfo("setup_only", "bool", primitives.TypeInfoBool),
+	)
+	benchmarkResultTypeInfo = primitives.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"frame_benchmarking", "BenchmarkResult"}, "",
+		primitives.NewFieldTypeInfo("reads", "u32", primitives.TypeInfoU32),
+		primitives.NewFieldTypeInfo("writes", "u32", primitives.TypeInfoU32),
+		primitives.NewFieldTypeInfo("proof_size", "u64", primitives.TypeInfoU64),
+	)
+)
+
+// Api is the `Benchmark` runtime API, which runs the benchmarks of the dispatchables of the
+// modules. It is exported only by runtimes built with the `benchmarks` tag.
+var Api = api.New("Benchmark", 1, "Runtime api for benchmarking the dispatchables of the runtime.",
+	api.NewMethod("benchmark_metadata",
+		primitives.NewSequenceTypeInfo(benchmarkListTypeInfo),
+		"Get the benchmarks available in the runtime.",
+		BenchmarkMetadata),
+	api.NewMethod1("dispatch_benchmark",
+		api.NewArg("config", benchmarkConfigTypeInfo, DecodeBenchmarkConfig),
+		primitives.NewResultTypeInfo(benchmarkResultTypeInfo, primitives.TypeInfoString),
+		"Dispatch the given benchmark.",
+		DispatchBenchmark),
+)
+
+// BenchmarkList is a benchmark of a module.
+type BenchmarkList struct {
+	Module     sc.U8
+	ModuleName sc.Str
+	Benchmark  sc.Str
+	Components sc.Sequence[benchmarking.Component]
+}
+
+func (bl BenchmarkList) Encode(buffer *bytes.Buffer) {
+	bl.Module.Encode(buffer)
+	bl.ModuleName.Encode(buffer)
+	bl.Benchmark.Encode(buffer)
+	bl.Components.Encode(buffer)
+}
+
+func (bl BenchmarkList) Bytes() []byte {
+	return sc.EncodedBytes(bl)
+}
+
+// BenchmarkConfig selects the benchmark to run and the values of its components.
+type BenchmarkConfig struct {
+	Module    sc.U8
+	Benchmark sc.Str
+	Values    sc.Sequence[sc.U32]
+	SetupOnly sc.Bool
+}
+
+func (bc BenchmarkConfig) Encode(buffer *bytes.Buffer) {
+	bc.Module.Encode(buffer)
+	bc.Benchmark.Encode(buffer)
+	bc.Values.Encode(buffer)
+	bc.SetupOnly.Encode(buffer)
+}
+
+func DecodeBenchmarkConfig(buffer *bytes.Buffer) BenchmarkConfig {
+	return BenchmarkConfig{
+		Module:    sc.DecodeU8(buffer),
+		Benchmark: sc.DecodeStr(buffer),
+		Values:    sc.DecodeSequence[sc.U32](buffer),
+		SetupOnly: sc.DecodeBool(buffer),
+	}
+}
+
+func (bc BenchmarkConfig) Bytes() []byte {
+	return sc.EncodedBytes(bc)
+}
+
+// BenchmarkMetadata returns the benchmarks of the modules, in the order in which the modules are
+// declared.
+func BenchmarkMetadata() sc.Sequence[BenchmarkList] {
+	list := sc.Sequence[BenchmarkList]{}
+
+	for _, index := range config.ModuleIndices() {
+		module, ok := config.Modules[index].(benchmarking.Module)
+		if !ok {
+			continue
+		}
+
+		name := config.Modules[index].Metadata(primitives.NewMetadataTypeRegistry(0)).Name
+		for _, benchmark := range module.Benchmarks() {
+			list = append(list, BenchmarkList{
+				Module:     index,
+				ModuleName: name,
+				Benchmark:  sc.Str(benchmark.Name),
+				Components: sc.Sequence[benchmarking.Component](benchmark.Components),
+			})
+		}
+	}
+
+	return list
+}
+
+// DispatchBenchmark runs the benchmark selected by `benchmarkConfig`.
+// Returns the result, with the storage reads and writes and the proof size of the benchmarked call
+// or with an error message if the benchmark fails.
+func DispatchBenchmark(benchmarkConfig BenchmarkConfig) sc.Result[sc.Encodable] {
+	result, err := dispatchBenchmark(benchmarkConfig)
+	if err != nil {
+		return sc.Result[sc.Encodable]{HasError: true, Value: sc.Str(err.Error())}
+	}
+
+	return sc.Result[sc.Encodable]{Value: result}
+}
+
+func dispatchBenchmark(benchmarkConfig BenchmarkConfig) (benchmarking.Result, error) {
+	module, ok := config.Modules[benchmarkConfig.Module].(benchmarking.Module)
+	if !ok {
+		return benchmarking.Result{}, fmt.Errorf("module %d has no benchmarks", benchmarkConfig.Module)
+	}
+
+	for _, benchmark := range module.Benchmarks() {
+		if benchmark.Name == string(benchmarkConfig.Benchmark) {
+			return benchmark.Run(benchmarkConfig.Values, bool(benchmarkConfig.SetupOnly), storageInfo())
+		}
+	}
+
+	return benchmarking.Result{}, fmt.Errorf("module %d has no benchmark %s", benchmarkConfig.Module, benchmarkConfig.Benchmark)
+}
+
+// storageInfo returns the storage items described by the modules.
+func storageInfo() []primitives.StorageInfo {
+	var storageInfo []primitives.StorageInfo
+
+	for _, index := range config.ModuleIndices() {
+		if module, ok := config.Modules[index].(primitives.StorageInfoModule); ok {
+			storageInfo = append(storageInfo, module.StorageInfo()...)
+		}
+	}
+
+	return storageInfo
+}
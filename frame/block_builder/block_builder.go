@@ -4,110 +4,88 @@ import (
 	"bytes"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/execution/inherent"
 	"github.com/LimeChain/gosemble/execution/types"
 	"github.com/LimeChain/gosemble/frame/executive"
 	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/frame/timestamp"
+	"github.com/LimeChain/gosemble/primitives/api"
 	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
-	"github.com/LimeChain/gosemble/utils"
 )
 
-type BlockBuilder interface {
-	ApplyExtrinsic(dataPtr int32, dataLen int32) int64
-	FinalizeBlock(dataPtr int32, dataLen int32) int64
-	InherentExtrinisics(dataPtr int32, dataLen int32) int64
-	CheckInherents(dataPtr int32, dataLen int32) int64
-	RandomSeed(dataPtr int32, dataLen int32) int64
-}
+// Api is the `BlockBuilder` runtime API, which provides the required functionality for building a block.
+var Api = api.New("BlockBuilder", 6, "The `BlockBuilder` api trait that provides the required functionality for building a block.",
+	api.NewMethod1("apply_extrinsic",
+		api.NewArg("extrinsic", primitives.TypeId(metadata.UncheckedExtrinsic), types.DecodeUncheckedExtrinsic),
+		primitives.TypeId(metadata.TypesApplyExtrinsicResult),
+		"Apply the given extrinsic. Returns an inclusion outcome which specifies if this extrinsic is included in this block or not.",
+		ApplyExtrinsic),
+	api.NewMethod("finalize_block",
+		primitives.TypeId(metadata.TypesHeader),
+		"Finish the current block.",
+		FinalizeBlock),
+	api.NewMethod1("inherent_extrinsics",
+		api.NewArg("inherent", primitives.TypeId(metadata.TypesInherentData), decodeInherentData),
+		primitives.TypeId(metadata.TypesSequenceUncheckedExtrinsic),
+		"Generate inherent extrinsics. The inherent data will vary from chain to chain.",
+		InherentExtrinsics),
+	api.NewMethod2("check_inherents",
+		api.NewArg("block", primitives.TypeId(metadata.TypesBlock), types.DecodeBlock),
+		api.NewArg("data", primitives.TypeId(metadata.TypesInherentData), decodeInherentData),
+		primitives.TypeId(metadata.TypesCheckInherentsResult),
+		"Check that the inherents are valid. The inherent data will vary from chain to chain.",
+		CheckInherents),
+)
 
 // ApplyExtrinsic applies an extrinsic to a particular block.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded unchecked extrinsic.
-// Returns a pointer-size of the SCALE-encoded result, which specifies if this extrinsic is included in this block or not.
+// Returns the result, which specifies if this extrinsic is included in this block or not.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#sect-rte-apply-extrinsic)
-func ApplyExtrinsic(dataPtr int32, dataLen int32) int64 {
-	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(b)
-
-	uxt := types.DecodeUncheckedExtrinsic(buffer)
-
+func ApplyExtrinsic(uxt types.UncheckedExtrinsic) primitives.ApplyExtrinsicResult {
 	ok, err := executive.ApplyExtrinsic(uxt)
-	var applyExtrinsicResult primitives.ApplyExtrinsicResult
 	if err != nil {
-		applyExtrinsicResult = primitives.NewApplyExtrinsicResult(err)
-	} else {
-		applyExtrinsicResult = primitives.NewApplyExtrinsicResult(ok)
+		return primitives.NewApplyExtrinsicResult(err)
 	}
 
-	buffer.Reset()
-	applyExtrinsicResult.Encode(buffer)
-
-	return utils.BytesToOffsetAndSize(buffer.Bytes())
+	return primitives.NewApplyExtrinsicResult(ok)
 }
 
 // FinalizeBlock finalizes the state changes for the current block.
-// Returns a pointer-size of the SCALE-encoded header for this block.
+// Returns the header for this block.
 // [Specification](https://spec.polkadot.network/#defn-rt-blockbuilder-finalize-block)
-func FinalizeBlock() int64 {
+func FinalizeBlock() primitives.Header {
 	system.NoteFinishedExtrinsics()
 
 	blockNumber := system.StorageGetBlockNumber()
 
 	executive.IdleAndFinalizeHook(blockNumber)
 
-	header := system.Finalize()
-	encodedHeader := header.Bytes()
-
-	return utils.BytesToOffsetAndSize(encodedHeader)
+	return system.Finalize()
 }
 
 // InherentExtrinsics generates inherent extrinsics. Inherent data varies depending on chain configuration.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded inherent data.
-// Returns a pointer-size of the SCALE-encoded timestamp extrinsic.
+// Returns the SCALE-encoded sequence of inherent extrinsics, which holds the timestamp extrinsic.
 // [Specification](https://spec.polkadot.network/#defn-rt-builder-inherent-extrinsics)
-func InherentExtrinsics(dataPtr int32, dataLen int32) int64 {
-	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(b)
-
-	inherentData, err := primitives.DecodeInherentData(buffer)
-	if err != nil {
-		log.Critical(err.Error())
-	}
-
-	result := timestamp.CreateInherent(*inherentData)
+func InherentExtrinsics(inherentData primitives.InherentData) sc.FixedSequence[sc.U8] {
+	result := timestamp.CreateInherent(inherentData)
 	result = append(sc.ToCompact(1).Bytes(), result...)
 
-	return utils.BytesToOffsetAndSize(result)
+	return sc.BytesToFixedSequenceU8(result)
 }
 
 // CheckInherents checks the inherents are valid.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded inherent data.
-// Returns a pointer-size of the SCALE-encoded result, specifying if all inherents are valid.
+// Returns the result, specifying if all inherents are valid.
 // [Specification](https://spec.polkadot.network/#id-blockbuilder_check_inherents)
-func CheckInherents(dataPtr int32, dataLen int32) int64 {
-	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(b)
-
-	block := types.DecodeBlock(buffer)
+func CheckInherents(block types.Block, inherentData primitives.InherentData) primitives.CheckInherentsResult {
+	return inherent.CheckExtrinsics(inherentData, block)
+}
 
+func decodeInherentData(buffer *bytes.Buffer) primitives.InherentData {
 	inherentData, err := primitives.DecodeInherentData(buffer)
 	if err != nil {
 		log.Critical(err.Error())
 	}
-	buffer.Reset()
-
-	checkInherentsResult := inherent.CheckExtrinsics(*inherentData, block)
 
-	checkInherentsResult.Encode(buffer)
-	return utils.BytesToOffsetAndSize(buffer.Bytes())
+	return *inherentData
 }
@@ -0,0 +1,139 @@
+package collective
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/collective/events"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// IsMember checks whether `who` is a member of the collective.
+func IsMember(who types.AccountId) sc.Bool {
+	return contains(StorageGetMembers(), who)
+}
+
+// ProposalHash returns the hash under which `proposal` is stored.
+func ProposalHash(proposal types.Call) types.H256 {
+	hash := hashing.Blake256(proposal.Bytes())
+	return types.NewH256(sc.BytesToSequenceU8(hash)...)
+}
+
+// SortMembers sorts accounts by their byte representation, which is the order members are stored in.
+func SortMembers(members sc.Sequence[types.AccountId]) sc.Sequence[types.AccountId] {
+	sorted := append(sc.Sequence[types.AccountId]{}, members...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Bytes(), sorted[j].Bytes()) < 0
+	})
+
+	return sorted
+}
+
+// DoApproveProposal dispatches an approved proposal with the origin of `yesVotes` out of `seats` members
+// and removes it from storage.
+func DoApproveProposal(seats, yesVotes sc.U32, proposalHash types.H256, proposal types.Call) types.Weight {
+	system.DepositEvent(events.NewEventApproved(proposalHash))
+
+	info := types.GetDispatchInfo(proposal)
+	result := support.DispatchCall(proposal, NewOriginMembers(yesVotes, seats))
+	system.DepositEvent(events.NewEventExecuted(proposalHash, dispatchOutcome(result)))
+
+	RemoveProposal(proposalHash)
+
+	return types.ExtractActualWeight(&result, &info)
+}
+
+// DoDisapproveProposal removes a disapproved proposal from storage.
+func DoDisapproveProposal(proposalHash types.H256) {
+	system.DepositEvent(events.NewEventDisapproved(proposalHash))
+	RemoveProposal(proposalHash)
+}
+
+// RemoveProposal removes a proposal with its votes from storage.
+func RemoveProposal(proposalHash types.H256) {
+	StorageClearProposalOf(proposalHash)
+	StorageClearVoting(proposalHash)
+
+	proposals := sc.Sequence[types.H256]{}
+	for _, hash := range StorageGetProposals() {
+		if !reflect.DeepEqual(hash, proposalHash) {
+			proposals = append(proposals, hash)
+		}
+	}
+	StorageSetProposals(proposals)
+}
+
+// ChangeMembersSorted replaces the members of the collective, removing the votes of outgoing members
+// from all ongoing motions. Both `outgoing` and `newMembers` must be sorted.
+func ChangeMembersSorted(outgoing sc.Sequence[types.AccountId], newMembers sc.Sequence[types.AccountId]) {
+	if len(outgoing) > 0 {
+		for _, hash := range StorageGetProposals() {
+			maybeVotes := StorageGetVoting(hash)
+			if !maybeVotes.HasValue {
+				continue
+			}
+
+			votes := maybeVotes.Value
+			votes.Ayes = removeAll(votes.Ayes, outgoing)
+			votes.Nays = removeAll(votes.Nays, outgoing)
+			StorageSetVoting(hash, votes)
+		}
+	}
+
+	StorageSetMembers(newMembers)
+
+	prime := StorageGetPrime()
+	if prime.HasValue && !contains(newMembers, prime.Value) {
+		StorageClearPrime()
+	}
+}
+
+// ExecuteAsMember dispatches `proposal` with the origin of a single member of the collective.
+// Returns the outcome and the actual weight of the dispatch.
+func ExecuteAsMember(who types.AccountId, proposal types.Call) (types.DispatchOutcome, types.Weight) {
+	info := types.GetDispatchInfo(proposal)
+	result := support.DispatchCall(proposal, NewOriginMember(who))
+	return dispatchOutcome(result), types.ExtractActualWeight(&result, &info)
+}
+
+// ExecuteAsMembers dispatches `proposal` with the origin of `yesVotes` out of `seats` members.
+// Returns the outcome and the actual weight of the dispatch.
+func ExecuteAsMembers(seats, yesVotes sc.U32, proposal types.Call) (types.DispatchOutcome, types.Weight) {
+	info := types.GetDispatchInfo(proposal)
+	result := support.DispatchCall(proposal, NewOriginMembers(yesVotes, seats))
+	return dispatchOutcome(result), types.ExtractActualWeight(&result, &info)
+}
+
+func dispatchOutcome(result types.DispatchResultWithPostInfo[types.PostDispatchInfo]) types.DispatchOutcome {
+	if result.HasError {
+		return types.NewDispatchOutcome(result.Err.Error)
+	}
+
+	return types.NewDispatchOutcome(nil)
+}
+
+func contains(accounts sc.Sequence[types.AccountId], who types.AccountId) sc.Bool {
+	for _, account := range accounts {
+		if reflect.DeepEqual(account, who) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeAll(accounts sc.Sequence[types.AccountId], remove sc.Sequence[types.AccountId]) sc.Sequence[types.AccountId] {
+	result := sc.Sequence[types.AccountId]{}
+	for _, account := range accounts {
+		if !contains(remove, account) {
+			result = append(result, account)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,248 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/collective"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/collective"
+	"github.com/LimeChain/gosemble/frame/collective/errors"
+	"github.com/LimeChain/gosemble/frame/collective/events"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CloseCall struct {
+	primitives.Callable
+}
+
+func NewCloseCall(args sc.VaryingData) CloseCall {
+	call := CloseCall{
+		Callable: primitives.Callable{
+			ModuleId:   collective.ModuleIndex,
+			FunctionId: collective.FunctionCloseIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c CloseCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeH256(buffer),
+		sc.DecodeCompact(buffer),
+		types.DecodeWeight(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c CloseCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c CloseCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c CloseCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c CloseCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ CloseCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "close",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("proposal_hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+			primitives.NewFieldTypeInfo("index", "ProposalIndex", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("proposal_weight_bound", "Weight", primitives.TypeId(metadata.TypesWeight)),
+			primitives.NewFieldTypeInfo("length_bound", "u32", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+		},
+		Docs: "Close a vote that is either approved, disapproved or whose voting period has ended.",
+	}
+}
+
+func (c CloseCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ CloseCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 40_452 nanoseconds.
+	r := constants.DbWeight.Reads(5)
+	w := constants.DbWeight.Writes(3)
+	e := types.ProofWeight(pallet.StorageInfoVoting, pallet.StorageInfoMembers, pallet.StorageInfoPrime, pallet.StorageInfoProposals)
+	weight := types.WeightFromParts(41_278_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+
+	// An approved proposal is read and dispatched, so the proof of a proposal of `length_bound` bytes
+	// and the bound of its weight are added, once the arguments are decoded.
+	if len(b) == 0 {
+		return weight
+	}
+	args, ok := b[0].(sc.VaryingData)
+	if !ok || len(args) < 4 {
+		return weight
+	}
+	proposalWeightBound, ok := args[2].(types.Weight)
+	if !ok {
+		return weight
+	}
+	lengthBound, ok := args[3].(sc.Compact)
+	if !ok {
+		return weight
+	}
+	proposalProof := types.ProofWeight(pallet.StorageInfoProposalOf(sc.U32(sc.U128(lengthBound).ToBigInt().Uint64())))
+
+	return weight.
+		SaturatingAdd(proposalProof).
+		SaturatingAdd(proposalWeightBound)
+}
+
+func (_ CloseCall) IsInherent() bool {
+	return false
+}
+
+func (_ CloseCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ CloseCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ CloseCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ CloseCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	weight, err := closeProposal(origin, args[0].(types.H256), sc.U32(sc.U128(args[1].(sc.Compact)).ToBigInt().Uint64()), args[2].(types.Weight), sc.U32(sc.U128(args[3].(sc.Compact)).ToBigInt().Uint64()))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok: types.PostDispatchInfo{
+			ActualWeight: sc.NewOption[types.Weight](CloseCall{}.BaseWeight().SaturatingAdd(weight)),
+		},
+	}
+}
+
+// closeProposal closes a vote that is either approved, disapproved or whose voting period has ended.
+// May be called by any signed account.
+//
+// If the voting period has ended, the abstentions are counted according to the vote of the prime member.
+// Approved proposals are dispatched with the origin of the approving members. Returns the actual
+// weight of the proposal, if it is dispatched.
+func closeProposal(origin types.RuntimeOrigin, proposalHash types.H256, index sc.U32, proposalWeightBound types.Weight, lengthBound sc.U32) (types.Weight, types.DispatchError) {
+	if !origin.IsSignedOrigin() {
+		return types.WeightZero(), types.NewDispatchErrorBadOrigin()
+	}
+
+	maybeVoting := pallet.StorageGetVoting(proposalHash)
+	if !maybeVoting.HasValue {
+		return types.WeightZero(), types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorProposalMissing),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+	voting := maybeVoting.Value
+
+	if voting.Index != index {
+		return types.WeightZero(), types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorWrongIndex),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	members := pallet.StorageGetMembers()
+	seats := sc.U32(len(members))
+	yesVotes := sc.U32(len(voting.Ayes))
+	noVotes := sc.U32(len(voting.Nays))
+
+	approved := yesVotes >= voting.Threshold
+	disapproved := seats-noVotes < voting.Threshold
+
+	if !approved && !disapproved {
+		if system.StorageGetBlockNumber() < voting.End {
+			return types.WeightZero(), types.NewDispatchErrorModule(types.CustomModuleError{
+				Index:   collective.ModuleIndex,
+				Error:   sc.U32(errors.ErrorTooEarly),
+				Message: sc.NewOption[sc.Str](nil),
+			})
+		}
+
+		// Abstentions are counted as the vote of the prime member, or as nays if there is no prime member.
+		primeVote := false
+		prime := pallet.StorageGetPrime()
+		if prime.HasValue {
+			primeVote = position(voting.Ayes, prime.Value) >= 0
+		}
+
+		abstentions := seats - (yesVotes + noVotes)
+		if primeVote {
+			yesVotes += abstentions
+		} else {
+			noVotes += abstentions
+		}
+
+		approved = yesVotes >= voting.Threshold
+	}
+
+	if !approved {
+		system.DepositEvent(events.NewEventClosed(proposalHash, yesVotes, noVotes))
+		pallet.DoDisapproveProposal(proposalHash)
+		return types.WeightZero(), nil
+	}
+
+	maybeProposal := pallet.StorageGetProposalOf(proposalHash)
+	if !maybeProposal.HasValue {
+		return types.WeightZero(), types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorProposalMissing),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+	proposal := maybeProposal.Value
+
+	if sc.U32(len(proposal.Bytes())) > lengthBound {
+		return types.WeightZero(), types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorWrongProposalLength),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	if types.GetDispatchInfo(proposal).Weight.AnyGt(proposalWeightBound) {
+		return types.WeightZero(), types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorWrongProposalWeight),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	system.DepositEvent(events.NewEventClosed(proposalHash, yesVotes, noVotes))
+	weight := pallet.DoApproveProposal(seats, yesVotes, proposalHash, proposal)
+
+	return weight, nil
+}
@@ -0,0 +1,125 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/collective"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/collective"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type DisapproveProposalCall struct {
+	primitives.Callable
+}
+
+func NewDisapproveProposalCall(args sc.VaryingData) DisapproveProposalCall {
+	call := DisapproveProposalCall{
+		Callable: primitives.Callable{
+			ModuleId:   collective.ModuleIndex,
+			FunctionId: collective.FunctionDisapproveProposalIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c DisapproveProposalCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeH256(buffer),
+	)
+	return c
+}
+
+func (c DisapproveProposalCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c DisapproveProposalCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c DisapproveProposalCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c DisapproveProposalCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ DisapproveProposalCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "disapprove_proposal",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("proposal_hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+		},
+		Docs: "Disapprove a proposal, close, and remove it from the system, regardless of its current state.",
+	}
+}
+
+func (c DisapproveProposalCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ DisapproveProposalCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 13_682 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(3)
+	e := types.ProofWeight(pallet.StorageInfoProposals)
+	return types.WeightFromParts(13_962_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ DisapproveProposalCall) IsInherent() bool {
+	return false
+}
+
+func (_ DisapproveProposalCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ DisapproveProposalCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ DisapproveProposalCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ DisapproveProposalCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := disapproveProposal(origin, args[0].(types.H256))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// disapproveProposal disapproves a proposal, closing and removing it from the system, regardless of its
+// current state. Can only be called by root.
+func disapproveProposal(origin types.RuntimeOrigin, proposalHash types.H256) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	pallet.DoDisapproveProposal(proposalHash)
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/collective"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/collective"
+	"github.com/LimeChain/gosemble/frame/collective/errors"
+	"github.com/LimeChain/gosemble/frame/collective/events"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ExecuteCall struct {
+	primitives.Callable
+}
+
+func NewExecuteCall(args sc.VaryingData) ExecuteCall {
+	call := ExecuteCall{
+		Callable: primitives.Callable{
+			ModuleId:   collective.ModuleIndex,
+			FunctionId: collective.FunctionExecuteIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ExecuteCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		support.DecodeCall(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c ExecuteCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ExecuteCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ExecuteCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ExecuteCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ExecuteCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "execute",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("proposal", "Box<<T as Config<I>>::Proposal>", primitives.TypeId(metadata.RuntimeCall)),
+			primitives.NewFieldTypeInfo("length_bound", "u32", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+		},
+		Docs: "Dispatch a proposal from a member using the `Member` origin.",
+	}
+}
+
+func (c ExecuteCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ExecuteCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 16_347 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(0)
+	e := types.ProofWeight(pallet.StorageInfoMembers)
+	weight := types.WeightFromParts(16_681_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+
+	// The weight of the proposal is added, once the arguments are decoded.
+	if len(b) == 0 {
+		return weight
+	}
+	args, ok := b[0].(sc.VaryingData)
+	if !ok || len(args) == 0 {
+		return weight
+	}
+	proposal, ok := args[0].(types.Call)
+	if !ok {
+		return weight
+	}
+
+	return weight.SaturatingAdd(types.GetDispatchInfo(proposal).Weight)
+}
+
+func (_ ExecuteCall) IsInherent() bool {
+	return false
+}
+
+func (_ ExecuteCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ExecuteCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ExecuteCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ExecuteCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	weight, err := execute(origin, args[0].(types.Call), sc.U32(sc.U128(args[1].(sc.Compact)).ToBigInt().Uint64()))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok: types.PostDispatchInfo{
+			ActualWeight: sc.NewOption[types.Weight](ExecuteCall{}.BaseWeight().SaturatingAdd(weight)),
+		},
+	}
+}
+
+// execute dispatches a proposal directly with the origin of a single member of the collective.
+// Returns the actual weight of the proposal.
+func execute(origin types.RuntimeOrigin, proposal types.Call, lengthBound sc.U32) (types.Weight, types.DispatchError) {
+	if !origin.IsSignedOrigin() {
+		return types.WeightZero(), types.NewDispatchErrorBadOrigin()
+	}
+	who := origin.AsSigned()
+
+	if !pallet.IsMember(who) {
+		return types.WeightZero(), types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorNotMember),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	if sc.U32(len(proposal.Bytes())) > lengthBound {
+		return types.WeightZero(), types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorWrongProposalLength),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	proposalHash := pallet.ProposalHash(proposal)
+	result, weight := pallet.ExecuteAsMember(who, proposal)
+
+	system.DepositEvent(events.NewEventMemberExecuted(proposalHash, result))
+
+	return weight, nil
+}
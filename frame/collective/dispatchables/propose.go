@@ -0,0 +1,207 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/collective"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/collective"
+	"github.com/LimeChain/gosemble/frame/collective/errors"
+	"github.com/LimeChain/gosemble/frame/collective/events"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ProposeCall struct {
+	primitives.Callable
+}
+
+func NewProposeCall(args sc.VaryingData) ProposeCall {
+	call := ProposeCall{
+		Callable: primitives.Callable{
+			ModuleId:   collective.ModuleIndex,
+			FunctionId: collective.FunctionProposeIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ProposeCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		support.DecodeCall(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c ProposeCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ProposeCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ProposeCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ProposeCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ProposeCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "propose",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("threshold", "MemberCount", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("proposal", "Box<<T as Config<I>>::Proposal>", primitives.TypeId(metadata.RuntimeCall)),
+			primitives.NewFieldTypeInfo("length_bound", "u32", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+		},
+		Docs: "Add a new proposal to either be voted on or executed directly.",
+	}
+}
+
+func (c ProposeCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ProposeCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 26_356 nanoseconds.
+	r := constants.DbWeight.Reads(4)
+	w := constants.DbWeight.Writes(4)
+	// The proposal is not stored yet, so only its absence is proven.
+	e := types.ProofWeight(pallet.StorageInfoMembers, pallet.StorageInfoProposals, pallet.StorageInfoProposalCount, pallet.StorageInfoProposalOf(0))
+	weight := types.WeightFromParts(26_894_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+
+	// A proposal with a threshold lower than 2 is executed directly, so its weight is added,
+	// once the arguments are decoded.
+	if len(b) == 0 {
+		return weight
+	}
+	args, ok := b[0].(sc.VaryingData)
+	if !ok || len(args) < 2 {
+		return weight
+	}
+	proposal, ok := args[1].(types.Call)
+	if !ok {
+		return weight
+	}
+
+	return weight.SaturatingAdd(types.GetDispatchInfo(proposal).Weight)
+}
+
+func (_ ProposeCall) IsInherent() bool {
+	return false
+}
+
+func (_ ProposeCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ProposeCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ProposeCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ProposeCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	weight, err := propose(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(types.Call), sc.U32(sc.U128(args[2].(sc.Compact)).ToBigInt().Uint64()))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok: types.PostDispatchInfo{
+			ActualWeight: sc.NewOption[types.Weight](ProposeCall{}.BaseWeight().SaturatingAdd(weight)),
+		},
+	}
+}
+
+// propose adds a new proposal to be voted on. Must be called by a member of the collective.
+// Proposals with a threshold lower than 2 are executed directly. Returns the actual weight of the
+// proposal, if it is executed.
+func propose(origin types.RuntimeOrigin, threshold sc.U32, proposal types.Call, lengthBound sc.U32) (types.Weight, types.DispatchError) {
+	if !origin.IsSignedOrigin() {
+		return types.WeightZero(), types.NewDispatchErrorBadOrigin()
+	}
+	who := origin.AsSigned()
+
+	if !pallet.IsMember(who) {
+		return types.WeightZero(), types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorNotMember),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	if sc.U32(len(proposal.Bytes())) > lengthBound {
+		return types.WeightZero(), types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorWrongProposalLength),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	proposalHash := pallet.ProposalHash(proposal)
+	if pallet.StorageExistsProposalOf(proposalHash) {
+		return types.WeightZero(), types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorDuplicateProposal),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	if threshold < 2 {
+		result, weight := pallet.ExecuteAsMembers(sc.U32(len(pallet.StorageGetMembers())), 1, proposal)
+		system.DepositEvent(events.NewEventExecuted(proposalHash, result))
+		return weight, nil
+	}
+
+	proposals := pallet.StorageGetProposals()
+	if len(proposals) >= collective.MaxProposals {
+		return types.WeightZero(), types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorTooManyProposals),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+	pallet.StorageSetProposals(append(proposals, proposalHash))
+
+	index := pallet.StorageGetProposalCount()
+	pallet.StorageSetProposalCount(index + 1)
+
+	pallet.StorageSetProposalOf(proposalHash, proposal)
+	pallet.StorageSetVoting(proposalHash, types.CollectiveVotes{
+		Index:     index,
+		Threshold: threshold,
+		Ayes:      sc.Sequence[types.AccountId]{},
+		Nays:      sc.Sequence[types.AccountId]{},
+		End:       system.StorageGetBlockNumber() + collective.MotionDuration,
+	})
+
+	system.DepositEvent(events.NewEventProposed(who.FixedSequence, index, proposalHash, threshold))
+
+	return types.WeightZero(), nil
+}
@@ -0,0 +1,165 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/collective"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/collective"
+	"github.com/LimeChain/gosemble/frame/collective/errors"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetMembersCall struct {
+	primitives.Callable
+}
+
+func NewSetMembersCall(args sc.VaryingData) SetMembersCall {
+	call := SetMembersCall{
+		Callable: primitives.Callable{
+			ModuleId:   collective.ModuleIndex,
+			FunctionId: collective.FunctionSetMembersIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetMembersCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeSequenceWith(buffer, types.DecodeAccountId),
+		sc.DecodeOptionWith(buffer, types.DecodeAccountId),
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c SetMembersCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetMembersCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetMembersCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetMembersCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetMembersCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_members",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("new_members", "Vec<T::AccountId>", primitives.TypeId(metadata.TypesSequenceAddress32)),
+			primitives.NewFieldTypeInfo("prime", "Option<T::AccountId>", primitives.TypeId(metadata.TypesOptionAddress32)),
+			primitives.NewFieldTypeInfo("old_count", "MemberCount", primitives.TypeInfoU32),
+		},
+		Docs: "Set the collective's membership.",
+	}
+}
+
+func (c SetMembersCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetMembersCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 15_231 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	// The votes of each of the active proposals are updated.
+	e := types.ProofWeight(pallet.StorageInfoMembers, pallet.StorageInfoPrime, pallet.StorageInfoProposals).
+		SaturatingAdd(types.ProofWeight(pallet.StorageInfoVoting).SaturatingMul(collective.MaxProposals))
+	return types.WeightFromParts(15_542_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetMembersCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetMembersCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetMembersCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetMembersCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetMembersCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setMembers(origin, args[0].(sc.Sequence[types.AccountId]), args[1].(sc.Option[types.AccountId]), args[2].(sc.U32))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setMembers sets the collective's membership. Can only be called by root.
+// Votes of outgoing members are removed from all ongoing motions.
+func setMembers(origin types.RuntimeOrigin, newMembers sc.Sequence[types.AccountId], prime sc.Option[types.AccountId], oldCount sc.U32) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	if len(newMembers) > collective.MaxMembers {
+		log.Warn("New members count exceeds MaxMembers")
+	}
+
+	oldMembers := pallet.StorageGetMembers()
+	if sc.U32(len(oldMembers)) > oldCount {
+		log.Warn("Wrong count used to estimate set_members weight")
+	}
+
+	if prime.HasValue && position(newMembers, prime.Value) < 0 {
+		return types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorPrimeAccountNotMember),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	sorted := pallet.SortMembers(newMembers)
+
+	outgoing := sc.Sequence[types.AccountId]{}
+	for _, member := range oldMembers {
+		if position(sorted, member) < 0 {
+			outgoing = append(outgoing, member)
+		}
+	}
+
+	pallet.ChangeMembersSorted(outgoing, sorted)
+
+	if prime.HasValue {
+		pallet.StorageSetPrime(prime.Value)
+	} else {
+		pallet.StorageClearPrime()
+	}
+
+	return nil
+}
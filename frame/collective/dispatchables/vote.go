@@ -0,0 +1,201 @@
+package dispatchables
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/collective"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/collective"
+	"github.com/LimeChain/gosemble/frame/collective/errors"
+	"github.com/LimeChain/gosemble/frame/collective/events"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type VoteCall struct {
+	primitives.Callable
+}
+
+func NewVoteCall(args sc.VaryingData) VoteCall {
+	call := VoteCall{
+		Callable: primitives.Callable{
+			ModuleId:   collective.ModuleIndex,
+			FunctionId: collective.FunctionVoteIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c VoteCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeH256(buffer),
+		sc.DecodeCompact(buffer),
+		sc.DecodeBool(buffer),
+	)
+	return c
+}
+
+func (c VoteCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c VoteCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c VoteCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c VoteCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ VoteCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "vote",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("proposal", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+			primitives.NewFieldTypeInfo("index", "ProposalIndex", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("approve", "bool", primitives.TypeInfoBool),
+		},
+		Docs: "Add an aye or nay vote for the sender to the given proposal.",
+	}
+}
+
+func (c VoteCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ VoteCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 22_478 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoMembers, pallet.StorageInfoVoting)
+	return types.WeightFromParts(22_937_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ VoteCall) IsInherent() bool {
+	return false
+}
+
+func (_ VoteCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ VoteCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ VoteCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ VoteCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := vote(origin, args[0].(types.H256), sc.U32(sc.U128(args[1].(sc.Compact)).ToBigInt().Uint64()), args[2].(sc.Bool))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// vote adds an aye or nay vote for the sender to the given proposal. Must be called by a member of the collective.
+// A previous vote of the sender is replaced.
+func vote(origin types.RuntimeOrigin, proposalHash types.H256, index sc.U32, approve sc.Bool) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+	who := origin.AsSigned()
+
+	if !pallet.IsMember(who) {
+		return types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorNotMember),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	maybeVoting := pallet.StorageGetVoting(proposalHash)
+	if !maybeVoting.HasValue {
+		return types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorProposalMissing),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+	voting := maybeVoting.Value
+
+	if voting.Index != index {
+		return types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   collective.ModuleIndex,
+			Error:   sc.U32(errors.ErrorWrongIndex),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	positionYes := position(voting.Ayes, who)
+	positionNo := position(voting.Nays, who)
+
+	if approve {
+		if positionYes >= 0 {
+			return types.NewDispatchErrorModule(types.CustomModuleError{
+				Index:   collective.ModuleIndex,
+				Error:   sc.U32(errors.ErrorDuplicateVote),
+				Message: sc.NewOption[sc.Str](nil),
+			})
+		}
+		voting.Ayes = append(voting.Ayes, who)
+		if positionNo >= 0 {
+			voting.Nays = append(voting.Nays[:positionNo], voting.Nays[positionNo+1:]...)
+		}
+	} else {
+		if positionNo >= 0 {
+			return types.NewDispatchErrorModule(types.CustomModuleError{
+				Index:   collective.ModuleIndex,
+				Error:   sc.U32(errors.ErrorDuplicateVote),
+				Message: sc.NewOption[sc.Str](nil),
+			})
+		}
+		voting.Nays = append(voting.Nays, who)
+		if positionYes >= 0 {
+			voting.Ayes = append(voting.Ayes[:positionYes], voting.Ayes[positionYes+1:]...)
+		}
+	}
+
+	system.DepositEvent(events.NewEventVoted(who.FixedSequence, proposalHash, approve, sc.U32(len(voting.Ayes)), sc.U32(len(voting.Nays))))
+
+	pallet.StorageSetVoting(proposalHash, voting)
+
+	return nil
+}
+
+func position(accounts sc.Sequence[types.AccountId], who types.AccountId) int {
+	for i, account := range accounts {
+		if reflect.DeepEqual(account, who) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,18 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Collective module errors.
+const (
+	ErrorNotMember sc.U8 = iota
+	ErrorDuplicateProposal
+	ErrorProposalMissing
+	ErrorWrongIndex
+	ErrorDuplicateVote
+	ErrorAlreadyInitialized
+	ErrorTooEarly
+	ErrorTooManyProposals
+	ErrorWrongProposalWeight
+	ErrorWrongProposalLength
+	ErrorPrimeAccountNotMember
+)
@@ -0,0 +1,97 @@
+package events
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/collective"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Collective module events.
+const (
+	EventProposed sc.U8 = iota
+	EventVoted
+	EventApproved
+	EventDisapproved
+	EventExecuted
+	EventMemberExecuted
+	EventClosed
+)
+
+func NewEventProposed(account types.PublicKey, proposalIndex sc.U32, proposalHash types.H256, threshold sc.U32) types.Event {
+	return types.NewEvent(collective.ModuleIndex, EventProposed, account, proposalIndex, proposalHash, threshold)
+}
+
+func NewEventVoted(account types.PublicKey, proposalHash types.H256, voted sc.Bool, yes sc.U32, no sc.U32) types.Event {
+	return types.NewEvent(collective.ModuleIndex, EventVoted, account, proposalHash, voted, yes, no)
+}
+
+func NewEventApproved(proposalHash types.H256) types.Event {
+	return types.NewEvent(collective.ModuleIndex, EventApproved, proposalHash)
+}
+
+func NewEventDisapproved(proposalHash types.H256) types.Event {
+	return types.NewEvent(collective.ModuleIndex, EventDisapproved, proposalHash)
+}
+
+func NewEventExecuted(proposalHash types.H256, result types.DispatchOutcome) types.Event {
+	return types.NewEvent(collective.ModuleIndex, EventExecuted, proposalHash, result)
+}
+
+func NewEventMemberExecuted(proposalHash types.H256, result types.DispatchOutcome) types.Event {
+	return types.NewEvent(collective.ModuleIndex, EventMemberExecuted, proposalHash, result)
+}
+
+func NewEventClosed(proposalHash types.H256, yes sc.U32, no sc.U32) types.Event {
+	return types.NewEvent(collective.ModuleIndex, EventClosed, proposalHash, yes, no)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	module := sc.DecodeU8(buffer)
+	if module != collective.ModuleIndex {
+		log.Critical("invalid collective.Event module")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventProposed:
+		account := types.DecodePublicKey(buffer)
+		proposalIndex := sc.DecodeU32(buffer)
+		proposalHash := types.DecodeH256(buffer)
+		threshold := sc.DecodeU32(buffer)
+		return NewEventProposed(account, proposalIndex, proposalHash, threshold)
+	case EventVoted:
+		account := types.DecodePublicKey(buffer)
+		proposalHash := types.DecodeH256(buffer)
+		voted := sc.DecodeBool(buffer)
+		yes := sc.DecodeU32(buffer)
+		no := sc.DecodeU32(buffer)
+		return NewEventVoted(account, proposalHash, voted, yes, no)
+	case EventApproved:
+		proposalHash := types.DecodeH256(buffer)
+		return NewEventApproved(proposalHash)
+	case EventDisapproved:
+		proposalHash := types.DecodeH256(buffer)
+		return NewEventDisapproved(proposalHash)
+	case EventExecuted:
+		proposalHash := types.DecodeH256(buffer)
+		result := types.DecodeDispatchOutcome(buffer)
+		return NewEventExecuted(proposalHash, result)
+	case EventMemberExecuted:
+		proposalHash := types.DecodeH256(buffer)
+		result := types.DecodeDispatchOutcome(buffer)
+		return NewEventMemberExecuted(proposalHash, result)
+	case EventClosed:
+		proposalHash := types.DecodeH256(buffer)
+		yes := sc.DecodeU32(buffer)
+		no := sc.DecodeU32(buffer)
+		return NewEventClosed(proposalHash, yes, no)
+	default:
+		log.Critical("invalid collective.Event type")
+	}
+
+	panic("unreachable")
+}
@@ -0,0 +1,178 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/collective"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/collective"
+	"github.com/LimeChain/gosemble/frame/collective/dispatchables"
+	"github.com/LimeChain/gosemble/frame/collective/errors"
+	"github.com/LimeChain/gosemble/frame/collective/events"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CollectiveModule struct {
+	functions map[sc.U8]primitives.Call
+}
+
+func NewCollectiveModule() CollectiveModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[collective.FunctionSetMembersIndex] = dispatchables.NewSetMembersCall(nil)
+	functions[collective.FunctionExecuteIndex] = dispatchables.NewExecuteCall(nil)
+	functions[collective.FunctionProposeIndex] = dispatchables.NewProposeCall(nil)
+	functions[collective.FunctionVoteIndex] = dispatchables.NewVoteCall(nil)
+	functions[collective.FunctionDisapproveProposalIndex] = dispatchables.NewDisapproveProposalCall(nil)
+	functions[collective.FunctionCloseIndex] = dispatchables.NewCloseCall(nil)
+
+	return CollectiveModule{
+		functions: functions,
+	}
+}
+
+func (cm CollectiveModule) Functions() map[sc.U8]primitives.Call {
+	return cm.functions
+}
+
+func (cm CollectiveModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (cm CollectiveModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (cm CollectiveModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"A collective of members voting on proposals."}
+}
+
+func (cm CollectiveModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "Council",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "Council",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"Proposals",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceH256)),
+					"The hashes of the active proposals."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ProposalOf",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncIdentity},
+						sc.ToCompact(metadata.TypesH256),
+						sc.ToCompact(metadata.RuntimeCall)),
+					"Actual proposal for a given hash, if it's current."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Voting",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncIdentity},
+						sc.ToCompact(metadata.TypesH256),
+						sc.ToCompact(registry.Register(pallet.VotesTypeInfo))),
+					"Votes on a given proposal, if it is ongoing."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ProposalCount",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+					"Proposals so far."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Members",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceAddress32)),
+					"The current members of the collective. This is stored sorted (just by value)."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Prime",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesAddress32)),
+					"The prime member that helps determine the default vote behavior in case of abstentions."),
+			},
+		}),
+		Call:  sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(cm.callsTypeInfo()))),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(eventTypeInfo()))),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"MotionDuration",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(collective.MotionDuration.Bytes()),
+				"The time-out for council motions.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxProposals",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(collective.MaxProposals).Bytes()),
+				"Maximum number of proposals allowed to be active in parallel.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxMembers",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(collective.MaxMembers).Bytes()),
+				"The maximum number of members supported by the pallet.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(errorsTypeInfo()))),
+		Index: collective.ModuleIndex,
+	}
+}
+
+func (cm CollectiveModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_collective", "pallet", "Call"}, cm.functions)
+}
+
+func eventTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_collective", "pallet", "Event"},
+		"The events of the module.",
+		primitives.NewVariantTypeInfo("Proposed", events.EventProposed, "A motion (given hash) has been proposed (by given account) with a threshold (given `MemberCount`).",
+			primitives.NewFieldTypeInfo("account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("proposal_index", "ProposalIndex", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("proposal_hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+			primitives.NewFieldTypeInfo("threshold", "MemberCount", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("Voted", events.EventVoted, "A motion (given hash) has been voted on by given account, leaving a tally (yes votes and no votes given respectively as `MemberCount`).",
+			primitives.NewFieldTypeInfo("account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("proposal_hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+			primitives.NewFieldTypeInfo("voted", "bool", primitives.TypeInfoBool),
+			primitives.NewFieldTypeInfo("yes", "MemberCount", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("no", "MemberCount", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("Approved", events.EventApproved, "A motion was approved by the required threshold.",
+			primitives.NewFieldTypeInfo("proposal_hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+		),
+		primitives.NewVariantTypeInfo("Disapproved", events.EventDisapproved, "A motion was not approved by the required threshold.",
+			primitives.NewFieldTypeInfo("proposal_hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+		),
+		primitives.NewVariantTypeInfo("Executed", events.EventExecuted, "A motion was executed; result will be `Ok` if it returned without error.",
+			primitives.NewFieldTypeInfo("proposal_hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+			primitives.NewFieldTypeInfo("result", "DispatchResult", primitives.TypeId(metadata.TypesDispatchResult)),
+		),
+		primitives.NewVariantTypeInfo("MemberExecuted", events.EventMemberExecuted, "A single member did some action; result will be `Ok` if it returned without error.",
+			primitives.NewFieldTypeInfo("proposal_hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+			primitives.NewFieldTypeInfo("result", "DispatchResult", primitives.TypeId(metadata.TypesDispatchResult)),
+		),
+		primitives.NewVariantTypeInfo("Closed", events.EventClosed, "A proposal was closed because its threshold was reached or after its duration was up.",
+			primitives.NewFieldTypeInfo("proposal_hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+			primitives.NewFieldTypeInfo("yes", "MemberCount", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("no", "MemberCount", primitives.TypeInfoU32),
+		),
+	)
+}
+
+func errorsTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_collective", "pallet", "Error"},
+		"The errors of the module.",
+		primitives.NewVariantTypeInfo("NotMember", errors.ErrorNotMember, "Account is not a member"),
+		primitives.NewVariantTypeInfo("DuplicateProposal", errors.ErrorDuplicateProposal, "Duplicate proposals not allowed"),
+		primitives.NewVariantTypeInfo("ProposalMissing", errors.ErrorProposalMissing, "Proposal must exist"),
+		primitives.NewVariantTypeInfo("WrongIndex", errors.ErrorWrongIndex, "Mismatched index"),
+		primitives.NewVariantTypeInfo("DuplicateVote", errors.ErrorDuplicateVote, "Duplicate vote ignored"),
+		primitives.NewVariantTypeInfo("AlreadyInitialized", errors.ErrorAlreadyInitialized, "Members are already initialized!"),
+		primitives.NewVariantTypeInfo("TooEarly", errors.ErrorTooEarly, "The close call was made too early, before the end of the voting."),
+		primitives.NewVariantTypeInfo("TooManyProposals", errors.ErrorTooManyProposals, "There can only be a maximum of `MaxProposals` active proposals."),
+		primitives.NewVariantTypeInfo("WrongProposalWeight", errors.ErrorWrongProposalWeight, "The given weight bound for the proposal was too low."),
+		primitives.NewVariantTypeInfo("WrongProposalLength", errors.ErrorWrongProposalLength, "The given length bound for the proposal was too low."),
+		primitives.NewVariantTypeInfo("PrimeAccountNotMember", errors.ErrorPrimeAccountNotMember, "Prime account is not a member"),
+	)
+}
@@ -0,0 +1,18 @@
+package module
+
+import (
+	pallet "github.com/LimeChain/gosemble/frame/collective"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageInfo describes the storage items of the module whose values have a maximum encoded length.
+// The sizes of the map entries include their hashed keys.
+func (cm CollectiveModule) StorageInfo() []primitives.StorageInfo {
+	return []primitives.StorageInfo{
+		pallet.StorageInfoProposals,
+		pallet.StorageInfoVoting,
+		pallet.StorageInfoProposalCount,
+		pallet.StorageInfoMembers,
+		pallet.StorageInfoPrime,
+	}
+}
@@ -0,0 +1,135 @@
+package collective
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/collective"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	RawOriginMembers sc.U8 = iota
+	RawOriginMember
+)
+
+// RawOrigin The origin of a call dispatched by the collective.
+type RawOrigin struct {
+	sc.VaryingData
+}
+
+func init() {
+	types.RegisterOriginCaller(collective.ModuleIndex, func(buffer *bytes.Buffer) sc.Encodable {
+		return DecodeRawOrigin(buffer)
+	})
+}
+
+// NewRawOriginMembers creates an origin representing `yes` out of `total` members of the collective.
+func NewRawOriginMembers(yes sc.U32, total sc.U32) RawOrigin {
+	return RawOrigin{sc.NewVaryingData(RawOriginMembers, yes, total)}
+}
+
+// NewRawOriginMember creates an origin representing a single member of the collective.
+func NewRawOriginMember(account types.AccountId) RawOrigin {
+	return RawOrigin{sc.NewVaryingData(RawOriginMember, account)}
+}
+
+func DecodeRawOrigin(buffer *bytes.Buffer) RawOrigin {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case RawOriginMembers:
+		return NewRawOriginMembers(sc.DecodeU32(buffer), sc.DecodeU32(buffer))
+	case RawOriginMember:
+		return NewRawOriginMember(types.DecodeAccountId(buffer))
+	default:
+		log.Critical("invalid collective RawOrigin type")
+	}
+
+	panic("unreachable")
+}
+
+// NewOriginMembers creates a runtime origin representing `yes` out of `total` members of the collective.
+func NewOriginMembers(yes sc.U32, total sc.U32) types.RuntimeOrigin {
+	return types.NewRuntimeOrigin(collective.ModuleIndex, NewRawOriginMembers(yes, total))
+}
+
+// NewOriginMember creates a runtime origin representing a single member of the collective.
+func NewOriginMember(account types.AccountId) types.RuntimeOrigin {
+	return types.NewRuntimeOrigin(collective.ModuleIndex, NewRawOriginMember(account))
+}
+
+// asRawOrigin returns the collective origin of `origin`, if the origin was dispatched by the collective.
+func asRawOrigin(origin types.RuntimeOrigin) (RawOrigin, bool) {
+	if origin.Module != collective.ModuleIndex {
+		return RawOrigin{}, false
+	}
+
+	raw, ok := origin.Caller.(RawOrigin)
+	return raw, ok
+}
+
+// asMembers returns the number of approving members and the total members of the collective.
+func asMembers(origin types.RuntimeOrigin) (sc.U32, sc.U32, bool) {
+	raw, ok := asRawOrigin(origin)
+	if !ok || raw.VaryingData[0] != RawOriginMembers {
+		return 0, 0, false
+	}
+
+	return raw.VaryingData[1].(sc.U32), raw.VaryingData[2].(sc.U32), true
+}
+
+// EnsureMember ensures that the origin is a single member of the collective and returns it.
+func EnsureMember(origin types.RuntimeOrigin) (types.AccountId, types.DispatchError) {
+	raw, ok := asRawOrigin(origin)
+	if !ok || raw.VaryingData[0] != RawOriginMember {
+		return types.AccountId{}, types.NewDispatchErrorBadOrigin()
+	}
+
+	return raw.VaryingData[1].(types.AccountId), nil
+}
+
+// EnsureMembers ensures that at least `N` members of the collective have approved the origin.
+type EnsureMembers struct {
+	N sc.U32
+}
+
+func (e EnsureMembers) EnsureOrigin(origin types.RuntimeOrigin) types.DispatchError {
+	yes, _, ok := asMembers(origin)
+	if !ok || yes < e.N {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return nil
+}
+
+// EnsureProportionAtLeast ensures that at least `N/D` of the members of the collective have approved the origin.
+type EnsureProportionAtLeast struct {
+	N sc.U32
+	D sc.U32
+}
+
+func (e EnsureProportionAtLeast) EnsureOrigin(origin types.RuntimeOrigin) types.DispatchError {
+	yes, total, ok := asMembers(origin)
+	if !ok || uint64(yes)*uint64(e.D) < uint64(e.N)*uint64(total) {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return nil
+}
+
+// EnsureProportionMoreThan ensures that more than `N/D` of the members of the collective have approved the origin.
+type EnsureProportionMoreThan struct {
+	N sc.U32
+	D sc.U32
+}
+
+func (e EnsureProportionMoreThan) EnsureOrigin(origin types.RuntimeOrigin) types.DispatchError {
+	yes, total, ok := asMembers(origin)
+	if !ok || uint64(yes)*uint64(e.D) <= uint64(e.N)*uint64(total) {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return nil
+}
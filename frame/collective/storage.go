@@ -0,0 +1,142 @@
+package collective
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetProposals returns the hashes of the active proposals.
+func StorageGetProposals() sc.Sequence[types.H256] {
+	return storage.GetDecode(keyProposals(), func(buffer *bytes.Buffer) sc.Sequence[types.H256] {
+		return sc.DecodeSequenceWith(buffer, types.DecodeH256)
+	})
+}
+
+func StorageSetProposals(proposals sc.Sequence[types.H256]) {
+	storage.Set(keyProposals(), proposals.Bytes())
+}
+
+// StorageGetProposalOf returns the actual proposal for a given hash.
+// It returns `None` if the proposal cannot be decoded, e.g. after a runtime upgrade changed its call.
+func StorageGetProposalOf(hash types.H256) sc.Option[types.Call] {
+	option := storage.Get(keyProposalOf(hash))
+	if !option.HasValue {
+		return sc.NewOption[types.Call](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	call, err := support.TryDecodeCall(buffer)
+	if err != nil {
+		return sc.NewOption[types.Call](nil)
+	}
+
+	return sc.NewOption[types.Call](call)
+}
+
+func StorageSetProposalOf(hash types.H256, proposal types.Call) {
+	storage.Set(keyProposalOf(hash), proposal.Bytes())
+}
+
+func StorageClearProposalOf(hash types.H256) {
+	storage.Clear(keyProposalOf(hash))
+}
+
+func StorageExistsProposalOf(hash types.H256) sc.Bool {
+	return storage.Exists(keyProposalOf(hash)) == 1
+}
+
+// StorageGetVoting returns the votes on a given proposal, if it is ongoing.
+func StorageGetVoting(hash types.H256) sc.Option[types.CollectiveVotes] {
+	option := storage.Get(keyVoting(hash))
+	if !option.HasValue {
+		return sc.NewOption[types.CollectiveVotes](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.CollectiveVotes](types.DecodeCollectiveVotes(buffer))
+}
+
+func StorageSetVoting(hash types.H256, votes types.CollectiveVotes) {
+	storage.Set(keyVoting(hash), votes.Bytes())
+}
+
+func StorageClearVoting(hash types.H256) {
+	storage.Clear(keyVoting(hash))
+}
+
+// StorageGetProposalCount returns the number of proposals that have been made.
+func StorageGetProposalCount() sc.U32 {
+	return storage.GetDecode(keyProposalCount(), sc.DecodeU32)
+}
+
+func StorageSetProposalCount(count sc.U32) {
+	storage.Set(keyProposalCount(), count.Bytes())
+}
+
+// StorageGetMembers returns the current members of the collective, sorted.
+func StorageGetMembers() sc.Sequence[types.AccountId] {
+	return storage.GetDecode(keyMembers(), func(buffer *bytes.Buffer) sc.Sequence[types.AccountId] {
+		return sc.DecodeSequenceWith(buffer, types.DecodeAccountId)
+	})
+}
+
+func StorageSetMembers(members sc.Sequence[types.AccountId]) {
+	storage.Set(keyMembers(), members.Bytes())
+}
+
+// StorageGetPrime returns the prime member that helps determine the default vote behavior
+// in case of abstentions.
+func StorageGetPrime() sc.Option[types.AccountId] {
+	option := storage.Get(keyPrime())
+	if !option.HasValue {
+		return sc.NewOption[types.AccountId](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.AccountId](types.DecodeAccountId(buffer))
+}
+
+func StorageSetPrime(prime types.AccountId) {
+	storage.Set(keyPrime(), prime.Bytes())
+}
+
+func StorageClearPrime() {
+	storage.Clear(keyPrime())
+}
+
+func keyProposals() []byte {
+	return append(hashing.Twox128(constants.KeyCouncil), hashing.Twox128(constants.KeyProposals)...)
+}
+
+// keyProposalOf returns the storage key of `ProposalOf`, which uses the identity hasher.
+func keyProposalOf(hash types.H256) []byte {
+	key := append(hashing.Twox128(constants.KeyCouncil), hashing.Twox128(constants.KeyProposalOf)...)
+	return append(key, hash.Bytes()...)
+}
+
+// keyVoting returns the storage key of `Voting`, which uses the identity hasher.
+func keyVoting(hash types.H256) []byte {
+	key := append(hashing.Twox128(constants.KeyCouncil), hashing.Twox128(constants.KeyVoting)...)
+	return append(key, hash.Bytes()...)
+}
+
+func keyProposalCount() []byte {
+	return append(hashing.Twox128(constants.KeyCouncil), hashing.Twox128(constants.KeyProposalCount)...)
+}
+
+func keyMembers() []byte {
+	return append(hashing.Twox128(constants.KeyCouncil), hashing.Twox128(constants.KeyMembers)...)
+}
+
+func keyPrime() []byte {
+	return append(hashing.Twox128(constants.KeyCouncil), hashing.Twox128(constants.KeyPrime)...)
+}
@@ -0,0 +1,27 @@
+package collective
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/collective"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// StorageInfoProposals describes the `Proposals` value, a BoundedVec<Hash, MaxProposals>.
+	StorageInfoProposals = types.NewStorageValueInfo("Council", "Proposals", 2+collective.MaxProposals*32)
+	// StorageInfoVoting describes the `Voting` map, whose entries are Identity(Hash) ++ Votes,
+	// with at most MaxMembers ayes and nays.
+	StorageInfoVoting = types.NewStorageMapInfo("Council", "Voting", sc.NewOption[sc.U32](nil), 32+4+4+2*(2+collective.MaxMembers*32)+4)
+	// StorageInfoProposalCount describes the `ProposalCount` value.
+	StorageInfoProposalCount = types.NewStorageValueInfo("Council", "ProposalCount", 4)
+	// StorageInfoMembers describes the `Members` value, a Vec<AccountId> of at most MaxMembers.
+	StorageInfoMembers = types.NewStorageValueInfo("Council", "Members", 2+collective.MaxMembers*32)
+	// StorageInfoPrime describes the `Prime` value.
+	StorageInfoPrime = types.NewStorageValueInfo("Council", "Prime", 32)
+)
+
+// StorageInfoProposalOf describes the `ProposalOf` map, whose entries are Identity(Hash) ++ Call.
+// The calls have no maximum encoded length, so the size covers a proposal of `lengthBound` bytes.
+func StorageInfoProposalOf(lengthBound sc.U32) types.StorageInfo {
+	return types.NewStorageMapInfo("Council", "ProposalOf", sc.NewOption[sc.U32](nil), 32+lengthBound)
+}
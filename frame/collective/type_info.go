@@ -0,0 +1,30 @@
+package collective
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// VotesTypeInfo is the type info of types.CollectiveVotes.
+	VotesTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_collective", "Votes"},
+		"Info for keeping track of a motion being voted on.",
+		types.NewFieldTypeInfo("index", "ProposalIndex", types.TypeInfoU32),
+		types.NewFieldTypeInfo("threshold", "MemberCount", types.TypeInfoU32),
+		types.NewFieldTypeInfo("ayes", "Vec<AccountId>", types.TypeId(metadata.TypesSequenceAddress32)),
+		types.NewFieldTypeInfo("nays", "Vec<AccountId>", types.TypeId(metadata.TypesSequenceAddress32)),
+		types.NewFieldTypeInfo("end", "BlockNumber", types.TypeInfoU32),
+	)
+	// RawOriginTypeInfo is the type info of RawOrigin.
+	RawOriginTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_collective", "RawOrigin"},
+		"The origin of a call dispatched by the collective.",
+		types.NewVariantTypeInfo("Members", RawOriginMembers, "RawOrigin.Members",
+			types.NewFieldTypeInfo("", "MemberCount", types.TypeInfoU32),
+			types.NewFieldTypeInfo("", "MemberCount", types.TypeInfoU32),
+		),
+		types.NewVariantTypeInfo("Member", RawOriginMember, "RawOrigin.Member",
+			types.NewFieldTypeInfo("", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		),
+	)
+)
@@ -1,54 +1,51 @@
 package core
 
 import (
-	"bytes"
-
+	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/execution/types"
 	"github.com/LimeChain/gosemble/frame/executive"
+	"github.com/LimeChain/gosemble/primitives/api"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
-	"github.com/LimeChain/gosemble/utils"
 )
 
-type Core interface {
-	Version(dataPtr int32, dataLen int32) int64
-	ExecuteBlock(dataPtr int32, dataLen int32)
-	InitializeBlock(dataPtr int32, dataLen int32)
-}
+// Api is the `Core` runtime API that every Substrate runtime needs to implement.
+var Api = api.New("Core", 4, "The `Core` runtime api that every Substrate runtime needs to implement.",
+	api.NewMethod("version",
+		primitives.TypeId(metadata.TypesRuntimeVersion),
+		"Returns the version of the runtime.",
+		Version),
+	api.NewMethod1("execute_block",
+		api.NewArg("block", primitives.TypeId(metadata.TypesBlock), types.DecodeBlock),
+		primitives.TypeId(metadata.TypesEmptyTuple),
+		"Execute the given block.",
+		ExecuteBlock),
+	api.NewMethod1("initialize_block",
+		api.NewArg("header", primitives.TypeId(metadata.TypesHeader), primitives.DecodeHeader),
+		primitives.TypeId(metadata.TypesEmptyTuple),
+		"Initialize a block with the given header.",
+		InitializeBlock),
+)
 
-// Version returns a pointer-size SCALE-encoded Runtime version.
+// Version returns the version of the runtime.
 // [Specification](https://spec.polkadot.network/#defn-rt-core-version)
-func Version() int64 {
-	buffer := &bytes.Buffer{}
-	constants.RuntimeVersion.Encode(buffer)
-
-	return utils.BytesToOffsetAndSize(buffer.Bytes())
+func Version() primitives.RuntimeVersion {
+	return constants.RuntimeVersion
 }
 
 // InitializeBlock starts the execution of a particular block.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded header of the block.
 // [Specification](https://spec.polkadot.network/#sect-rte-core-initialize-block)
-func InitializeBlock(dataPtr int32, dataLen int32) {
-	data := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(data)
-
-	header := primitives.DecodeHeader(buffer)
+func InitializeBlock(header primitives.Header) sc.Empty {
 	executive.InitializeBlock(header)
+
+	return sc.Empty{}
 }
 
 // ExecuteBlock executes the provided block.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded block.
 // [Specification](https://spec.polkadot.network/#sect-rte-core-execute-block)
-func ExecuteBlock(dataPtr int32, dataLen int32) {
-	data := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(data)
-
-	block := types.DecodeBlock(buffer)
+func ExecuteBlock(block types.Block) sc.Empty {
 	executive.ExecuteBlock(block)
+
+	return sc.Empty{}
 }
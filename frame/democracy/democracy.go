@@ -0,0 +1,489 @@
+package democracy
+
+import (
+	"math/big"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/democracy/errors"
+	"github.com/LimeChain/gosemble/frame/democracy/events"
+	"github.com/LimeChain/gosemble/frame/scheduler"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// enactmentPriority is the scheduler priority of the dispatch of an approved proposal.
+const enactmentPriority = sc.U8(63)
+
+// InjectReferendum starts a new referendum, which ends at block `end`.
+// Returns the index of the referendum.
+func InjectReferendum(end types.BlockNumber, proposal types.Bounded, threshold types.VoteThreshold, delay types.BlockNumber) sc.U32 {
+	index := StorageGetReferendumCount()
+	StorageSetReferendumCount(index + 1)
+
+	status := types.ReferendumStatus{
+		End:       end,
+		Proposal:  proposal,
+		Threshold: threshold,
+		Delay:     delay,
+		Tally:     types.NewTally(),
+	}
+	StorageSetReferendumInfoOf(index, types.NewReferendumInfoOngoing(status))
+
+	system.DepositEvent(events.NewEventStarted(index, threshold))
+
+	return index
+}
+
+// CancelReferendum removes an ongoing referendum.
+func CancelReferendum(index sc.U32) types.DispatchError {
+	_, err := referendumStatus(index)
+	if err != nil {
+		return err
+	}
+
+	StorageClearReferendumInfoOf(index)
+
+	system.DepositEvent(events.NewEventCancelled(index))
+
+	return nil
+}
+
+// Propose adds a public proposal, reserving `value` from `who`.
+func Propose(who types.AccountId, proposal types.Bounded, value *big.Int) types.DispatchError {
+	if value.Cmp(democracy.MinimumDeposit) < 0 {
+		return newDemocracyError(errors.ErrorValueLow)
+	}
+
+	proposals := StorageGetPublicProps()
+	if len(proposals) >= democracy.MaxProposals {
+		return newDemocracyError(errors.ErrorTooMany)
+	}
+
+	err := dispatchables.Reserve(who, value)
+	if err != nil {
+		return err
+	}
+
+	index := StorageGetPublicPropCount()
+	StorageSetPublicPropCount(index + 1)
+
+	deposit := sc.NewU128FromBigInt(value)
+	StorageSetDepositOf(index, types.ProposalDeposit{
+		Depositors: sc.Sequence[types.AccountId]{who},
+		Deposit:    deposit,
+	})
+	StorageSetPublicProps(append(proposals, types.PublicProposal{
+		Index:    index,
+		Proposal: proposal,
+		Proposer: who,
+	}))
+
+	system.DepositEvent(events.NewEventProposed(index, deposit))
+
+	return nil
+}
+
+// Second backs the public proposal with the given index, reserving the same deposit as the proposer.
+func Second(who types.AccountId, index sc.U32) types.DispatchError {
+	maybeDeposit := StorageGetDepositOf(index)
+	if !maybeDeposit.HasValue {
+		return newDemocracyError(errors.ErrorProposalMissing)
+	}
+	deposit := maybeDeposit.Value
+
+	if len(deposit.Depositors) >= democracy.MaxDeposits {
+		return newDemocracyError(errors.ErrorTooMany)
+	}
+
+	err := dispatchables.Reserve(who, deposit.Deposit.ToBigInt())
+	if err != nil {
+		return err
+	}
+
+	deposit.Depositors = append(deposit.Depositors, who)
+	StorageSetDepositOf(index, deposit)
+
+	system.DepositEvent(events.NewEventSeconded(who.FixedSequence, index))
+
+	return nil
+}
+
+// ExternalPropose sets the proposal to be tabled from the external queue.
+// Fails if there is already an external proposal waiting.
+func ExternalPropose(proposal types.Bounded, threshold types.VoteThreshold) types.DispatchError {
+	if StorageGetNextExternal().HasValue {
+		return newDemocracyError(errors.ErrorDuplicateProposal)
+	}
+
+	StorageSetNextExternal(types.ExternalProposal{
+		Proposal:  proposal,
+		Threshold: threshold,
+	})
+
+	return nil
+}
+
+// Vote records the vote of `who` in an ongoing referendum, replacing any previous vote,
+// and locks the voted balance.
+func Vote(who types.AccountId, index sc.U32, vote types.AccountVote) types.DispatchError {
+	status, err := referendumStatus(index)
+	if err != nil {
+		return err
+	}
+
+	free := system.StorageGetAccount(who.FixedSequence).Data.Free
+	if vote.TotalBalance().ToBigInt().Cmp(free.ToBigInt()) > 0 {
+		return newDemocracyError(errors.ErrorInsufficientFunds)
+	}
+
+	voting := StorageGetVotingOf(who)
+	if voting.IsDelegating {
+		return newDemocracyError(errors.ErrorAlreadyDelegating)
+	}
+
+	i, found := findVote(voting.Votes, index)
+	if found {
+		previous := voting.Votes[i].Vote
+		status.Tally.Remove(previous)
+		if !previous.IsSplit {
+			status.Tally.Reduce(previous.Vote.Aye, voting.Delegations)
+		}
+		voting.Votes[i].Vote = vote
+	} else {
+		if len(voting.Votes) >= democracy.MaxVotes {
+			return newDemocracyError(errors.ErrorMaxVotesReached)
+		}
+		votes := append(sc.Sequence[types.ReferendumVote]{}, voting.Votes[:i]...)
+		votes = append(votes, types.ReferendumVote{Index: index, Vote: vote})
+		voting.Votes = append(votes, voting.Votes[i:]...)
+	}
+
+	status.Tally.Add(vote)
+	if !vote.IsSplit {
+		status.Tally.Increase(vote.Vote.Aye, voting.Delegations)
+	}
+
+	StorageSetVotingOf(who, voting)
+	StorageSetReferendumInfoOf(index, types.NewReferendumInfoOngoing(status))
+
+	dispatchables.ExtendLock(lockId(), who, vote.TotalBalance(), types.ReasonsAll)
+
+	system.DepositEvent(events.NewEventVoted(who.FixedSequence, index, vote))
+
+	return nil
+}
+
+// RemoveVote removes the vote of `who` in a referendum. If the referendum is ongoing, the vote
+// is removed from its tally. If the vote was on the winning side of a finished referendum, its
+// balance stays locked for the conviction's lock period as a prior lock.
+func RemoveVote(who types.AccountId, index sc.U32) types.DispatchError {
+	voting := StorageGetVotingOf(who)
+	if voting.IsDelegating {
+		return nil
+	}
+
+	i, found := findVote(voting.Votes, index)
+	if !found {
+		return newDemocracyError(errors.ErrorNotVoter)
+	}
+	vote := voting.Votes[i].Vote
+
+	maybeInfo := StorageGetReferendumInfoOf(index)
+	if maybeInfo.HasValue {
+		info := maybeInfo.Value
+		if !info.IsFinished {
+			status := info.Ongoing
+			status.Tally.Remove(vote)
+			if !vote.IsSplit {
+				status.Tally.Reduce(vote.Vote.Aye, voting.Delegations)
+			}
+			StorageSetReferendumInfoOf(index, types.NewReferendumInfoOngoing(status))
+		} else if lockPeriods, balance, ok := vote.LockedIf(info.Approved); ok {
+			unlockAt := info.End.SaturatingAdd(democracy.VoteLockingPeriod * lockPeriods)
+			if system.StorageGetBlockNumber() < unlockAt {
+				voting.Prior.Accumulate(unlockAt, balance)
+			}
+		}
+	}
+
+	voting.Votes = append(voting.Votes[:i], voting.Votes[i+1:]...)
+	StorageSetVotingOf(who, voting)
+
+	return nil
+}
+
+// Delegate delegates the voting power of `balance` of `who` to `target` with `conviction`.
+// Returns the number of votes of `target` that have been updated.
+func Delegate(who types.AccountId, target types.AccountId, conviction types.Conviction, balance types.Balance) (sc.U32, types.DispatchError) {
+	if reflect.DeepEqual(who, target) {
+		return 0, newDemocracyError(errors.ErrorNonsense)
+	}
+
+	free := system.StorageGetAccount(who.FixedSequence).Data.Free
+	if balance.ToBigInt().Cmp(free.ToBigInt()) > 0 {
+		return 0, newDemocracyError(errors.ErrorInsufficientFunds)
+	}
+
+	voting := StorageGetVotingOf(who)
+	if voting.IsDelegating {
+		return 0, newDemocracyError(errors.ErrorAlreadyDelegating)
+	}
+	if len(voting.Votes) != 0 {
+		return 0, newDemocracyError(errors.ErrorVotesExist)
+	}
+
+	StorageSetVotingOf(who, types.NewDemocracyVotingDelegating(balance, target, conviction, voting.Delegations, voting.Prior))
+
+	votes := increaseUpstreamDelegation(target, types.ConvictionVotes(conviction, balance))
+
+	dispatchables.ExtendLock(lockId(), who, balance, types.ReasonsAll)
+
+	system.DepositEvent(events.NewEventDelegated(who.FixedSequence, target.FixedSequence))
+
+	return votes, nil
+}
+
+// Undelegate stops the delegation of `who`. The delegated balance stays locked for the
+// conviction's lock period as a prior lock.
+// Returns the number of votes of the former target that have been updated.
+func Undelegate(who types.AccountId) (sc.U32, types.DispatchError) {
+	voting := StorageGetVotingOf(who)
+	if !voting.IsDelegating {
+		return 0, newDemocracyError(errors.ErrorNotDelegating)
+	}
+
+	votes := reduceUpstreamDelegation(voting.Target, types.ConvictionVotes(voting.Conviction, voting.Balance))
+
+	unlockAt := system.StorageGetBlockNumber().SaturatingAdd(democracy.VoteLockingPeriod * types.ConvictionLockPeriods(voting.Conviction))
+	prior := voting.Prior
+	prior.Accumulate(unlockAt, voting.Balance)
+
+	StorageSetVotingOf(who, types.NewDemocracyVotingDirect(sc.Sequence[types.ReferendumVote]{}, voting.Delegations, prior))
+
+	system.DepositEvent(events.NewEventUndelegated(who.FixedSequence))
+
+	return votes, nil
+}
+
+// UpdateLock recalculates the balance lock of `who`, removing expired prior locks.
+func UpdateLock(who types.AccountId) {
+	voting := StorageGetVotingOf(who)
+	voting.Rejig(system.StorageGetBlockNumber())
+	StorageSetVotingOf(who, voting)
+
+	lockNeeded := voting.LockedBalance()
+	if lockNeeded.ToBigInt().Cmp(constants.Zero) == 0 {
+		dispatchables.RemoveLock(lockId(), who)
+	} else {
+		dispatchables.SetLock(lockId(), who, lockNeeded, types.ReasonsAll)
+	}
+}
+
+// launchNext tables the next referendum, alternating between the external and the public queue.
+func launchNext(now types.BlockNumber) types.DispatchError {
+	if StorageGetLastTabledWasExternal() {
+		StorageSetLastTabledWasExternal(false)
+		if err := launchPublic(now); err != nil {
+			return launchExternal(now)
+		}
+		return nil
+	}
+
+	if err := launchExternal(now); err != nil {
+		return launchPublic(now)
+	}
+	return nil
+}
+
+// launchExternal tables the waiting external proposal as a referendum.
+func launchExternal(now types.BlockNumber) types.DispatchError {
+	maybeNext := StorageGetNextExternal()
+	if !maybeNext.HasValue {
+		return newDemocracyError(errors.ErrorNoneWaiting)
+	}
+	next := maybeNext.Value
+
+	StorageClearNextExternal()
+	StorageSetLastTabledWasExternal(true)
+
+	system.DepositEvent(events.NewEventExternalTabled())
+
+	InjectReferendum(now.SaturatingAdd(democracy.VotingPeriod), next.Proposal, next.Threshold, democracy.EnactmentPeriod)
+
+	return nil
+}
+
+// launchPublic tables the public proposal with the most backing as a referendum
+// and returns the deposits to its backers.
+func launchPublic(now types.BlockNumber) types.DispatchError {
+	proposals := StorageGetPublicProps()
+	if len(proposals) == 0 {
+		return newDemocracyError(errors.ErrorNoneWaiting)
+	}
+
+	best := 0
+	bestBacking := big.NewInt(0)
+	for i, proposal := range proposals {
+		backing := big.NewInt(0)
+		if deposit := StorageGetDepositOf(proposal.Index); deposit.HasValue {
+			backing.Mul(deposit.Value.Deposit.ToBigInt(), big.NewInt(int64(len(deposit.Value.Depositors))))
+		}
+
+		// Ties are won by the latest proposal, as in Substrate's `max_by_key`.
+		if backing.Cmp(bestBacking) >= 0 {
+			best = i
+			bestBacking = backing
+		}
+	}
+
+	proposal := proposals[best]
+	StorageSetPublicProps(append(proposals[:best], proposals[best+1:]...))
+
+	maybeDeposit := StorageGetDepositOf(proposal.Index)
+	if maybeDeposit.HasValue {
+		deposit := maybeDeposit.Value
+		StorageClearDepositOf(proposal.Index)
+
+		for _, depositor := range deposit.Depositors {
+			dispatchables.Unreserve(depositor, deposit.Deposit.ToBigInt())
+		}
+
+		system.DepositEvent(events.NewEventTabled(proposal.Index, deposit.Deposit))
+
+		InjectReferendum(now.SaturatingAdd(democracy.VotingPeriod), proposal.Proposal, types.VoteThresholdSuperMajorityApprove, democracy.EnactmentPeriod)
+	}
+
+	return nil
+}
+
+// bakeReferendum closes a referendum whose voting period has ended.
+// Approved proposals are scheduled for enactment after the referendum's delay.
+func bakeReferendum(now types.BlockNumber, index sc.U32, status types.ReferendumStatus) sc.Bool {
+	totalIssuance := dispatchables.StorageGetTotalIssuance()
+
+	if !approved(status.Threshold, status.Tally, totalIssuance) {
+		system.DepositEvent(events.NewEventNotPassed(index))
+		return false
+	}
+
+	system.DepositEvent(events.NewEventPassed(index))
+
+	_, err := scheduler.ScheduleNamed(
+		enactmentTaskName(index),
+		now.SaturatingAdd(status.Delay),
+		sc.NewOption[types.SchedulePeriod](nil),
+		enactmentPriority,
+		types.NewRuntimeOriginRoot(),
+		status.Proposal,
+	)
+	if err != nil {
+		log.Warn("failed to schedule the enactment of an approved referendum")
+	}
+
+	return true
+}
+
+// increaseUpstreamDelegation adds `amount` to the delegations of `who` and to the tally
+// of every ongoing referendum `who` voted on.
+func increaseUpstreamDelegation(who types.AccountId, amount types.Delegations) sc.U32 {
+	voting := StorageGetVotingOf(who)
+	voting.Delegations = voting.Delegations.Add(amount)
+	StorageSetVotingOf(who, voting)
+
+	if voting.IsDelegating {
+		return 1
+	}
+
+	for _, vote := range voting.Votes {
+		if vote.Vote.IsSplit {
+			continue
+		}
+
+		maybeInfo := StorageGetReferendumInfoOf(vote.Index)
+		if maybeInfo.HasValue && !maybeInfo.Value.IsFinished {
+			status := maybeInfo.Value.Ongoing
+			status.Tally.Increase(vote.Vote.Vote.Aye, amount)
+			StorageSetReferendumInfoOf(vote.Index, types.NewReferendumInfoOngoing(status))
+		}
+	}
+
+	return sc.U32(len(voting.Votes))
+}
+
+// reduceUpstreamDelegation removes `amount` from the delegations of `who` and from the tally
+// of every ongoing referendum `who` voted on.
+func reduceUpstreamDelegation(who types.AccountId, amount types.Delegations) sc.U32 {
+	voting := StorageGetVotingOf(who)
+	voting.Delegations = voting.Delegations.SaturatingSub(amount)
+	StorageSetVotingOf(who, voting)
+
+	if voting.IsDelegating {
+		return 1
+	}
+
+	for _, vote := range voting.Votes {
+		if vote.Vote.IsSplit {
+			continue
+		}
+
+		maybeInfo := StorageGetReferendumInfoOf(vote.Index)
+		if maybeInfo.HasValue && !maybeInfo.Value.IsFinished {
+			status := maybeInfo.Value.Ongoing
+			status.Tally.Reduce(vote.Vote.Vote.Aye, amount)
+			StorageSetReferendumInfoOf(vote.Index, types.NewReferendumInfoOngoing(status))
+		}
+	}
+
+	return sc.U32(len(voting.Votes))
+}
+
+// referendumStatus returns the status of an ongoing referendum.
+func referendumStatus(index sc.U32) (types.ReferendumStatus, types.DispatchError) {
+	info := StorageGetReferendumInfoOf(index)
+	if !info.HasValue || info.Value.IsFinished {
+		return types.ReferendumStatus{}, newDemocracyError(errors.ErrorReferendumInvalid)
+	}
+
+	return info.Value.Ongoing, nil
+}
+
+// findVote returns the position of the vote on referendum `index` in the sorted `votes`,
+// or the position where it should be inserted.
+func findVote(votes sc.Sequence[types.ReferendumVote], index sc.U32) (int, bool) {
+	for i, vote := range votes {
+		if vote.Index == index {
+			return i, true
+		}
+		if vote.Index > index {
+			return i, false
+		}
+	}
+
+	return len(votes), false
+}
+
+// enactmentTaskName returns the name of the scheduler task which enacts referendum `index`.
+func enactmentTaskName(index sc.U32) types.TaskName {
+	data := append(democracy.LockId[:], sc.Str("enactment").Bytes()...)
+	data = append(data, index.Bytes()...)
+
+	return types.NewTaskName(sc.BytesToSequenceU8(hashing.Blake256(data))...)
+}
+
+func lockId() types.LockIdentifier {
+	return types.NewLockIdentifier(sc.BytesToSequenceU8(democracy.LockId[:])...)
+}
+
+func newDemocracyError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   democracy.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
@@ -0,0 +1,122 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	pallet "github.com/LimeChain/gosemble/frame/democracy"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CancelReferendumCall struct {
+	primitives.Callable
+}
+
+func NewCancelReferendumCall(args sc.VaryingData) CancelReferendumCall {
+	call := CancelReferendumCall{
+		Callable: primitives.Callable{
+			ModuleId:   democracy.ModuleIndex,
+			FunctionId: democracy.FunctionCancelReferendumIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c CancelReferendumCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c CancelReferendumCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c CancelReferendumCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c CancelReferendumCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c CancelReferendumCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ CancelReferendumCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "cancel_referendum",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("ref_index", "ReferendumIndex", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+		},
+		Docs: "Remove a referendum.",
+	}
+}
+
+func (c CancelReferendumCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ CancelReferendumCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 14_127 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoReferendumInfoOf)
+	return types.WeightFromParts(14_416_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ CancelReferendumCall) IsInherent() bool {
+	return false
+}
+
+func (_ CancelReferendumCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ CancelReferendumCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ CancelReferendumCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ CancelReferendumCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := cancelReferendum(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// cancelReferendum removes a referendum. Can only be called by root or two thirds of the council.
+func cancelReferendum(origin types.RuntimeOrigin, refIndex sc.U32) types.DispatchError {
+	err := pallet.CancellationOrigin.EnsureOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	return pallet.CancelReferendum(refIndex)
+}
@@ -0,0 +1,138 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	balances "github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	pallet "github.com/LimeChain/gosemble/frame/democracy"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type DelegateCall struct {
+	primitives.Callable
+}
+
+func NewDelegateCall(args sc.VaryingData) DelegateCall {
+	call := DelegateCall{
+		Callable: primitives.Callable{
+			ModuleId:   democracy.ModuleIndex,
+			FunctionId: democracy.FunctionDelegateIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c DelegateCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+		types.DecodeConviction(buffer),
+		sc.DecodeU128(buffer),
+	)
+	return c
+}
+
+func (c DelegateCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c DelegateCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c DelegateCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c DelegateCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ DelegateCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "delegate",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("to", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("conviction", "Conviction", pallet.ConvictionTypeInfo),
+			primitives.NewFieldTypeInfo("balance", "BalanceOf<T>", primitives.TypeInfoU128),
+		},
+		Docs: "Delegate the voting power (with some given conviction) of the sending account.",
+	}
+}
+
+func (c DelegateCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ DelegateCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 34_854 nanoseconds.
+	r := constants.DbWeight.Reads(4)
+	w := constants.DbWeight.Writes(4)
+	// The delegated votes are added to each of the referendums the delegate voted on.
+	e := types.ProofWeight(pallet.StorageInfoVotingOf, pallet.StorageInfoVotingOf, balances.StorageInfoLocks, system.StorageInfoAccount).
+		SaturatingAdd(types.ProofWeight(pallet.StorageInfoReferendumInfoOf).SaturatingMul(democracy.MaxVotes))
+	return types.WeightFromParts(35_566_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ DelegateCall) IsInherent() bool {
+	return false
+}
+
+func (_ DelegateCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ DelegateCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ DelegateCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ DelegateCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := delegate(origin, args[0].(types.MultiAddress), args[1].(types.Conviction), args[2].(types.Balance))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// delegate delegates the voting power of `balance` of the sender to `to`, with `conviction`.
+// The delegated balance is locked for as long as it is delegated, and thereafter for the
+// time appropriate for the conviction's lock period.
+func delegate(origin types.RuntimeOrigin, to types.MultiAddress, conviction types.Conviction, balance types.Balance) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	target, e := types.DefaultAccountIdLookup().Lookup(to)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	_, err := pallet.Delegate(origin.AsSigned(), target, conviction, balance)
+	return err
+}
@@ -0,0 +1,124 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/democracy"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ExternalProposeCall struct {
+	primitives.Callable
+}
+
+func NewExternalProposeCall(args sc.VaryingData) ExternalProposeCall {
+	call := ExternalProposeCall{
+		Callable: primitives.Callable{
+			ModuleId:   democracy.ModuleIndex,
+			FunctionId: democracy.FunctionExternalProposeIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ExternalProposeCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeBounded(buffer),
+	)
+	return c
+}
+
+func (c ExternalProposeCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ExternalProposeCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ExternalProposeCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ExternalProposeCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ExternalProposeCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "external_propose",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("proposal", "BoundedCallOf<T>", primitives.TypeId(metadata.TypesBounded)),
+		},
+		Docs: "Schedule a referendum to be tabled once it is legal to schedule an external referendum.",
+	}
+}
+
+func (c ExternalProposeCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ExternalProposeCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 14_827 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoNextExternal)
+	return types.WeightFromParts(15_130_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ExternalProposeCall) IsInherent() bool {
+	return false
+}
+
+func (_ ExternalProposeCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ExternalProposeCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ExternalProposeCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ExternalProposeCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := externalPropose(origin, args[0].(types.Bounded))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// externalPropose schedules a referendum to be tabled once it is legal to schedule an external
+// referendum. Must be called by at least half of the council.
+func externalPropose(origin types.RuntimeOrigin, proposal types.Bounded) types.DispatchError {
+	err := pallet.ExternalOrigin.EnsureOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	return pallet.ExternalPropose(proposal, types.VoteThresholdSuperMajorityApprove)
+}
@@ -0,0 +1,124 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/democracy"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ExternalProposeMajorityCall struct {
+	primitives.Callable
+}
+
+func NewExternalProposeMajorityCall(args sc.VaryingData) ExternalProposeMajorityCall {
+	call := ExternalProposeMajorityCall{
+		Callable: primitives.Callable{
+			ModuleId:   democracy.ModuleIndex,
+			FunctionId: democracy.FunctionExternalProposeMajorityIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ExternalProposeMajorityCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeBounded(buffer),
+	)
+	return c
+}
+
+func (c ExternalProposeMajorityCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ExternalProposeMajorityCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ExternalProposeMajorityCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ExternalProposeMajorityCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ExternalProposeMajorityCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "external_propose_majority",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("proposal", "BoundedCallOf<T>", primitives.TypeId(metadata.TypesBounded)),
+		},
+		Docs: "Schedule a majority-carries referendum to be tabled next once it is legal to schedule an external referendum.",
+	}
+}
+
+func (c ExternalProposeMajorityCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ExternalProposeMajorityCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 5_076 nanoseconds.
+	r := constants.DbWeight.Reads(0)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoNextExternal)
+	return types.WeightFromParts(5_180_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ExternalProposeMajorityCall) IsInherent() bool {
+	return false
+}
+
+func (_ ExternalProposeMajorityCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ExternalProposeMajorityCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ExternalProposeMajorityCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ExternalProposeMajorityCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := externalProposeMajority(origin, args[0].(types.Bounded))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// externalProposeMajority schedules a majority-carries referendum to be tabled next, once it is
+// legal to schedule an external referendum. Must be called by at least three quarters of the council.
+func externalProposeMajority(origin types.RuntimeOrigin, proposal types.Bounded) types.DispatchError {
+	err := pallet.ExternalMajorityOrigin.EnsureOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	return pallet.ExternalPropose(proposal, types.VoteThresholdSimpleMajority)
+}
@@ -0,0 +1,126 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/democracy"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ProposeCall struct {
+	primitives.Callable
+}
+
+func NewProposeCall(args sc.VaryingData) ProposeCall {
+	call := ProposeCall{
+		Callable: primitives.Callable{
+			ModuleId:   democracy.ModuleIndex,
+			FunctionId: democracy.FunctionProposeIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ProposeCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeBounded(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c ProposeCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ProposeCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ProposeCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ProposeCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ProposeCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "propose",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("proposal", "BoundedCallOf<T>", primitives.TypeId(metadata.TypesBounded)),
+			primitives.NewFieldTypeInfo("value", "BalanceOf<T>", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Propose a sensitive action to be taken.",
+	}
+}
+
+func (c ProposeCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ProposeCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 41_318 nanoseconds.
+	r := constants.DbWeight.Reads(3)
+	w := constants.DbWeight.Writes(3)
+	e := types.ProofWeight(pallet.StorageInfoPublicPropCount, pallet.StorageInfoPublicProps, system.StorageInfoAccount)
+	return types.WeightFromParts(42_162_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ProposeCall) IsInherent() bool {
+	return false
+}
+
+func (_ ProposeCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ProposeCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ProposeCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ProposeCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := propose(origin, args[0].(types.Bounded), sc.U128(args[1].(sc.Compact)))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// propose submits a public proposal, reserving `value` from the proposer.
+// The deposit must be at least the minimum deposit.
+func propose(origin types.RuntimeOrigin, proposal types.Bounded, value sc.U128) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.Propose(origin.AsSigned(), proposal, value.ToBigInt())
+}
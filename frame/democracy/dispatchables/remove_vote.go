@@ -0,0 +1,122 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	pallet "github.com/LimeChain/gosemble/frame/democracy"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type RemoveVoteCall struct {
+	primitives.Callable
+}
+
+func NewRemoveVoteCall(args sc.VaryingData) RemoveVoteCall {
+	call := RemoveVoteCall{
+		Callable: primitives.Callable{
+			ModuleId:   democracy.ModuleIndex,
+			FunctionId: democracy.FunctionRemoveVoteIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c RemoveVoteCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c RemoveVoteCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c RemoveVoteCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c RemoveVoteCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c RemoveVoteCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ RemoveVoteCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "remove_vote",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("index", "ReferendumIndex", primitives.TypeInfoU32),
+		},
+		Docs: "Remove a vote for a referendum.",
+	}
+}
+
+func (c RemoveVoteCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ RemoveVoteCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 32_501 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoVotingOf, pallet.StorageInfoReferendumInfoOf)
+	return types.WeightFromParts(33_165_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ RemoveVoteCall) IsInherent() bool {
+	return false
+}
+
+func (_ RemoveVoteCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ RemoveVoteCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ RemoveVoteCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ RemoveVoteCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := removeVote(origin, args[0].(sc.U32))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// removeVote removes the vote of the sender in a referendum. If the referendum has finished and
+// the vote was on the winning side, the voted balance stays locked for the conviction's lock period.
+func removeVote(origin types.RuntimeOrigin, index sc.U32) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.RemoveVote(origin.AsSigned(), index)
+}
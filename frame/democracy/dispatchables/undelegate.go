@@ -0,0 +1,120 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	pallet "github.com/LimeChain/gosemble/frame/democracy"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type UndelegateCall struct {
+	primitives.Callable
+}
+
+func NewUndelegateCall(args sc.VaryingData) UndelegateCall {
+	call := UndelegateCall{
+		Callable: primitives.Callable{
+			ModuleId:   democracy.ModuleIndex,
+			FunctionId: democracy.FunctionUndelegateIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c UndelegateCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData()
+	return c
+}
+
+func (c UndelegateCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c UndelegateCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c UndelegateCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c UndelegateCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ UndelegateCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "undelegate",
+		Docs: "Undelegate the voting power of the sending account.",
+	}
+}
+
+func (c UndelegateCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ UndelegateCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 19_431 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	// The delegated votes are removed from each of the referendums the delegate voted on.
+	e := types.ProofWeight(pallet.StorageInfoVotingOf, pallet.StorageInfoVotingOf).
+		SaturatingAdd(types.ProofWeight(pallet.StorageInfoReferendumInfoOf).SaturatingMul(democracy.MaxVotes))
+	return types.WeightFromParts(19_828_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ UndelegateCall) IsInherent() bool {
+	return false
+}
+
+func (_ UndelegateCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ UndelegateCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ UndelegateCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ UndelegateCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := undelegate(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// undelegate undelegates the voting power of the sender. The delegated balance stays locked
+// for the time appropriate for the conviction's lock period.
+func undelegate(origin types.RuntimeOrigin) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	_, err := pallet.Undelegate(origin.AsSigned())
+	return err
+}
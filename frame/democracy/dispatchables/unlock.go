@@ -0,0 +1,131 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	balances "github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	pallet "github.com/LimeChain/gosemble/frame/democracy"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type UnlockCall struct {
+	primitives.Callable
+}
+
+func NewUnlockCall(args sc.VaryingData) UnlockCall {
+	call := UnlockCall{
+		Callable: primitives.Callable{
+			ModuleId:   democracy.ModuleIndex,
+			FunctionId: democracy.FunctionUnlockIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c UnlockCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c UnlockCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c UnlockCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c UnlockCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c UnlockCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ UnlockCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "unlock",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("target", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Unlock tokens that have an expired lock.",
+	}
+}
+
+func (c UnlockCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ UnlockCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 26_902 nanoseconds.
+	r := constants.DbWeight.Reads(3)
+	w := constants.DbWeight.Writes(3)
+	e := types.ProofWeight(pallet.StorageInfoVotingOf, balances.StorageInfoLocks, system.StorageInfoAccount)
+	return types.WeightFromParts(27_452_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ UnlockCall) IsInherent() bool {
+	return false
+}
+
+func (_ UnlockCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ UnlockCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ UnlockCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ UnlockCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := unlock(origin, args[0].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// unlock removes the expired locks of the democracy module from the balance of `target`.
+func unlock(origin types.RuntimeOrigin, target types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	who, e := types.DefaultAccountIdLookup().Lookup(target)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	pallet.UpdateLock(who)
+
+	return nil
+}
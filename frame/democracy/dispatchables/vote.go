@@ -0,0 +1,126 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	balances "github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	pallet "github.com/LimeChain/gosemble/frame/democracy"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type VoteCall struct {
+	primitives.Callable
+}
+
+func NewVoteCall(args sc.VaryingData) VoteCall {
+	call := VoteCall{
+		Callable: primitives.Callable{
+			ModuleId:   democracy.ModuleIndex,
+			FunctionId: democracy.FunctionVoteIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c VoteCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeAccountVote(buffer),
+	)
+	return c
+}
+
+func (c VoteCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c VoteCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c VoteCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c VoteCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ VoteCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "vote",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("ref_index", "ReferendumIndex", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("vote", "AccountVote<BalanceOf<T>>", pallet.AccountVoteTypeInfo),
+		},
+		Docs: "Vote in a referendum. If `vote.is_aye()`, the vote is to enact the proposal; otherwise it is a vote to keep the status quo.",
+	}
+}
+
+func (c VoteCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ VoteCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 51_332 nanoseconds.
+	r := constants.DbWeight.Reads(3)
+	w := constants.DbWeight.Writes(3)
+	e := types.ProofWeight(pallet.StorageInfoReferendumInfoOf, pallet.StorageInfoVotingOf, balances.StorageInfoLocks, system.StorageInfoAccount)
+	return types.WeightFromParts(52_380_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ VoteCall) IsInherent() bool {
+	return false
+}
+
+func (_ VoteCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ VoteCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ VoteCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ VoteCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := vote(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(types.AccountVote))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// vote votes in a referendum. If `vote` is an aye, the vote is to enact the proposal,
+// otherwise it is a vote to keep the status quo. The voted balance is locked.
+func vote(origin types.RuntimeOrigin, refIndex sc.U32, vote types.AccountVote) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.Vote(origin.AsSigned(), refIndex, vote)
+}
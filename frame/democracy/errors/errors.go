@@ -0,0 +1,20 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Democracy module errors.
+const (
+	ErrorValueLow sc.U8 = iota
+	ErrorProposalMissing
+	ErrorDuplicateProposal
+	ErrorReferendumInvalid
+	ErrorNoneWaiting
+	ErrorNotVoter
+	ErrorAlreadyDelegating
+	ErrorInsufficientFunds
+	ErrorNotDelegating
+	ErrorVotesExist
+	ErrorNonsense
+	ErrorMaxVotesReached
+	ErrorTooMany
+)
@@ -0,0 +1,124 @@
+package events
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Democracy module events.
+const (
+	EventProposed sc.U8 = iota
+	EventTabled
+	EventExternalTabled
+	EventStarted
+	EventPassed
+	EventNotPassed
+	EventCancelled
+	EventDelegated
+	EventUndelegated
+	EventVoted
+	EventSeconded
+)
+
+func NewEventProposed(proposalIndex sc.U32, deposit types.Balance) types.Event {
+	return types.NewEvent(democracy.ModuleIndex, EventProposed, proposalIndex, deposit)
+}
+
+func NewEventTabled(proposalIndex sc.U32, deposit types.Balance) types.Event {
+	return types.NewEvent(democracy.ModuleIndex, EventTabled, proposalIndex, deposit)
+}
+
+func NewEventExternalTabled() types.Event {
+	return types.NewEvent(democracy.ModuleIndex, EventExternalTabled)
+}
+
+func NewEventStarted(refIndex sc.U32, threshold types.VoteThreshold) types.Event {
+	return types.NewEvent(democracy.ModuleIndex, EventStarted, refIndex, threshold)
+}
+
+func NewEventPassed(refIndex sc.U32) types.Event {
+	return types.NewEvent(democracy.ModuleIndex, EventPassed, refIndex)
+}
+
+func NewEventNotPassed(refIndex sc.U32) types.Event {
+	return types.NewEvent(democracy.ModuleIndex, EventNotPassed, refIndex)
+}
+
+func NewEventCancelled(refIndex sc.U32) types.Event {
+	return types.NewEvent(democracy.ModuleIndex, EventCancelled, refIndex)
+}
+
+func NewEventDelegated(who types.PublicKey, target types.PublicKey) types.Event {
+	return types.NewEvent(democracy.ModuleIndex, EventDelegated, who, target)
+}
+
+func NewEventUndelegated(account types.PublicKey) types.Event {
+	return types.NewEvent(democracy.ModuleIndex, EventUndelegated, account)
+}
+
+func NewEventVoted(voter types.PublicKey, refIndex sc.U32, vote types.AccountVote) types.Event {
+	return types.NewEvent(democracy.ModuleIndex, EventVoted, voter, refIndex, vote)
+}
+
+func NewEventSeconded(seconder types.PublicKey, proposalIndex sc.U32) types.Event {
+	return types.NewEvent(democracy.ModuleIndex, EventSeconded, seconder, proposalIndex)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	module := sc.DecodeU8(buffer)
+	if module != democracy.ModuleIndex {
+		log.Critical("invalid democracy.Event module")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventProposed:
+		proposalIndex := sc.DecodeU32(buffer)
+		deposit := sc.DecodeU128(buffer)
+		return NewEventProposed(proposalIndex, deposit)
+	case EventTabled:
+		proposalIndex := sc.DecodeU32(buffer)
+		deposit := sc.DecodeU128(buffer)
+		return NewEventTabled(proposalIndex, deposit)
+	case EventExternalTabled:
+		return NewEventExternalTabled()
+	case EventStarted:
+		refIndex := sc.DecodeU32(buffer)
+		threshold := types.DecodeVoteThreshold(buffer)
+		return NewEventStarted(refIndex, threshold)
+	case EventPassed:
+		refIndex := sc.DecodeU32(buffer)
+		return NewEventPassed(refIndex)
+	case EventNotPassed:
+		refIndex := sc.DecodeU32(buffer)
+		return NewEventNotPassed(refIndex)
+	case EventCancelled:
+		refIndex := sc.DecodeU32(buffer)
+		return NewEventCancelled(refIndex)
+	case EventDelegated:
+		who := types.DecodePublicKey(buffer)
+		target := types.DecodePublicKey(buffer)
+		return NewEventDelegated(who, target)
+	case EventUndelegated:
+		account := types.DecodePublicKey(buffer)
+		return NewEventUndelegated(account)
+	case EventVoted:
+		voter := types.DecodePublicKey(buffer)
+		refIndex := sc.DecodeU32(buffer)
+		vote := types.DecodeAccountVote(buffer)
+		return NewEventVoted(voter, refIndex, vote)
+	case EventSeconded:
+		seconder := types.DecodePublicKey(buffer)
+		proposalIndex := sc.DecodeU32(buffer)
+		return NewEventSeconded(seconder, proposalIndex)
+	default:
+		log.Critical("invalid democracy.Event type")
+	}
+
+	panic("unreachable")
+}
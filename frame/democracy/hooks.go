@@ -0,0 +1,48 @@
+package democracy
+
+import (
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OnInitialize tables the next referendum at the start of every launch period
+// and bakes the referenda whose voting period ends at block `n`.
+func OnInitialize(n types.BlockNumber) types.Weight {
+	weight := constants.DbWeight.Reads(0)
+
+	if n%democracy.LaunchPeriod == 0 {
+		launchNext(n)
+		weight = weight.SaturatingAdd(constants.DbWeight.ReadsWrites(5, 6))
+	}
+
+	lowest := StorageGetLowestUnbaked()
+	last := StorageGetReferendumCount()
+	for index := lowest; index < last; index++ {
+		weight = weight.SaturatingAdd(constants.DbWeight.Reads(1))
+
+		info := StorageGetReferendumInfoOf(index)
+		if !info.HasValue || info.Value.IsFinished || info.Value.Ongoing.End != n {
+			continue
+		}
+
+		approved := bakeReferendum(n, index, info.Value.Ongoing)
+		StorageSetReferendumInfoOf(index, types.NewReferendumInfoFinished(approved, n))
+		weight = weight.SaturatingAdd(constants.DbWeight.ReadsWrites(4, 4))
+	}
+
+	unbaked := lowest
+	for unbaked < last {
+		info := StorageGetReferendumInfoOf(unbaked)
+		if info.HasValue && !info.Value.IsFinished {
+			break
+		}
+		unbaked++
+	}
+	if unbaked != lowest {
+		StorageSetLowestUnbaked(unbaked)
+		weight = weight.SaturatingAdd(constants.DbWeight.Writes(1))
+	}
+
+	return weight
+}
@@ -0,0 +1,243 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/democracy"
+	"github.com/LimeChain/gosemble/frame/democracy/dispatchables"
+	"github.com/LimeChain/gosemble/frame/democracy/errors"
+	"github.com/LimeChain/gosemble/frame/democracy/events"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type DemocracyModule struct {
+	functions map[sc.U8]primitives.Call
+}
+
+func NewDemocracyModule() DemocracyModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[democracy.FunctionProposeIndex] = dispatchables.NewProposeCall(nil)
+	functions[democracy.FunctionSecondIndex] = dispatchables.NewSecondCall(nil)
+	functions[democracy.FunctionVoteIndex] = dispatchables.NewVoteCall(nil)
+	functions[democracy.FunctionExternalProposeIndex] = dispatchables.NewExternalProposeCall(nil)
+	functions[democracy.FunctionExternalProposeMajorityIndex] = dispatchables.NewExternalProposeMajorityCall(nil)
+	functions[democracy.FunctionCancelReferendumIndex] = dispatchables.NewCancelReferendumCall(nil)
+	functions[democracy.FunctionDelegateIndex] = dispatchables.NewDelegateCall(nil)
+	functions[democracy.FunctionUndelegateIndex] = dispatchables.NewUndelegateCall(nil)
+	functions[democracy.FunctionUnlockIndex] = dispatchables.NewUnlockCall(nil)
+	functions[democracy.FunctionRemoveVoteIndex] = dispatchables.NewRemoveVoteCall(nil)
+
+	return DemocracyModule{
+		functions: functions,
+	}
+}
+
+func (dm DemocracyModule) Functions() map[sc.U8]primitives.Call {
+	return dm.functions
+}
+
+func (dm DemocracyModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (dm DemocracyModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// OnInitialize launches the next referendum at the end of each launch period and bakes the referenda ending at the block.
+func (dm DemocracyModule) OnInitialize(n primitives.BlockNumber) primitives.Weight {
+	return pallet.OnInitialize(n)
+}
+
+func (dm DemocracyModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Stake-weighted public referenda."}
+}
+
+func (dm DemocracyModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "Democracy",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "Democracy",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"PublicPropCount",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+					"The number of (public) proposals that have been made so far."),
+				primitives.NewMetadataModuleStorageEntry(
+					"PublicProps",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(primitives.NewSequenceTypeInfo(primitives.NewTupleTypeInfo(primitives.TypeInfoU32, primitives.TypeId(metadata.TypesBounded), primitives.TypeId(metadata.TypesAddress32)))))),
+					"The public proposals. Unsorted. The second item is the proposal."),
+				primitives.NewMetadataModuleStorageEntry(
+					"DepositOf",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.PrimitiveTypesU32),
+						sc.ToCompact(registry.Register(primitives.NewTupleTypeInfo(primitives.TypeId(metadata.TypesSequenceAddress32), primitives.TypeInfoU128)))),
+					"Those who have locked a deposit."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ReferendumCount",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+					"The next free referendum index, aka the number of referenda started so far."),
+				primitives.NewMetadataModuleStorageEntry(
+					"LowestUnbaked",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+					"The lowest referendum index representing an unbaked referendum. Equal to `ReferendumCount` if there isn't a unbaked referendum."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ReferendumInfoOf",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.PrimitiveTypesU32),
+						sc.ToCompact(registry.Register(pallet.ReferendumInfoTypeInfo))),
+					"Information concerning any given referendum."),
+				primitives.NewMetadataModuleStorageEntry(
+					"VotingOf",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(registry.Register(pallet.VotingTypeInfo))),
+					"All votes for a particular voter. We store the balance for the number of votes that we have recorded. The second item is the total amount of delegations, that will be added."),
+				primitives.NewMetadataModuleStorageEntry(
+					"LastTabledWasExternal",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesBool)),
+					"True if the last referendum tabled was submitted externally. False if it was a public proposal."),
+				primitives.NewMetadataModuleStorageEntry(
+					"NextExternal",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(primitives.NewTupleTypeInfo(primitives.TypeId(metadata.TypesBounded), pallet.VoteThresholdTypeInfo)))),
+					"The referendum to be tabled whenever it would be valid to table an external proposal."),
+			},
+		}),
+		Call:  sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(dm.callsTypeInfo()))),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(eventTypeInfo()))),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"EnactmentPeriod",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(democracy.EnactmentPeriod.Bytes()),
+				"The period between a proposal being approved and enacted.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"LaunchPeriod",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(democracy.LaunchPeriod.Bytes()),
+				"How often (in blocks) new public referenda are launched.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"VotingPeriod",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(democracy.VotingPeriod.Bytes()),
+				"How often (in blocks) to check for new votes.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"VoteLockingPeriod",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(democracy.VoteLockingPeriod.Bytes()),
+				"The minimum period of vote locking.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MinimumDeposit",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(democracy.MinimumDeposit).Bytes()),
+				"The minimum amount to be used as a deposit for a public referendum proposal.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxVotes",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(democracy.MaxVotes).Bytes()),
+				"The maximum number of votes for an account.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxProposals",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(democracy.MaxProposals).Bytes()),
+				"The maximum number of public proposals that can exist at any time.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxDeposits",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(democracy.MaxDeposits).Bytes()),
+				"The maximum number of deposits a public proposal may have at any time.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(errorsTypeInfo()))),
+		Index: democracy.ModuleIndex,
+	}
+}
+
+func (dm DemocracyModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_democracy", "pallet", "Call"}, dm.functions)
+}
+
+func eventTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_democracy", "pallet", "Event"},
+		"The events of the module.",
+		primitives.NewVariantTypeInfo("Proposed", events.EventProposed, "A motion has been proposed by a public account.",
+			primitives.NewFieldTypeInfo("proposal_index", "PropIndex", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("deposit", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Tabled", events.EventTabled, "A public proposal has been tabled for referendum vote.",
+			primitives.NewFieldTypeInfo("proposal_index", "PropIndex", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("deposit", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("ExternalTabled", events.EventExternalTabled, "An external proposal has been tabled."),
+		primitives.NewVariantTypeInfo("Started", events.EventStarted, "A referendum has begun.",
+			primitives.NewFieldTypeInfo("ref_index", "ReferendumIndex", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("threshold", "VoteThreshold", pallet.VoteThresholdTypeInfo),
+		),
+		primitives.NewVariantTypeInfo("Passed", events.EventPassed, "A proposal has been approved by referendum.",
+			primitives.NewFieldTypeInfo("ref_index", "ReferendumIndex", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("NotPassed", events.EventNotPassed, "A proposal has been rejected by referendum.",
+			primitives.NewFieldTypeInfo("ref_index", "ReferendumIndex", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("Cancelled", events.EventCancelled, "A referendum has been cancelled.",
+			primitives.NewFieldTypeInfo("ref_index", "ReferendumIndex", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("Delegated", events.EventDelegated, "An account has delegated their vote to another account.",
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("target", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("Undelegated", events.EventUndelegated, "An account has cancelled a previous delegation operation.",
+			primitives.NewFieldTypeInfo("account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("Voted", events.EventVoted, "An account has voted in a referendum.",
+			primitives.NewFieldTypeInfo("voter", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("ref_index", "ReferendumIndex", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("vote", "AccountVote<BalanceOf<T>>", pallet.AccountVoteTypeInfo),
+		),
+		primitives.NewVariantTypeInfo("Seconded", events.EventSeconded, "An account has seconded a proposal.",
+			primitives.NewFieldTypeInfo("seconder", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("prop_index", "PropIndex", primitives.TypeInfoU32),
+		),
+	)
+}
+
+func errorsTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_democracy", "pallet", "Error"},
+		"The errors of the module.",
+		primitives.NewVariantTypeInfo("ValueLow", errors.ErrorValueLow, "Value too low"),
+		primitives.NewVariantTypeInfo("ProposalMissing", errors.ErrorProposalMissing, "Proposal does not exist"),
+		primitives.NewVariantTypeInfo("DuplicateProposal", errors.ErrorDuplicateProposal, "Proposal already made"),
+		primitives.NewVariantTypeInfo("ReferendumInvalid", errors.ErrorReferendumInvalid, "Vote given for invalid referendum"),
+		primitives.NewVariantTypeInfo("NoneWaiting", errors.ErrorNoneWaiting, "No proposals waiting"),
+		primitives.NewVariantTypeInfo("NotVoter", errors.ErrorNotVoter, "The given account did not vote on the referendum."),
+		primitives.NewVariantTypeInfo("AlreadyDelegating", errors.ErrorAlreadyDelegating, "The account is already delegating."),
+		primitives.NewVariantTypeInfo("InsufficientFunds", errors.ErrorInsufficientFunds, "Too high a balance was provided that the account cannot afford."),
+		primitives.NewVariantTypeInfo("NotDelegating", errors.ErrorNotDelegating, "The account is not currently delegating."),
+		primitives.NewVariantTypeInfo("VotesExist", errors.ErrorVotesExist, "The account currently has votes attached to it and the operation cannot succeed until these are removed, either through `unvote` or `reap_vote`."),
+		primitives.NewVariantTypeInfo("Nonsense", errors.ErrorNonsense, "Delegation to oneself makes no sense."),
+		primitives.NewVariantTypeInfo("MaxVotesReached", errors.ErrorMaxVotesReached, "Maximum number of votes reached."),
+		primitives.NewVariantTypeInfo("TooMany", errors.ErrorTooMany, "Maximum number of items reached."),
+	)
+}
@@ -0,0 +1,22 @@
+package module
+
+import (
+	pallet "github.com/LimeChain/gosemble/frame/democracy"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageInfo describes the storage items of the module whose values have a maximum encoded length.
+// The sizes of the map entries include their hashed keys.
+func (dm DemocracyModule) StorageInfo() []primitives.StorageInfo {
+	return []primitives.StorageInfo{
+		pallet.StorageInfoPublicPropCount,
+		pallet.StorageInfoPublicProps,
+		pallet.StorageInfoDepositOf,
+		pallet.StorageInfoReferendumCount,
+		pallet.StorageInfoLowestUnbaked,
+		pallet.StorageInfoReferendumInfoOf,
+		pallet.StorageInfoVotingOf,
+		pallet.StorageInfoLastTabledWasExternal,
+		pallet.StorageInfoNextExternal,
+	}
+}
@@ -0,0 +1,20 @@
+package democracy
+
+import (
+	"github.com/LimeChain/gosemble/frame/collective"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// ExternalOrigin is the origin which can schedule a referendum with a super-majority-approve threshold.
+	ExternalOrigin types.EnsureOrigin = collective.EnsureProportionAtLeast{N: 1, D: 2}
+	// ExternalMajorityOrigin is the origin which can schedule a referendum with a simple-majority threshold.
+	ExternalMajorityOrigin types.EnsureOrigin = collective.EnsureProportionAtLeast{N: 3, D: 4}
+	// CancellationOrigin is the origin which can cancel an ongoing referendum.
+	CancellationOrigin types.EnsureOrigin = support.EnsureOneOf{
+		Left:  system.EnsureRoot{},
+		Right: collective.EnsureProportionAtLeast{N: 2, D: 3},
+	}
+)
@@ -0,0 +1,178 @@
+package democracy
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetPublicPropCount returns the number of public proposals that have been made so far.
+func StorageGetPublicPropCount() sc.U32 {
+	return storage.GetDecode(keyPublicPropCount(), sc.DecodeU32)
+}
+
+func StorageSetPublicPropCount(count sc.U32) {
+	storage.Set(keyPublicPropCount(), count.Bytes())
+}
+
+// StorageGetPublicProps returns the public proposals waiting to be tabled.
+func StorageGetPublicProps() sc.Sequence[types.PublicProposal] {
+	return storage.GetDecode(keyPublicProps(), func(buffer *bytes.Buffer) sc.Sequence[types.PublicProposal] {
+		return sc.DecodeSequenceWith(buffer, types.DecodePublicProposal)
+	})
+}
+
+func StorageSetPublicProps(proposals sc.Sequence[types.PublicProposal]) {
+	storage.Set(keyPublicProps(), proposals.Bytes())
+}
+
+// StorageGetDepositOf returns the accounts backing a public proposal and the deposit each of them reserved.
+func StorageGetDepositOf(index sc.U32) sc.Option[types.ProposalDeposit] {
+	option := storage.Get(keyDepositOf(index))
+	if !option.HasValue {
+		return sc.NewOption[types.ProposalDeposit](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.ProposalDeposit](types.DecodeProposalDeposit(buffer))
+}
+
+func StorageSetDepositOf(index sc.U32, deposit types.ProposalDeposit) {
+	storage.Set(keyDepositOf(index), deposit.Bytes())
+}
+
+func StorageClearDepositOf(index sc.U32) {
+	storage.Clear(keyDepositOf(index))
+}
+
+// StorageGetReferendumCount returns the next free referendum index.
+func StorageGetReferendumCount() sc.U32 {
+	return storage.GetDecode(keyReferendumCount(), sc.DecodeU32)
+}
+
+func StorageSetReferendumCount(count sc.U32) {
+	storage.Set(keyReferendumCount(), count.Bytes())
+}
+
+// StorageGetLowestUnbaked returns the lowest referendum index that has not yet been baked.
+func StorageGetLowestUnbaked() sc.U32 {
+	return storage.GetDecode(keyLowestUnbaked(), sc.DecodeU32)
+}
+
+func StorageSetLowestUnbaked(index sc.U32) {
+	storage.Set(keyLowestUnbaked(), index.Bytes())
+}
+
+// StorageGetReferendumInfoOf returns the information about a referendum.
+func StorageGetReferendumInfoOf(index sc.U32) sc.Option[types.ReferendumInfo] {
+	option := storage.Get(keyReferendumInfoOf(index))
+	if !option.HasValue {
+		return sc.NewOption[types.ReferendumInfo](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.ReferendumInfo](types.DecodeReferendumInfo(buffer))
+}
+
+func StorageSetReferendumInfoOf(index sc.U32, info types.ReferendumInfo) {
+	storage.Set(keyReferendumInfoOf(index), info.Bytes())
+}
+
+func StorageClearReferendumInfoOf(index sc.U32) {
+	storage.Clear(keyReferendumInfoOf(index))
+}
+
+// StorageGetVotingOf returns all votes of an account, or its delegation.
+func StorageGetVotingOf(who types.AccountId) types.DemocracyVoting {
+	return storage.GetDecodeOnEmpty(keyVotingOf(who), types.DecodeDemocracyVoting, types.DefaultDemocracyVoting())
+}
+
+func StorageSetVotingOf(who types.AccountId, voting types.DemocracyVoting) {
+	storage.Set(keyVotingOf(who), voting.Bytes())
+}
+
+func StorageClearVotingOf(who types.AccountId) {
+	storage.Clear(keyVotingOf(who))
+}
+
+// StorageGetLastTabledWasExternal returns whether the last referendum tabled was submitted externally.
+func StorageGetLastTabledWasExternal() sc.Bool {
+	return storage.GetDecode(keyLastTabledWasExternal(), sc.DecodeBool)
+}
+
+func StorageSetLastTabledWasExternal(value sc.Bool) {
+	storage.Set(keyLastTabledWasExternal(), value.Bytes())
+}
+
+// StorageGetNextExternal returns the referendum to be tabled whenever it would be valid to table an external proposal.
+func StorageGetNextExternal() sc.Option[types.ExternalProposal] {
+	option := storage.Get(keyNextExternal())
+	if !option.HasValue {
+		return sc.NewOption[types.ExternalProposal](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.ExternalProposal](types.DecodeExternalProposal(buffer))
+}
+
+func StorageSetNextExternal(proposal types.ExternalProposal) {
+	storage.Set(keyNextExternal(), proposal.Bytes())
+}
+
+func StorageClearNextExternal() {
+	storage.Clear(keyNextExternal())
+}
+
+func keyPublicPropCount() []byte {
+	return append(hashing.Twox128(constants.KeyDemocracy), hashing.Twox128(constants.KeyPublicPropCount)...)
+}
+
+func keyPublicProps() []byte {
+	return append(hashing.Twox128(constants.KeyDemocracy), hashing.Twox128(constants.KeyPublicProps)...)
+}
+
+// keyDepositOf returns the storage key of `DepositOf`, which uses the twox64 concat hasher.
+func keyDepositOf(index sc.U32) []byte {
+	key := append(hashing.Twox128(constants.KeyDemocracy), hashing.Twox128(constants.KeyDepositOf)...)
+	key = append(key, hashing.Twox64(index.Bytes())...)
+	return append(key, index.Bytes()...)
+}
+
+func keyReferendumCount() []byte {
+	return append(hashing.Twox128(constants.KeyDemocracy), hashing.Twox128(constants.KeyReferendumCount)...)
+}
+
+func keyLowestUnbaked() []byte {
+	return append(hashing.Twox128(constants.KeyDemocracy), hashing.Twox128(constants.KeyLowestUnbaked)...)
+}
+
+// keyReferendumInfoOf returns the storage key of `ReferendumInfoOf`, which uses the twox64 concat hasher.
+func keyReferendumInfoOf(index sc.U32) []byte {
+	key := append(hashing.Twox128(constants.KeyDemocracy), hashing.Twox128(constants.KeyReferendumInfoOf)...)
+	key = append(key, hashing.Twox64(index.Bytes())...)
+	return append(key, index.Bytes()...)
+}
+
+// keyVotingOf returns the storage key of `VotingOf`, which uses the twox64 concat hasher.
+func keyVotingOf(who types.AccountId) []byte {
+	whoBytes := sc.FixedSequenceU8ToBytes(who.FixedSequence)
+
+	key := append(hashing.Twox128(constants.KeyDemocracy), hashing.Twox128(constants.KeyVotingOf)...)
+	key = append(key, hashing.Twox64(whoBytes)...)
+	return append(key, whoBytes...)
+}
+
+func keyLastTabledWasExternal() []byte {
+	return append(hashing.Twox128(constants.KeyDemocracy), hashing.Twox128(constants.KeyLastTabledWasExternal)...)
+}
+
+func keyNextExternal() []byte {
+	return append(hashing.Twox128(constants.KeyDemocracy), hashing.Twox128(constants.KeyNextExternal)...)
+}
@@ -0,0 +1,32 @@
+package democracy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// StorageInfoPublicPropCount describes the `PublicPropCount` value.
+	StorageInfoPublicPropCount = types.NewStorageValueInfo("Democracy", "PublicPropCount", 4)
+	// StorageInfoPublicProps describes the `PublicProps` value, a
+	// BoundedVec<(PropIndex, Bounded, AccountId), MaxProposals>.
+	StorageInfoPublicProps = types.NewStorageValueInfo("Democracy", "PublicProps", 2+democracy.MaxProposals*(4+types.MaxBoundedEncodedLen+32))
+	// StorageInfoDepositOf describes the `DepositOf` map, whose entries are
+	// Twox64Concat(PropIndex) ++ (BoundedVec<AccountId, MaxDeposits>, Balance).
+	StorageInfoDepositOf = types.NewStorageMapInfo("Democracy", "DepositOf", sc.NewOption[sc.U32](nil), 12+2+democracy.MaxDeposits*32+16)
+	// StorageInfoReferendumCount describes the `ReferendumCount` value.
+	StorageInfoReferendumCount = types.NewStorageValueInfo("Democracy", "ReferendumCount", 4)
+	// StorageInfoLowestUnbaked describes the `LowestUnbaked` value.
+	StorageInfoLowestUnbaked = types.NewStorageValueInfo("Democracy", "LowestUnbaked", 4)
+	// StorageInfoReferendumInfoOf describes the `ReferendumInfoOf` map, whose entries are
+	// Twox64Concat(ReferendumIndex) ++ ReferendumInfo.
+	StorageInfoReferendumInfoOf = types.NewStorageMapInfo("Democracy", "ReferendumInfoOf", sc.NewOption[sc.U32](nil), 12+1+4+types.MaxBoundedEncodedLen+1+4+48)
+	// StorageInfoVotingOf describes the `VotingOf` map, whose entries are Twox64Concat(AccountId) ++ Voting,
+	// with at most MaxVotes split votes.
+	StorageInfoVotingOf = types.NewStorageMapInfo("Democracy", "VotingOf", sc.NewOption[sc.U32](nil), 40+1+2+democracy.MaxVotes*(4+33)+32+20)
+	// StorageInfoLastTabledWasExternal describes the `LastTabledWasExternal` value.
+	StorageInfoLastTabledWasExternal = types.NewStorageValueInfo("Democracy", "LastTabledWasExternal", 1)
+	// StorageInfoNextExternal describes the `NextExternal` value, a (Bounded, VoteThreshold).
+	StorageInfoNextExternal = types.NewStorageValueInfo("Democracy", "NextExternal", types.MaxBoundedEncodedLen+1)
+)
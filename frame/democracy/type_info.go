@@ -0,0 +1,100 @@
+package democracy
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// VoteThresholdTypeInfo is the type info of types.VoteThreshold.
+	VoteThresholdTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_democracy", "vote_threshold", "VoteThreshold"},
+		"A means of determining if a vote is past pass threshold.",
+		types.NewVariantTypeInfo("SuperMajorityApprove", types.VoteThresholdSuperMajorityApprove, "VoteThreshold.SuperMajorityApprove"),
+		types.NewVariantTypeInfo("SuperMajorityAgainst", types.VoteThresholdSuperMajorityAgainst, "VoteThreshold.SuperMajorityAgainst"),
+		types.NewVariantTypeInfo("SimpleMajority", types.VoteThresholdSimpleMajority, "VoteThreshold.SimpleMajority"),
+	)
+	// ConvictionTypeInfo is the type info of types.Conviction.
+	ConvictionTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_democracy", "conviction", "Conviction"},
+		"A value denoting the strength of conviction of a vote.",
+		types.NewVariantTypeInfo("None", types.ConvictionNone, "Conviction.None"),
+		types.NewVariantTypeInfo("Locked1x", types.ConvictionLocked1x, "Conviction.Locked1x"),
+		types.NewVariantTypeInfo("Locked2x", types.ConvictionLocked2x, "Conviction.Locked2x"),
+		types.NewVariantTypeInfo("Locked3x", types.ConvictionLocked3x, "Conviction.Locked3x"),
+		types.NewVariantTypeInfo("Locked4x", types.ConvictionLocked4x, "Conviction.Locked4x"),
+		types.NewVariantTypeInfo("Locked5x", types.ConvictionLocked5x, "Conviction.Locked5x"),
+		types.NewVariantTypeInfo("Locked6x", types.ConvictionLocked6x, "Conviction.Locked6x"),
+	)
+	// VoteTypeInfo is the type info of types.Vote.
+	VoteTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_democracy", "vote", "Vote"},
+		"A number of lock periods, plus a vote, one way or the other.",
+		types.NewFieldTypeInfo("", "", types.TypeInfoU8),
+	)
+	// AccountVoteTypeInfo is the type info of types.AccountVote.
+	AccountVoteTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_democracy", "vote", "AccountVote"},
+		"A vote for a referendum of a particular account.",
+		types.NewVariantTypeInfo("Standard", types.AccountVoteStandard, "AccountVote.Standard",
+			types.NewFieldTypeInfo("vote", "Vote", VoteTypeInfo),
+			types.NewFieldTypeInfo("balance", "Balance", types.TypeInfoU128),
+		),
+		types.NewVariantTypeInfo("Split", types.AccountVoteSplit, "AccountVote.Split",
+			types.NewFieldTypeInfo("aye", "Balance", types.TypeInfoU128),
+			types.NewFieldTypeInfo("nay", "Balance", types.TypeInfoU128),
+		),
+	)
+	// TallyTypeInfo is the type info of types.Tally.
+	TallyTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_democracy", "types", "Tally"},
+		"Info regarding an ongoing referendum.",
+		types.NewFieldTypeInfo("ayes", "Balance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("nays", "Balance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("turnout", "Balance", types.TypeInfoU128),
+	)
+	// DelegationsTypeInfo is the type info of types.Delegations.
+	DelegationsTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_democracy", "types", "Delegations"},
+		"Amount of votes and capital placed in delegation for an account.",
+		types.NewFieldTypeInfo("votes", "Balance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("capital", "Balance", types.TypeInfoU128),
+	)
+	// PriorLockTypeInfo is the type info of types.PriorLock.
+	PriorLockTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_democracy", "vote", "PriorLock"},
+		"A \"prior\" lock, i.e. a lock for some now-forgotten reason.",
+		types.NewFieldTypeInfo("", "BlockNumber", types.TypeInfoU32),
+		types.NewFieldTypeInfo("", "Balance", types.TypeInfoU128),
+	)
+	// VotingTypeInfo is the type info of types.DemocracyVoting.
+	VotingTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_democracy", "vote", "Voting"},
+		"An indicator for what an account is doing; it can either be delegating or voting.",
+		types.NewVariantTypeInfo("Direct", types.DemocracyVotingDirect, "Voting.Direct",
+			types.NewFieldTypeInfo("votes", "BoundedVec<(ReferendumIndex, AccountVote<Balance>), MaxVotes>", types.NewSequenceTypeInfo(types.NewTupleTypeInfo(types.TypeInfoU32, AccountVoteTypeInfo))),
+			types.NewFieldTypeInfo("delegations", "Delegations<Balance>", DelegationsTypeInfo),
+			types.NewFieldTypeInfo("prior", "PriorLock<BlockNumber, Balance>", PriorLockTypeInfo),
+		),
+		types.NewVariantTypeInfo("Delegating", types.DemocracyVotingDelegating, "Voting.Delegating",
+			types.NewFieldTypeInfo("balance", "Balance", types.TypeInfoU128),
+			types.NewFieldTypeInfo("target", "AccountId", types.TypeId(metadata.TypesAddress32)),
+			types.NewFieldTypeInfo("conviction", "Conviction", ConvictionTypeInfo),
+			types.NewFieldTypeInfo("delegations", "Delegations<Balance>", DelegationsTypeInfo),
+			types.NewFieldTypeInfo("prior", "PriorLock<BlockNumber, Balance>", PriorLockTypeInfo),
+		),
+	)
+	// ReferendumStatusTypeInfo is the type info of types.ReferendumStatus.
+	ReferendumStatusTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_democracy", "types", "ReferendumStatus"},
+		"Info regarding an ongoing referendum.",
+		types.NewFieldTypeInfo("end", "BlockNumber", types.TypeInfoU32),
+		types.NewFieldTypeInfo("proposal", "Proposal", types.TypeId(metadata.TypesBounded)),
+		types.NewFieldTypeInfo("threshold", "VoteThreshold", VoteThresholdTypeInfo),
+		types.NewFieldTypeInfo("delay", "BlockNumber", types.TypeInfoU32),
+		types.NewFieldTypeInfo("tally", "Tally<Balance>", TallyTypeInfo),
+	)
+	// ReferendumInfoTypeInfo is the type info of types.ReferendumInfo.
+	ReferendumInfoTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_democracy", "types", "ReferendumInfo"},
+		"Info regarding a referendum, present or past.",
+		types.NewVariantTypeInfo("Ongoing", types.ReferendumInfoOngoing, "ReferendumInfo.Ongoing",
+			types.NewFieldTypeInfo("", "ReferendumStatus<BlockNumber, Proposal, Balance>", ReferendumStatusTypeInfo),
+		),
+		types.NewVariantTypeInfo("Finished", types.ReferendumInfoFinished, "ReferendumInfo.Finished",
+			types.NewFieldTypeInfo("approved", "bool", types.TypeInfoBool),
+			types.NewFieldTypeInfo("end", "BlockNumber", types.TypeInfoU32),
+		),
+	)
+)
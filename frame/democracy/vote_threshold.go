@@ -0,0 +1,36 @@
+package democracy
+
+import (
+	"math/big"
+
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// approved returns whether a referendum with the given `tally` passes its `threshold`,
+// given the total issuance as `electorate`. Super-majority thresholds are biased by the
+// turnout, following adaptive quorum biasing.
+func approved(threshold types.VoteThreshold, tally types.Tally, electorate types.Balance) bool {
+	sqrtVoters := new(big.Int).Sqrt(tally.Turnout.ToBigInt())
+	sqrtElectorate := new(big.Int).Sqrt(electorate.ToBigInt())
+
+	if sqrtVoters.Sign() == 0 {
+		return false
+	}
+
+	ayes := tally.Ayes.ToBigInt()
+	nays := tally.Nays.ToBigInt()
+
+	switch threshold {
+	case types.VoteThresholdSuperMajorityApprove:
+		return lessThan(nays, sqrtVoters, ayes, sqrtElectorate)
+	case types.VoteThresholdSuperMajorityAgainst:
+		return lessThan(nays, sqrtElectorate, ayes, sqrtVoters)
+	default:
+		return ayes.Cmp(nays) > 0
+	}
+}
+
+// lessThan returns whether `n1/d1 < n2/d2`.
+func lessThan(n1, d1, n2, d2 *big.Int) bool {
+	return new(big.Int).Mul(n1, d2).Cmp(new(big.Int).Mul(n2, d1)) < 0
+}
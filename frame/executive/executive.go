@@ -5,11 +5,11 @@ import (
 	"reflect"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/execution/extrinsic"
 	"github.com/LimeChain/gosemble/execution/inherent"
 	"github.com/LimeChain/gosemble/execution/types"
-	"github.com/LimeChain/gosemble/frame/aura"
 	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/primitives/crypto"
 	"github.com/LimeChain/gosemble/primitives/hashing"
@@ -26,13 +26,12 @@ func InitializeBlock(header primitives.Header) {
 
 	weight := primitives.WeightZero()
 	if runtimeUpgrade() {
-		weight = weight.SaturatingAdd(executeOnRuntimeUpgrade())
+		weight = weight.SaturatingAdd(ExecuteOnRuntimeUpgrade())
 	}
 
 	system.Initialize(header.Number, header.ParentHash, extractPreRuntimeDigest(header.Digest))
 
-	// TODO: accumulate the weight from all pallets that have on_initialize
-	weight = weight.SaturatingAdd(aura.OnInitialize())
+	weight = weight.SaturatingAdd(onInitialize(header.Number))
 	weight = weight.SaturatingAdd(system.DefaultBlockWeights().BaseBlock)
 	// use in case of dynamic weight calculation
 	system.RegisterExtraWeightUnchecked(weight, primitives.NewDispatchClassMandatory())
@@ -41,6 +40,17 @@ func InitializeBlock(header primitives.Header) {
 }
 
 func ExecuteBlock(block types.Block) {
+	executeBlock(block, true)
+}
+
+// TryExecuteBlock executes the given block like ExecuteBlock, checking its state root only if
+// `stateRootCheck` is true, so that blocks can be re-executed against a state snapshot whose
+// root differs. Returns the weight consumed by the block.
+func TryExecuteBlock(block types.Block, stateRootCheck bool) primitives.Weight {
+	return executeBlock(block, stateRootCheck)
+}
+
+func executeBlock(block types.Block, stateRootCheck bool) primitives.Weight {
 	log.Trace(fmt.Sprintf("execute_block %v", block.Header.Number))
 
 	InitializeBlock(block.Header)
@@ -53,7 +63,11 @@ func ExecuteBlock(block types.Block) {
 		log.Critical("Signature verification failed")
 	}
 
-	finalChecks(&block.Header)
+	weight := system.StorageGetBlockWeight().Total()
+
+	finalChecks(&block.Header, stateRootCheck)
+
+	return weight
 }
 
 // ApplyExtrinsic applies extrinsic outside the block execution function.
@@ -204,7 +218,7 @@ func extractPreRuntimeDigest(digest primitives.Digest) primitives.Digest {
 	return result
 }
 
-func finalChecks(header *primitives.Header) {
+func finalChecks(header *primitives.Header, stateRootCheck bool) {
 	newHeader := system.Finalize()
 
 	if len(header.Digest) != len(newHeader.Digest) {
@@ -218,7 +232,7 @@ func finalChecks(header *primitives.Header) {
 		}
 	}
 
-	if !reflect.DeepEqual(header.StateRoot, newHeader.StateRoot) {
+	if stateRootCheck && !reflect.DeepEqual(header.StateRoot, newHeader.StateRoot) {
 		log.Critical("Storage root must match that calculated")
 	}
 
@@ -227,9 +241,14 @@ func finalChecks(header *primitives.Header) {
 	}
 }
 
-// Execute all `OnRuntimeUpgrade` of this runtime, and return the aggregate weight.
-func executeOnRuntimeUpgrade() primitives.Weight {
-	// TODO: ex: balances
-	// call on_runtime_upgrade hook for all modules that implement it
-	return onRuntimeUpgrade()
+// ExecuteOnRuntimeUpgrade executes all `OnRuntimeUpgrade` of this runtime, and returns the aggregate weight.
+// The migrations are executed in the order in which they are declared in config.Migrations.
+func ExecuteOnRuntimeUpgrade() primitives.Weight {
+	weight := onRuntimeUpgrade()
+
+	for _, migration := range config.Migrations {
+		weight = weight.SaturatingAdd(migration.OnRuntimeUpgrade())
+	}
+
+	return weight
 }
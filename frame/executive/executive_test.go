@@ -0,0 +1,135 @@
+package executive
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/support"
+	sm "github.com/LimeChain/gosemble/frame/system/module"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var keyLastRuntimeUpgrade = append(hashing.Twox128(constants.KeySystem), hashing.Twox128(constants.KeyLastRuntimeUpgrade)...)
+
+// testMigration appends its id to `executed` when it is executed.
+type testMigration struct {
+	id       int
+	executed *[]int
+}
+
+func (tm testMigration) OnRuntimeUpgrade() primitives.Weight {
+	*tm.executed = append(*tm.executed, tm.id)
+	return primitives.WeightFromParts(sc.U64(tm.id), 0)
+}
+
+func (tm testMigration) PreUpgrade() ([]byte, error) {
+	return nil, nil
+}
+
+func (tm testMigration) PostUpgrade(_ []byte) error {
+	return nil
+}
+
+// testHookModule appends its index to `executed` when one of its hooks is run.
+type testHookModule struct {
+	primitives.Module
+	index    sc.U8
+	executed *[]sc.U8
+}
+
+func (tm testHookModule) OnInitialize(n primitives.BlockNumber) primitives.Weight {
+	*tm.executed = append(*tm.executed, tm.index)
+	return primitives.WeightFromParts(sc.U64(n), sc.U64(tm.index))
+}
+
+func (tm testHookModule) OnFinalize(_ primitives.BlockNumber) {
+	*tm.executed = append(*tm.executed, tm.index)
+}
+
+func Test_onInitialize(t *testing.T) {
+	modules := config.Modules
+	defer func() { config.Modules = modules }()
+
+	var executed []sc.U8
+	config.Modules = map[sc.U8]primitives.Module{
+		3: testHookModule{index: 3, executed: &executed},
+		1: testHookModule{index: 1, executed: &executed},
+		2: sm.NewSystemModule(),
+	}
+
+	weight := onInitialize(10)
+
+	assert.Equal(t, []sc.U8{1, 3}, executed)
+	assert.Equal(t, primitives.WeightFromParts(20, 4), weight)
+}
+
+func Test_onFinalize(t *testing.T) {
+	modules := config.Modules
+	defer func() { config.Modules = modules }()
+
+	var executed []sc.U8
+	config.Modules = map[sc.U8]primitives.Module{
+		3: testHookModule{index: 3, executed: &executed},
+		1: testHookModule{index: 1, executed: &executed},
+		2: sm.NewSystemModule(),
+	}
+
+	onFinalize(10)
+
+	assert.Equal(t, []sc.U8{1, 3}, executed)
+}
+
+func Test_ExecuteOnRuntimeUpgrade_Migrations(t *testing.T) {
+	migrations := config.Migrations
+	defer func() { config.Migrations = migrations }()
+
+	var executed []int
+	config.Migrations = []support.Migration{
+		testMigration{id: 1000, executed: &executed},
+		testMigration{id: 20, executed: &executed},
+	}
+
+	weight := ExecuteOnRuntimeUpgrade()
+
+	assert.Equal(t, []int{1000, 20}, executed)
+	assert.Equal(t, onRuntimeUpgrade().SaturatingAdd(primitives.WeightFromParts(1020, 0)), weight)
+}
+
+func Test_runtimeUpgrade(t *testing.T) {
+	storage.Clear(keyLastRuntimeUpgrade)
+
+	assert.Equal(t, sc.Bool(true), runtimeUpgrade())
+
+	lrui := primitives.LastRuntimeUpgradeInfo{
+		SpecVersion: sc.ToCompact(constants.RuntimeVersion.SpecVersion),
+		SpecName:    constants.RuntimeVersion.SpecName,
+	}
+	assert.Equal(t, lrui.Bytes(), storage.TakeBytes(keyLastRuntimeUpgrade))
+}
+
+func Test_runtimeUpgrade_SameVersion(t *testing.T) {
+	lrui := primitives.LastRuntimeUpgradeInfo{
+		SpecVersion: sc.ToCompact(constants.RuntimeVersion.SpecVersion),
+		SpecName:    constants.RuntimeVersion.SpecName,
+	}
+	storage.Set(keyLastRuntimeUpgrade, lrui.Bytes())
+	defer storage.Clear(keyLastRuntimeUpgrade)
+
+	assert.Equal(t, sc.Bool(false), runtimeUpgrade())
+}
+
+func Test_runtimeUpgrade_LowerVersion(t *testing.T) {
+	lrui := primitives.LastRuntimeUpgradeInfo{
+		SpecVersion: sc.ToCompact(constants.RuntimeVersion.SpecVersion - 1),
+		SpecName:    constants.RuntimeVersion.SpecName,
+	}
+	storage.Set(keyLastRuntimeUpgrade, lrui.Bytes())
+	defer storage.Clear(keyLastRuntimeUpgrade)
+
+	assert.Equal(t, sc.Bool(true), runtimeUpgrade())
+}
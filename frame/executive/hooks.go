@@ -3,8 +3,8 @@ package executive
 import (
 	"fmt"
 
+	"github.com/LimeChain/gosemble/config"
 	"github.com/LimeChain/gosemble/frame/system"
-	"github.com/LimeChain/gosemble/frame/timestamp"
 	"github.com/LimeChain/gosemble/primitives/log"
 	"github.com/LimeChain/gosemble/primitives/types"
 )
@@ -21,8 +21,29 @@ func IdleAndFinalizeHook(blockNumber types.BlockNumber) {
 		system.RegisterExtraWeightUnchecked(usedWeight, types.NewDispatchClassMandatory())
 	}
 
-	// Each pallet (babe, grandpa) has its own on_finalize that has to be implemented once it is supported
-	timestamp.OnFinalize()
+	onFinalize(blockNumber)
+}
+
+// onInitialize runs the OnInitialize hooks of the modules in the order of their indices and
+// returns the weight they consumed.
+func onInitialize(n types.BlockNumber) types.Weight {
+	weight := types.WeightZero()
+	for _, index := range config.ModuleIndices() {
+		if module, ok := config.Modules[index].(types.OnInitializeModule); ok {
+			weight = weight.SaturatingAdd(module.OnInitialize(n))
+		}
+	}
+
+	return weight
+}
+
+// onFinalize runs the OnFinalize hooks of the modules in the order of their indices.
+func onFinalize(n types.BlockNumber) {
+	for _, index := range config.ModuleIndices() {
+		if module, ok := config.Modules[index].(types.OnFinalizeModule); ok {
+			module.OnFinalize(n)
+		}
+	}
 }
 
 func onRuntimeUpgrade() types.Weight {
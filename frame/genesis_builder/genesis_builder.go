@@ -0,0 +1,119 @@
+package genesis_builder
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/api"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Api is the `GenesisBuilder` runtime API, which creates and builds the genesis config of the runtime.
+var Api = api.New("GenesisBuilder", 1, "API to interact with the genesis config of the runtime.",
+	api.NewMethod("create_default_config",
+		types.TypeId(metadata.TypesSequenceU8),
+		"Creates the default JSON genesis config of the runtime.",
+		CreateDefaultConfig),
+	api.NewMethod1("build_config",
+		api.NewArg("json", types.TypeId(metadata.TypesSequenceU8), sc.DecodeSequence[sc.U8]),
+		types.TypeId(metadata.TypesResultEmptyTupleString),
+		"Builds the genesis storage from the given JSON genesis config.",
+		BuildConfig),
+)
+
+// CreateDefaultConfig creates the default JSON genesis config of the runtime, which holds the
+// default genesis config of each module under its camel case name.
+func CreateDefaultConfig() sc.Sequence[sc.U8] {
+	gc := map[string]json.RawMessage{}
+
+	for _, index := range config.ModuleIndices() {
+		module, ok := config.Modules[index].(types.GenesisBuilderModule)
+		if !ok {
+			continue
+		}
+
+		moduleConfig, err := module.CreateDefaultConfig()
+		if err != nil {
+			log.Critical(err.Error())
+		}
+
+		gc[configKey(config.Modules[index])] = moduleConfig
+	}
+
+	gcJson, err := json.Marshal(gc)
+	if err != nil {
+		log.Critical(err.Error())
+	}
+
+	return sc.BytesToSequenceU8(gcJson)
+}
+
+// BuildConfig builds the genesis storage of the runtime from a JSON genesis config, with the
+// storage versions of the versioned modules.
+// The modules are built in the order in which they are declared, with their default genesis
+// config if the genesis config does not contain theirs.
+// Returns the result, with an error message if the genesis config is invalid.
+func BuildConfig(gcJson sc.Sequence[sc.U8]) sc.Result[sc.Encodable] {
+	result := sc.Result[sc.Encodable]{Value: sc.Empty{}}
+	if err := buildConfig(sc.SequenceU8ToBytes(gcJson)); err != nil {
+		result = sc.Result[sc.Encodable]{HasError: true, Value: sc.Str(err.Error())}
+	}
+
+	return result
+}
+
+func buildConfig(gcJson []byte) error {
+	gc := map[string]json.RawMessage{}
+	if err := json.Unmarshal(gcJson, &gc); err != nil {
+		return err
+	}
+
+	for _, index := range config.ModuleIndices() {
+		module, ok := config.Modules[index].(types.GenesisBuilderModule)
+		if !ok {
+			continue
+		}
+
+		key := configKey(config.Modules[index])
+
+		moduleConfig, ok := gc[key]
+		if !ok {
+			defaultConfig, err := module.CreateDefaultConfig()
+			if err != nil {
+				return err
+			}
+			moduleConfig = defaultConfig
+		}
+		delete(gc, key)
+
+		if err := module.BuildConfig(moduleConfig); err != nil {
+			return errors.New(key + ": " + err.Error())
+		}
+	}
+
+	for key := range gc {
+		return errors.New("unknown module in genesis config: " + key)
+	}
+
+	for _, index := range config.ModuleIndices() {
+		if versioned, ok := config.Modules[index].(types.VersionedModule); ok {
+			support.PutStorageVersion(versioned, versioned.StorageVersion())
+		}
+	}
+
+	return nil
+}
+
+// configKey returns the key of the genesis config of a module, which is its name in camel case.
+func configKey(module types.Module) string {
+	mModule := module.Metadata(types.NewMetadataTypeRegistry(metadata.FirstRegisteredType))
+	name := string(mModule.Name)
+
+	return strings.ToLower(name[:1]) + name[1:]
+}
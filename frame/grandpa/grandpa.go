@@ -6,16 +6,24 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/api"
 	"github.com/LimeChain/gosemble/primitives/log"
 	"github.com/LimeChain/gosemble/primitives/storage"
 	"github.com/LimeChain/gosemble/primitives/types"
-	"github.com/LimeChain/gosemble/utils"
+)
+
+// Api is the `GrandpaApi` runtime API, for integrating the GRANDPA finality gadget into the runtime.
+var Api = api.New("GrandpaApi", 3, "APIs for integrating the GRANDPA finality gadget into runtimes.",
+	api.NewMethod("grandpa_authorities",
+		types.TypeId(metadata.TypesSequenceTupleGrandpaAuthorityIdU64),
+		"Get the current GRANDPA authorities and weights. This should not change except for when changes are scheduled and the corresponding delay has passed.",
+		Authorities),
 )
 
 // Authorities returns the current set of authorities, including their respective weights.
-// Returns a pointer-size of the SCALE-encoded set of authorities with their weights.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#sect-rte-grandpa-auth)
-func Authorities() int64 {
+func Authorities() sc.Sequence[types.Authority] {
 	versionedAuthorityList := storage.GetDecode(constants.KeyGrandpaAuthorities, types.DecodeVersionedAuthorityList)
 
 	authorities := versionedAuthorityList.AuthorityList
@@ -24,5 +32,14 @@ func Authorities() int64 {
 		authorities = sc.Sequence[types.Authority]{}
 	}
 
-	return utils.BytesToOffsetAndSize(authorities.Bytes())
+	return authorities
+}
+
+func StorageSetAuthorities(authorities sc.Sequence[types.Authority]) {
+	versionedAuthorityList := types.VersionedAuthorityList{
+		Version:       grandpa.AuthorityVersion,
+		AuthorityList: authorities,
+	}
+
+	storage.Set(constants.KeyGrandpaAuthorities, versionedAuthorityList.Bytes())
 }
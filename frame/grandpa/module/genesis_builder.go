@@ -0,0 +1,56 @@
+package module
+
+import (
+	"encoding/json"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/grandpa"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var errInvalidAuthority = errors.New("authorities must be 32 byte ed25519 public keys")
+
+type gcGrandpa struct {
+	Authorities []gcGrandpaAuthority `json:"authorities"`
+}
+
+// gcGrandpaAuthority is an authority and its weight, encoded as a `[id, weight]` tuple.
+type gcGrandpaAuthority struct {
+	Id     primitives.HexBytes
+	Weight uint64
+}
+
+func (a gcGrandpaAuthority) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{a.Id, a.Weight})
+}
+
+func (a *gcGrandpaAuthority) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &[]interface{}{&a.Id, &a.Weight})
+}
+
+func (gm GrandpaModule) CreateDefaultConfig() ([]byte, error) {
+	return json.Marshal(gcGrandpa{Authorities: []gcGrandpaAuthority{}})
+}
+
+func (gm GrandpaModule) BuildConfig(config []byte) error {
+	gc := gcGrandpa{}
+	if err := json.Unmarshal(config, &gc); err != nil {
+		return err
+	}
+
+	authorities := sc.Sequence[primitives.Authority]{}
+	for _, authority := range gc.Authorities {
+		if len(authority.Id) != 32 {
+			return errInvalidAuthority
+		}
+		authorities = append(authorities, primitives.Authority{
+			Id:     sc.BytesToFixedSequenceU8(authority.Id),
+			Weight: sc.U64(authority.Weight),
+		})
+	}
+
+	grandpa.StorageSetAuthorities(authorities)
+
+	return nil
+}
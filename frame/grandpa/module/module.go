@@ -26,8 +26,14 @@ func (gm GrandpaModule) ValidateUnsigned(_ primitives.TransactionSource, _ primi
 	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
 }
 
-func (gm GrandpaModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
-	return gm.metadataTypes(), primitives.MetadataModule{
+func (gm GrandpaModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"The GRANDPA finality gadget authority set."}
+}
+
+func (gm GrandpaModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	registry.Add(gm.metadataTypes()...)
+
+	return primitives.MetadataModule{
 		Name:      "Grandpa",
 		Storage:   sc.Option[primitives.MetadataModuleStorage]{},
 		Call:      sc.NewOption[sc.Compact](nil),
@@ -0,0 +1,128 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type AddRegistrarCall struct {
+	primitives.Callable
+}
+
+func NewAddRegistrarCall(args sc.VaryingData) AddRegistrarCall {
+	call := AddRegistrarCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionAddRegistrarIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c AddRegistrarCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c AddRegistrarCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c AddRegistrarCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c AddRegistrarCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c AddRegistrarCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ AddRegistrarCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "add_registrar",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("account", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Add a registrar to the system.",
+	}
+}
+
+func (c AddRegistrarCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ AddRegistrarCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 11_760 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoRegistrars)
+	return types.WeightFromParts(12_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ AddRegistrarCall) IsInherent() bool {
+	return false
+}
+
+func (_ AddRegistrarCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ AddRegistrarCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ AddRegistrarCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ AddRegistrarCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := addRegistrar(origin, args[0].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// addRegistrar adds a registrar with zero fee. Must be called by the registrar origin.
+func addRegistrar(origin types.RuntimeOrigin, account types.MultiAddress) types.DispatchError {
+	err := pallet.RegistrarOrigin.EnsureOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	registrar, e := types.DefaultAccountIdLookup().Lookup(account)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.AddRegistrar(registrar)
+}
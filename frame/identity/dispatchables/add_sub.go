@@ -0,0 +1,130 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type AddSubCall struct {
+	primitives.Callable
+}
+
+func NewAddSubCall(args sc.VaryingData) AddSubCall {
+	call := AddSubCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionAddSubIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c AddSubCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+		types.DecodeIdentityData(buffer),
+	)
+	return c
+}
+
+func (c AddSubCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c AddSubCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c AddSubCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c AddSubCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ AddSubCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "add_sub",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("sub", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("data", "Data", pallet.DataTypeInfo),
+		},
+		Docs: "Add the given account to the sender's subs.",
+	}
+}
+
+func (c AddSubCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ AddSubCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 24_500 nanoseconds.
+	r := constants.DbWeight.Reads(3)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoIdentityOf, pallet.StorageInfoSuperOf, pallet.StorageInfoSubsOf, system.StorageInfoAccount)
+	return types.WeightFromParts(25_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ AddSubCall) IsInherent() bool {
+	return false
+}
+
+func (_ AddSubCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ AddSubCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ AddSubCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ AddSubCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := addSub(origin, args[0].(types.MultiAddress), args[1].(types.IdentityData))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// addSub adds `sub` as a sub-account of the sender, reserving the sub-account deposit.
+func addSub(origin types.RuntimeOrigin, sub types.MultiAddress, data types.IdentityData) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	subAccount, err := types.DefaultAccountIdLookup().Lookup(sub)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.AddSub(origin.AsSigned(), subAccount, data)
+}
@@ -0,0 +1,122 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CancelRequestCall struct {
+	primitives.Callable
+}
+
+func NewCancelRequestCall(args sc.VaryingData) CancelRequestCall {
+	call := CancelRequestCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionCancelRequestIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c CancelRequestCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c CancelRequestCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c CancelRequestCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c CancelRequestCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c CancelRequestCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ CancelRequestCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "cancel_request",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("reg_index", "RegistrarIndex", primitives.TypeInfoU32),
+		},
+		Docs: "Cancel a previous request.",
+	}
+}
+
+func (c CancelRequestCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ CancelRequestCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 28_420 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoIdentityOf, system.StorageInfoAccount)
+	return types.WeightFromParts(29_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ CancelRequestCall) IsInherent() bool {
+	return false
+}
+
+func (_ CancelRequestCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ CancelRequestCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ CancelRequestCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ CancelRequestCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := cancelRequest(origin, args[0].(sc.U32))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// cancelRequest cancels a pending judgement request of the sender and returns the reserved fee.
+func cancelRequest(origin types.RuntimeOrigin, registrarIndex sc.U32) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.CancelRequest(origin.AsSigned(), registrarIndex)
+}
@@ -0,0 +1,117 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ClearIdentityCall struct {
+	primitives.Callable
+}
+
+func NewClearIdentityCall(args sc.VaryingData) ClearIdentityCall {
+	call := ClearIdentityCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionClearIdentityIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ClearIdentityCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData()
+	return c
+}
+
+func (c ClearIdentityCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ClearIdentityCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ClearIdentityCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ClearIdentityCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ClearIdentityCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "clear_identity",
+		Docs: "Clear an account's identity info and all sub-accounts and return all deposits.",
+	}
+}
+
+func (c ClearIdentityCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ClearIdentityCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 51_940 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoIdentityOf, pallet.StorageInfoSubsOf, system.StorageInfoAccount)
+	return types.WeightFromParts(53_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ClearIdentityCall) IsInherent() bool {
+	return false
+}
+
+func (_ ClearIdentityCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ClearIdentityCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ClearIdentityCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ClearIdentityCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := clearIdentity(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// clearIdentity clears the identity and the sub-accounts of the sender and returns their deposits.
+func clearIdentity(origin types.RuntimeOrigin) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.ClearIdentity(origin.AsSigned())
+}
@@ -0,0 +1,130 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type KillIdentityCall struct {
+	primitives.Callable
+}
+
+func NewKillIdentityCall(args sc.VaryingData) KillIdentityCall {
+	call := KillIdentityCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionKillIdentityIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c KillIdentityCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c KillIdentityCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c KillIdentityCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c KillIdentityCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c KillIdentityCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ KillIdentityCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "kill_identity",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("target", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Remove an account's identity and sub-account information and slash the deposits.",
+	}
+}
+
+func (c KillIdentityCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ KillIdentityCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 64_680 nanoseconds.
+	r := constants.DbWeight.Reads(3)
+	w := constants.DbWeight.Writes(3)
+	e := types.ProofWeight(pallet.StorageInfoIdentityOf, pallet.StorageInfoSubsOf, system.StorageInfoAccount, system.StorageInfoAccount)
+	return types.WeightFromParts(66_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ KillIdentityCall) IsInherent() bool {
+	return false
+}
+
+func (_ KillIdentityCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ KillIdentityCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ KillIdentityCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ KillIdentityCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := killIdentity(origin, args[0].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// killIdentity removes the identity and the sub-accounts of `target` and slashes their deposits.
+// Must be called by the force origin.
+func killIdentity(origin types.RuntimeOrigin, target types.MultiAddress) types.DispatchError {
+	err := pallet.ForceOrigin.EnsureOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	targetAccount, e := types.DefaultAccountIdLookup().Lookup(target)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.KillIdentity(targetAccount)
+}
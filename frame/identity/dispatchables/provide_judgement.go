@@ -0,0 +1,134 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ProvideJudgementCall struct {
+	primitives.Callable
+}
+
+func NewProvideJudgementCall(args sc.VaryingData) ProvideJudgementCall {
+	call := ProvideJudgementCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionProvideJudgementIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ProvideJudgementCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeMultiAddress(buffer),
+		types.DecodeJudgement(buffer),
+		types.DecodeH256(buffer),
+	)
+	return c
+}
+
+func (c ProvideJudgementCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ProvideJudgementCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ProvideJudgementCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ProvideJudgementCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ProvideJudgementCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "provide_judgement",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("reg_index", "RegistrarIndex", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("target", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("judgement", "Judgement<BalanceOf<T>>", pallet.JudgementTypeInfo),
+			primitives.NewFieldTypeInfo("identity", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+		},
+		Docs: "Provide a judgement for an account's identity.",
+	}
+}
+
+func (c ProvideJudgementCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ProvideJudgementCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 21_560 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoRegistrars, pallet.StorageInfoIdentityOf, system.StorageInfoAccount, system.StorageInfoAccount)
+	return types.WeightFromParts(22_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ProvideJudgementCall) IsInherent() bool {
+	return false
+}
+
+func (_ ProvideJudgementCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ProvideJudgementCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ProvideJudgementCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ProvideJudgementCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := provideJudgement(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(types.MultiAddress), args[2].(types.Judgement), args[3].(types.H256))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// provideJudgement gives a judgement on the identity of `target`. Must be called by the account of the registrar.
+func provideJudgement(origin types.RuntimeOrigin, registrarIndex sc.U32, target types.MultiAddress, judgement types.Judgement, identityHash types.H256) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	targetAccount, err := types.DefaultAccountIdLookup().Lookup(target)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.ProvideJudgement(origin.AsSigned(), registrarIndex, targetAccount, judgement, identityHash)
+}
@@ -0,0 +1,117 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type QuitSubCall struct {
+	primitives.Callable
+}
+
+func NewQuitSubCall(args sc.VaryingData) QuitSubCall {
+	call := QuitSubCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionQuitSubIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c QuitSubCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData()
+	return c
+}
+
+func (c QuitSubCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c QuitSubCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c QuitSubCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c QuitSubCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ QuitSubCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "quit_sub",
+		Docs: "Remove the sender as a sub-account.",
+	}
+}
+
+func (c QuitSubCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ QuitSubCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 20_580 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoSuperOf, pallet.StorageInfoSubsOf, system.StorageInfoAccount, system.StorageInfoAccount)
+	return types.WeightFromParts(21_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ QuitSubCall) IsInherent() bool {
+	return false
+}
+
+func (_ QuitSubCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ QuitSubCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ QuitSubCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ QuitSubCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := quitSub(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// quitSub removes the sender as a sub-account of its parent. The deposit reserved for it is paid to the sender.
+func quitSub(origin types.RuntimeOrigin) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.QuitSub(origin.AsSigned())
+}
@@ -0,0 +1,128 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type RemoveSubCall struct {
+	primitives.Callable
+}
+
+func NewRemoveSubCall(args sc.VaryingData) RemoveSubCall {
+	call := RemoveSubCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionRemoveSubIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c RemoveSubCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c RemoveSubCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c RemoveSubCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c RemoveSubCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c RemoveSubCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ RemoveSubCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "remove_sub",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("sub", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Remove the given account from the sender's subs.",
+	}
+}
+
+func (c RemoveSubCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ RemoveSubCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 27_440 nanoseconds.
+	r := constants.DbWeight.Reads(3)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoIdentityOf, pallet.StorageInfoSuperOf, pallet.StorageInfoSubsOf, system.StorageInfoAccount)
+	return types.WeightFromParts(28_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ RemoveSubCall) IsInherent() bool {
+	return false
+}
+
+func (_ RemoveSubCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ RemoveSubCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ RemoveSubCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ RemoveSubCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := removeSub(origin, args[0].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// removeSub removes a sub-account of the sender and returns its deposit.
+func removeSub(origin types.RuntimeOrigin, sub types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	subAccount, err := types.DefaultAccountIdLookup().Lookup(sub)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.RemoveSub(origin.AsSigned(), subAccount)
+}
@@ -0,0 +1,129 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type RenameSubCall struct {
+	primitives.Callable
+}
+
+func NewRenameSubCall(args sc.VaryingData) RenameSubCall {
+	call := RenameSubCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionRenameSubIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c RenameSubCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+		types.DecodeIdentityData(buffer),
+	)
+	return c
+}
+
+func (c RenameSubCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c RenameSubCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c RenameSubCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c RenameSubCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ RenameSubCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "rename_sub",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("sub", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("data", "Data", pallet.DataTypeInfo),
+		},
+		Docs: "Alter the associated name of the given sub-account.",
+	}
+}
+
+func (c RenameSubCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ RenameSubCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 10_780 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoIdentityOf, pallet.StorageInfoSuperOf)
+	return types.WeightFromParts(11_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ RenameSubCall) IsInherent() bool {
+	return false
+}
+
+func (_ RenameSubCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ RenameSubCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ RenameSubCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ RenameSubCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := renameSub(origin, args[0].(types.MultiAddress), args[1].(types.IdentityData))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// renameSub changes the name of a sub-account of the sender.
+func renameSub(origin types.RuntimeOrigin, sub types.MultiAddress, data types.IdentityData) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	subAccount, err := types.DefaultAccountIdLookup().Lookup(sub)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.RenameSub(origin.AsSigned(), subAccount, data)
+}
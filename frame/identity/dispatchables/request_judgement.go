@@ -0,0 +1,124 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type RequestJudgementCall struct {
+	primitives.Callable
+}
+
+func NewRequestJudgementCall(args sc.VaryingData) RequestJudgementCall {
+	call := RequestJudgementCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionRequestJudgementIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c RequestJudgementCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c RequestJudgementCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c RequestJudgementCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c RequestJudgementCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c RequestJudgementCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ RequestJudgementCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "request_judgement",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("reg_index", "RegistrarIndex", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("max_fee", "BalanceOf<T>", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Request a judgement from a registrar.",
+	}
+}
+
+func (c RequestJudgementCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ RequestJudgementCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 31_360 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoRegistrars, pallet.StorageInfoIdentityOf, system.StorageInfoAccount)
+	return types.WeightFromParts(32_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ RequestJudgementCall) IsInherent() bool {
+	return false
+}
+
+func (_ RequestJudgementCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ RequestJudgementCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ RequestJudgementCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ RequestJudgementCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := requestJudgement(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), sc.U128(args[1].(sc.Compact)))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// requestJudgement requests a judgement from a registrar, reserving its fee, which must not exceed `maxFee`.
+func requestJudgement(origin types.RuntimeOrigin, registrarIndex sc.U32, maxFee types.Balance) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.RequestJudgement(origin.AsSigned(), registrarIndex, maxFee.ToBigInt())
+}
@@ -0,0 +1,129 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetAccountIdCall struct {
+	primitives.Callable
+}
+
+func NewSetAccountIdCall(args sc.VaryingData) SetAccountIdCall {
+	call := SetAccountIdCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionSetAccountIdIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetAccountIdCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c SetAccountIdCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetAccountIdCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetAccountIdCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetAccountIdCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetAccountIdCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_account_id",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("index", "RegistrarIndex", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("new", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Change the account associated with a registrar.",
+	}
+}
+
+func (c SetAccountIdCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetAccountIdCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 6_860 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoRegistrars)
+	return types.WeightFromParts(7_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetAccountIdCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetAccountIdCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetAccountIdCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetAccountIdCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetAccountIdCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setAccountId(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setAccountId changes the account of a registrar. Must be called by the current account of the registrar.
+func setAccountId(origin types.RuntimeOrigin, index sc.U32, account types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	newAccount, err := types.DefaultAccountIdLookup().Lookup(account)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.SetAccountId(origin.AsSigned(), index, newAccount)
+}
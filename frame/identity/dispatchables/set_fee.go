@@ -0,0 +1,123 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetFeeCall struct {
+	primitives.Callable
+}
+
+func NewSetFeeCall(args sc.VaryingData) SetFeeCall {
+	call := SetFeeCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionSetFeeIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetFeeCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c SetFeeCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetFeeCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetFeeCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetFeeCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetFeeCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_fee",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("index", "RegistrarIndex", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("fee", "BalanceOf<T>", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Set the fee required for a judgement to be requested from a registrar.",
+	}
+}
+
+func (c SetFeeCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetFeeCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 6_860 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoRegistrars)
+	return types.WeightFromParts(7_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetFeeCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetFeeCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetFeeCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetFeeCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetFeeCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setFee(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), sc.U128(args[1].(sc.Compact)))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setFee sets the fee of a registrar. Must be called by the account of the registrar.
+func setFee(origin types.RuntimeOrigin, index sc.U32, fee types.Balance) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.SetFee(origin.AsSigned(), index, fee)
+}
@@ -0,0 +1,123 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetFieldsCall struct {
+	primitives.Callable
+}
+
+func NewSetFieldsCall(args sc.VaryingData) SetFieldsCall {
+	call := SetFieldsCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionSetFieldsIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetFieldsCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		sc.DecodeU64(buffer),
+	)
+	return c
+}
+
+func (c SetFieldsCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetFieldsCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetFieldsCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetFieldsCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetFieldsCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_fields",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("index", "RegistrarIndex", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+			primitives.NewFieldTypeInfo("fields", "IdentityFields", pallet.IdentityFieldsTypeInfo),
+		},
+		Docs: "Set the field information for a registrar.",
+	}
+}
+
+func (c SetFieldsCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetFieldsCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 6_860 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoRegistrars)
+	return types.WeightFromParts(7_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetFieldsCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetFieldsCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetFieldsCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetFieldsCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetFieldsCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setFields(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()), args[1].(sc.U64))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setFields sets the identity fields checked by a registrar. Must be called by the account of the registrar.
+func setFields(origin types.RuntimeOrigin, index sc.U32, fields sc.U64) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.SetFields(origin.AsSigned(), index, fields)
+}
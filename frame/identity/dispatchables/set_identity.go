@@ -0,0 +1,122 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetIdentityCall struct {
+	primitives.Callable
+}
+
+func NewSetIdentityCall(args sc.VaryingData) SetIdentityCall {
+	call := SetIdentityCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionSetIdentityIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetIdentityCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeIdentityInfo(buffer),
+	)
+	return c
+}
+
+func (c SetIdentityCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetIdentityCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetIdentityCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetIdentityCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetIdentityCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_identity",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("info", "Box<IdentityInfo<T::MaxAdditionalFields>>", pallet.IdentityInfoTypeInfo),
+		},
+		Docs: "Set an account's identity information and reserve the appropriate deposit.",
+	}
+}
+
+func (c SetIdentityCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetIdentityCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 31_360 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoIdentityOf, system.StorageInfoAccount)
+	return types.WeightFromParts(32_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetIdentityCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetIdentityCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetIdentityCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetIdentityCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetIdentityCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setIdentity(origin, args[0].(types.IdentityInfo))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setIdentity sets the identity of the sender. A deposit depending on the size of the information is reserved.
+func setIdentity(origin types.RuntimeOrigin, info types.IdentityInfo) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.SetIdentity(origin.AsSigned(), info)
+}
@@ -0,0 +1,125 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetSubsCall struct {
+	primitives.Callable
+}
+
+func NewSetSubsCall(args sc.VaryingData) SetSubsCall {
+	call := SetSubsCall{
+		Callable: primitives.Callable{
+			ModuleId:   identity.ModuleIndex,
+			FunctionId: identity.FunctionSetSubsIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetSubsCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeSequenceWith(buffer, types.DecodeIdentitySubAccount),
+	)
+	return c
+}
+
+func (c SetSubsCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetSubsCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetSubsCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetSubsCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetSubsCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_subs",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("subs", "Vec<(T::AccountId, Data)>", primitives.NewSequenceTypeInfo(primitives.NewTupleTypeInfo(primitives.TypeId(metadata.TypesAddress32), pallet.DataTypeInfo))),
+		},
+		Docs: "Set the sub-accounts of the sender.",
+	}
+}
+
+func (c SetSubsCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetSubsCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 23_520 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	// Each of the old and the new sub-accounts is proven.
+	e := types.ProofWeight(pallet.StorageInfoIdentityOf, pallet.StorageInfoSubsOf, system.StorageInfoAccount).
+		SaturatingAdd(types.ProofWeight(pallet.StorageInfoSuperOf).SaturatingMul(identity.MaxSubAccounts))
+	return types.WeightFromParts(24_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetSubsCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetSubsCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetSubsCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetSubsCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetSubsCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setSubs(origin, args[0].(sc.Sequence[types.IdentitySubAccount]))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setSubs replaces the sub-accounts of the sender. The sender must have an identity.
+func setSubs(origin types.RuntimeOrigin, subs sc.Sequence[types.IdentitySubAccount]) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.SetSubs(origin.AsSigned(), subs)
+}
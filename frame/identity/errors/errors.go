@@ -0,0 +1,24 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Identity module errors.
+const (
+	ErrorTooManySubAccounts sc.U8 = iota
+	ErrorNotFound
+	ErrorNotNamed
+	ErrorEmptyIndex
+	ErrorFeeChanged
+	ErrorNoIdentity
+	ErrorStickyJudgement
+	ErrorJudgementGiven
+	ErrorInvalidJudgement
+	ErrorInvalidIndex
+	ErrorInvalidTarget
+	ErrorTooManyFields
+	ErrorTooManyRegistrars
+	ErrorAlreadyClaimed
+	ErrorNotSub
+	ErrorNotOwned
+	ErrorJudgementForDifferentIdentity
+)
@@ -0,0 +1,121 @@
+package events
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Identity module events.
+const (
+	EventIdentitySet sc.U8 = iota
+	EventIdentityCleared
+	EventIdentityKilled
+	EventJudgementRequested
+	EventJudgementUnrequested
+	EventJudgementGiven
+	EventRegistrarAdded
+	EventSubIdentityAdded
+	EventSubIdentityRemoved
+	EventSubIdentityRevoked
+)
+
+func NewEventIdentitySet(who types.PublicKey) types.Event {
+	return types.NewEvent(identity.ModuleIndex, EventIdentitySet, who)
+}
+
+func NewEventIdentityCleared(who types.PublicKey, deposit types.Balance) types.Event {
+	return types.NewEvent(identity.ModuleIndex, EventIdentityCleared, who, deposit)
+}
+
+func NewEventIdentityKilled(who types.PublicKey, deposit types.Balance) types.Event {
+	return types.NewEvent(identity.ModuleIndex, EventIdentityKilled, who, deposit)
+}
+
+func NewEventJudgementRequested(who types.PublicKey, registrarIndex sc.U32) types.Event {
+	return types.NewEvent(identity.ModuleIndex, EventJudgementRequested, who, registrarIndex)
+}
+
+func NewEventJudgementUnrequested(who types.PublicKey, registrarIndex sc.U32) types.Event {
+	return types.NewEvent(identity.ModuleIndex, EventJudgementUnrequested, who, registrarIndex)
+}
+
+func NewEventJudgementGiven(target types.PublicKey, registrarIndex sc.U32) types.Event {
+	return types.NewEvent(identity.ModuleIndex, EventJudgementGiven, target, registrarIndex)
+}
+
+func NewEventRegistrarAdded(registrarIndex sc.U32) types.Event {
+	return types.NewEvent(identity.ModuleIndex, EventRegistrarAdded, registrarIndex)
+}
+
+func NewEventSubIdentityAdded(sub types.PublicKey, main types.PublicKey, deposit types.Balance) types.Event {
+	return types.NewEvent(identity.ModuleIndex, EventSubIdentityAdded, sub, main, deposit)
+}
+
+func NewEventSubIdentityRemoved(sub types.PublicKey, main types.PublicKey, deposit types.Balance) types.Event {
+	return types.NewEvent(identity.ModuleIndex, EventSubIdentityRemoved, sub, main, deposit)
+}
+
+func NewEventSubIdentityRevoked(sub types.PublicKey, main types.PublicKey, deposit types.Balance) types.Event {
+	return types.NewEvent(identity.ModuleIndex, EventSubIdentityRevoked, sub, main, deposit)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	module := sc.DecodeU8(buffer)
+	if module != identity.ModuleIndex {
+		log.Critical("invalid identity.Event module")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventIdentitySet:
+		who := types.DecodePublicKey(buffer)
+		return NewEventIdentitySet(who)
+	case EventIdentityCleared:
+		who := types.DecodePublicKey(buffer)
+		deposit := sc.DecodeU128(buffer)
+		return NewEventIdentityCleared(who, deposit)
+	case EventIdentityKilled:
+		who := types.DecodePublicKey(buffer)
+		deposit := sc.DecodeU128(buffer)
+		return NewEventIdentityKilled(who, deposit)
+	case EventJudgementRequested:
+		who := types.DecodePublicKey(buffer)
+		registrarIndex := sc.DecodeU32(buffer)
+		return NewEventJudgementRequested(who, registrarIndex)
+	case EventJudgementUnrequested:
+		who := types.DecodePublicKey(buffer)
+		registrarIndex := sc.DecodeU32(buffer)
+		return NewEventJudgementUnrequested(who, registrarIndex)
+	case EventJudgementGiven:
+		target := types.DecodePublicKey(buffer)
+		registrarIndex := sc.DecodeU32(buffer)
+		return NewEventJudgementGiven(target, registrarIndex)
+	case EventRegistrarAdded:
+		registrarIndex := sc.DecodeU32(buffer)
+		return NewEventRegistrarAdded(registrarIndex)
+	case EventSubIdentityAdded:
+		sub := types.DecodePublicKey(buffer)
+		main := types.DecodePublicKey(buffer)
+		deposit := sc.DecodeU128(buffer)
+		return NewEventSubIdentityAdded(sub, main, deposit)
+	case EventSubIdentityRemoved:
+		sub := types.DecodePublicKey(buffer)
+		main := types.DecodePublicKey(buffer)
+		deposit := sc.DecodeU128(buffer)
+		return NewEventSubIdentityRemoved(sub, main, deposit)
+	case EventSubIdentityRevoked:
+		sub := types.DecodePublicKey(buffer)
+		main := types.DecodePublicKey(buffer)
+		deposit := sc.DecodeU128(buffer)
+		return NewEventSubIdentityRevoked(sub, main, deposit)
+	default:
+		log.Critical("invalid identity.Event type")
+	}
+
+	panic("unreachable")
+}
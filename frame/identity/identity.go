@@ -0,0 +1,511 @@
+package identity
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/identity/errors"
+	"github.com/LimeChain/gosemble/frame/identity/events"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/treasury"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// AddRegistrar adds `account` as a new registrar, without a fee and without checked fields.
+func AddRegistrar(account types.AccountId) types.DispatchError {
+	registrars := StorageGetRegistrars()
+	if len(registrars) >= identity.MaxRegistrars {
+		return newIdentityError(errors.ErrorTooManyRegistrars)
+	}
+
+	registrars = append(registrars, sc.NewOption[types.RegistrarInfo](types.RegistrarInfo{
+		Account: account,
+		Fee:     sc.NewU128FromBigInt(big.NewInt(0)),
+		Fields:  0,
+	}))
+	StorageSetRegistrars(registrars)
+
+	system.DepositEvent(events.NewEventRegistrarAdded(sc.U32(len(registrars) - 1)))
+
+	return nil
+}
+
+// SetIdentity sets the identity of `who`, replacing any previous one. Judgements which are not sticky
+// are removed. The deposit depends on the encoded size of `info`.
+func SetIdentity(who types.AccountId, info types.IdentityInfo) types.DispatchError {
+	if len(info.Additional) > identity.MaxAdditionalFields {
+		return newIdentityError(errors.ErrorTooManyFields)
+	}
+
+	registration := types.Registration{
+		Judgements: sc.Sequence[types.RegistrarJudgement]{},
+		Deposit:    sc.NewU128FromBigInt(big.NewInt(0)),
+	}
+
+	maybeRegistration := StorageGetIdentityOf(who)
+	if maybeRegistration.HasValue {
+		registration = maybeRegistration.Value
+		judgements := sc.Sequence[types.RegistrarJudgement]{}
+		for _, judgement := range registration.Judgements {
+			if judgement.Judgement.IsSticky() {
+				judgements = append(judgements, judgement)
+			}
+		}
+		registration.Judgements = judgements
+	}
+	registration.Info = info
+
+	oldDeposit := registration.Deposit.ToBigInt()
+	newDeposit := identityDeposit(info)
+
+	if newDeposit.Cmp(oldDeposit) > 0 {
+		err := dispatchables.Reserve(who, new(big.Int).Sub(newDeposit, oldDeposit))
+		if err != nil {
+			return err
+		}
+	} else {
+		dispatchables.Unreserve(who, new(big.Int).Sub(oldDeposit, newDeposit))
+	}
+
+	registration.Deposit = sc.NewU128FromBigInt(newDeposit)
+	StorageSetIdentityOf(who, registration)
+
+	system.DepositEvent(events.NewEventIdentitySet(who.FixedSequence))
+
+	return nil
+}
+
+// SetSubs replaces the sub-accounts of `who` with `subs`. A deposit is reserved per sub-account.
+func SetSubs(who types.AccountId, subs sc.Sequence[types.IdentitySubAccount]) types.DispatchError {
+	if !StorageGetIdentityOf(who).HasValue {
+		return newIdentityError(errors.ErrorNotFound)
+	}
+
+	if len(subs) > identity.MaxSubAccounts {
+		return newIdentityError(errors.ErrorTooManySubAccounts)
+	}
+
+	for _, sub := range subs {
+		maybeParent := StorageGetSuperOf(sub.Account)
+		if bool(maybeParent.HasValue) && !reflect.DeepEqual(maybeParent.Value.Account, who) {
+			return newIdentityError(errors.ErrorAlreadyClaimed)
+		}
+	}
+
+	oldSubs := StorageGetSubsOf(who)
+	oldDeposit := oldSubs.Deposit.ToBigInt()
+	newDeposit := new(big.Int).Mul(identity.SubAccountDeposit, big.NewInt(int64(len(subs))))
+
+	if newDeposit.Cmp(oldDeposit) > 0 {
+		err := dispatchables.Reserve(who, new(big.Int).Sub(newDeposit, oldDeposit))
+		if err != nil {
+			return err
+		}
+	} else {
+		dispatchables.Unreserve(who, new(big.Int).Sub(oldDeposit, newDeposit))
+	}
+
+	for _, account := range oldSubs.Accounts {
+		StorageClearSuperOf(account)
+	}
+
+	accounts := sc.Sequence[types.AccountId]{}
+	for _, sub := range subs {
+		StorageSetSuperOf(sub.Account, types.IdentitySubAccount{Account: who, Name: sub.Name})
+		accounts = append(accounts, sub.Account)
+	}
+
+	if len(accounts) == 0 {
+		StorageClearSubsOf(who)
+	} else {
+		StorageSetSubsOf(who, types.IdentitySubs{
+			Deposit:  sc.NewU128FromBigInt(newDeposit),
+			Accounts: accounts,
+		})
+	}
+
+	return nil
+}
+
+// ClearIdentity removes the identity and the sub-accounts of `who` and returns all their deposits,
+// including the fees of pending judgement requests.
+func ClearIdentity(who types.AccountId) types.DispatchError {
+	deposit, err := removeIdentity(who)
+	if err != nil {
+		return err
+	}
+
+	dispatchables.Unreserve(who, deposit)
+
+	system.DepositEvent(events.NewEventIdentityCleared(who.FixedSequence, sc.NewU128FromBigInt(deposit)))
+
+	return nil
+}
+
+// KillIdentity removes the identity and the sub-accounts of `target` and slashes all their deposits.
+func KillIdentity(target types.AccountId) types.DispatchError {
+	deposit, err := removeIdentity(target)
+	if err != nil {
+		return err
+	}
+
+	slashed, _ := dispatchables.SlashReserved(target, deposit)
+	treasury.OnUnbalanced(sc.NewU128FromBigInt(slashed))
+
+	system.DepositEvent(events.NewEventIdentityKilled(target.FixedSequence, sc.NewU128FromBigInt(deposit)))
+
+	return nil
+}
+
+// RequestJudgement requests a judgement on the identity of `who` from the registrar at `registrarIndex`.
+// The fee of the registrar, which must not exceed `maxFee`, is reserved until the judgement is given.
+func RequestJudgement(who types.AccountId, registrarIndex sc.U32, maxFee *big.Int) types.DispatchError {
+	registrars := StorageGetRegistrars()
+	if int(registrarIndex) >= len(registrars) || !bool(registrars[registrarIndex].HasValue) {
+		return newIdentityError(errors.ErrorEmptyIndex)
+	}
+	fee := registrars[registrarIndex].Value.Fee
+
+	registration, err := registrationOf(who)
+	if err != nil {
+		return err
+	}
+
+	if fee.ToBigInt().Cmp(maxFee) > 0 {
+		return newIdentityError(errors.ErrorFeeChanged)
+	}
+
+	judgement := types.RegistrarJudgement{
+		RegistrarIndex: registrarIndex,
+		Judgement:      types.NewJudgementFeePaid(fee),
+	}
+
+	position, found := judgementPosition(registration.Judgements, registrarIndex)
+	if found {
+		if registration.Judgements[position].Judgement.IsSticky() {
+			return newIdentityError(errors.ErrorStickyJudgement)
+		}
+		registration.Judgements[position] = judgement
+	} else {
+		if len(registration.Judgements) >= identity.MaxRegistrars {
+			return newIdentityError(errors.ErrorTooManyRegistrars)
+		}
+		registration.Judgements = insertJudgement(registration.Judgements, position, judgement)
+	}
+
+	err = dispatchables.Reserve(who, fee.ToBigInt())
+	if err != nil {
+		return err
+	}
+
+	StorageSetIdentityOf(who, registration)
+
+	system.DepositEvent(events.NewEventJudgementRequested(who.FixedSequence, registrarIndex))
+
+	return nil
+}
+
+// CancelRequest cancels a pending judgement request of `who` and returns the reserved fee.
+func CancelRequest(who types.AccountId, registrarIndex sc.U32) types.DispatchError {
+	registration, err := registrationOf(who)
+	if err != nil {
+		return err
+	}
+
+	position, found := judgementPosition(registration.Judgements, registrarIndex)
+	if !found {
+		return newIdentityError(errors.ErrorNotFound)
+	}
+
+	judgement := registration.Judgements[position].Judgement
+	if judgement.Variant != types.JudgementFeePaid {
+		return newIdentityError(errors.ErrorJudgementGiven)
+	}
+
+	dispatchables.Unreserve(who, judgement.Fee.ToBigInt())
+
+	registration.Judgements = append(registration.Judgements[:position], registration.Judgements[position+1:]...)
+	StorageSetIdentityOf(who, registration)
+
+	system.DepositEvent(events.NewEventJudgementUnrequested(who.FixedSequence, registrarIndex))
+
+	return nil
+}
+
+// SetFee sets the fee of the registrar at `index`. `origin` must be the account of the registrar.
+func SetFee(origin types.AccountId, index sc.U32, fee types.Balance) types.DispatchError {
+	return mutateRegistrar(origin, index, func(registrar *types.RegistrarInfo) {
+		registrar.Fee = fee
+	})
+}
+
+// SetAccountId changes the account of the registrar at `index`. `origin` must be the current account of the registrar.
+func SetAccountId(origin types.AccountId, index sc.U32, account types.AccountId) types.DispatchError {
+	return mutateRegistrar(origin, index, func(registrar *types.RegistrarInfo) {
+		registrar.Account = account
+	})
+}
+
+// SetFields sets the identity fields checked by the registrar at `index`. `origin` must be the account of the registrar.
+func SetFields(origin types.AccountId, index sc.U32, fields sc.U64) types.DispatchError {
+	return mutateRegistrar(origin, index, func(registrar *types.RegistrarInfo) {
+		registrar.Fields = fields
+	})
+}
+
+// ProvideJudgement gives a judgement on the identity of `target`, which must hash to `identityHash`.
+// If the judgement was requested, the reserved fee is paid to the registrar. `origin` must be the account
+// of the registrar at `registrarIndex`.
+func ProvideJudgement(origin types.AccountId, registrarIndex sc.U32, target types.AccountId, judgement types.Judgement, identityHash types.H256) types.DispatchError {
+	registrars := StorageGetRegistrars()
+	if int(registrarIndex) >= len(registrars) ||
+		!bool(registrars[registrarIndex].HasValue) ||
+		!reflect.DeepEqual(registrars[registrarIndex].Value.Account, origin) {
+		return newIdentityError(errors.ErrorInvalidIndex)
+	}
+
+	if judgement.Variant == types.JudgementFeePaid {
+		return newIdentityError(errors.ErrorInvalidJudgement)
+	}
+
+	maybeRegistration := StorageGetIdentityOf(target)
+	if !maybeRegistration.HasValue {
+		return newIdentityError(errors.ErrorInvalidTarget)
+	}
+	registration := maybeRegistration.Value
+
+	if !bytes.Equal(hashing.Blake256(registration.Info.Bytes()), sc.FixedSequenceU8ToBytes(identityHash.FixedSequence)) {
+		return newIdentityError(errors.ErrorJudgementForDifferentIdentity)
+	}
+
+	item := types.RegistrarJudgement{
+		RegistrarIndex: registrarIndex,
+		Judgement:      judgement,
+	}
+
+	position, found := judgementPosition(registration.Judgements, registrarIndex)
+	if found {
+		previous := registration.Judgements[position].Judgement
+		if previous.Variant == types.JudgementFeePaid {
+			err := repatriateReserved(target, origin, previous.Fee.ToBigInt())
+			if err != nil {
+				return err
+			}
+		}
+		registration.Judgements[position] = item
+	} else {
+		registration.Judgements = insertJudgement(registration.Judgements, position, item)
+	}
+	StorageSetIdentityOf(target, registration)
+
+	system.DepositEvent(events.NewEventJudgementGiven(target.FixedSequence, registrarIndex))
+
+	return nil
+}
+
+// AddSub adds `sub` as a sub-account of `who` with the given name, reserving the sub-account deposit.
+func AddSub(who types.AccountId, sub types.AccountId, name types.IdentityData) types.DispatchError {
+	if !StorageGetIdentityOf(who).HasValue {
+		return newIdentityError(errors.ErrorNoIdentity)
+	}
+
+	if StorageGetSuperOf(sub).HasValue {
+		return newIdentityError(errors.ErrorAlreadyClaimed)
+	}
+
+	subs := StorageGetSubsOf(who)
+	if len(subs.Accounts) >= identity.MaxSubAccounts {
+		return newIdentityError(errors.ErrorTooManySubAccounts)
+	}
+
+	err := dispatchables.Reserve(who, identity.SubAccountDeposit)
+	if err != nil {
+		return err
+	}
+
+	StorageSetSuperOf(sub, types.IdentitySubAccount{Account: who, Name: name})
+
+	subs.Accounts = append(subs.Accounts, sub)
+	subs.Deposit = sc.NewU128FromBigInt(new(big.Int).Add(subs.Deposit.ToBigInt(), identity.SubAccountDeposit))
+	StorageSetSubsOf(who, subs)
+
+	system.DepositEvent(events.NewEventSubIdentityAdded(sub.FixedSequence, who.FixedSequence, sc.NewU128FromBigInt(identity.SubAccountDeposit)))
+
+	return nil
+}
+
+// RenameSub changes the name of the sub-account `sub` of `who`.
+func RenameSub(who types.AccountId, sub types.AccountId, name types.IdentityData) types.DispatchError {
+	if !StorageGetIdentityOf(who).HasValue {
+		return newIdentityError(errors.ErrorNoIdentity)
+	}
+
+	maybeParent := StorageGetSuperOf(sub)
+	if !bool(maybeParent.HasValue) || !reflect.DeepEqual(maybeParent.Value.Account, who) {
+		return newIdentityError(errors.ErrorNotOwned)
+	}
+
+	StorageSetSuperOf(sub, types.IdentitySubAccount{Account: who, Name: name})
+
+	return nil
+}
+
+// RemoveSub removes the sub-account `sub` of `who` and returns its deposit.
+func RemoveSub(who types.AccountId, sub types.AccountId) types.DispatchError {
+	if !StorageGetIdentityOf(who).HasValue {
+		return newIdentityError(errors.ErrorNoIdentity)
+	}
+
+	maybeParent := StorageGetSuperOf(sub)
+	if !bool(maybeParent.HasValue) || !reflect.DeepEqual(maybeParent.Value.Account, who) {
+		return newIdentityError(errors.ErrorNotOwned)
+	}
+
+	deposit := removeSubAccount(who, sub)
+	dispatchables.Unreserve(who, deposit)
+
+	system.DepositEvent(events.NewEventSubIdentityRemoved(sub.FixedSequence, who.FixedSequence, sc.NewU128FromBigInt(deposit)))
+
+	return nil
+}
+
+// QuitSub removes `sub` from the sub-accounts of its parent. The deposit reserved
+// by the parent for the sub-account is paid to `sub`.
+func QuitSub(sub types.AccountId) types.DispatchError {
+	maybeParent := StorageGetSuperOf(sub)
+	if !maybeParent.HasValue {
+		return newIdentityError(errors.ErrorNotSub)
+	}
+	parent := maybeParent.Value.Account
+
+	deposit := removeSubAccount(parent, sub)
+	_ = repatriateReserved(parent, sub, deposit)
+
+	system.DepositEvent(events.NewEventSubIdentityRevoked(sub.FixedSequence, parent.FixedSequence, sc.NewU128FromBigInt(deposit)))
+
+	return nil
+}
+
+// removeIdentity removes the identity and the sub-accounts of `who`.
+// Returns their total deposit, including the fees of pending judgement requests.
+func removeIdentity(who types.AccountId) (*big.Int, types.DispatchError) {
+	registration, err := registrationOf(who)
+	if err != nil {
+		return nil, err
+	}
+
+	subs := StorageGetSubsOf(who)
+	for _, account := range subs.Accounts {
+		StorageClearSuperOf(account)
+	}
+	StorageClearSubsOf(who)
+	StorageClearIdentityOf(who)
+
+	deposit := new(big.Int).Add(registration.Deposit.ToBigInt(), subs.Deposit.ToBigInt())
+	for _, judgement := range registration.Judgements {
+		if judgement.Judgement.Variant == types.JudgementFeePaid {
+			deposit.Add(deposit, judgement.Judgement.Fee.ToBigInt())
+		}
+	}
+
+	return deposit, nil
+}
+
+// removeSubAccount removes `sub` from the sub-accounts of `parent` and returns the deposit reserved for it.
+func removeSubAccount(parent types.AccountId, sub types.AccountId) *big.Int {
+	StorageClearSuperOf(sub)
+
+	subs := StorageGetSubsOf(parent)
+	accounts := sc.Sequence[types.AccountId]{}
+	for _, account := range subs.Accounts {
+		if !reflect.DeepEqual(account, sub) {
+			accounts = append(accounts, account)
+		}
+	}
+
+	deposit := identity.SubAccountDeposit
+	if subs.Deposit.ToBigInt().Cmp(deposit) < 0 {
+		deposit = subs.Deposit.ToBigInt()
+	}
+
+	subs.Accounts = accounts
+	subs.Deposit = sc.NewU128FromBigInt(new(big.Int).Sub(subs.Deposit.ToBigInt(), deposit))
+	StorageSetSubsOf(parent, subs)
+
+	return deposit
+}
+
+func registrationOf(who types.AccountId) (types.Registration, types.DispatchError) {
+	maybeRegistration := StorageGetIdentityOf(who)
+	if !maybeRegistration.HasValue {
+		return types.Registration{}, newIdentityError(errors.ErrorNoIdentity)
+	}
+
+	return maybeRegistration.Value, nil
+}
+
+// mutateRegistrar applies `f` to the registrar at `index`, if `origin` is its account.
+func mutateRegistrar(origin types.AccountId, index sc.U32, f func(registrar *types.RegistrarInfo)) types.DispatchError {
+	registrars := StorageGetRegistrars()
+	if int(index) >= len(registrars) ||
+		!bool(registrars[index].HasValue) ||
+		!reflect.DeepEqual(registrars[index].Value.Account, origin) {
+		return newIdentityError(errors.ErrorInvalidIndex)
+	}
+
+	registrar := registrars[index].Value
+	f(&registrar)
+	registrars[index] = sc.NewOption[types.RegistrarInfo](registrar)
+	StorageSetRegistrars(registrars)
+
+	return nil
+}
+
+// judgementPosition returns the position of the judgement of the registrar at `registrarIndex`
+// and whether it exists. If it does not exist, the position is where it should be inserted.
+func judgementPosition(judgements sc.Sequence[types.RegistrarJudgement], registrarIndex sc.U32) (int, bool) {
+	for i, judgement := range judgements {
+		if judgement.RegistrarIndex == registrarIndex {
+			return i, true
+		}
+		if judgement.RegistrarIndex > registrarIndex {
+			return i, false
+		}
+	}
+
+	return len(judgements), false
+}
+
+func insertJudgement(judgements sc.Sequence[types.RegistrarJudgement], position int, judgement types.RegistrarJudgement) sc.Sequence[types.RegistrarJudgement] {
+	judgements = append(judgements, types.RegistrarJudgement{})
+	copy(judgements[position+1:], judgements[position:])
+	judgements[position] = judgement
+	return judgements
+}
+
+// identityDeposit returns the deposit for an identity, depending on its encoded size.
+func identityDeposit(info types.IdentityInfo) *big.Int {
+	deposit := new(big.Int).Mul(identity.ByteDeposit, big.NewInt(int64(len(info.Bytes()))))
+	return deposit.Add(deposit, identity.BasicDeposit)
+}
+
+// repatriateReserved moves up to `amount` from the reserved balance of `from` to the free balance of `to`.
+func repatriateReserved(from types.AccountId, to types.AccountId, amount *big.Int) types.DispatchError {
+	remaining := dispatchables.Unreserve(from, amount)
+	unreserved := new(big.Int).Sub(amount, remaining)
+
+	return dispatchables.Transfer(from, to, sc.NewU128FromBigInt(unreserved), types.ExistenceRequirementAllowDeath)
+}
+
+func newIdentityError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   identity.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
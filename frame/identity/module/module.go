@@ -0,0 +1,213 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	"github.com/LimeChain/gosemble/frame/identity/dispatchables"
+	"github.com/LimeChain/gosemble/frame/identity/errors"
+	"github.com/LimeChain/gosemble/frame/identity/events"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type IdentityModule struct {
+	functions map[sc.U8]primitives.Call
+}
+
+func NewIdentityModule() IdentityModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[identity.FunctionAddRegistrarIndex] = dispatchables.NewAddRegistrarCall(nil)
+	functions[identity.FunctionSetIdentityIndex] = dispatchables.NewSetIdentityCall(nil)
+	functions[identity.FunctionSetSubsIndex] = dispatchables.NewSetSubsCall(nil)
+	functions[identity.FunctionClearIdentityIndex] = dispatchables.NewClearIdentityCall(nil)
+	functions[identity.FunctionRequestJudgementIndex] = dispatchables.NewRequestJudgementCall(nil)
+	functions[identity.FunctionCancelRequestIndex] = dispatchables.NewCancelRequestCall(nil)
+	functions[identity.FunctionSetFeeIndex] = dispatchables.NewSetFeeCall(nil)
+	functions[identity.FunctionSetAccountIdIndex] = dispatchables.NewSetAccountIdCall(nil)
+	functions[identity.FunctionSetFieldsIndex] = dispatchables.NewSetFieldsCall(nil)
+	functions[identity.FunctionProvideJudgementIndex] = dispatchables.NewProvideJudgementCall(nil)
+	functions[identity.FunctionKillIdentityIndex] = dispatchables.NewKillIdentityCall(nil)
+	functions[identity.FunctionAddSubIndex] = dispatchables.NewAddSubCall(nil)
+	functions[identity.FunctionRenameSubIndex] = dispatchables.NewRenameSubCall(nil)
+	functions[identity.FunctionRemoveSubIndex] = dispatchables.NewRemoveSubCall(nil)
+	functions[identity.FunctionQuitSubIndex] = dispatchables.NewQuitSubCall(nil)
+
+	return IdentityModule{
+		functions: functions,
+	}
+}
+
+func (im IdentityModule) Functions() map[sc.U8]primitives.Call {
+	return im.functions
+}
+
+func (im IdentityModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (im IdentityModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (im IdentityModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Federated identities with registrar judgements and sub-accounts."}
+}
+
+func (im IdentityModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "Identity",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "Identity",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"IdentityOf",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(registry.Register(pallet.RegistrationTypeInfo))),
+					"Information that is pertinent to identify the entity behind an account."),
+				primitives.NewMetadataModuleStorageEntry(
+					"SuperOf",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(registry.Register(primitives.NewTupleTypeInfo(primitives.TypeId(metadata.TypesAddress32), pallet.DataTypeInfo)))),
+					"The super-identity of an alternative \"sub\" identity together with its name, within that context."),
+				primitives.NewMetadataModuleStorageEntry(
+					"SubsOf",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(registry.Register(primitives.NewTupleTypeInfo(primitives.TypeInfoU128, primitives.TypeId(metadata.TypesSequenceAddress32))))),
+					"Alternative \"sub\" identities of this account."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Registrars",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(primitives.NewSequenceTypeInfo(primitives.NewOptionTypeInfo(pallet.RegistrarInfoTypeInfo))))),
+					"The set of registrars. Not expected to get very big as can only be added through a special origin (likely a council motion)."),
+			},
+		}),
+		Call:  sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(im.callsTypeInfo()))),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(eventTypeInfo()))),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"BasicDeposit",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(identity.BasicDeposit).Bytes()),
+				"The amount held on deposit for a registered identity.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"ByteDeposit",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(identity.ByteDeposit).Bytes()),
+				"The amount held on deposit per encoded byte for a registered identity.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"SubAccountDeposit",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(identity.SubAccountDeposit).Bytes()),
+				"The amount held on deposit for a registered subaccount.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxSubAccounts",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(identity.MaxSubAccounts).Bytes()),
+				"The maximum number of sub-accounts allowed per identified account.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxAdditionalFields",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(identity.MaxAdditionalFields).Bytes()),
+				"Maximum number of additional fields that may be stored in an ID.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxRegistrars",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(identity.MaxRegistrars).Bytes()),
+				"Maximum number of registrars allowed in the system.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(errorsTypeInfo()))),
+		Index: identity.ModuleIndex,
+	}
+}
+
+func (im IdentityModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_identity", "pallet", "Call"}, im.functions)
+}
+
+func eventTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_identity", "pallet", "Event"},
+		"The events of the module.",
+		primitives.NewVariantTypeInfo("IdentitySet", events.EventIdentitySet, "A name was set or reset (which will remove all judgements).",
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("IdentityCleared", events.EventIdentityCleared, "A name was cleared, and the given balance returned.",
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("deposit", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("IdentityKilled", events.EventIdentityKilled, "A name was removed and the given balance slashed.",
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("deposit", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("JudgementRequested", events.EventJudgementRequested, "A judgement was asked from a registrar.",
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("registrar_index", "RegistrarIndex", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("JudgementUnrequested", events.EventJudgementUnrequested, "A judgement request was retracted.",
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("registrar_index", "RegistrarIndex", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("JudgementGiven", events.EventJudgementGiven, "A judgement was given by a registrar.",
+			primitives.NewFieldTypeInfo("target", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("registrar_index", "RegistrarIndex", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("RegistrarAdded", events.EventRegistrarAdded, "A registrar was added.",
+			primitives.NewFieldTypeInfo("registrar_index", "RegistrarIndex", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("SubIdentityAdded", events.EventSubIdentityAdded, "A sub-identity was added to an identity and the deposit paid.",
+			primitives.NewFieldTypeInfo("sub", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("main", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("deposit", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("SubIdentityRemoved", events.EventSubIdentityRemoved, "A sub-identity was removed from an identity and the deposit freed.",
+			primitives.NewFieldTypeInfo("sub", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("main", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("deposit", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("SubIdentityRevoked", events.EventSubIdentityRevoked, "A sub-identity was cleared, and the given deposit repatriated from the main identity account to the sub-identity account.",
+			primitives.NewFieldTypeInfo("sub", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("main", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("deposit", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+	)
+}
+
+func errorsTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_identity", "pallet", "Error"},
+		"The errors of the module.",
+		primitives.NewVariantTypeInfo("TooManySubAccounts", errors.ErrorTooManySubAccounts, "Too many subs-accounts."),
+		primitives.NewVariantTypeInfo("NotFound", errors.ErrorNotFound, "Account isn't found."),
+		primitives.NewVariantTypeInfo("NotNamed", errors.ErrorNotNamed, "Account isn't named."),
+		primitives.NewVariantTypeInfo("EmptyIndex", errors.ErrorEmptyIndex, "Empty index."),
+		primitives.NewVariantTypeInfo("FeeChanged", errors.ErrorFeeChanged, "Fee is changed."),
+		primitives.NewVariantTypeInfo("NoIdentity", errors.ErrorNoIdentity, "No identity found."),
+		primitives.NewVariantTypeInfo("StickyJudgement", errors.ErrorStickyJudgement, "Sticky judgement."),
+		primitives.NewVariantTypeInfo("JudgementGiven", errors.ErrorJudgementGiven, "Judgement given."),
+		primitives.NewVariantTypeInfo("InvalidJudgement", errors.ErrorInvalidJudgement, "Invalid judgement."),
+		primitives.NewVariantTypeInfo("InvalidIndex", errors.ErrorInvalidIndex, "The index is invalid."),
+		primitives.NewVariantTypeInfo("InvalidTarget", errors.ErrorInvalidTarget, "The target is invalid."),
+		primitives.NewVariantTypeInfo("TooManyFields", errors.ErrorTooManyFields, "Too many additional fields."),
+		primitives.NewVariantTypeInfo("TooManyRegistrars", errors.ErrorTooManyRegistrars, "Maximum amount of registrars reached. Cannot add any more."),
+		primitives.NewVariantTypeInfo("AlreadyClaimed", errors.ErrorAlreadyClaimed, "Account ID is already named."),
+		primitives.NewVariantTypeInfo("NotSub", errors.ErrorNotSub, "Sender is not a sub-account."),
+		primitives.NewVariantTypeInfo("NotOwned", errors.ErrorNotOwned, "Sub-account isn't owned by sender."),
+		primitives.NewVariantTypeInfo("JudgementForDifferentIdentity", errors.ErrorJudgementForDifferentIdentity, "The provided judgement was for a different identity."),
+	)
+}
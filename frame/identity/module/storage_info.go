@@ -0,0 +1,17 @@
+package module
+
+import (
+	pallet "github.com/LimeChain/gosemble/frame/identity"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageInfo describes the storage items of the module whose values have a maximum encoded length.
+// The sizes of the map entries include their hashed keys.
+func (im IdentityModule) StorageInfo() []primitives.StorageInfo {
+	return []primitives.StorageInfo{
+		pallet.StorageInfoIdentityOf,
+		pallet.StorageInfoSuperOf,
+		pallet.StorageInfoSubsOf,
+		pallet.StorageInfoRegistrars,
+	}
+}
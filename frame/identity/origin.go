@@ -0,0 +1,13 @@
+package identity
+
+import (
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// RegistrarOrigin is the origin which can add registrars.
+	RegistrarOrigin types.EnsureOrigin = system.EnsureRoot{}
+	// ForceOrigin is the origin which can remove identities and slash their deposits.
+	ForceOrigin types.EnsureOrigin = system.EnsureRoot{}
+)
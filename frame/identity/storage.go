@@ -0,0 +1,102 @@
+package identity
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetIdentityOf returns the identity of an account.
+func StorageGetIdentityOf(who types.AccountId) sc.Option[types.Registration] {
+	option := storage.Get(keyIdentityOf(who))
+	if !option.HasValue {
+		return sc.NewOption[types.Registration](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.Registration](types.DecodeRegistration(buffer))
+}
+
+func StorageSetIdentityOf(who types.AccountId, registration types.Registration) {
+	storage.Set(keyIdentityOf(who), registration.Bytes())
+}
+
+func StorageClearIdentityOf(who types.AccountId) {
+	storage.Clear(keyIdentityOf(who))
+}
+
+// StorageGetSuperOf returns the parent account of a sub-account and the name of the sub-account.
+func StorageGetSuperOf(who types.AccountId) sc.Option[types.IdentitySubAccount] {
+	option := storage.Get(keySuperOf(who))
+	if !option.HasValue {
+		return sc.NewOption[types.IdentitySubAccount](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.IdentitySubAccount](types.DecodeIdentitySubAccount(buffer))
+}
+
+func StorageSetSuperOf(who types.AccountId, parent types.IdentitySubAccount) {
+	storage.Set(keySuperOf(who), parent.Bytes())
+}
+
+func StorageClearSuperOf(who types.AccountId) {
+	storage.Clear(keySuperOf(who))
+}
+
+// StorageGetSubsOf returns the sub-accounts of an account and the deposit reserved for them.
+func StorageGetSubsOf(who types.AccountId) types.IdentitySubs {
+	return storage.GetDecode(keySubsOf(who), types.DecodeIdentitySubs)
+}
+
+func StorageSetSubsOf(who types.AccountId, subs types.IdentitySubs) {
+	storage.Set(keySubsOf(who), subs.Bytes())
+}
+
+func StorageClearSubsOf(who types.AccountId) {
+	storage.Clear(keySubsOf(who))
+}
+
+// StorageGetRegistrars returns the registrars, indexed by registrar index.
+// A registrar which has been removed is left as an empty option.
+func StorageGetRegistrars() sc.Sequence[sc.Option[types.RegistrarInfo]] {
+	return storage.GetDecode(keyRegistrars(), func(buffer *bytes.Buffer) sc.Sequence[sc.Option[types.RegistrarInfo]] {
+		return sc.DecodeSequenceWith(buffer, func(buffer *bytes.Buffer) sc.Option[types.RegistrarInfo] {
+			return sc.DecodeOptionWith(buffer, types.DecodeRegistrarInfo)
+		})
+	})
+}
+
+func StorageSetRegistrars(registrars sc.Sequence[sc.Option[types.RegistrarInfo]]) {
+	storage.Set(keyRegistrars(), registrars.Bytes())
+}
+
+// blake2128Concat returns the key of `value` hashed with the blake2 128 concat hasher.
+func blake2128Concat(value []byte) []byte {
+	return append(hashing.Blake128(value), value...)
+}
+
+func keyIdentityOf(who types.AccountId) []byte {
+	key := append(hashing.Twox128(constants.KeyIdentity), hashing.Twox128(constants.KeyIdentityOf)...)
+	return append(key, blake2128Concat(sc.FixedSequenceU8ToBytes(who.FixedSequence))...)
+}
+
+func keySuperOf(who types.AccountId) []byte {
+	key := append(hashing.Twox128(constants.KeyIdentity), hashing.Twox128(constants.KeySuperOf)...)
+	return append(key, blake2128Concat(sc.FixedSequenceU8ToBytes(who.FixedSequence))...)
+}
+
+func keySubsOf(who types.AccountId) []byte {
+	key := append(hashing.Twox128(constants.KeyIdentity), hashing.Twox128(constants.KeySubsOf)...)
+	return append(key, blake2128Concat(sc.FixedSequenceU8ToBytes(who.FixedSequence))...)
+}
+
+func keyRegistrars() []byte {
+	return append(hashing.Twox128(constants.KeyIdentity), hashing.Twox128(constants.KeyRegistrars)...)
+}
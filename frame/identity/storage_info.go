@@ -0,0 +1,28 @@
+package identity
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// dataMaxSize is the maximum encoded length of a types.IdentityData, a raw value of 32 bytes or a hash.
+const dataMaxSize = 1 + types.IdentityDataMaxRawLength
+
+// registrationMaxSize is the maximum encoded length of a types.Registration: the judgements of
+// MaxRegistrars, the deposit and the info with MaxAdditionalFields, 7 data fields and the PGP fingerprint.
+const registrationMaxSize = 1 + identity.MaxRegistrars*(4+17) + 16 + 2 + identity.MaxAdditionalFields*2*dataMaxSize + 7*dataMaxSize + 21
+
+var (
+	// StorageInfoIdentityOf describes the `IdentityOf` map, whose entries are
+	// Blake2_128Concat(AccountId) ++ Registration.
+	StorageInfoIdentityOf = types.NewStorageMapInfo("Identity", "IdentityOf", sc.NewOption[sc.U32](nil), 48+registrationMaxSize)
+	// StorageInfoSuperOf describes the `SuperOf` map, whose entries are
+	// Blake2_128Concat(AccountId) ++ (AccountId, Data).
+	StorageInfoSuperOf = types.NewStorageMapInfo("Identity", "SuperOf", sc.NewOption[sc.U32](nil), 48+32+dataMaxSize)
+	// StorageInfoSubsOf describes the `SubsOf` map, whose entries are
+	// Blake2_128Concat(AccountId) ++ (Balance, BoundedVec<AccountId, MaxSubAccounts>).
+	StorageInfoSubsOf = types.NewStorageMapInfo("Identity", "SubsOf", sc.NewOption[sc.U32](nil), 48+16+2+identity.MaxSubAccounts*32)
+	// StorageInfoRegistrars describes the `Registrars` value, a BoundedVec<Option<RegistrarInfo>, MaxRegistrars>.
+	StorageInfoRegistrars = types.NewStorageValueInfo("Identity", "Registrars", 1+identity.MaxRegistrars*(1+32+16+8))
+)
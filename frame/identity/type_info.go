@@ -0,0 +1,88 @@
+package identity
+
+import (
+	"strconv"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// DataTypeInfo is the type info of types.IdentityData.
+	DataTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_identity", "types", "Data"},
+		"Either nothing, up to 32 bytes of raw data or a 32 byte hash of the data.",
+		dataVariants()...,
+	)
+	// IdentityInfoTypeInfo is the type info of types.IdentityInfo.
+	IdentityInfoTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_identity", "types", "IdentityInfo"},
+		"The information of an identity.",
+		types.NewFieldTypeInfo("additional", "BoundedVec<(Data, Data), FieldLimit>", types.NewSequenceTypeInfo(types.NewTupleTypeInfo(DataTypeInfo, DataTypeInfo))),
+		types.NewFieldTypeInfo("display", "Data", DataTypeInfo),
+		types.NewFieldTypeInfo("legal", "Data", DataTypeInfo),
+		types.NewFieldTypeInfo("web", "Data", DataTypeInfo),
+		types.NewFieldTypeInfo("riot", "Data", DataTypeInfo),
+		types.NewFieldTypeInfo("email", "Data", DataTypeInfo),
+		types.NewFieldTypeInfo("pgp_fingerprint", "Option<[u8; 20]>", types.NewOptionTypeInfo(types.TypeId(metadata.TypesFixedSequence20U8))),
+		types.NewFieldTypeInfo("image", "Data", DataTypeInfo),
+		types.NewFieldTypeInfo("twitter", "Data", DataTypeInfo),
+	)
+	// IdentityFieldsTypeInfo is the type info of the identity fields.
+	IdentityFieldsTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_identity", "types", "BitFlags"},
+		"The fields of an identity, as bit flags.",
+		types.NewFieldTypeInfo("", "IdentityFields", types.TypeInfoU64),
+	)
+	// JudgementTypeInfo is the type info of types.Judgement.
+	JudgementTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_identity", "types", "Judgement"},
+		"The judgement of a registrar on an identity.",
+		types.NewVariantTypeInfo("Unknown", types.JudgementUnknown, "Judgement.Unknown"),
+		types.NewVariantTypeInfo("FeePaid", types.JudgementFeePaid, "Judgement.FeePaid",
+			types.NewFieldTypeInfo("", "Balance", types.TypeInfoU128),
+		),
+		types.NewVariantTypeInfo("Reasonable", types.JudgementReasonable, "Judgement.Reasonable"),
+		types.NewVariantTypeInfo("KnownGood", types.JudgementKnownGood, "Judgement.KnownGood"),
+		types.NewVariantTypeInfo("OutOfDate", types.JudgementOutOfDate, "Judgement.OutOfDate"),
+		types.NewVariantTypeInfo("LowQuality", types.JudgementLowQuality, "Judgement.LowQuality"),
+		types.NewVariantTypeInfo("Erroneous", types.JudgementErroneous, "Judgement.Erroneous"),
+	)
+	// RegistrationTypeInfo is the type info of types.Registration.
+	RegistrationTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_identity", "types", "Registration"},
+		"The identity of an account, with the judgements on it and the deposit reserved for it.",
+		types.NewFieldTypeInfo("judgements", "BoundedVec<(RegistrarIndex, Judgement<Balance>), MaxJudgements>", types.NewSequenceTypeInfo(types.NewTupleTypeInfo(types.TypeInfoU32, JudgementTypeInfo))),
+		types.NewFieldTypeInfo("deposit", "Balance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("info", "IdentityInfo<MaxAdditionalFields>", IdentityInfoTypeInfo),
+	)
+	// RegistrarInfoTypeInfo is the type info of types.RegistrarInfo.
+	RegistrarInfoTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_identity", "types", "RegistrarInfo"},
+		"The information of a registrar.",
+		types.NewFieldTypeInfo("account", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("fee", "Balance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("fields", "IdentityFields", IdentityFieldsTypeInfo),
+	)
+)
+
+// dataVariants returns the variants of the identity data. The raw variants hold their bytes as
+// separate u8 fields, which is encoded the same as a fixed size byte array.
+func dataVariants() []types.VariantTypeInfo {
+	variants := []types.VariantTypeInfo{
+		types.NewVariantTypeInfo("None", types.IdentityDataNone, "Data.None"),
+	}
+
+	for length := 0; length <= types.IdentityDataMaxRawLength; length++ {
+		fields := []types.FieldTypeInfo{}
+		for i := 0; i < length; i++ {
+			fields = append(fields, types.NewFieldTypeInfo("", "", types.TypeInfoU8))
+		}
+
+		name := "Raw" + strconv.Itoa(length)
+		variants = append(variants, types.NewVariantTypeInfo(name, types.IdentityDataRaw+sc.U8(length), "Data."+name, fields...))
+	}
+
+	for i, name := range []string{"BlakeTwo256", "Sha256", "Keccak256", "ShaThree256"} {
+		variants = append(variants, types.NewVariantTypeInfo(name, types.IdentityDataBlakeTwo256+sc.U8(i), "Data."+name,
+			types.NewFieldTypeInfo("", "", types.TypeId(metadata.TypesFixedSequence32U8)),
+		))
+	}
+
+	return variants
+}
@@ -0,0 +1,127 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/im_online"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/im_online"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type HeartbeatCall struct {
+	primitives.Callable
+}
+
+func NewHeartbeatCall(args sc.VaryingData) HeartbeatCall {
+	call := HeartbeatCall{
+		Callable: primitives.Callable{
+			ModuleId:   im_online.ModuleIndex,
+			FunctionId: im_online.FunctionHeartbeatIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c HeartbeatCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeHeartbeat(buffer),
+		types.DecodeSr25519(buffer),
+	)
+	return c
+}
+
+func (c HeartbeatCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c HeartbeatCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c HeartbeatCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c HeartbeatCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ HeartbeatCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "heartbeat",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("heartbeat", "Heartbeat<T::BlockNumber>", pallet.HeartbeatTypeInfo),
+			primitives.NewFieldTypeInfo("signature", "<T::AuthorityId as RuntimeAppPublic>::Signature", primitives.TypeId(metadata.TypesSignatureSr25519)),
+		},
+		Docs: "Record that the sending authority is online in the current session.",
+	}
+}
+
+func (c HeartbeatCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ HeartbeatCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 78_400 nanoseconds.
+	r := constants.DbWeight.Reads(4)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoKeys, pallet.StorageInfoReceivedHeartbeats)
+	return types.WeightFromParts(80_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ HeartbeatCall) IsInherent() bool {
+	return false
+}
+
+func (_ HeartbeatCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ HeartbeatCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ HeartbeatCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ HeartbeatCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := heartbeat(origin, args[0].(types.Heartbeat))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// heartbeat records that the sending authority is online in the current session.
+// The dispatch origin for this call must be `None`, as heartbeats are submitted as unsigned transactions.
+func heartbeat(origin types.RuntimeOrigin, heartbeat types.Heartbeat) types.DispatchError {
+	err := system.EnsureNone{}.EnsureOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	return pallet.Heartbeat(heartbeat)
+}
@@ -0,0 +1,9 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// ImOnline module errors.
+const (
+	ErrorInvalidKey sc.U8 = iota
+	ErrorDuplicatedHeartbeat
+)
@@ -0,0 +1,53 @@
+package events
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/im_online"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ImOnline module events.
+const (
+	EventHeartbeatReceived sc.U8 = iota
+	EventAllGood
+	EventSomeOffline
+)
+
+func NewEventHeartbeatReceived(authorityId types.PublicKey) types.Event {
+	return types.NewEvent(im_online.ModuleIndex, EventHeartbeatReceived, authorityId)
+}
+
+func NewEventAllGood() types.Event {
+	return types.NewEvent(im_online.ModuleIndex, EventAllGood)
+}
+
+func NewEventSomeOffline(offline sc.Sequence[types.AccountId]) types.Event {
+	return types.NewEvent(im_online.ModuleIndex, EventSomeOffline, offline)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	module := sc.DecodeU8(buffer)
+	if module != im_online.ModuleIndex {
+		log.Critical("invalid im_online.Event module")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventHeartbeatReceived:
+		authorityId := types.DecodePublicKey(buffer)
+		return NewEventHeartbeatReceived(authorityId)
+	case EventAllGood:
+		return NewEventAllGood()
+	case EventSomeOffline:
+		offline := sc.DecodeSequenceWith(buffer, types.DecodeAccountId)
+		return NewEventSomeOffline(offline)
+	default:
+		log.Critical("invalid im_online.Event type")
+	}
+
+	panic("unreachable")
+}
@@ -0,0 +1,24 @@
+package im_online
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OnInitialize reports the authorities that were offline in the ending session and starts
+// tracking the heartbeats of the authorities of the new one, at the start of every session period.
+// It runs before the staking hook, so offences are reported before the session is rotated.
+func OnInitialize(n types.BlockNumber) types.Weight {
+	if n == 0 || n%staking.SessionPeriod != 0 {
+		return constants.DbWeight.Reads(0)
+	}
+
+	keys := sc.U64(len(StorageGetKeys()))
+
+	endSession(currentSessionIndex() - 1)
+	startSession(n)
+
+	return constants.DbWeight.ReadsWrites(keys+2, 3)
+}
@@ -0,0 +1,134 @@
+package im_online
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/im_online"
+	"github.com/LimeChain/gosemble/constants/staking"
+	"github.com/LimeChain/gosemble/frame/aura"
+	"github.com/LimeChain/gosemble/frame/im_online/errors"
+	"github.com/LimeChain/gosemble/frame/im_online/events"
+	stakingpallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Heartbeat records that the authority at `heartbeat.AuthorityIndex` is online in the current session.
+// The signature of the heartbeat is checked in `ValidateHeartbeat` before it is dispatched.
+func Heartbeat(heartbeat types.Heartbeat) types.DispatchError {
+	session := currentSessionIndex()
+	keys := StorageGetKeys()
+
+	if heartbeat.SessionIndex != session || int(heartbeat.AuthorityIndex) >= len(keys) {
+		return newImOnlineError(errors.ErrorInvalidKey)
+	}
+
+	if StorageGetReceivedHeartbeats(session, heartbeat.AuthorityIndex) {
+		return newImOnlineError(errors.ErrorDuplicatedHeartbeat)
+	}
+
+	StorageSetReceivedHeartbeats(session, heartbeat.AuthorityIndex)
+
+	system.DepositEvent(events.NewEventHeartbeatReceived(keys[heartbeat.AuthorityIndex].FixedSequence))
+
+	return nil
+}
+
+// ValidateHeartbeat accepts only heartbeats of the current session, signed by one of its
+// authorities, that were not received yet.
+func ValidateHeartbeat(heartbeat types.Heartbeat, signature types.Sr25519) (types.ValidTransaction, types.TransactionValidityError) {
+	session := currentSessionIndex()
+	if heartbeat.SessionIndex < session {
+		return types.ValidTransaction{}, types.NewTransactionValidityError(types.NewInvalidTransactionStale())
+	}
+	if heartbeat.SessionIndex > session {
+		return types.ValidTransaction{}, types.NewTransactionValidityError(types.NewInvalidTransactionFuture())
+	}
+
+	keys := StorageGetKeys()
+	if int(heartbeat.AuthorityIndex) >= len(keys) || heartbeat.ValidatorsLen != sc.U32(len(keys)) {
+		return types.ValidTransaction{}, types.NewTransactionValidityError(types.NewInvalidTransactionBadSigner())
+	}
+
+	if StorageGetReceivedHeartbeats(session, heartbeat.AuthorityIndex) {
+		return types.ValidTransaction{}, types.NewTransactionValidityError(types.NewInvalidTransactionStale())
+	}
+
+	if !signature.Verify(sc.BytesToSequenceU8(heartbeat.Bytes()), keys[heartbeat.AuthorityIndex].FixedSequence) {
+		return types.ValidTransaction{}, types.NewTransactionValidityError(types.NewInvalidTransactionBadProof())
+	}
+
+	return types.ValidTransaction{
+		Priority:  im_online.UnsignedPriority,
+		Requires:  sc.Sequence[types.TransactionTag]{},
+		Provides:  sc.Sequence[types.TransactionTag]{sc.BytesToSequenceU8(append(session.Bytes(), heartbeat.AuthorityIndex.Bytes()...))},
+		Longevity: types.TransactionLongevity(staking.SessionPeriod / 2),
+		Propagate: true,
+	}, nil
+}
+
+// endSession reports the authorities that did not send a heartbeat in `session`, and removes
+// the heartbeats received in it. There is no session module that maps the session keys of the
+// validators to their stashes, so the Aura key of an authority must be the account of its stash.
+// Offline authorities are reported with the account of their key, and those that are not elected
+// in the era of the session have no exposure and are not slashed.
+func endSession(session sc.U32) {
+	keys := StorageGetKeys()
+
+	offline := sc.Sequence[types.AccountId]{}
+	for i, key := range keys {
+		if !StorageGetReceivedHeartbeats(session, sc.U32(i)) {
+			offline = append(offline, types.AccountIdFromPublicKey(key.FixedSequence))
+		}
+	}
+
+	StorageClearReceivedHeartbeats(session)
+
+	if len(offline) == 0 {
+		system.DepositEvent(events.NewEventAllGood())
+		return
+	}
+
+	system.DepositEvent(events.NewEventSomeOffline(offline))
+
+	stakingpallet.ReportOffence(sc.Sequence[types.AccountId]{}, UnresponsivenessOffence{
+		Session:           session,
+		ValidatorSetCount: sc.U32(len(keys)),
+		Offline:           offline,
+	})
+}
+
+// startSession tracks the heartbeats of the current Aura authorities. Heartbeats are sent
+// after the middle of the session, so that authorities which go offline early are reported.
+func startSession(n types.BlockNumber) {
+	keys := sc.Sequence[types.Address32]{}
+	for _, authority := range aura.StorageGetAuthorities() {
+		keys = append(keys, types.Address32{FixedSequence: authority})
+	}
+
+	StorageSetKeys(keys)
+	StorageSetHeartbeatAfter(n + staking.SessionPeriod/2)
+}
+
+func currentSessionIndex() sc.U32 {
+	return stakingpallet.CurrentSessionIndex(system.StorageGetBlockNumber())
+}
+
+func containsKey(keys sc.Sequence[sc.FixedSequence[sc.U8]], key types.Address32) bool {
+	for _, k := range keys {
+		if reflect.DeepEqual(k, key.FixedSequence) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newImOnlineError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   im_online.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
@@ -0,0 +1,122 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/im_online"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	pallet "github.com/LimeChain/gosemble/frame/im_online"
+	"github.com/LimeChain/gosemble/frame/im_online/dispatchables"
+	"github.com/LimeChain/gosemble/frame/im_online/errors"
+	"github.com/LimeChain/gosemble/frame/im_online/events"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ImOnlineModule struct {
+	functions map[sc.U8]primitives.Call
+}
+
+func NewImOnlineModule() ImOnlineModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[im_online.FunctionHeartbeatIndex] = dispatchables.NewHeartbeatCall(nil)
+
+	return ImOnlineModule{
+		functions: functions,
+	}
+}
+
+func (iom ImOnlineModule) Functions() map[sc.U8]primitives.Call {
+	return iom.functions
+}
+
+// PreDispatch performs the same checks as ValidateUnsigned, as the heartbeat may have become
+// invalid since it entered the transaction pool.
+func (iom ImOnlineModule) PreDispatch(call primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	_, err := iom.ValidateUnsigned(primitives.NewTransactionSourceInBlock(), call)
+	return sc.Empty{}, err
+}
+
+// ValidateUnsigned accepts only heartbeats of the current session signed by one of its authorities.
+func (iom ImOnlineModule) ValidateUnsigned(_ primitives.TransactionSource, call primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	if call.FunctionIndex() != im_online.FunctionHeartbeatIndex {
+		return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionCall())
+	}
+
+	args := call.Args()
+
+	return pallet.ValidateHeartbeat(args[0].(primitives.Heartbeat), args[1].(primitives.Sr25519))
+}
+
+// OffchainWorker sends the heartbeats of the local authorities.
+func (iom ImOnlineModule) OffchainWorker(n primitives.BlockNumber) {
+	pallet.OffchainWorker(n)
+}
+
+// OnInitialize reports the authorities which were offline in the ending session at the start of each session.
+func (iom ImOnlineModule) OnInitialize(n primitives.BlockNumber) primitives.Weight {
+	return pallet.OnInitialize(n)
+}
+
+func (iom ImOnlineModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Heartbeats of the validators, reporting the unresponsive ones as offline."}
+}
+
+func (iom ImOnlineModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "ImOnline",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "ImOnline",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"HeartbeatAfter",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+					"The block number after which it's ok to send heartbeats in the current session."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Keys",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceAddress32)),
+					"The current set of keys that may issue a heartbeat."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ReceivedHeartbeats",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64, primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.TypesTupleU32U32),
+						sc.ToCompact(metadata.PrimitiveTypesBool)),
+					"For each session index, we keep a mapping of `SessionIndex` and `AuthIndex`."),
+			},
+		}),
+		Call:      sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(iom.callsTypeInfo()))),
+		Event:     sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(eventTypeInfo()))),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(errorsTypeInfo()))),
+		Index:     im_online.ModuleIndex,
+	}
+}
+
+func (iom ImOnlineModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_im_online", "pallet", "Call"}, iom.functions)
+}
+
+func eventTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_im_online", "pallet", "Event"},
+		"The events of the module.",
+		primitives.NewVariantTypeInfo("HeartbeatReceived", events.EventHeartbeatReceived, "A new heartbeat was received from `AuthorityId`.",
+			primitives.NewFieldTypeInfo("authority_id", "T::AuthorityId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("AllGood", events.EventAllGood, "At the end of the session, no offence was committed."),
+		primitives.NewVariantTypeInfo("SomeOffline", events.EventSomeOffline, "At the end of the session, at least one validator was found to be offline.",
+			primitives.NewFieldTypeInfo("offline", "Vec<T::AuthorityId>", primitives.TypeId(metadata.TypesSequenceAddress32)),
+		),
+	)
+}
+
+func errorsTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_im_online", "pallet", "Error"},
+		"The errors of the module.",
+		primitives.NewVariantTypeInfo("InvalidKey", errors.ErrorInvalidKey, "Non existent public key."),
+		primitives.NewVariantTypeInfo("DuplicatedHeartbeat", errors.ErrorDuplicatedHeartbeat, "Duplicated heartbeat."),
+	)
+}
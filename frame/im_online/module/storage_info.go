@@ -0,0 +1,16 @@
+package module
+
+import (
+	pallet "github.com/LimeChain/gosemble/frame/im_online"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageInfo describes the storage items of the module whose values have a maximum encoded length.
+// The sizes of the map entries include their hashed keys.
+func (iom ImOnlineModule) StorageInfo() []primitives.StorageInfo {
+	return []primitives.StorageInfo{
+		pallet.StorageInfoHeartbeatAfter,
+		pallet.StorageInfoKeys,
+		pallet.StorageInfoReceivedHeartbeats,
+	}
+}
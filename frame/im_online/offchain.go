@@ -0,0 +1,60 @@
+package im_online
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/im_online"
+	"github.com/LimeChain/gosemble/frame/system/offchain"
+	"github.com/LimeChain/gosemble/primitives/crypto"
+	"github.com/LimeChain/gosemble/primitives/log"
+	offchainprimitives "github.com/LimeChain/gosemble/primitives/offchain"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OffchainWorker sends a heartbeat for each authority of the current session whose key is in the
+// local keystore, once the middle of the session is reached.
+func OffchainWorker(n types.BlockNumber) {
+	if !offchainprimitives.IsValidator() || n < StorageGetHeartbeatAfter() {
+		return
+	}
+
+	keys := StorageGetKeys()
+	if len(keys) == 0 {
+		return
+	}
+
+	session := currentSessionIndex()
+	localKeys := crypto.ExtCryptoSr25519PublicKeysVersion1(im_online.KeyTypeId[:])
+
+	for i, key := range keys {
+		authorityIndex := sc.U32(i)
+		if !containsKey(localKeys, key) || bool(StorageGetReceivedHeartbeats(session, authorityIndex)) {
+			continue
+		}
+
+		heartbeat := types.Heartbeat{
+			BlockNumber:    n,
+			SessionIndex:   session,
+			AuthorityIndex: authorityIndex,
+			ValidatorsLen:  sc.U32(len(keys)),
+		}
+
+		signature := crypto.ExtCryptoSr25519SignVersion1(im_online.KeyTypeId[:], sc.FixedSequenceU8ToBytes(key.FixedSequence), heartbeat.Bytes())
+		if !signature.HasValue {
+			log.Warn("failed to sign heartbeat")
+			continue
+		}
+
+		if err := offchain.SendUnsignedTransaction(heartbeatCall(heartbeat, types.NewSr25519(signature.Value...))); err != nil {
+			log.Warn("failed to submit heartbeat: " + err.Error())
+		}
+	}
+}
+
+// heartbeatCall returns the heartbeat call, signed by the authority that sends it.
+func heartbeatCall(heartbeat types.Heartbeat, signature types.Sr25519) types.Callable {
+	return types.Callable{
+		ModuleId:   im_online.ModuleIndex,
+		FunctionId: im_online.FunctionHeartbeatIndex,
+		Arguments:  sc.NewVaryingData(heartbeat, signature),
+	}
+}
@@ -0,0 +1,41 @@
+package im_online
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// UnresponsivenessOffence is committed by the authorities that did not send a heartbeat in a session.
+type UnresponsivenessOffence struct {
+	// Session is the session in which the authorities were offline.
+	Session sc.U32
+	// ValidatorSetCount is the number of authorities in the session.
+	ValidatorSetCount sc.U32
+	// Offline are the authorities that were offline.
+	Offline sc.Sequence[types.AccountId]
+}
+
+func (o UnresponsivenessOffence) Offenders() sc.Sequence[types.AccountId] {
+	return o.Offline
+}
+
+func (o UnresponsivenessOffence) SessionIndex() sc.U32 {
+	return o.Session
+}
+
+// SlashFraction returns `min((3 * (k - (n / 10 + 1))) / n, 1) * 7%`, where `k` is the number of
+// offenders and `n` the number of authorities. Up to 10% of the authorities can be offline without
+// being slashed, after which the slash grows linearly up to 7%.
+func (o UnresponsivenessOffence) SlashFraction(offendersCount sc.U32, validatorSetCount sc.U32) types.Permill {
+	threshold := validatorSetCount/10 + 1
+	if validatorSetCount == 0 || offendersCount <= threshold {
+		return types.Permill{}
+	}
+
+	parts := uint64(3*(offendersCount-threshold)) * 1_000_000 / uint64(validatorSetCount)
+	if parts > 1_000_000 {
+		parts = 1_000_000
+	}
+
+	return types.Permill{Parts: sc.U32(parts * 7 / 100)}
+}
@@ -0,0 +1,68 @@
+package im_online
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetHeartbeatAfter returns the block number after which it's ok to send heartbeats in the current session.
+func StorageGetHeartbeatAfter() types.BlockNumber {
+	return storage.GetDecode(keyHeartbeatAfter(), sc.DecodeU32)
+}
+
+func StorageSetHeartbeatAfter(n types.BlockNumber) {
+	storage.Set(keyHeartbeatAfter(), n.Bytes())
+}
+
+// StorageGetKeys returns the authorities of the current session.
+func StorageGetKeys() sc.Sequence[types.Address32] {
+	return storage.GetDecode(keyKeys(), func(buffer *bytes.Buffer) sc.Sequence[types.Address32] {
+		return sc.DecodeSequenceWith(buffer, types.DecodeAddress32)
+	})
+}
+
+func StorageSetKeys(keys sc.Sequence[types.Address32]) {
+	storage.Set(keyKeys(), keys.Bytes())
+}
+
+// StorageGetReceivedHeartbeats returns whether a heartbeat was received from the authority at
+// `authorityIndex` in `session`.
+func StorageGetReceivedHeartbeats(session sc.U32, authorityIndex sc.U32) sc.Bool {
+	return storage.GetDecode(keyReceivedHeartbeats(session, authorityIndex), sc.DecodeBool)
+}
+
+func StorageSetReceivedHeartbeats(session sc.U32, authorityIndex sc.U32) {
+	storage.Set(keyReceivedHeartbeats(session, authorityIndex), sc.Bool(true).Bytes())
+}
+
+// StorageClearReceivedHeartbeats removes the heartbeats received in `session`.
+func StorageClearReceivedHeartbeats(session sc.U32) {
+	storage.ClearPrefix(keyReceivedHeartbeatsPrefix(session), sc.NewOption[sc.U32](nil).Bytes())
+}
+
+func keyHeartbeatAfter() []byte {
+	return append(hashing.Twox128(constants.KeyImOnline), hashing.Twox128(constants.KeyHeartbeatAfter)...)
+}
+
+func keyKeys() []byte {
+	return append(hashing.Twox128(constants.KeyImOnline), hashing.Twox128(constants.KeyKeys)...)
+}
+
+func keyReceivedHeartbeatsPrefix(session sc.U32) []byte {
+	key := append(hashing.Twox128(constants.KeyImOnline), hashing.Twox128(constants.KeyReceivedHeartbeats)...)
+	return append(key, twox64Concat(session.Bytes())...)
+}
+
+func keyReceivedHeartbeats(session sc.U32, authorityIndex sc.U32) []byte {
+	return append(keyReceivedHeartbeatsPrefix(session), twox64Concat(authorityIndex.Bytes())...)
+}
+
+// twox64Concat returns the key of `value` hashed with the twox 64 concat hasher.
+func twox64Concat(value []byte) []byte {
+	return append(hashing.Twox64(value), value...)
+}
@@ -0,0 +1,18 @@
+package im_online
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/aura"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// StorageInfoHeartbeatAfter describes the `HeartbeatAfter` value.
+	StorageInfoHeartbeatAfter = types.NewStorageValueInfo("ImOnline", "HeartbeatAfter", 4)
+	// StorageInfoKeys describes the `Keys` value, the keys of the Aura authorities, of which
+	// there are at most MaxAuthorities.
+	StorageInfoKeys = types.NewStorageValueInfo("ImOnline", "Keys", 2+aura.MaxAuthorities*32)
+	// StorageInfoReceivedHeartbeats describes the `ReceivedHeartbeats` map, whose entries are
+	// Twox64Concat(SessionIndex) ++ Twox64Concat(AuthIndex) ++ bool.
+	StorageInfoReceivedHeartbeats = types.NewStorageMapInfo("ImOnline", "ReceivedHeartbeats", sc.NewOption[sc.U32](nil), 12+12+1)
+)
@@ -0,0 +1,17 @@
+package im_online
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// HeartbeatTypeInfo is the type info of types.Heartbeat.
+	HeartbeatTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_im_online", "Heartbeat"},
+		"Sent by an authority to prove that it is online in the current session.",
+		types.NewFieldTypeInfo("block_number", "BlockNumber", types.TypeInfoU32),
+		types.NewFieldTypeInfo("session_index", "SessionIndex", types.TypeInfoU32),
+		types.NewFieldTypeInfo("authority_index", "AuthIndex", types.TypeInfoU32),
+		types.NewFieldTypeInfo("validators_len", "u32", types.TypeInfoU32),
+	)
+)
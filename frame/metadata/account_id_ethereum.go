@@ -0,0 +1,17 @@
+//go:build ethereum
+
+package metadata
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// accountIdType returns the metadata type of the 20 byte Ethereum account id. It keeps the
+// `TypesAddress32` id, which all modules reference as the account id.
+func accountIdType() primitives.MetadataType {
+	return primitives.NewMetadataTypeWithPath(metadata.TypesAddress32, "Address20", sc.Sequence[sc.Str]{"fp_account", "AccountId20"}, primitives.NewMetadataTypeDefinitionComposite(
+		sc.Sequence[primitives.MetadataTypeDefinitionField]{primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesFixedSequence20U8, "[u8; 20]")},
+	))
+}
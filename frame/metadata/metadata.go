@@ -3,41 +3,174 @@ package metadata
 import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/config"
-	"github.com/LimeChain/gosemble/constants/balances"
-	"github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/constants/collective"
 	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/constants/system"
-	"github.com/LimeChain/gosemble/constants/timestamp"
-	"github.com/LimeChain/gosemble/constants/transaction_payment"
 	"github.com/LimeChain/gosemble/execution/types"
+	collectivepallet "github.com/LimeChain/gosemble/frame/collective"
+	"github.com/LimeChain/gosemble/primitives/api"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
-	"github.com/LimeChain/gosemble/utils"
 )
 
-// Metadata returns the metadata of the runtime.
-// Returns a pointer-size of the SCALE-encoded metadata of the runtime.
+// Api is the `Metadata` runtime API, which returns the metadata of the runtime.
+var Api = api.New("Metadata", 2, "The `Metadata` api trait that returns metadata for the runtime.",
+	api.NewMethod("metadata",
+		primitives.TypeId(metadata.TypesSequenceU8),
+		"Returns the metadata of a runtime.",
+		Metadata),
+	api.NewMethod1("metadata_at_version",
+		api.NewArg("version", primitives.TypeInfoU32, sc.DecodeU32),
+		primitives.TypeId(metadata.TypesOptionSequenceU8),
+		"Returns the metadata at a given version, if the version is supported by the runtime.",
+		MetadataAtVersion),
+	api.NewMethod("metadata_versions",
+		primitives.NewSequenceTypeInfo(primitives.TypeInfoU32),
+		"Returns the supported metadata versions.",
+		MetadataVersions),
+)
+
+// Metadata returns the SCALE-encoded metadata of the runtime.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#sect-rte-metadata-metadata)
-func Metadata() int64 {
-	metadata := buildMetadata()
-	bMetadata := sc.BytesToSequenceU8(metadata.Bytes())
+func Metadata() sc.Sequence[sc.U8] {
+	return sc.BytesToSequenceU8(buildMetadata().Bytes())
+}
+
+// MetadataAtVersion returns the SCALE-encoded metadata of the runtime at a given version,
+// which is none if the version is not supported.
+func MetadataAtVersion(version sc.U32) sc.Option[sc.Sequence[sc.U8]] {
+	switch version {
+	case sc.U32(primitives.MetadataVersion):
+		return sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(buildMetadata().Bytes()))
+	case sc.U32(primitives.MetadataVersion15):
+		return sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(buildMetadataV15().Bytes()))
+	default:
+		return sc.NewOption[sc.Sequence[sc.U8]](nil)
+	}
+}
 
-	return utils.BytesToOffsetAndSize(bMetadata.Bytes())
+// MetadataVersions returns the metadata versions supported by the runtime.
+func MetadataVersions() sc.Sequence[sc.U32] {
+	return primitives.MetadataVersions
 }
 
 func buildMetadata() primitives.Metadata {
-	metadataTypes := append(primitiveTypes(), basicTypes()...)
-	metadataTypes = append(metadataTypes, runtimeTypes()...)
+	registry, modules := buildModules()
 
-	var modules sc.Sequence[primitives.MetadataModule]
+	var v14Modules sc.Sequence[primitives.MetadataModule]
+	for _, module := range modules {
+		v14Modules = append(v14Modules, module.module)
+	}
 
-	for _, module := range config.Modules {
-		mTypes, mModule := module.Metadata()
+	runtimeV14Metadata := primitives.RuntimeMetadataV14{
+		Types:     registry.Types(),
+		Modules:   v14Modules,
+		Extrinsic: extrinsic(),
+		Type:      sc.ToCompact(metadata.Runtime),
+	}
 
-		metadataTypes = append(metadataTypes, mTypes...)
-		modules = append(modules, mModule)
+	return primitives.NewMetadata(runtimeV14Metadata)
+}
+
+func buildMetadataV15() primitives.MetadataV15 {
+	registry, modules := buildModules()
+	apis := runtimeApis(registry)
+
+	var v15Modules sc.Sequence[primitives.MetadataModuleV15]
+	for _, module := range modules {
+		v15Modules = append(v15Modules, primitives.NewMetadataModuleV15(module.module, module.docs))
 	}
 
-	extrinsic := primitives.MetadataExtrinsic{
+	v14Extrinsic := extrinsic()
+	extrinsicV15 := primitives.MetadataExtrinsicV15{
+		Version:          v14Extrinsic.Version,
+		Address:          sc.ToCompact(metadata.TypesMultiAddress),
+		Call:             sc.ToCompact(metadata.RuntimeCall),
+		Signature:        sc.ToCompact(metadata.TypesMultiSignature),
+		Extra:            sc.ToCompact(metadata.SignedExtra),
+		SignedExtensions: v14Extrinsic.SignedExtensions,
+	}
+
+	runtimeV15Metadata := primitives.RuntimeMetadataV15{
+		Types:     registry.Types(),
+		Modules:   v15Modules,
+		Extrinsic: extrinsicV15,
+		Type:      sc.ToCompact(metadata.Runtime),
+		Apis:      apis,
+		OuterEnums: primitives.OuterEnums{
+			Call:  sc.ToCompact(metadata.RuntimeCall),
+			Event: sc.ToCompact(metadata.TypesRuntimeEvent),
+			Error: sc.ToCompact(metadata.TypesRuntimeError),
+		},
+		Custom: customValues(),
+	}
+
+	return primitives.NewMetadataV15(runtimeV15Metadata)
+}
+
+type moduleMetadata struct {
+	module primitives.MetadataModule
+	docs   sc.Sequence[sc.Str]
+}
+
+// buildModules returns the registry of the metadata types and the metadata of the modules, in
+// the order of their indices. The types of the modules are registered in the registry, which
+// assigns their ids and deduplicates them. The System, Grandpa, Aura, TransactionPayment and
+// AssetTxPayment modules still add their types with hand-assigned ids from constants/metadata.
+func buildModules() (*primitives.MetadataTypeRegistry, []moduleMetadata) {
+	registry := primitives.NewMetadataTypeRegistry(metadata.FirstRegisteredType)
+	registry.Add(primitiveTypes()...)
+	registry.Add(basicTypes()...)
+	registry.Add(runtimeTypes(registry)...)
+	registry.Add(runtimeApiTypes()...)
+
+	var modules []moduleMetadata
+
+	for _, index := range config.ModuleIndices() {
+		mModule := config.Modules[index].Metadata(registry)
+
+		docs := sc.Sequence[sc.Str]{}
+		if documented, ok := config.Modules[index].(primitives.DocumentedModule); ok {
+			docs = documented.Docs()
+		}
+
+		modules = append(modules, moduleMetadata{module: mModule, docs: docs})
+	}
+
+	registry.Add(
+		outerEnumType(metadata.RuntimeCall, "RuntimeCall", "Call", modules, func(m primitives.MetadataModule) sc.Option[sc.Compact] { return m.Call }),
+		outerEnumType(metadata.TypesRuntimeEvent, "RuntimeEvent", "Events", modules, func(m primitives.MetadataModule) sc.Option[sc.Compact] { return m.Event }),
+		outerEnumType(metadata.TypesRuntimeError, "RuntimeError", "Errors", modules, func(m primitives.MetadataModule) sc.Option[sc.Compact] { return m.Error }),
+	)
+
+	return registry, modules
+}
+
+// outerEnumType returns the enum aggregating the calls, events or errors of all modules, which
+// have a type returned by `moduleType`.
+func outerEnumType(id int, name string, docs string, modules []moduleMetadata, moduleType func(primitives.MetadataModule) sc.Option[sc.Compact]) primitives.MetadataType {
+	variants := sc.Sequence[primitives.MetadataDefinitionVariant]{}
+
+	for _, module := range modules {
+		mType := moduleType(module.module)
+		if !mType.HasValue {
+			continue
+		}
+
+		moduleName := string(module.module.Name)
+		variants = append(variants, primitives.NewMetadataDefinitionVariant(
+			moduleName,
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionField(int(mType.Value.ToBigInt().Int64())),
+			},
+			module.module.Index,
+			docs+"."+moduleName))
+	}
+
+	return primitives.NewMetadataTypeWithPath(id, "node_template_runtime "+name, sc.Sequence[sc.Str]{"node_template_runtime", sc.Str(name)}, primitives.NewMetadataTypeDefinitionVariant(variants))
+}
+
+func extrinsic() primitives.MetadataExtrinsic {
+	return primitives.MetadataExtrinsic{
 		Type:    sc.ToCompact(metadata.UncheckedExtrinsic),
 		Version: types.ExtrinsicFormatVersion,
 		SignedExtensions: sc.Sequence[primitives.MetadataSignedExtension]{
@@ -48,18 +181,16 @@ func buildMetadata() primitives.Metadata {
 			primitives.NewMetadataSignedExtension("CheckMortality", metadata.CheckMortality, metadata.TypesH256),
 			primitives.NewMetadataSignedExtension("CheckNonce", metadata.CheckNonce, metadata.TypesEmptyTuple),
 			primitives.NewMetadataSignedExtension("CheckWeight", metadata.CheckWeight, metadata.TypesEmptyTuple),
-			primitives.NewMetadataSignedExtension("ChargeTransactionPayment", metadata.ChargeTransactionPayment, metadata.TypesEmptyTuple),
+			primitives.NewMetadataSignedExtension("ChargeAssetTxPayment", metadata.ChargeAssetTxPayment, metadata.TypesEmptyTuple),
 		},
 	}
+}
 
-	runtimeV14Metadata := primitives.RuntimeMetadataV14{
-		Types:     metadataTypes,
-		Modules:   modules,
-		Extrinsic: extrinsic,
-		Type:      sc.ToCompact(metadata.Runtime),
+// customValues returns the custom values of the metadata, ordered by name.
+func customValues() primitives.CustomMetadata {
+	return primitives.CustomMetadata{
+		primitives.NewCustomValueMetadata("SS58Prefix", metadata.PrimitiveTypesU16, sc.BytesToSequenceU8(system.SS58Prefix.Bytes())),
 	}
-
-	return primitives.NewMetadata(runtimeV14Metadata)
 }
 
 // primitiveTypes returns all primitive types
@@ -86,6 +217,7 @@ func primitiveTypes() sc.Sequence[primitives.MetadataType] {
 func basicTypes() sc.Sequence[primitives.MetadataType] {
 	return sc.Sequence[primitives.MetadataType]{
 		primitives.NewMetadataType(metadata.TypesFixedSequence4U8, "[4]byte", primitives.NewMetadataTypeDefinitionFixedSequence(4, sc.ToCompact(metadata.PrimitiveTypesU8))),
+		primitives.NewMetadataType(metadata.TypesFixedSequence8U8, "[8]byte", primitives.NewMetadataTypeDefinitionFixedSequence(8, sc.ToCompact(metadata.PrimitiveTypesU8))),
 		primitives.NewMetadataType(metadata.TypesFixedSequence20U8, "[20]byte", primitives.NewMetadataTypeDefinitionFixedSequence(20, sc.ToCompact(metadata.PrimitiveTypesU8))),
 		primitives.NewMetadataType(metadata.TypesFixedSequence32U8, "[32]byte", primitives.NewMetadataTypeDefinitionFixedSequence(32, sc.ToCompact(metadata.PrimitiveTypesU8))),
 		primitives.NewMetadataType(metadata.TypesFixedSequence64U8, "[64]byte", primitives.NewMetadataTypeDefinitionFixedSequence(64, sc.ToCompact(metadata.PrimitiveTypesU8))),
@@ -104,9 +236,7 @@ func basicTypes() sc.Sequence[primitives.MetadataType] {
 			primitives.NewMetadataTypeDefinitionComposite(sc.Sequence[primitives.MetadataTypeDefinitionField]{
 				primitives.NewMetadataTypeDefinitionField(metadata.TypesFixedSequence32U8)})),
 
-		primitives.NewMetadataTypeWithPath(metadata.TypesAddress32, "Address32", sc.Sequence[sc.Str]{"sp_core", "crypto", "AccountId32"}, primitives.NewMetadataTypeDefinitionComposite(
-			sc.Sequence[primitives.MetadataTypeDefinitionField]{primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesFixedSequence32U8, "[u8; 32]")},
-		)),
+		accountIdType(),
 
 		primitives.NewMetadataTypeWithPath(metadata.TypesAccountData, "AccountData", sc.Sequence[sc.Str]{"pallet_balances", "AccountData"}, primitives.NewMetadataTypeDefinitionComposite(
 			sc.Sequence[primitives.MetadataTypeDefinitionField]{
@@ -174,6 +304,10 @@ func basicTypes() sc.Sequence[primitives.MetadataType] {
 			primitives.NewMetadataTypeDefinitionComposite(
 				sc.Sequence[primitives.MetadataTypeDefinitionField]{primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesFixedSequence65U8, "[u8; 65]")},
 			)),
+		primitives.NewMetadataTypeWithPath(metadata.TypesSignatureEthereum, "SignatureEthereum", sc.Sequence[sc.Str]{"fp_account", "EthereumSignature"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesFixedSequence65U8, "[u8; 65]")},
+			)),
 		primitives.NewMetadataTypeWithPath(metadata.TypesMultiSignature, "MultiSignature", sc.Sequence[sc.Str]{"sp_runtime", "MultiSignature"},
 			primitives.NewMetadataTypeDefinitionVariant(
 				sc.Sequence[primitives.MetadataDefinitionVariant]{
@@ -198,6 +332,13 @@ func basicTypes() sc.Sequence[primitives.MetadataType] {
 						},
 						primitives.MultiSignatureEcdsa,
 						"MultiSignature.Ecdsa"),
+					primitives.NewMetadataDefinitionVariant(
+						"Ethereum",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSignatureEthereum, "EthereumSignature"),
+						},
+						primitives.MultiSignatureEthereum,
+						"MultiSignature.Ethereum"),
 				})),
 
 		primitives.NewMetadataType(metadata.TypesEmptyTuple, "EmptyTuple", primitives.NewMetadataTypeDefinitionTuple(
@@ -207,6 +348,132 @@ func basicTypes() sc.Sequence[primitives.MetadataType] {
 		primitives.NewMetadataType(metadata.TypesTupleU32U32, "(U32, U32)",
 			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.PrimitiveTypesU32), sc.ToCompact(metadata.PrimitiveTypesU32)})),
 
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionTupleU32U32, "Option<(U32, U32)>", sc.Sequence[sc.Str]{"Option"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"None",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					0,
+					"Option<(U32, U32)>(nil)"),
+				primitives.NewMetadataDefinitionVariant(
+					"Some",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesTupleU32U32),
+					},
+					1,
+					"Option<(U32, U32)>(value)"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.TypesTupleU32U32, "T")),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesDispatchResult, "Result<(), DispatchError>", sc.Sequence[sc.Str]{"Result"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Ok",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesEmptyTuple),
+					},
+					0,
+					"Result.Ok"),
+				primitives.NewMetadataDefinitionVariant(
+					"Err",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesDispatchError),
+					},
+					1,
+					"Result.Err"),
+			}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.TypesEmptyTuple, "T"),
+				primitives.NewMetadataTypeParameter(metadata.TypesDispatchError, "E"),
+			}),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesBounded, "Bounded", sc.Sequence[sc.Str]{"frame_support", "traits", "preimages", "Bounded"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Legacy",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "hash", "H::Output"),
+					},
+					primitives.BoundedLegacy,
+					"Bounded.Legacy"),
+				primitives.NewMetadataDefinitionVariant(
+					"Inline",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSequenceU8, "BoundedInline"),
+					},
+					primitives.BoundedInline,
+					"Bounded.Inline"),
+				primitives.NewMetadataDefinitionVariant(
+					"Lookup",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "hash", "H::Output"),
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "len", "u32"),
+					},
+					primitives.BoundedLookup,
+					"Bounded.Lookup"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.RuntimeCall, "T")),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesRawOrigin, "RawOrigin", sc.Sequence[sc.Str]{"frame_support", "dispatch", "RawOrigin"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Root",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					primitives.RawOriginRoot,
+					"RawOrigin.Root"),
+				primitives.NewMetadataDefinitionVariant(
+					"Signed",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesAddress32, "AccountId"),
+					},
+					primitives.RawOriginSigned,
+					"RawOrigin.Signed"),
+				primitives.NewMetadataDefinitionVariant(
+					"None",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					primitives.RawOriginNone,
+					"RawOrigin.None"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.TypesAddress32, "AccountId")),
+
+		primitives.NewMetadataType(metadata.TypesSequenceAddress32, "[]Address32", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesAddress32))),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionAddress32, "Option<Address32>", sc.Sequence[sc.Str]{"Option"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"None",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					0,
+					"Option<Address32>(nil)"),
+				primitives.NewMetadataDefinitionVariant(
+					"Some",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesAddress32),
+					},
+					1,
+					"Option<Address32>(value)"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.TypesAddress32, "T")),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionU32, "Option<U32>", sc.Sequence[sc.Str]{"Option"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"None",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					0,
+					"Option<U32>(nil)"),
+				primitives.NewMetadataDefinitionVariant(
+					"Some",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU32),
+					},
+					1,
+					"Option<U32>(value)"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU32, "T")),
+
+		primitives.NewMetadataType(metadata.TypesSequenceH256, "[]H256", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesH256))),
+
 		primitives.NewMetadataTypeWithParams(metadata.TypesMultiAddress, "MultiAddress", sc.Sequence[sc.Str]{"sp_runtime", "multiaddress", "MultiAddress"}, primitives.NewMetadataTypeDefinitionVariant(
 			sc.Sequence[primitives.MetadataDefinitionVariant]{
 				primitives.NewMetadataDefinitionVariant(
@@ -516,36 +783,24 @@ func basicTypes() sc.Sequence[primitives.MetadataType] {
 	}
 }
 
-func runtimeTypes() sc.Sequence[primitives.MetadataType] {
+func runtimeTypes(registry *primitives.MetadataTypeRegistry) sc.Sequence[primitives.MetadataType] {
 	return sc.Sequence[primitives.MetadataType]{
-		primitives.NewMetadataTypeWithPath(metadata.TypesRuntimeEvent, "node_template_runtime RuntimeEvent", sc.Sequence[sc.Str]{"node_template_runtime", "RuntimeEvent"}, primitives.NewMetadataTypeDefinitionVariant(
+		primitives.NewMetadataTypeWithPath(metadata.TypesOriginCaller, "node_template_runtime OriginCaller", sc.Sequence[sc.Str]{"node_template_runtime", "OriginCaller"}, primitives.NewMetadataTypeDefinitionVariant(
 			sc.Sequence[primitives.MetadataDefinitionVariant]{
 				primitives.NewMetadataDefinitionVariant(
-					"System",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSystemEvent, "frame_system::Event<Runtime>"),
-					},
-					system.ModuleIndex,
-					"Events.System"),
-				primitives.NewMetadataDefinitionVariant( // TODO:
-					"Grandpa",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-					grandpa.ModuleIndex,
-					"Events.Grandpa"),
-				primitives.NewMetadataDefinitionVariant(
-					"Balances",
+					"system",
 					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesBalancesEvent, "pallet_balances::Event<Runtime>"),
+						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesRawOrigin, "frame_system::Origin<Runtime>"),
 					},
-					balances.ModuleIndex,
-					"Events.Balances"),
+					primitives.OriginCallerSystem,
+					"OriginCaller.system"),
 				primitives.NewMetadataDefinitionVariant(
-					"TransactionPayment",
+					"Council",
 					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesTransactionPaymentEvent, "pallet_transaction_payment::Event<Runtime>"),
+						primitives.NewMetadataTypeDefinitionFieldWithName(registry.Register(collectivepallet.RawOriginTypeInfo), "pallet_collective::Origin<Runtime, pallet_collective::Instance1>"),
 					},
-					transaction_payment.ModuleIndex,
-					"Events.TransactionPayment"),
+					collective.ModuleIndex,
+					"OriginCaller.Council"),
 			})),
 
 		primitives.NewMetadataTypeWithPath(metadata.TypesRuntimeVersion, "sp_version RuntimeVersion", sc.Sequence[sc.Str]{"sp_version", "RuntimeVersion"}, primitives.NewMetadataTypeDefinitionComposite(
@@ -569,7 +824,7 @@ func runtimeTypes() sc.Sequence[primitives.MetadataType] {
 				sc.ToCompact(metadata.CheckMortality),
 				sc.ToCompact(metadata.CheckNonce),
 				sc.ToCompact(metadata.CheckWeight),
-				sc.ToCompact(metadata.ChargeTransactionPayment),
+				sc.ToCompact(metadata.ChargeAssetTxPayment),
 			})),
 
 		primitives.NewMetadataTypeWithParams(metadata.UncheckedExtrinsic, "UncheckedExtrinsic",
@@ -586,37 +841,6 @@ func runtimeTypes() sc.Sequence[primitives.MetadataType] {
 			},
 		),
 
-		primitives.NewMetadataTypeWithPath(metadata.RuntimeCall, "RuntimeCall", sc.Sequence[sc.Str]{"node_template_runtime", "RuntimeCall"},
-			primitives.NewMetadataTypeDefinitionVariant(sc.Sequence[primitives.MetadataDefinitionVariant]{
-				primitives.NewMetadataDefinitionVariant(
-					"System",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.SystemCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<System, Runtime>"),
-					},
-					system.ModuleIndex,
-					"Call.System"),
-				primitives.NewMetadataDefinitionVariant(
-					"Timestamp",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TimestampCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Timestamp, Runtime>"),
-					},
-					timestamp.ModuleIndex,
-					"Call.Timestamp"),
-				primitives.NewMetadataDefinitionVariant(
-					"Grandpa",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TimestampCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Grandpa, Runtime>"),
-					},
-					grandpa.ModuleIndex,
-					"Call.Grandpa"),
-				primitives.NewMetadataDefinitionVariant(
-					"Balances",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.BalancesCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Balances, Runtime>"),
-					},
-					balances.ModuleIndex,
-					"Call.Balances"),
-			})),
 		primitives.NewMetadataType(metadata.Runtime, "Runtime", primitives.NewMetadataTypeDefinitionComposite(
 			sc.Sequence[primitives.MetadataTypeDefinitionField]{})),
 	}
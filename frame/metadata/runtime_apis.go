@@ -0,0 +1,289 @@
+package metadata
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/api"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// runtimeApis returns the metadata of the runtime APIs registered by the runtime. The types of
+// their methods are registered in `registry`.
+func runtimeApis(registry *primitives.MetadataTypeRegistry) sc.Sequence[primitives.RuntimeApiMetadata] {
+	apis := sc.Sequence[primitives.RuntimeApiMetadata]{}
+	for _, a := range api.Registered() {
+		apis = append(apis, a.Metadata(registry))
+	}
+
+	return apis
+}
+
+// runtimeApiTypes returns the types of the inputs and outputs of the runtime APIs.
+func runtimeApiTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithParams(metadata.TypesHeader, "Header", sc.Sequence[sc.Str]{"sp_runtime", "generic", "header", "Header"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "parent_hash", "Hash::Output"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU32, "number", "Number"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "state_root", "Hash::Output"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "extrinsics_root", "Hash::Output"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDigest, "digest", "Digest"),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU32, "Number"),
+				primitives.NewMetadataEmptyTypeParameter("Hash"),
+			}),
+		primitives.NewMetadataType(metadata.TypesSequenceUncheckedExtrinsic, "Vec<UncheckedExtrinsic>", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.UncheckedExtrinsic))),
+		primitives.NewMetadataTypeWithParams(metadata.TypesBlock, "Block", sc.Sequence[sc.Str]{"sp_runtime", "generic", "block", "Block"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesHeader, "header", "Header"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceUncheckedExtrinsic, "extrinsics", "Vec<Extrinsic>"),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.TypesHeader, "Header"),
+				primitives.NewMetadataTypeParameter(metadata.UncheckedExtrinsic, "Extrinsic"),
+			}),
+
+		primitives.NewMetadataType(metadata.TypesTupleFixedSequence8U8SequenceU8, "([8]byte, []byte)", primitives.NewMetadataTypeDefinitionTuple(
+			sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesFixedSequence8U8), sc.ToCompact(metadata.TypesSequenceU8)})),
+		primitives.NewMetadataType(metadata.TypesSequenceTupleFixedSequence8U8SequenceU8, "[]([8]byte, []byte)", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleFixedSequence8U8SequenceU8))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesInherentData, "InherentData", sc.Sequence[sc.Str]{"sp_inherents", "InherentData"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceTupleFixedSequence8U8SequenceU8, "data", "BTreeMap<InherentIdentifier, Vec<u8>>"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesCheckInherentsResult, "CheckInherentsResult", sc.Sequence[sc.Str]{"sp_inherents", "CheckInherentsResult"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "okay", "bool"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "fatal_error", "bool"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesInherentData, "errors", "InherentData"),
+				})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesInvalidTransaction, "InvalidTransaction", sc.Sequence[sc.Str]{"sp_runtime", "transaction_validity", "InvalidTransaction"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant("Call", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionCall, "InvalidTransaction.Call"),
+				primitives.NewMetadataDefinitionVariant("Payment", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionPayment, "InvalidTransaction.Payment"),
+				primitives.NewMetadataDefinitionVariant("Future", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionFuture, "InvalidTransaction.Future"),
+				primitives.NewMetadataDefinitionVariant("Stale", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionStale, "InvalidTransaction.Stale"),
+				primitives.NewMetadataDefinitionVariant("BadProof", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionBadProof, "InvalidTransaction.BadProof"),
+				primitives.NewMetadataDefinitionVariant("AncientBirthBlock", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionAncientBirthBlock, "InvalidTransaction.AncientBirthBlock"),
+				primitives.NewMetadataDefinitionVariant("ExhaustsResources", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionExhaustsResources, "InvalidTransaction.ExhaustsResources"),
+				primitives.NewMetadataDefinitionVariant("Custom",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.PrimitiveTypesU8, "u8"),
+					},
+					primitives.InvalidTransactionCustom,
+					"InvalidTransaction.Custom"),
+				primitives.NewMetadataDefinitionVariant("BadMandatory", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionBadMandatory, "InvalidTransaction.BadMandatory"),
+				primitives.NewMetadataDefinitionVariant("MandatoryValidation", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionMandatoryValidation, "InvalidTransaction.MandatoryValidation"),
+				primitives.NewMetadataDefinitionVariant("BadSigner", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionBadSigner, "InvalidTransaction.BadSigner"),
+			})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesUnknownTransaction, "UnknownTransaction", sc.Sequence[sc.Str]{"sp_runtime", "transaction_validity", "UnknownTransaction"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant("CannotLookup", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.UnknownTransactionCannotLookup, "UnknownTransaction.CannotLookup"),
+				primitives.NewMetadataDefinitionVariant("NoUnsignedValidator", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.UnknownTransactionNoUnsignedValidator, "UnknownTransaction.NoUnsignedValidator"),
+				primitives.NewMetadataDefinitionVariant("Custom",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.PrimitiveTypesU8, "u8"),
+					},
+					primitives.UnknownTransactionCustomUnknownTransaction,
+					"UnknownTransaction.Custom"),
+			})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesTransactionValidityError, "TransactionValidityError", sc.Sequence[sc.Str]{"sp_runtime", "transaction_validity", "TransactionValidityError"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant("Invalid",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesInvalidTransaction, "InvalidTransaction"),
+					},
+					primitives.TransactionValidityErrorInvalidTransaction,
+					"TransactionValidityError.Invalid"),
+				primitives.NewMetadataDefinitionVariant("Unknown",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesUnknownTransaction, "UnknownTransaction"),
+					},
+					primitives.TransactionValidityErrorUnknownTransaction,
+					"TransactionValidityError.Unknown"),
+			})),
+		primitives.NewMetadataTypeWithParams(metadata.TypesApplyExtrinsicResult, "Result<DispatchResult, TransactionValidityError>", sc.Sequence[sc.Str]{"Result"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Ok",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesDispatchResult),
+					},
+					0,
+					"Result.Ok"),
+				primitives.NewMetadataDefinitionVariant(
+					"Err",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesTransactionValidityError),
+					},
+					1,
+					"Result.Err"),
+			}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.TypesDispatchResult, "T"),
+				primitives.NewMetadataTypeParameter(metadata.TypesTransactionValidityError, "E"),
+			}),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesTransactionSource, "TransactionSource", sc.Sequence[sc.Str]{"sp_runtime", "transaction_validity", "TransactionSource"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant("InBlock", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.TransactionSourceInBlock, "TransactionSource.InBlock"),
+				primitives.NewMetadataDefinitionVariant("Local", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.TransactionSourceLocal, "TransactionSource.Local"),
+				primitives.NewMetadataDefinitionVariant("External", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.TransactionSourceExternal, "TransactionSource.External"),
+			})),
+		primitives.NewMetadataType(metadata.TypesSequenceSequenceU8, "[][]byte", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesSequenceU8))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesValidTransaction, "ValidTransaction", sc.Sequence[sc.Str]{"sp_runtime", "transaction_validity", "ValidTransaction"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "priority", "TransactionPriority"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceSequenceU8, "requires", "Vec<TransactionTag>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceSequenceU8, "provides", "Vec<TransactionTag>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "longevity", "TransactionLongevity"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "propagate", "bool"),
+				})),
+		primitives.NewMetadataTypeWithParams(metadata.TypesTransactionValidity, "Result<ValidTransaction, TransactionValidityError>", sc.Sequence[sc.Str]{"Result"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Ok",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesValidTransaction),
+					},
+					0,
+					"Result.Ok"),
+				primitives.NewMetadataDefinitionVariant(
+					"Err",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesTransactionValidityError),
+					},
+					1,
+					"Result.Err"),
+			}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.TypesValidTransaction, "T"),
+				primitives.NewMetadataTypeParameter(metadata.TypesTransactionValidityError, "E"),
+			}),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionSequenceU8, "Option<[]byte>", sc.Sequence[sc.Str]{"Option"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"None",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					0,
+					"Option<[]byte>(nil)"),
+				primitives.NewMetadataDefinitionVariant(
+					"Some",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceU8),
+					},
+					1,
+					"Option<[]byte>(value)"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.TypesSequenceU8, "T")),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesKeyTypeId, "KeyTypeId", sc.Sequence[sc.Str]{"sp_core", "crypto", "KeyTypeId"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesFixedSequence4U8, "[u8; 4]"),
+				})),
+		primitives.NewMetadataType(metadata.TypesTupleSequenceU8KeyTypeId, "([]byte, KeyTypeId)", primitives.NewMetadataTypeDefinitionTuple(
+			sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesSequenceU8), sc.ToCompact(metadata.TypesKeyTypeId)})),
+		primitives.NewMetadataType(metadata.TypesSequenceTupleSequenceU8KeyTypeId, "[]([]byte, KeyTypeId)", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleSequenceU8KeyTypeId))),
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionSequenceTupleSequenceU8KeyTypeId, "Option<[]([]byte, KeyTypeId)>", sc.Sequence[sc.Str]{"Option"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"None",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					0,
+					"Option<[]([]byte, KeyTypeId)>(nil)"),
+				primitives.NewMetadataDefinitionVariant(
+					"Some",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceTupleSequenceU8KeyTypeId),
+					},
+					1,
+					"Option<[]([]byte, KeyTypeId)>(value)"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.TypesSequenceTupleSequenceU8KeyTypeId, "T")),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesAuraSlotDuration, "sp_consensus_slots SlotDuration", sc.Sequence[sc.Str]{"sp_consensus_slots", "SlotDuration"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU64),
+				})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesEd25519PubKey, "sp_core ed25519 Public", sc.Sequence[sc.Str]{"sp_core", "ed25519", "Public"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{primitives.NewMetadataTypeDefinitionField(metadata.TypesFixedSequence32U8)})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesGrandpaAuthorityId, "sp_consensus_grandpa app Public", sc.Sequence[sc.Str]{"sp_consensus_grandpa", "app", "Public"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{primitives.NewMetadataTypeDefinitionField(metadata.TypesEd25519PubKey)})),
+		primitives.NewMetadataType(metadata.TypesTupleGrandpaAuthorityIdU64, "(AuthorityId, U64)", primitives.NewMetadataTypeDefinitionTuple(
+			sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesGrandpaAuthorityId), sc.ToCompact(metadata.PrimitiveTypesU64)})),
+		primitives.NewMetadataType(metadata.TypesSequenceTupleGrandpaAuthorityIdU64, "[](AuthorityId, U64)", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleGrandpaAuthorityIdU64))),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesRuntimeDispatchInfo, "RuntimeDispatchInfo", sc.Sequence[sc.Str]{"pallet_transaction_payment", "types", "RuntimeDispatchInfo"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesWeight, "weight", "Weight"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchClass, "class", "DispatchClass"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "partial_fee", "Balance"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance")),
+		primitives.NewMetadataTypeWithParam(metadata.TypesInclusionFee, "InclusionFee", sc.Sequence[sc.Str]{"pallet_transaction_payment", "types", "InclusionFee"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "base_fee", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "len_fee", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "adjusted_weight_fee", "Balance"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance")),
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionInclusionFee, "Option<InclusionFee>", sc.Sequence[sc.Str]{"Option"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"None",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					0,
+					"Option<InclusionFee>(nil)"),
+				primitives.NewMetadataDefinitionVariant(
+					"Some",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesInclusionFee),
+					},
+					1,
+					"Option<InclusionFee>(value)"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.TypesInclusionFee, "T")),
+		primitives.NewMetadataTypeWithParam(metadata.TypesFeeDetails, "FeeDetails", sc.Sequence[sc.Str]{"pallet_transaction_payment", "types", "FeeDetails"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionInclusionFee, "inclusion_fee", "Option<InclusionFee<Balance>>"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU128, "Balance")),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesResultEmptyTupleString, "Result<(), String>", sc.Sequence[sc.Str]{"Result"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Ok",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesEmptyTuple),
+					},
+					0,
+					"Result.Ok"),
+				primitives.NewMetadataDefinitionVariant(
+					"Err",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesString),
+					},
+					1,
+					"Result.Err"),
+			}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.TypesEmptyTuple, "T"),
+				primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesString, "E"),
+			}),
+	}
+}
@@ -0,0 +1,134 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ApproveTransferCall struct {
+	primitives.Callable
+}
+
+func NewApproveTransferCall(args sc.VaryingData) ApproveTransferCall {
+	call := ApproveTransferCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionApproveTransferIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ApproveTransferCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeU32(buffer),
+		types.DecodeMultiAddress(buffer),
+		sc.DecodeOption[sc.U32](buffer),
+	)
+	return c
+}
+
+func (c ApproveTransferCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ApproveTransferCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ApproveTransferCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ApproveTransferCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ApproveTransferCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "approve_transfer",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("delegate", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("maybe_deadline", "Option<BlockNumberFor<T>>", primitives.TypeId(metadata.TypesOptionU32)),
+		},
+		Docs: "Approve an item to be transferred by a delegated third-party account.",
+	}
+}
+
+func (c ApproveTransferCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ApproveTransferCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 18_620 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoCollection, pallet.StorageInfoItem)
+	return types.WeightFromParts(19_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ApproveTransferCall) IsInherent() bool {
+	return false
+}
+
+func (_ ApproveTransferCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ApproveTransferCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ApproveTransferCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ApproveTransferCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := approveTransfer(origin, args[0].(sc.U32), args[1].(sc.U32), args[2].(types.MultiAddress), args[3].(sc.Option[sc.U32]))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// approveTransfer approves `delegate` to transfer an item, optionally for a number of blocks.
+// Must be called by the owner of the item.
+func approveTransfer(origin types.RuntimeOrigin, collection types.CollectionId, item types.ItemId, delegate types.MultiAddress, maybeDeadline sc.Option[types.BlockNumber]) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	delegateAccount, err := types.DefaultAccountIdLookup().Lookup(delegate)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.ApproveTransfer(collection, item, origin.AsSigned(), delegateAccount, maybeDeadline)
+}
@@ -0,0 +1,125 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type BurnCall struct {
+	primitives.Callable
+}
+
+func NewBurnCall(args sc.VaryingData) BurnCall {
+	call := BurnCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionBurnIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c BurnCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c BurnCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c BurnCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c BurnCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c BurnCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ BurnCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "burn",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+		},
+		Docs: "Destroy a single item.",
+	}
+}
+
+func (c BurnCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ BurnCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 43_120 nanoseconds.
+	r := constants.DbWeight.Reads(5)
+	w := constants.DbWeight.Writes(5)
+	// The attributes of an item are not bounded in number, so the proof covers one of them.
+	e := types.ProofWeight(pallet.StorageInfoCollection, pallet.StorageInfoItem, pallet.StorageInfoItemMetadataOf, pallet.StorageInfoAttribute, system.StorageInfoAccount)
+	return types.WeightFromParts(44_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ BurnCall) IsInherent() bool {
+	return false
+}
+
+func (_ BurnCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ BurnCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ BurnCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ BurnCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := burn(origin, args[0].(sc.U32), args[1].(sc.U32))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// burn destroys an item. Must be called by the owner of the item or the admin of the collection.
+func burn(origin types.RuntimeOrigin, collection types.CollectionId, item types.ItemId) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.Burn(collection, item, origin.AsSigned())
+}
@@ -0,0 +1,132 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CancelApprovalCall struct {
+	primitives.Callable
+}
+
+func NewCancelApprovalCall(args sc.VaryingData) CancelApprovalCall {
+	call := CancelApprovalCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionCancelApprovalIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c CancelApprovalCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeU32(buffer),
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c CancelApprovalCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c CancelApprovalCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c CancelApprovalCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c CancelApprovalCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ CancelApprovalCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "cancel_approval",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("delegate", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Cancel one of the transfer approvals for a specific item.",
+	}
+}
+
+func (c CancelApprovalCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ CancelApprovalCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 17_640 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoCollection, pallet.StorageInfoItem)
+	return types.WeightFromParts(18_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ CancelApprovalCall) IsInherent() bool {
+	return false
+}
+
+func (_ CancelApprovalCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ CancelApprovalCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ CancelApprovalCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ CancelApprovalCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := cancelApproval(origin, args[0].(sc.U32), args[1].(sc.U32), args[2].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// cancelApproval removes the approval of `delegate` to transfer an item. Must be called by the owner
+// of the item, unless the approval has expired.
+func cancelApproval(origin types.RuntimeOrigin, collection types.CollectionId, item types.ItemId, delegate types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	delegateAccount, err := types.DefaultAccountIdLookup().Lookup(delegate)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.CancelApproval(collection, item, origin.AsSigned(), delegateAccount)
+}
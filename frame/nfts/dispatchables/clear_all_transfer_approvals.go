@@ -0,0 +1,123 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ClearAllTransferApprovalsCall struct {
+	primitives.Callable
+}
+
+func NewClearAllTransferApprovalsCall(args sc.VaryingData) ClearAllTransferApprovalsCall {
+	call := ClearAllTransferApprovalsCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionClearAllTransferApprovalsIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ClearAllTransferApprovalsCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c ClearAllTransferApprovalsCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ClearAllTransferApprovalsCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ClearAllTransferApprovalsCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ClearAllTransferApprovalsCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ClearAllTransferApprovalsCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "clear_all_transfer_approvals",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+		},
+		Docs: "Cancel all the approvals of a specific item.",
+	}
+}
+
+func (c ClearAllTransferApprovalsCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ClearAllTransferApprovalsCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 16_660 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoCollection, pallet.StorageInfoItem)
+	return types.WeightFromParts(17_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ClearAllTransferApprovalsCall) IsInherent() bool {
+	return false
+}
+
+func (_ ClearAllTransferApprovalsCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ClearAllTransferApprovalsCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ClearAllTransferApprovalsCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ClearAllTransferApprovalsCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := clearAllTransferApprovals(origin, args[0].(sc.U32), args[1].(sc.U32))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// clearAllTransferApprovals removes all approvals to transfer an item. Must be called by the owner of the item.
+func clearAllTransferApprovals(origin types.RuntimeOrigin, collection types.CollectionId, item types.ItemId) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.ClearAllTransferApprovals(collection, item, origin.AsSigned())
+}
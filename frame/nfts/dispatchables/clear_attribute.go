@@ -0,0 +1,127 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ClearAttributeCall struct {
+	primitives.Callable
+}
+
+func NewClearAttributeCall(args sc.VaryingData) ClearAttributeCall {
+	call := ClearAttributeCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionClearAttributeIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ClearAttributeCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeOption[sc.U32](buffer),
+		sc.DecodeSequence[sc.U8](buffer),
+	)
+	return c
+}
+
+func (c ClearAttributeCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ClearAttributeCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ClearAttributeCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ClearAttributeCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ClearAttributeCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "clear_attribute",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("maybe_item", "Option<T::ItemId>", primitives.TypeId(metadata.TypesOptionU32)),
+			primitives.NewFieldTypeInfo("key", "BoundedVec<u8, T::KeyLimit>", primitives.TypeId(metadata.TypesSequenceU8)),
+		},
+		Docs: "Clear an attribute for a collection or item.",
+	}
+}
+
+func (c ClearAttributeCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ClearAttributeCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 37_240 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoCollection, pallet.StorageInfoAttribute, system.StorageInfoAccount)
+	return types.WeightFromParts(38_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ClearAttributeCall) IsInherent() bool {
+	return false
+}
+
+func (_ ClearAttributeCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ClearAttributeCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ClearAttributeCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ClearAttributeCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := clearAttribute(origin, args[0].(sc.U32), args[1].(sc.Option[sc.U32]), args[2].(sc.Sequence[sc.U8]))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// clearAttribute removes an attribute of a collection or one of its items. Must be called by the admin of the collection.
+func clearAttribute(origin types.RuntimeOrigin, collection types.CollectionId, maybeItem sc.Option[types.ItemId], key sc.Sequence[sc.U8]) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.ClearAttribute(collection, maybeItem, origin.AsSigned(), key)
+}
@@ -0,0 +1,122 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ClearCollectionMetadataCall struct {
+	primitives.Callable
+}
+
+func NewClearCollectionMetadataCall(args sc.VaryingData) ClearCollectionMetadataCall {
+	call := ClearCollectionMetadataCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionClearCollectionMetadataIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ClearCollectionMetadataCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c ClearCollectionMetadataCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ClearCollectionMetadataCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ClearCollectionMetadataCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ClearCollectionMetadataCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ClearCollectionMetadataCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "clear_collection_metadata",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+		},
+		Docs: "Clear the metadata for a collection.",
+	}
+}
+
+func (c ClearCollectionMetadataCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ClearCollectionMetadataCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 30_380 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoCollection, pallet.StorageInfoCollectionMetadataOf, system.StorageInfoAccount)
+	return types.WeightFromParts(31_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ClearCollectionMetadataCall) IsInherent() bool {
+	return false
+}
+
+func (_ ClearCollectionMetadataCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ClearCollectionMetadataCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ClearCollectionMetadataCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ClearCollectionMetadataCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := clearCollectionMetadata(origin, args[0].(sc.U32))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// clearCollectionMetadata removes the metadata of a collection. Must be called by the admin of the collection.
+func clearCollectionMetadata(origin types.RuntimeOrigin, collection types.CollectionId) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.ClearCollectionMetadata(collection, origin.AsSigned())
+}
@@ -0,0 +1,124 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ClearMetadataCall struct {
+	primitives.Callable
+}
+
+func NewClearMetadataCall(args sc.VaryingData) ClearMetadataCall {
+	call := ClearMetadataCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionClearMetadataIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ClearMetadataCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c ClearMetadataCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ClearMetadataCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ClearMetadataCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ClearMetadataCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ClearMetadataCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "clear_metadata",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+		},
+		Docs: "Clear the metadata for an item.",
+	}
+}
+
+func (c ClearMetadataCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ClearMetadataCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 32_340 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoCollection, pallet.StorageInfoItemMetadataOf, system.StorageInfoAccount)
+	return types.WeightFromParts(33_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ClearMetadataCall) IsInherent() bool {
+	return false
+}
+
+func (_ ClearMetadataCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ClearMetadataCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ClearMetadataCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ClearMetadataCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := clearMetadata(origin, args[0].(sc.U32), args[1].(sc.U32))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// clearMetadata removes the metadata of an item. Must be called by the admin of the collection.
+func clearMetadata(origin types.RuntimeOrigin, collection types.CollectionId, item types.ItemId) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.ClearMetadata(collection, item, origin.AsSigned())
+}
@@ -0,0 +1,129 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CreateCall struct {
+	primitives.Callable
+}
+
+func NewCreateCall(args sc.VaryingData) CreateCall {
+	call := CreateCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionCreateIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c CreateCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c CreateCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c CreateCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c CreateCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c CreateCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ CreateCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "create",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("admin", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Issue a new collection of non-fungible items from a public origin.",
+	}
+}
+
+func (c CreateCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ CreateCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 32_340 nanoseconds.
+	r := constants.DbWeight.Reads(3)
+	w := constants.DbWeight.Writes(5)
+	e := types.ProofWeight(pallet.StorageInfoNextCollectionId, system.StorageInfoAccount)
+	return types.WeightFromParts(33_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ CreateCall) IsInherent() bool {
+	return false
+}
+
+func (_ CreateCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ CreateCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ CreateCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ CreateCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := create(origin, args[0].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// create creates a new collection with the next available id. The collection deposit is reserved
+// from the sender, who becomes the owner. `admin` becomes the issuer, admin and freezer.
+func create(origin types.RuntimeOrigin, admin types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	adminAccount, err := types.DefaultAccountIdLookup().Lookup(admin)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.Create(origin.AsSigned(), adminAccount)
+}
@@ -0,0 +1,126 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type DestroyCall struct {
+	primitives.Callable
+}
+
+func NewDestroyCall(args sc.VaryingData) DestroyCall {
+	call := DestroyCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionDestroyIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c DestroyCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c DestroyCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c DestroyCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c DestroyCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c DestroyCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ DestroyCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "destroy",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+		},
+		Docs: "Destroy a collection of fungible items.",
+	}
+}
+
+func (c DestroyCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ DestroyCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 39_200 nanoseconds.
+	r := constants.DbWeight.Reads(3)
+	w := constants.DbWeight.Writes(4)
+	e := types.ProofWeight(pallet.StorageInfoCollection, system.StorageInfoAccount)
+	return types.WeightFromParts(40_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ DestroyCall) IsInherent() bool {
+	return false
+}
+
+func (_ DestroyCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ DestroyCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ DestroyCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ DestroyCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := destroy(origin, args[0].(sc.U32))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// destroy removes an empty collection. Must be called by the owner of the collection or the force origin.
+func destroy(origin types.RuntimeOrigin, collection types.CollectionId) types.DispatchError {
+	maybeCheckOwner := sc.NewOption[types.AccountId](nil)
+	if pallet.ForceOrigin.EnsureOrigin(origin) != nil {
+		if !origin.IsSignedOrigin() {
+			return types.NewDispatchErrorBadOrigin()
+		}
+		maybeCheckOwner = sc.NewOption[types.AccountId](origin.AsSigned())
+	}
+
+	return pallet.Destroy(collection, maybeCheckOwner)
+}
@@ -0,0 +1,128 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ForceCreateCall struct {
+	primitives.Callable
+}
+
+func NewForceCreateCall(args sc.VaryingData) ForceCreateCall {
+	call := ForceCreateCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionForceCreateIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ForceCreateCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c ForceCreateCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ForceCreateCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ForceCreateCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ForceCreateCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ForceCreateCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "force_create",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("owner", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Issue a new collection of non-fungible items from a privileged origin.",
+	}
+}
+
+func (c ForceCreateCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ForceCreateCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 18_620 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(5)
+	e := types.ProofWeight(pallet.StorageInfoNextCollectionId)
+	return types.WeightFromParts(19_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ForceCreateCall) IsInherent() bool {
+	return false
+}
+
+func (_ ForceCreateCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ForceCreateCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ForceCreateCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ForceCreateCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := forceCreate(origin, args[0].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// forceCreate creates a new collection with the next available id from a privileged origin, without a deposit.
+func forceCreate(origin types.RuntimeOrigin, owner types.MultiAddress) types.DispatchError {
+	err := pallet.ForceOrigin.EnsureOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	ownerAccount, e := types.DefaultAccountIdLookup().Lookup(owner)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.ForceCreate(ownerAccount)
+}
@@ -0,0 +1,123 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type LockItemTransferCall struct {
+	primitives.Callable
+}
+
+func NewLockItemTransferCall(args sc.VaryingData) LockItemTransferCall {
+	call := LockItemTransferCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionLockItemTransferIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c LockItemTransferCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c LockItemTransferCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c LockItemTransferCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c LockItemTransferCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c LockItemTransferCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ LockItemTransferCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "lock_item_transfer",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+		},
+		Docs: "Disallow further unprivileged transfer of an item.",
+	}
+}
+
+func (c LockItemTransferCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ LockItemTransferCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 14_700 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoCollection, pallet.StorageInfoItem)
+	return types.WeightFromParts(15_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ LockItemTransferCall) IsInherent() bool {
+	return false
+}
+
+func (_ LockItemTransferCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ LockItemTransferCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ LockItemTransferCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ LockItemTransferCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := lockItemTransfer(origin, args[0].(sc.U32), args[1].(sc.U32))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// lockItemTransfer disallows the transfer of an item. Must be called by the freezer of the collection.
+func lockItemTransfer(origin types.RuntimeOrigin, collection types.CollectionId, item types.ItemId) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.LockItemTransfer(collection, item, origin.AsSigned())
+}
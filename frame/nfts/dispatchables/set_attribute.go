@@ -0,0 +1,130 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetAttributeCall struct {
+	primitives.Callable
+}
+
+func NewSetAttributeCall(args sc.VaryingData) SetAttributeCall {
+	call := SetAttributeCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionSetAttributeIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetAttributeCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeOption[sc.U32](buffer),
+		sc.DecodeSequence[sc.U8](buffer),
+		sc.DecodeSequence[sc.U8](buffer),
+	)
+	return c
+}
+
+func (c SetAttributeCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetAttributeCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetAttributeCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetAttributeCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetAttributeCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_attribute",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("maybe_item", "Option<T::ItemId>", primitives.TypeId(metadata.TypesOptionU32)),
+			primitives.NewFieldTypeInfo("key", "BoundedVec<u8, T::KeyLimit>", primitives.TypeId(metadata.TypesSequenceU8)),
+			primitives.NewFieldTypeInfo("value", "BoundedVec<u8, T::ValueLimit>", primitives.TypeId(metadata.TypesSequenceU8)),
+		},
+		Docs: "Set an attribute for a collection or item.",
+	}
+}
+
+func (c SetAttributeCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetAttributeCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 38_220 nanoseconds.
+	r := constants.DbWeight.Reads(3)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoCollection, pallet.StorageInfoItem, pallet.StorageInfoAttribute, system.StorageInfoAccount)
+	return types.WeightFromParts(39_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetAttributeCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetAttributeCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetAttributeCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetAttributeCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetAttributeCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setAttribute(origin, args[0].(sc.U32), args[1].(sc.Option[sc.U32]), args[2].(sc.Sequence[sc.U8]), args[3].(sc.Sequence[sc.U8]))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setAttribute sets an attribute of a collection or one of its items. Must be called by the admin of the collection.
+// A deposit depending on the length of the key and value is reserved from the owner of the collection.
+func setAttribute(origin types.RuntimeOrigin, collection types.CollectionId, maybeItem sc.Option[types.ItemId], key sc.Sequence[sc.U8], value sc.Sequence[sc.U8]) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.SetAttribute(collection, maybeItem, origin.AsSigned(), key, value)
+}
@@ -0,0 +1,126 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetCollectionMetadataCall struct {
+	primitives.Callable
+}
+
+func NewSetCollectionMetadataCall(args sc.VaryingData) SetCollectionMetadataCall {
+	call := SetCollectionMetadataCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionSetCollectionMetadataIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetCollectionMetadataCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeSequence[sc.U8](buffer),
+	)
+	return c
+}
+
+func (c SetCollectionMetadataCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetCollectionMetadataCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetCollectionMetadataCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetCollectionMetadataCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetCollectionMetadataCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_collection_metadata",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("data", "BoundedVec<u8, T::StringLimit>", primitives.TypeId(metadata.TypesSequenceU8)),
+		},
+		Docs: "Set the metadata for a collection.",
+	}
+}
+
+func (c SetCollectionMetadataCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetCollectionMetadataCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 32_340 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoCollection, pallet.StorageInfoCollectionMetadataOf, system.StorageInfoAccount)
+	return types.WeightFromParts(33_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetCollectionMetadataCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetCollectionMetadataCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetCollectionMetadataCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetCollectionMetadataCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetCollectionMetadataCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setCollectionMetadata(origin, args[0].(sc.U32), args[1].(sc.Sequence[sc.U8]))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setCollectionMetadata sets the metadata of a collection. Must be called by the admin of the collection.
+// A deposit depending on the length of the data is reserved from the owner of the collection.
+func setCollectionMetadata(origin types.RuntimeOrigin, collection types.CollectionId, data sc.Sequence[sc.U8]) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.SetCollectionMetadata(collection, origin.AsSigned(), data)
+}
@@ -0,0 +1,128 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetMetadataCall struct {
+	primitives.Callable
+}
+
+func NewSetMetadataCall(args sc.VaryingData) SetMetadataCall {
+	call := SetMetadataCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionSetMetadataIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetMetadataCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeU32(buffer),
+		sc.DecodeSequence[sc.U8](buffer),
+	)
+	return c
+}
+
+func (c SetMetadataCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetMetadataCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetMetadataCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetMetadataCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetMetadataCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_metadata",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("data", "BoundedVec<u8, T::StringLimit>", primitives.TypeId(metadata.TypesSequenceU8)),
+		},
+		Docs: "Set the metadata for an item.",
+	}
+}
+
+func (c SetMetadataCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetMetadataCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 35_280 nanoseconds.
+	r := constants.DbWeight.Reads(3)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoCollection, pallet.StorageInfoItem, pallet.StorageInfoItemMetadataOf, system.StorageInfoAccount)
+	return types.WeightFromParts(36_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetMetadataCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetMetadataCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetMetadataCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetMetadataCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetMetadataCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setMetadata(origin, args[0].(sc.U32), args[1].(sc.U32), args[2].(sc.Sequence[sc.U8]))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setMetadata sets the metadata of an item. Must be called by the admin of the collection.
+// A deposit depending on the length of the data is reserved from the owner of the collection.
+func setMetadata(origin types.RuntimeOrigin, collection types.CollectionId, item types.ItemId, data sc.Sequence[sc.U8]) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.SetMetadata(collection, item, origin.AsSigned(), data)
+}
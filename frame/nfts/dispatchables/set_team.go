@@ -0,0 +1,143 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetTeamCall struct {
+	primitives.Callable
+}
+
+func NewSetTeamCall(args sc.VaryingData) SetTeamCall {
+	call := SetTeamCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionSetTeamIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetTeamCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		types.DecodeMultiAddress(buffer),
+		types.DecodeMultiAddress(buffer),
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c SetTeamCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetTeamCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetTeamCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetTeamCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetTeamCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_team",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("issuer", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("admin", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("freezer", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Change the Issuer, Admin and Freezer of a collection.",
+	}
+}
+
+func (c SetTeamCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetTeamCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 18_620 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoCollection)
+	return types.WeightFromParts(19_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetTeamCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetTeamCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetTeamCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetTeamCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetTeamCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setTeam(origin, args[0].(sc.U32), args[1].(types.MultiAddress), args[2].(types.MultiAddress), args[3].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setTeam changes the issuer, admin and freezer of a collection. Must be called by the owner of the collection.
+func setTeam(origin types.RuntimeOrigin, collection types.CollectionId, issuer types.MultiAddress, admin types.MultiAddress, freezer types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	issuerAccount, err := types.DefaultAccountIdLookup().Lookup(issuer)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	adminAccount, err := types.DefaultAccountIdLookup().Lookup(admin)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	freezerAccount, err := types.DefaultAccountIdLookup().Lookup(freezer)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.SetTeam(collection, origin.AsSigned(), issuerAccount, adminAccount, freezerAccount)
+}
@@ -0,0 +1,131 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type TransferOwnershipCall struct {
+	primitives.Callable
+}
+
+func NewTransferOwnershipCall(args sc.VaryingData) TransferOwnershipCall {
+	call := TransferOwnershipCall{
+		Callable: primitives.Callable{
+			ModuleId:   nfts.ModuleIndex,
+			FunctionId: nfts.FunctionTransferOwnershipIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c TransferOwnershipCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c TransferOwnershipCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c TransferOwnershipCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c TransferOwnershipCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c TransferOwnershipCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ TransferOwnershipCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "transfer_ownership",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("new_owner", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Change the Owner of a collection.",
+	}
+}
+
+func (c TransferOwnershipCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ TransferOwnershipCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 21_560 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoCollection, system.StorageInfoAccount, system.StorageInfoAccount)
+	return types.WeightFromParts(22_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ TransferOwnershipCall) IsInherent() bool {
+	return false
+}
+
+func (_ TransferOwnershipCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ TransferOwnershipCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ TransferOwnershipCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ TransferOwnershipCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := transferOwnership(origin, args[0].(sc.U32), args[1].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// transferOwnership changes the owner of a collection, moving its deposits to the new owner.
+// Must be called by the owner of the collection.
+func transferOwnership(origin types.RuntimeOrigin, collection types.CollectionId, newOwner types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	newOwnerAccount, err := types.DefaultAccountIdLookup().Lookup(newOwner)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.TransferOwnership(collection, origin.AsSigned(), newOwnerAccount)
+}
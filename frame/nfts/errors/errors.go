@@ -0,0 +1,21 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Nfts module errors.
+const (
+	ErrorNoPermission sc.U8 = iota
+	ErrorUnknownCollection
+	ErrorUnknownItem
+	ErrorAlreadyExists
+	ErrorApprovalExpired
+	ErrorDeadlineExpired
+	ErrorCollectionNotEmpty
+	ErrorItemLocked
+	ErrorNotDelegate
+	ErrorReachedApprovalLimit
+	ErrorIncorrectData
+	ErrorIncorrectMetadata
+	ErrorAttributeNotFound
+	ErrorMetadataNotFound
+)
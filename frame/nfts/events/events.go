@@ -0,0 +1,216 @@
+package events
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Nfts module events.
+const (
+	EventCreated sc.U8 = iota
+	EventForceCreated
+	EventDestroyed
+	EventIssued
+	EventTransferred
+	EventBurned
+	EventItemTransferLocked
+	EventItemTransferUnlocked
+	EventOwnerChanged
+	EventTeamChanged
+	EventTransferApproved
+	EventApprovalCancelled
+	EventAllApprovalsCancelled
+	EventCollectionMetadataSet
+	EventCollectionMetadataCleared
+	EventItemMetadataSet
+	EventItemMetadataCleared
+	EventAttributeSet
+	EventAttributeCleared
+)
+
+func NewEventCreated(collection types.CollectionId, creator types.PublicKey, owner types.PublicKey) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventCreated, collection, creator, owner)
+}
+
+func NewEventForceCreated(collection types.CollectionId, owner types.PublicKey) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventForceCreated, collection, owner)
+}
+
+func NewEventDestroyed(collection types.CollectionId) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventDestroyed, collection)
+}
+
+func NewEventIssued(collection types.CollectionId, item types.ItemId, owner types.PublicKey) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventIssued, collection, item, owner)
+}
+
+func NewEventTransferred(collection types.CollectionId, item types.ItemId, from types.PublicKey, to types.PublicKey) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventTransferred, collection, item, from, to)
+}
+
+func NewEventBurned(collection types.CollectionId, item types.ItemId, owner types.PublicKey) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventBurned, collection, item, owner)
+}
+
+func NewEventItemTransferLocked(collection types.CollectionId, item types.ItemId) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventItemTransferLocked, collection, item)
+}
+
+func NewEventItemTransferUnlocked(collection types.CollectionId, item types.ItemId) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventItemTransferUnlocked, collection, item)
+}
+
+func NewEventOwnerChanged(collection types.CollectionId, newOwner types.PublicKey) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventOwnerChanged, collection, newOwner)
+}
+
+func NewEventTeamChanged(collection types.CollectionId, issuer types.PublicKey, admin types.PublicKey, freezer types.PublicKey) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventTeamChanged, collection, issuer, admin, freezer)
+}
+
+func NewEventTransferApproved(collection types.CollectionId, item types.ItemId, owner types.PublicKey, delegate types.PublicKey, deadline sc.Option[types.BlockNumber]) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventTransferApproved, collection, item, owner, delegate, deadline)
+}
+
+func NewEventApprovalCancelled(collection types.CollectionId, item types.ItemId, owner types.PublicKey, delegate types.PublicKey) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventApprovalCancelled, collection, item, owner, delegate)
+}
+
+func NewEventAllApprovalsCancelled(collection types.CollectionId, item types.ItemId, owner types.PublicKey) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventAllApprovalsCancelled, collection, item, owner)
+}
+
+func NewEventCollectionMetadataSet(collection types.CollectionId, data sc.Sequence[sc.U8]) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventCollectionMetadataSet, collection, data)
+}
+
+func NewEventCollectionMetadataCleared(collection types.CollectionId) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventCollectionMetadataCleared, collection)
+}
+
+func NewEventItemMetadataSet(collection types.CollectionId, item types.ItemId, data sc.Sequence[sc.U8]) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventItemMetadataSet, collection, item, data)
+}
+
+func NewEventItemMetadataCleared(collection types.CollectionId, item types.ItemId) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventItemMetadataCleared, collection, item)
+}
+
+func NewEventAttributeSet(collection types.CollectionId, maybeItem sc.Option[types.ItemId], key sc.Sequence[sc.U8], value sc.Sequence[sc.U8]) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventAttributeSet, collection, maybeItem, key, value)
+}
+
+func NewEventAttributeCleared(collection types.CollectionId, maybeItem sc.Option[types.ItemId], key sc.Sequence[sc.U8]) types.Event {
+	return types.NewEvent(nfts.ModuleIndex, EventAttributeCleared, collection, maybeItem, key)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	module := sc.DecodeU8(buffer)
+	if module != nfts.ModuleIndex {
+		log.Critical("invalid nfts.Event module")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventCreated:
+		collection := sc.DecodeU32(buffer)
+		creator := types.DecodePublicKey(buffer)
+		owner := types.DecodePublicKey(buffer)
+		return NewEventCreated(collection, creator, owner)
+	case EventForceCreated:
+		collection := sc.DecodeU32(buffer)
+		owner := types.DecodePublicKey(buffer)
+		return NewEventForceCreated(collection, owner)
+	case EventDestroyed:
+		collection := sc.DecodeU32(buffer)
+		return NewEventDestroyed(collection)
+	case EventIssued:
+		collection := sc.DecodeU32(buffer)
+		item := sc.DecodeU32(buffer)
+		owner := types.DecodePublicKey(buffer)
+		return NewEventIssued(collection, item, owner)
+	case EventTransferred:
+		collection := sc.DecodeU32(buffer)
+		item := sc.DecodeU32(buffer)
+		from := types.DecodePublicKey(buffer)
+		to := types.DecodePublicKey(buffer)
+		return NewEventTransferred(collection, item, from, to)
+	case EventBurned:
+		collection := sc.DecodeU32(buffer)
+		item := sc.DecodeU32(buffer)
+		owner := types.DecodePublicKey(buffer)
+		return NewEventBurned(collection, item, owner)
+	case EventItemTransferLocked:
+		collection := sc.DecodeU32(buffer)
+		item := sc.DecodeU32(buffer)
+		return NewEventItemTransferLocked(collection, item)
+	case EventItemTransferUnlocked:
+		collection := sc.DecodeU32(buffer)
+		item := sc.DecodeU32(buffer)
+		return NewEventItemTransferUnlocked(collection, item)
+	case EventOwnerChanged:
+		collection := sc.DecodeU32(buffer)
+		newOwner := types.DecodePublicKey(buffer)
+		return NewEventOwnerChanged(collection, newOwner)
+	case EventTeamChanged:
+		collection := sc.DecodeU32(buffer)
+		issuer := types.DecodePublicKey(buffer)
+		admin := types.DecodePublicKey(buffer)
+		freezer := types.DecodePublicKey(buffer)
+		return NewEventTeamChanged(collection, issuer, admin, freezer)
+	case EventTransferApproved:
+		collection := sc.DecodeU32(buffer)
+		item := sc.DecodeU32(buffer)
+		owner := types.DecodePublicKey(buffer)
+		delegate := types.DecodePublicKey(buffer)
+		deadline := sc.DecodeOption[types.BlockNumber](buffer)
+		return NewEventTransferApproved(collection, item, owner, delegate, deadline)
+	case EventApprovalCancelled:
+		collection := sc.DecodeU32(buffer)
+		item := sc.DecodeU32(buffer)
+		owner := types.DecodePublicKey(buffer)
+		delegate := types.DecodePublicKey(buffer)
+		return NewEventApprovalCancelled(collection, item, owner, delegate)
+	case EventAllApprovalsCancelled:
+		collection := sc.DecodeU32(buffer)
+		item := sc.DecodeU32(buffer)
+		owner := types.DecodePublicKey(buffer)
+		return NewEventAllApprovalsCancelled(collection, item, owner)
+	case EventCollectionMetadataSet:
+		collection := sc.DecodeU32(buffer)
+		data := sc.DecodeSequence[sc.U8](buffer)
+		return NewEventCollectionMetadataSet(collection, data)
+	case EventCollectionMetadataCleared:
+		collection := sc.DecodeU32(buffer)
+		return NewEventCollectionMetadataCleared(collection)
+	case EventItemMetadataSet:
+		collection := sc.DecodeU32(buffer)
+		item := sc.DecodeU32(buffer)
+		data := sc.DecodeSequence[sc.U8](buffer)
+		return NewEventItemMetadataSet(collection, item, data)
+	case EventItemMetadataCleared:
+		collection := sc.DecodeU32(buffer)
+		item := sc.DecodeU32(buffer)
+		return NewEventItemMetadataCleared(collection, item)
+	case EventAttributeSet:
+		collection := sc.DecodeU32(buffer)
+		maybeItem := sc.DecodeOption[types.ItemId](buffer)
+		key := sc.DecodeSequence[sc.U8](buffer)
+		value := sc.DecodeSequence[sc.U8](buffer)
+		return NewEventAttributeSet(collection, maybeItem, key, value)
+	case EventAttributeCleared:
+		collection := sc.DecodeU32(buffer)
+		maybeItem := sc.DecodeOption[types.ItemId](buffer)
+		key := sc.DecodeSequence[sc.U8](buffer)
+		return NewEventAttributeCleared(collection, maybeItem, key)
+	default:
+		log.Critical("invalid nfts.Event type")
+	}
+
+	panic("unreachable")
+}
@@ -0,0 +1,298 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	"github.com/LimeChain/gosemble/frame/nfts/dispatchables"
+	"github.com/LimeChain/gosemble/frame/nfts/errors"
+	"github.com/LimeChain/gosemble/frame/nfts/events"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type NftsModule struct {
+	functions map[sc.U8]primitives.Call
+}
+
+func NewNftsModule() NftsModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[nfts.FunctionCreateIndex] = dispatchables.NewCreateCall(nil)
+	functions[nfts.FunctionForceCreateIndex] = dispatchables.NewForceCreateCall(nil)
+	functions[nfts.FunctionDestroyIndex] = dispatchables.NewDestroyCall(nil)
+	functions[nfts.FunctionMintIndex] = dispatchables.NewMintCall(nil)
+	functions[nfts.FunctionBurnIndex] = dispatchables.NewBurnCall(nil)
+	functions[nfts.FunctionTransferIndex] = dispatchables.NewTransferCall(nil)
+	functions[nfts.FunctionLockItemTransferIndex] = dispatchables.NewLockItemTransferCall(nil)
+	functions[nfts.FunctionUnlockItemTransferIndex] = dispatchables.NewUnlockItemTransferCall(nil)
+	functions[nfts.FunctionTransferOwnershipIndex] = dispatchables.NewTransferOwnershipCall(nil)
+	functions[nfts.FunctionSetTeamIndex] = dispatchables.NewSetTeamCall(nil)
+	functions[nfts.FunctionApproveTransferIndex] = dispatchables.NewApproveTransferCall(nil)
+	functions[nfts.FunctionCancelApprovalIndex] = dispatchables.NewCancelApprovalCall(nil)
+	functions[nfts.FunctionClearAllTransferApprovalsIndex] = dispatchables.NewClearAllTransferApprovalsCall(nil)
+	functions[nfts.FunctionSetAttributeIndex] = dispatchables.NewSetAttributeCall(nil)
+	functions[nfts.FunctionClearAttributeIndex] = dispatchables.NewClearAttributeCall(nil)
+	functions[nfts.FunctionSetMetadataIndex] = dispatchables.NewSetMetadataCall(nil)
+	functions[nfts.FunctionClearMetadataIndex] = dispatchables.NewClearMetadataCall(nil)
+	functions[nfts.FunctionSetCollectionMetadataIndex] = dispatchables.NewSetCollectionMetadataCall(nil)
+	functions[nfts.FunctionClearCollectionMetadataIndex] = dispatchables.NewClearCollectionMetadataCall(nil)
+
+	return NftsModule{
+		functions: functions,
+	}
+}
+
+func (nm NftsModule) Functions() map[sc.U8]primitives.Call {
+	return nm.functions
+}
+
+func (nm NftsModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (nm NftsModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (nm NftsModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Collections of non-fungible items with approvals and attributes."}
+}
+
+func (nm NftsModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "Nfts",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "Nfts",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"Collection",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.PrimitiveTypesU32),
+						sc.ToCompact(registry.Register(pallet.CollectionDetailsTypeInfo))),
+					"Details of a collection."),
+				primitives.NewMetadataModuleStorageEntry(
+					"NextCollectionId",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+					"Stores the `CollectionId` that is going to be used for the next collection."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Item",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat, primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesTupleU32U32),
+						sc.ToCompact(registry.Register(pallet.ItemDetailsTypeInfo))),
+					"The items held by any given account."),
+				primitives.NewMetadataModuleStorageEntry(
+					"CollectionMetadataOf",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.PrimitiveTypesU32),
+						sc.ToCompact(registry.Register(pallet.ItemMetadataTypeInfo))),
+					"Metadata of a collection."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ItemMetadataOf",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat, primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesTupleU32U32),
+						sc.ToCompact(registry.Register(pallet.ItemMetadataTypeInfo))),
+					"Metadata of an item."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Attribute",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat, primitives.MetadataModuleStorageHashFuncMultiBlake128Concat, primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(registry.Register(primitives.NewTupleTypeInfo(primitives.TypeInfoU32, primitives.TypeId(metadata.TypesOptionU32), primitives.TypeId(metadata.TypesSequenceU8)))),
+						sc.ToCompact(registry.Register(primitives.NewTupleTypeInfo(primitives.TypeId(metadata.TypesSequenceU8), primitives.TypeInfoU128)))),
+					"Attributes of a collection or an item."),
+			},
+		}),
+		Call:  sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(nm.callsTypeInfo()))),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(eventTypeInfo()))),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"CollectionDeposit",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(nfts.CollectionDeposit).Bytes()),
+				"The basic amount of funds that must be reserved for collection.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"ItemDeposit",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(nfts.ItemDeposit).Bytes()),
+				"The basic amount of funds that must be reserved for an item.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MetadataDepositBase",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(nfts.MetadataDepositBase).Bytes()),
+				"The basic amount of funds that must be reserved when adding metadata to your item.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"AttributeDepositBase",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(nfts.AttributeDepositBase).Bytes()),
+				"The basic amount of funds that must be reserved when adding an attribute to an item.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"DepositPerByte",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(nfts.DepositPerByte).Bytes()),
+				"The additional funds that must be reserved for the number of bytes store in metadata, either \"normal\" metadata or attribute metadata.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"StringLimit",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(nfts.StringLimit).Bytes()),
+				"The maximum length of data stored on-chain.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"KeyLimit",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(nfts.KeyLimit).Bytes()),
+				"The maximum length of an attribute key.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"ValueLimit",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(nfts.ValueLimit).Bytes()),
+				"The maximum length of an attribute value.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"ApprovalsLimit",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(nfts.ApprovalsLimit).Bytes()),
+				"The maximum approvals an item could have.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(errorsTypeInfo()))),
+		Index: nfts.ModuleIndex,
+	}
+}
+
+func (nm NftsModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_nfts", "pallet", "Call"}, nm.functions)
+}
+
+func eventTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_nfts", "pallet", "Event"},
+		"The events of the module.",
+		primitives.NewVariantTypeInfo("Created", events.EventCreated, "A `collection` was created.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("creator", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("owner", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("ForceCreated", events.EventForceCreated, "A `collection` was force-created.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("owner", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("Destroyed", events.EventDestroyed, "A `collection` was destroyed.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("Issued", events.EventIssued, "An `item` was issued.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("owner", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("Transferred", events.EventTransferred, "An `item` was transferred.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("from", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("to", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("Burned", events.EventBurned, "An `item` was destroyed.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("owner", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("ItemTransferLocked", events.EventItemTransferLocked, "An `item` became non-transferable.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("ItemTransferUnlocked", events.EventItemTransferUnlocked, "An `item` became transferable.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("OwnerChanged", events.EventOwnerChanged, "The owner changed.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("new_owner", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("TeamChanged", events.EventTeamChanged, "The management team changed.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("issuer", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("admin", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("freezer", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("TransferApproved", events.EventTransferApproved, "An `item` of a `collection` has been approved by the `owner` for transfer by a `delegate`.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("owner", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("delegate", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("deadline", "Option<BlockNumberFor<T>>", primitives.TypeId(metadata.TypesOptionU32)),
+		),
+		primitives.NewVariantTypeInfo("ApprovalCancelled", events.EventApprovalCancelled, "An approval for a `delegate` account to transfer the `item` of an item `collection` was cancelled by its `owner`.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("owner", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("delegate", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("AllApprovalsCancelled", events.EventAllApprovalsCancelled, "All approvals of an item got cancelled.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("owner", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("CollectionMetadataSet", events.EventCollectionMetadataSet, "New metadata has been set for a `collection`.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("data", "BoundedVec<u8, T::StringLimit>", primitives.TypeId(metadata.TypesSequenceU8)),
+		),
+		primitives.NewVariantTypeInfo("CollectionMetadataCleared", events.EventCollectionMetadataCleared, "Metadata has been cleared for a `collection`.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("ItemMetadataSet", events.EventItemMetadataSet, "New metadata has been set for an item.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("data", "BoundedVec<u8, T::StringLimit>", primitives.TypeId(metadata.TypesSequenceU8)),
+		),
+		primitives.NewVariantTypeInfo("ItemMetadataCleared", events.EventItemMetadataCleared, "Metadata has been cleared for an item.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("item", "T::ItemId", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("AttributeSet", events.EventAttributeSet, "New attribute metadata has been set for a `collection` or `item`.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("maybe_item", "Option<T::ItemId>", primitives.TypeId(metadata.TypesOptionU32)),
+			primitives.NewFieldTypeInfo("key", "BoundedVec<u8, T::KeyLimit>", primitives.TypeId(metadata.TypesSequenceU8)),
+			primitives.NewFieldTypeInfo("value", "BoundedVec<u8, T::ValueLimit>", primitives.TypeId(metadata.TypesSequenceU8)),
+		),
+		primitives.NewVariantTypeInfo("AttributeCleared", events.EventAttributeCleared, "Attribute metadata has been cleared for a `collection` or `item`.",
+			primitives.NewFieldTypeInfo("collection", "T::CollectionId", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("maybe_item", "Option<T::ItemId>", primitives.TypeId(metadata.TypesOptionU32)),
+			primitives.NewFieldTypeInfo("key", "BoundedVec<u8, T::KeyLimit>", primitives.TypeId(metadata.TypesSequenceU8)),
+		),
+	)
+}
+
+func errorsTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_nfts", "pallet", "Error"},
+		"The errors of the module.",
+		primitives.NewVariantTypeInfo("NoPermission", errors.ErrorNoPermission, "The signing account has no permission to do the operation."),
+		primitives.NewVariantTypeInfo("UnknownCollection", errors.ErrorUnknownCollection, "The given collection ID is unknown."),
+		primitives.NewVariantTypeInfo("UnknownItem", errors.ErrorUnknownItem, "The given item ID is unknown."),
+		primitives.NewVariantTypeInfo("AlreadyExists", errors.ErrorAlreadyExists, "The item ID has already been used for an item."),
+		primitives.NewVariantTypeInfo("ApprovalExpired", errors.ErrorApprovalExpired, "The approval had a deadline that expired, so the approval isn't valid anymore."),
+		primitives.NewVariantTypeInfo("DeadlineExpired", errors.ErrorDeadlineExpired, "The provided deadline has already expired."),
+		primitives.NewVariantTypeInfo("CollectionNotEmpty", errors.ErrorCollectionNotEmpty, "The collection still has items and cannot be destroyed."),
+		primitives.NewVariantTypeInfo("ItemLocked", errors.ErrorItemLocked, "The item is locked (non-transferable)."),
+		primitives.NewVariantTypeInfo("NotDelegate", errors.ErrorNotDelegate, "The delegate is not approved to transfer the item."),
+		primitives.NewVariantTypeInfo("ReachedApprovalLimit", errors.ErrorReachedApprovalLimit, "The item has reached its approval limit."),
+		primitives.NewVariantTypeInfo("IncorrectData", errors.ErrorIncorrectData, "The key or value of an attribute is too long."),
+		primitives.NewVariantTypeInfo("IncorrectMetadata", errors.ErrorIncorrectMetadata, "The metadata is too long."),
+		primitives.NewVariantTypeInfo("AttributeNotFound", errors.ErrorAttributeNotFound, "The given attribute is not set."),
+		primitives.NewVariantTypeInfo("MetadataNotFound", errors.ErrorMetadataNotFound, "The given metadata is not set."),
+	)
+}
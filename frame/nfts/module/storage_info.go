@@ -0,0 +1,19 @@
+package module
+
+import (
+	pallet "github.com/LimeChain/gosemble/frame/nfts"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageInfo describes the storage items of the module whose values have a maximum encoded length.
+// The sizes of the map entries include their hashed keys.
+func (nm NftsModule) StorageInfo() []primitives.StorageInfo {
+	return []primitives.StorageInfo{
+		pallet.StorageInfoCollection,
+		pallet.StorageInfoNextCollectionId,
+		pallet.StorageInfoItem,
+		pallet.StorageInfoCollectionMetadataOf,
+		pallet.StorageInfoItemMetadataOf,
+		pallet.StorageInfoAttribute,
+	}
+}
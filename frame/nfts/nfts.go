@@ -0,0 +1,694 @@
+package nfts
+
+import (
+	"math/big"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/nfts/errors"
+	"github.com/LimeChain/gosemble/frame/nfts/events"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Owner returns the owner of an item, if it exists.
+func Owner(collection types.CollectionId, item types.ItemId) sc.Option[types.AccountId] {
+	details := StorageGetItem(collection, item)
+	if !details.HasValue {
+		return sc.NewOption[types.AccountId](nil)
+	}
+
+	return sc.NewOption[types.AccountId](details.Value.Owner)
+}
+
+// Create creates a new collection with the next available id, reserving the collection deposit from `owner`.
+// The `admin` account is set as issuer, admin and freezer of the collection.
+func Create(owner types.AccountId, admin types.AccountId) types.DispatchError {
+	collection := StorageGetNextCollectionId()
+
+	err := dispatchables.Reserve(owner, nfts.CollectionDeposit)
+	if err != nil {
+		return err
+	}
+
+	StorageSetCollection(collection, types.CollectionDetails{
+		Owner:        owner,
+		Issuer:       admin,
+		Admin:        admin,
+		Freezer:      admin,
+		OwnerDeposit: sc.NewU128FromBigInt(nfts.CollectionDeposit),
+	})
+	StorageSetNextCollectionId(collection + 1)
+
+	system.DepositEvent(events.NewEventCreated(collection, owner.FixedSequence, admin.FixedSequence))
+
+	return nil
+}
+
+// ForceCreate creates a new collection with the next available id without taking a deposit.
+func ForceCreate(owner types.AccountId) types.DispatchError {
+	collection := StorageGetNextCollectionId()
+
+	StorageSetCollection(collection, types.CollectionDetails{
+		Owner:        owner,
+		Issuer:       owner,
+		Admin:        owner,
+		Freezer:      owner,
+		OwnerDeposit: sc.NewU128FromBigInt(big.NewInt(0)),
+	})
+	StorageSetNextCollectionId(collection + 1)
+
+	system.DepositEvent(events.NewEventForceCreated(collection, owner.FixedSequence))
+
+	return nil
+}
+
+// Destroy removes an empty collection together with its metadata and attributes,
+// and returns all deposits to the owner. If `maybeCheckOwner` is set, it must be the owner of the collection.
+func Destroy(collection types.CollectionId, maybeCheckOwner sc.Option[types.AccountId]) types.DispatchError {
+	details, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	if bool(maybeCheckOwner.HasValue) && !reflect.DeepEqual(details.Owner, maybeCheckOwner.Value) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	if details.Items != 0 {
+		return newNftsError(errors.ErrorCollectionNotEmpty)
+	}
+
+	collectionAttributes := sc.NewOption[types.ItemId](nil)
+	for _, key := range StorageGetAttributeKeys(collection, collectionAttributes, int(details.Attributes)) {
+		StorageClearAttribute(collection, collectionAttributes, key)
+	}
+	StorageClearCollectionMetadata(collection)
+
+	dispatchables.Unreserve(details.Owner, details.OwnerDeposit.ToBigInt())
+	StorageClearCollection(collection)
+
+	system.DepositEvent(events.NewEventDestroyed(collection))
+
+	return nil
+}
+
+// Mint issues a new item in a collection to `owner`. The item deposit is reserved
+// from the owner of the collection. `origin` must be the issuer of the collection.
+func Mint(collection types.CollectionId, item types.ItemId, origin types.AccountId, owner types.AccountId) types.DispatchError {
+	details, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(details.Issuer, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	if StorageGetItem(collection, item).HasValue {
+		return newNftsError(errors.ErrorAlreadyExists)
+	}
+
+	err = dispatchables.Reserve(details.Owner, nfts.ItemDeposit)
+	if err != nil {
+		return err
+	}
+
+	StorageSetItem(collection, item, types.ItemDetails{
+		Owner:     owner,
+		Approvals: sc.Sequence[types.ItemApproval]{},
+		Deposit: types.ItemDeposit{
+			Account: details.Owner,
+			Amount:  sc.NewU128FromBigInt(nfts.ItemDeposit),
+		},
+	})
+
+	details.Items++
+	StorageSetCollection(collection, details)
+
+	system.DepositEvent(events.NewEventIssued(collection, item, owner.FixedSequence))
+
+	return nil
+}
+
+// Burn destroys an item together with its metadata and attributes, and returns their deposits.
+// `origin` must be the owner of the item or the admin of the collection.
+func Burn(collection types.CollectionId, item types.ItemId, origin types.AccountId) types.DispatchError {
+	details, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	itemDetails, err := itemDetails(collection, item)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(itemDetails.Owner, origin) && !reflect.DeepEqual(details.Admin, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	maybeMetadata := StorageGetItemMetadata(collection, item)
+	if maybeMetadata.HasValue {
+		releaseOwnerDeposit(&details, maybeMetadata.Value.Deposit.ToBigInt())
+		StorageClearItemMetadata(collection, item)
+		details.ItemMetadatas--
+	}
+
+	maybeItem := sc.NewOption[types.ItemId](item)
+	for _, key := range StorageGetAttributeKeys(collection, maybeItem, int(details.Attributes)) {
+		attribute := StorageGetAttribute(collection, maybeItem, key).Value
+		releaseOwnerDeposit(&details, attribute.Deposit.ToBigInt())
+		StorageClearAttribute(collection, maybeItem, key)
+		details.Attributes--
+	}
+
+	dispatchables.Unreserve(itemDetails.Deposit.Account, itemDetails.Deposit.Amount.ToBigInt())
+	StorageClearItem(collection, item)
+
+	details.Items--
+	StorageSetCollection(collection, details)
+
+	system.DepositEvent(events.NewEventBurned(collection, item, itemDetails.Owner.FixedSequence))
+
+	return nil
+}
+
+// Transfer moves an item to `dest` and clears its approvals. `origin` must be the owner
+// of the item or a delegate whose approval has not expired.
+func Transfer(collection types.CollectionId, item types.ItemId, origin types.AccountId, dest types.AccountId) types.DispatchError {
+	_, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	itemDetails, err := itemDetails(collection, item)
+	if err != nil {
+		return err
+	}
+
+	if itemDetails.IsTransferLocked {
+		return newNftsError(errors.ErrorItemLocked)
+	}
+
+	if !reflect.DeepEqual(itemDetails.Owner, origin) {
+		index := approvalIndex(itemDetails.Approvals, origin)
+		if index < 0 {
+			return newNftsError(errors.ErrorNoPermission)
+		}
+
+		deadline := itemDetails.Approvals[index].Deadline
+		if bool(deadline.HasValue) && deadline.Value < system.StorageGetBlockNumber() {
+			return newNftsError(errors.ErrorApprovalExpired)
+		}
+	}
+
+	from := itemDetails.Owner
+	itemDetails.Owner = dest
+	itemDetails.Approvals = sc.Sequence[types.ItemApproval]{}
+	StorageSetItem(collection, item, itemDetails)
+
+	system.DepositEvent(events.NewEventTransferred(collection, item, from.FixedSequence, dest.FixedSequence))
+
+	return nil
+}
+
+// LockItemTransfer disallows the transfer of an item. `origin` must be the freezer of the collection.
+func LockItemTransfer(collection types.CollectionId, item types.ItemId, origin types.AccountId) types.DispatchError {
+	return setItemTransferLocked(collection, item, origin, true)
+}
+
+// UnlockItemTransfer allows the transfer of a locked item. `origin` must be the freezer of the collection.
+func UnlockItemTransfer(collection types.CollectionId, item types.ItemId, origin types.AccountId) types.DispatchError {
+	return setItemTransferLocked(collection, item, origin, false)
+}
+
+// TransferOwnership changes the owner of a collection. The collection deposits are
+// moved from the current owner to `newOwner`. `origin` must be the owner of the collection.
+func TransferOwnership(collection types.CollectionId, origin types.AccountId, newOwner types.AccountId) types.DispatchError {
+	details, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(details.Owner, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	if reflect.DeepEqual(details.Owner, newOwner) {
+		return nil
+	}
+
+	deposit := details.OwnerDeposit.ToBigInt()
+	err = dispatchables.Reserve(newOwner, deposit)
+	if err != nil {
+		return err
+	}
+	dispatchables.Unreserve(details.Owner, deposit)
+
+	details.Owner = newOwner
+	StorageSetCollection(collection, details)
+
+	system.DepositEvent(events.NewEventOwnerChanged(collection, newOwner.FixedSequence))
+
+	return nil
+}
+
+// SetTeam changes the issuer, admin and freezer of a collection. `origin` must be the owner of the collection.
+func SetTeam(collection types.CollectionId, origin types.AccountId, issuer types.AccountId, admin types.AccountId, freezer types.AccountId) types.DispatchError {
+	details, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(details.Owner, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	details.Issuer = issuer
+	details.Admin = admin
+	details.Freezer = freezer
+	StorageSetCollection(collection, details)
+
+	system.DepositEvent(events.NewEventTeamChanged(collection, issuer.FixedSequence, admin.FixedSequence, freezer.FixedSequence))
+
+	return nil
+}
+
+// ApproveTransfer approves `delegate` to transfer an item, optionally for `maybeDeadline` blocks
+// from the current one. `origin` must be the owner of the item.
+func ApproveTransfer(collection types.CollectionId, item types.ItemId, origin types.AccountId, delegate types.AccountId, maybeDeadline sc.Option[types.BlockNumber]) types.DispatchError {
+	_, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	itemDetails, err := itemDetails(collection, item)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(itemDetails.Owner, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	if itemDetails.IsTransferLocked {
+		return newNftsError(errors.ErrorItemLocked)
+	}
+
+	deadline := sc.NewOption[types.BlockNumber](nil)
+	if maybeDeadline.HasValue {
+		deadline = sc.NewOption[types.BlockNumber](system.StorageGetBlockNumber() + maybeDeadline.Value)
+	}
+
+	approval := types.ItemApproval{
+		Delegate: delegate,
+		Deadline: deadline,
+	}
+
+	index := approvalIndex(itemDetails.Approvals, delegate)
+	if index >= 0 {
+		itemDetails.Approvals[index] = approval
+	} else {
+		if len(itemDetails.Approvals) >= nfts.ApprovalsLimit {
+			return newNftsError(errors.ErrorReachedApprovalLimit)
+		}
+		itemDetails.Approvals = append(itemDetails.Approvals, approval)
+	}
+	StorageSetItem(collection, item, itemDetails)
+
+	system.DepositEvent(events.NewEventTransferApproved(collection, item, origin.FixedSequence, delegate.FixedSequence, deadline))
+
+	return nil
+}
+
+// CancelApproval removes the approval of `delegate` to transfer an item. `origin` must be
+// the owner of the item, unless the approval has expired, in which case anyone can remove it.
+func CancelApproval(collection types.CollectionId, item types.ItemId, origin types.AccountId, delegate types.AccountId) types.DispatchError {
+	_, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	itemDetails, err := itemDetails(collection, item)
+	if err != nil {
+		return err
+	}
+
+	index := approvalIndex(itemDetails.Approvals, delegate)
+	if index < 0 {
+		return newNftsError(errors.ErrorNotDelegate)
+	}
+
+	deadline := itemDetails.Approvals[index].Deadline
+	isExpired := bool(deadline.HasValue) && deadline.Value < system.StorageGetBlockNumber()
+	if !isExpired && !reflect.DeepEqual(itemDetails.Owner, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	itemDetails.Approvals = append(itemDetails.Approvals[:index], itemDetails.Approvals[index+1:]...)
+	StorageSetItem(collection, item, itemDetails)
+
+	system.DepositEvent(events.NewEventApprovalCancelled(collection, item, itemDetails.Owner.FixedSequence, delegate.FixedSequence))
+
+	return nil
+}
+
+// ClearAllTransferApprovals removes all approvals to transfer an item. `origin` must be the owner of the item.
+func ClearAllTransferApprovals(collection types.CollectionId, item types.ItemId, origin types.AccountId) types.DispatchError {
+	_, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	itemDetails, err := itemDetails(collection, item)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(itemDetails.Owner, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	itemDetails.Approvals = sc.Sequence[types.ItemApproval]{}
+	StorageSetItem(collection, item, itemDetails)
+
+	system.DepositEvent(events.NewEventAllApprovalsCancelled(collection, item, origin.FixedSequence))
+
+	return nil
+}
+
+// SetAttribute sets an attribute of a collection, or of an item if `maybeItem` is set.
+// The deposit depends on the length of `key` and `value` and is reserved from the owner of the collection.
+// `origin` must be the admin of the collection.
+func SetAttribute(collection types.CollectionId, maybeItem sc.Option[types.ItemId], origin types.AccountId, key sc.Sequence[sc.U8], value sc.Sequence[sc.U8]) types.DispatchError {
+	if len(key) > nfts.KeyLimit || len(value) > nfts.ValueLimit {
+		return newNftsError(errors.ErrorIncorrectData)
+	}
+
+	details, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(details.Admin, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	if bool(maybeItem.HasValue) && !bool(StorageGetItem(collection, maybeItem.Value).HasValue) {
+		return newNftsError(errors.ErrorUnknownItem)
+	}
+
+	oldDeposit := big.NewInt(0)
+	maybeAttribute := StorageGetAttribute(collection, maybeItem, key)
+	if maybeAttribute.HasValue {
+		oldDeposit = maybeAttribute.Value.Deposit.ToBigInt()
+	}
+
+	newDeposit := new(big.Int).Mul(nfts.DepositPerByte, big.NewInt(int64(len(key)+len(value))))
+	newDeposit.Add(newDeposit, nfts.AttributeDepositBase)
+
+	err = updateOwnerDeposit(&details, oldDeposit, newDeposit)
+	if err != nil {
+		return err
+	}
+
+	if !maybeAttribute.HasValue {
+		details.Attributes++
+	}
+	StorageSetCollection(collection, details)
+
+	StorageSetAttribute(collection, maybeItem, key, types.NftAttribute{
+		Value:   value,
+		Deposit: sc.NewU128FromBigInt(newDeposit),
+	})
+
+	system.DepositEvent(events.NewEventAttributeSet(collection, maybeItem, key, value))
+
+	return nil
+}
+
+// ClearAttribute removes an attribute of a collection, or of an item if `maybeItem` is set,
+// and returns its deposit. `origin` must be the admin of the collection.
+func ClearAttribute(collection types.CollectionId, maybeItem sc.Option[types.ItemId], origin types.AccountId, key sc.Sequence[sc.U8]) types.DispatchError {
+	details, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(details.Admin, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	maybeAttribute := StorageGetAttribute(collection, maybeItem, key)
+	if !maybeAttribute.HasValue {
+		return newNftsError(errors.ErrorAttributeNotFound)
+	}
+
+	releaseOwnerDeposit(&details, maybeAttribute.Value.Deposit.ToBigInt())
+	details.Attributes--
+	StorageSetCollection(collection, details)
+
+	StorageClearAttribute(collection, maybeItem, key)
+
+	system.DepositEvent(events.NewEventAttributeCleared(collection, maybeItem, key))
+
+	return nil
+}
+
+// SetMetadata sets the metadata of an item. The deposit depends on the length of `data`
+// and is reserved from the owner of the collection. `origin` must be the admin of the collection.
+func SetMetadata(collection types.CollectionId, item types.ItemId, origin types.AccountId, data sc.Sequence[sc.U8]) types.DispatchError {
+	if len(data) > nfts.StringLimit {
+		return newNftsError(errors.ErrorIncorrectMetadata)
+	}
+
+	details, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(details.Admin, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	_, err = itemDetails(collection, item)
+	if err != nil {
+		return err
+	}
+
+	oldDeposit := big.NewInt(0)
+	maybeMetadata := StorageGetItemMetadata(collection, item)
+	if maybeMetadata.HasValue {
+		oldDeposit = maybeMetadata.Value.Deposit.ToBigInt()
+	}
+	newDeposit := metadataDeposit(data)
+
+	err = updateOwnerDeposit(&details, oldDeposit, newDeposit)
+	if err != nil {
+		return err
+	}
+
+	if !maybeMetadata.HasValue {
+		details.ItemMetadatas++
+	}
+	StorageSetCollection(collection, details)
+
+	StorageSetItemMetadata(collection, item, types.NftMetadata{
+		Deposit: sc.NewU128FromBigInt(newDeposit),
+		Data:    data,
+	})
+
+	system.DepositEvent(events.NewEventItemMetadataSet(collection, item, data))
+
+	return nil
+}
+
+// ClearMetadata removes the metadata of an item and returns its deposit. `origin` must be the admin of the collection.
+func ClearMetadata(collection types.CollectionId, item types.ItemId, origin types.AccountId) types.DispatchError {
+	details, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(details.Admin, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	maybeMetadata := StorageGetItemMetadata(collection, item)
+	if !maybeMetadata.HasValue {
+		return newNftsError(errors.ErrorMetadataNotFound)
+	}
+
+	releaseOwnerDeposit(&details, maybeMetadata.Value.Deposit.ToBigInt())
+	details.ItemMetadatas--
+	StorageSetCollection(collection, details)
+
+	StorageClearItemMetadata(collection, item)
+
+	system.DepositEvent(events.NewEventItemMetadataCleared(collection, item))
+
+	return nil
+}
+
+// SetCollectionMetadata sets the metadata of a collection. The deposit depends on the length of `data`
+// and is reserved from the owner of the collection. `origin` must be the admin of the collection.
+func SetCollectionMetadata(collection types.CollectionId, origin types.AccountId, data sc.Sequence[sc.U8]) types.DispatchError {
+	if len(data) > nfts.StringLimit {
+		return newNftsError(errors.ErrorIncorrectMetadata)
+	}
+
+	details, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(details.Admin, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	oldDeposit := big.NewInt(0)
+	maybeMetadata := StorageGetCollectionMetadata(collection)
+	if maybeMetadata.HasValue {
+		oldDeposit = maybeMetadata.Value.Deposit.ToBigInt()
+	}
+	newDeposit := metadataDeposit(data)
+
+	err = updateOwnerDeposit(&details, oldDeposit, newDeposit)
+	if err != nil {
+		return err
+	}
+	StorageSetCollection(collection, details)
+
+	StorageSetCollectionMetadata(collection, types.NftMetadata{
+		Deposit: sc.NewU128FromBigInt(newDeposit),
+		Data:    data,
+	})
+
+	system.DepositEvent(events.NewEventCollectionMetadataSet(collection, data))
+
+	return nil
+}
+
+// ClearCollectionMetadata removes the metadata of a collection and returns its deposit.
+// `origin` must be the admin of the collection.
+func ClearCollectionMetadata(collection types.CollectionId, origin types.AccountId) types.DispatchError {
+	details, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(details.Admin, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	maybeMetadata := StorageGetCollectionMetadata(collection)
+	if !maybeMetadata.HasValue {
+		return newNftsError(errors.ErrorMetadataNotFound)
+	}
+
+	releaseOwnerDeposit(&details, maybeMetadata.Value.Deposit.ToBigInt())
+	StorageSetCollection(collection, details)
+
+	StorageClearCollectionMetadata(collection)
+
+	system.DepositEvent(events.NewEventCollectionMetadataCleared(collection))
+
+	return nil
+}
+
+func setItemTransferLocked(collection types.CollectionId, item types.ItemId, origin types.AccountId, isLocked sc.Bool) types.DispatchError {
+	details, err := collectionDetails(collection)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(details.Freezer, origin) {
+		return newNftsError(errors.ErrorNoPermission)
+	}
+
+	itemDetails, err := itemDetails(collection, item)
+	if err != nil {
+		return err
+	}
+
+	itemDetails.IsTransferLocked = isLocked
+	StorageSetItem(collection, item, itemDetails)
+
+	if isLocked {
+		system.DepositEvent(events.NewEventItemTransferLocked(collection, item))
+	} else {
+		system.DepositEvent(events.NewEventItemTransferUnlocked(collection, item))
+	}
+
+	return nil
+}
+
+func collectionDetails(collection types.CollectionId) (types.CollectionDetails, types.DispatchError) {
+	maybeDetails := StorageGetCollection(collection)
+	if !maybeDetails.HasValue {
+		return types.CollectionDetails{}, newNftsError(errors.ErrorUnknownCollection)
+	}
+
+	return maybeDetails.Value, nil
+}
+
+func itemDetails(collection types.CollectionId, item types.ItemId) (types.ItemDetails, types.DispatchError) {
+	maybeDetails := StorageGetItem(collection, item)
+	if !maybeDetails.HasValue {
+		return types.ItemDetails{}, newNftsError(errors.ErrorUnknownItem)
+	}
+
+	return maybeDetails.Value, nil
+}
+
+// approvalIndex returns the index of the approval of `delegate`, or -1 if there is none.
+func approvalIndex(approvals sc.Sequence[types.ItemApproval], delegate types.AccountId) int {
+	for i, approval := range approvals {
+		if reflect.DeepEqual(approval.Delegate, delegate) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func metadataDeposit(data sc.Sequence[sc.U8]) *big.Int {
+	deposit := new(big.Int).Mul(nfts.DepositPerByte, big.NewInt(int64(len(data))))
+	return deposit.Add(deposit, nfts.MetadataDepositBase)
+}
+
+// updateOwnerDeposit reserves or unreserves the difference between `oldDeposit` and `newDeposit`
+// from the owner of the collection.
+func updateOwnerDeposit(details *types.CollectionDetails, oldDeposit *big.Int, newDeposit *big.Int) types.DispatchError {
+	if newDeposit.Cmp(oldDeposit) > 0 {
+		diff := new(big.Int).Sub(newDeposit, oldDeposit)
+
+		err := dispatchables.Reserve(details.Owner, diff)
+		if err != nil {
+			return err
+		}
+
+		details.OwnerDeposit = sc.NewU128FromBigInt(new(big.Int).Add(details.OwnerDeposit.ToBigInt(), diff))
+	} else {
+		releaseOwnerDeposit(details, new(big.Int).Sub(oldDeposit, newDeposit))
+	}
+
+	return nil
+}
+
+// releaseOwnerDeposit unreserves `amount` from the owner of the collection.
+func releaseOwnerDeposit(details *types.CollectionDetails, amount *big.Int) {
+	dispatchables.Unreserve(details.Owner, amount)
+	details.OwnerDeposit = sc.NewU128FromBigInt(new(big.Int).Sub(details.OwnerDeposit.ToBigInt(), amount))
+}
+
+func newNftsError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   nfts.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
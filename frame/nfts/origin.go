@@ -0,0 +1,9 @@
+package nfts
+
+import (
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ForceOrigin is the origin which can force create collections and destroy them without being the owner.
+var ForceOrigin types.EnsureOrigin = system.EnsureRoot{}
@@ -0,0 +1,198 @@
+package nfts
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetCollection returns the details of a collection.
+func StorageGetCollection(collection types.CollectionId) sc.Option[types.CollectionDetails] {
+	option := storage.Get(keyCollection(collection))
+	if !option.HasValue {
+		return sc.NewOption[types.CollectionDetails](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.CollectionDetails](types.DecodeCollectionDetails(buffer))
+}
+
+func StorageSetCollection(collection types.CollectionId, details types.CollectionDetails) {
+	storage.Set(keyCollection(collection), details.Bytes())
+}
+
+func StorageClearCollection(collection types.CollectionId) {
+	storage.Clear(keyCollection(collection))
+}
+
+// StorageGetNextCollectionId returns the id to be used by the next created collection.
+func StorageGetNextCollectionId() types.CollectionId {
+	return storage.GetDecode(keyNextCollectionId(), sc.DecodeU32)
+}
+
+func StorageSetNextCollectionId(collection types.CollectionId) {
+	storage.Set(keyNextCollectionId(), collection.Bytes())
+}
+
+// StorageGetItem returns the details of an item in a collection.
+func StorageGetItem(collection types.CollectionId, item types.ItemId) sc.Option[types.ItemDetails] {
+	option := storage.Get(keyItem(collection, item))
+	if !option.HasValue {
+		return sc.NewOption[types.ItemDetails](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.ItemDetails](types.DecodeItemDetails(buffer))
+}
+
+func StorageSetItem(collection types.CollectionId, item types.ItemId, details types.ItemDetails) {
+	storage.Set(keyItem(collection, item), details.Bytes())
+}
+
+func StorageClearItem(collection types.CollectionId, item types.ItemId) {
+	storage.Clear(keyItem(collection, item))
+}
+
+// StorageGetCollectionMetadata returns the metadata of a collection.
+func StorageGetCollectionMetadata(collection types.CollectionId) sc.Option[types.NftMetadata] {
+	option := storage.Get(keyCollectionMetadataOf(collection))
+	if !option.HasValue {
+		return sc.NewOption[types.NftMetadata](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.NftMetadata](types.DecodeNftMetadata(buffer))
+}
+
+func StorageSetCollectionMetadata(collection types.CollectionId, metadata types.NftMetadata) {
+	storage.Set(keyCollectionMetadataOf(collection), metadata.Bytes())
+}
+
+func StorageClearCollectionMetadata(collection types.CollectionId) {
+	storage.Clear(keyCollectionMetadataOf(collection))
+}
+
+// StorageGetItemMetadata returns the metadata of an item in a collection.
+func StorageGetItemMetadata(collection types.CollectionId, item types.ItemId) sc.Option[types.NftMetadata] {
+	option := storage.Get(keyItemMetadataOf(collection, item))
+	if !option.HasValue {
+		return sc.NewOption[types.NftMetadata](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.NftMetadata](types.DecodeNftMetadata(buffer))
+}
+
+func StorageSetItemMetadata(collection types.CollectionId, item types.ItemId, metadata types.NftMetadata) {
+	storage.Set(keyItemMetadataOf(collection, item), metadata.Bytes())
+}
+
+func StorageClearItemMetadata(collection types.CollectionId, item types.ItemId) {
+	storage.Clear(keyItemMetadataOf(collection, item))
+}
+
+// StorageGetAttribute returns the value of an attribute of a collection, or of an item if `maybeItem` is set.
+func StorageGetAttribute(collection types.CollectionId, maybeItem sc.Option[types.ItemId], key sc.Sequence[sc.U8]) sc.Option[types.NftAttribute] {
+	option := storage.Get(keyAttribute(collection, maybeItem, key))
+	if !option.HasValue {
+		return sc.NewOption[types.NftAttribute](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.NftAttribute](types.DecodeNftAttribute(buffer))
+}
+
+func StorageSetAttribute(collection types.CollectionId, maybeItem sc.Option[types.ItemId], key sc.Sequence[sc.U8], attribute types.NftAttribute) {
+	storage.Set(keyAttribute(collection, maybeItem, key), attribute.Bytes())
+}
+
+func StorageClearAttribute(collection types.CollectionId, maybeItem sc.Option[types.ItemId], key sc.Sequence[sc.U8]) {
+	storage.Clear(keyAttribute(collection, maybeItem, key))
+}
+
+// StorageGetAttributeKeys returns up to `limit` keys of the attributes of a collection,
+// or of an item if `maybeItem` is set.
+func StorageGetAttributeKeys(collection types.CollectionId, maybeItem sc.Option[types.ItemId], limit int) sc.Sequence[sc.Sequence[sc.U8]] {
+	prefix := prefixAttribute(collection, maybeItem)
+
+	keys := sc.Sequence[sc.Sequence[sc.U8]]{}
+	for _, key := range iterKeys(prefix, limit) {
+		// blake2_128_concat(key)
+		keys = append(keys, sc.DecodeSequence[sc.U8](bytes.NewBuffer(key[len(prefix)+16:])))
+	}
+
+	return keys
+}
+
+// iterKeys returns up to `limit` storage keys starting with `prefix`.
+func iterKeys(prefix []byte, limit int) [][]byte {
+	keys := [][]byte{}
+
+	current := prefix
+	for len(keys) < limit {
+		next := storage.NextKey(current)
+		if !next.HasValue {
+			break
+		}
+
+		key := sc.SequenceU8ToBytes(next.Value)
+		if !bytes.HasPrefix(key, prefix) {
+			break
+		}
+
+		keys = append(keys, key)
+		current = key
+	}
+
+	return keys
+}
+
+// blake2128Concat returns the key of `value` hashed with the blake2 128 concat hasher.
+func blake2128Concat(value []byte) []byte {
+	return append(hashing.Blake128(value), value...)
+}
+
+func keyCollection(collection types.CollectionId) []byte {
+	key := append(hashing.Twox128(constants.KeyNfts), hashing.Twox128(constants.KeyCollection)...)
+	return append(key, blake2128Concat(collection.Bytes())...)
+}
+
+func keyNextCollectionId() []byte {
+	return append(hashing.Twox128(constants.KeyNfts), hashing.Twox128(constants.KeyNextCollectionId)...)
+}
+
+func keyItem(collection types.CollectionId, item types.ItemId) []byte {
+	key := append(hashing.Twox128(constants.KeyNfts), hashing.Twox128(constants.KeyItem)...)
+	key = append(key, blake2128Concat(collection.Bytes())...)
+	return append(key, blake2128Concat(item.Bytes())...)
+}
+
+func keyCollectionMetadataOf(collection types.CollectionId) []byte {
+	key := append(hashing.Twox128(constants.KeyNfts), hashing.Twox128(constants.KeyCollectionMetadataOf)...)
+	return append(key, blake2128Concat(collection.Bytes())...)
+}
+
+func keyItemMetadataOf(collection types.CollectionId, item types.ItemId) []byte {
+	key := append(hashing.Twox128(constants.KeyNfts), hashing.Twox128(constants.KeyItemMetadataOf)...)
+	key = append(key, blake2128Concat(collection.Bytes())...)
+	return append(key, blake2128Concat(item.Bytes())...)
+}
+
+func prefixAttribute(collection types.CollectionId, maybeItem sc.Option[types.ItemId]) []byte {
+	key := append(hashing.Twox128(constants.KeyNfts), hashing.Twox128(constants.KeyAttribute)...)
+	key = append(key, blake2128Concat(collection.Bytes())...)
+	return append(key, blake2128Concat(maybeItem.Bytes())...)
+}
+
+func keyAttribute(collection types.CollectionId, maybeItem sc.Option[types.ItemId], key sc.Sequence[sc.U8]) []byte {
+	return append(prefixAttribute(collection, maybeItem), blake2128Concat(key.Bytes())...)
+}
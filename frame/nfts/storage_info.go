@@ -0,0 +1,30 @@
+package nfts
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// metadataMaxSize is the maximum encoded length of a types.NftMetadata, with at most StringLimit bytes.
+const metadataMaxSize = 16 + 2 + nfts.StringLimit
+
+var (
+	// StorageInfoCollection describes the `Collection` map, whose entries are
+	// Blake2_128Concat(CollectionId) ++ CollectionDetails.
+	StorageInfoCollection = types.NewStorageMapInfo("Nfts", "Collection", sc.NewOption[sc.U32](nil), 20+156)
+	// StorageInfoNextCollectionId describes the `NextCollectionId` value.
+	StorageInfoNextCollectionId = types.NewStorageValueInfo("Nfts", "NextCollectionId", 4)
+	// StorageInfoItem describes the `Item` map, whose entries are Blake2_128Concat(CollectionId) ++
+	// Blake2_128Concat(ItemId) ++ ItemDetails, with at most ApprovalsLimit approvals.
+	StorageInfoItem = types.NewStorageMapInfo("Nfts", "Item", sc.NewOption[sc.U32](nil), 20+20+32+1+nfts.ApprovalsLimit*(32+5)+48+1)
+	// StorageInfoCollectionMetadataOf describes the `CollectionMetadataOf` map, whose entries are
+	// Blake2_128Concat(CollectionId) ++ CollectionMetadata.
+	StorageInfoCollectionMetadataOf = types.NewStorageMapInfo("Nfts", "CollectionMetadataOf", sc.NewOption[sc.U32](nil), 20+metadataMaxSize)
+	// StorageInfoItemMetadataOf describes the `ItemMetadataOf` map, whose entries are
+	// Blake2_128Concat(CollectionId) ++ Blake2_128Concat(ItemId) ++ ItemMetadata.
+	StorageInfoItemMetadataOf = types.NewStorageMapInfo("Nfts", "ItemMetadataOf", sc.NewOption[sc.U32](nil), 20+20+metadataMaxSize)
+	// StorageInfoAttribute describes the `Attribute` map, whose entries are Blake2_128Concat(CollectionId) ++
+	// Blake2_128Concat(Option<ItemId>) ++ Blake2_128Concat(BoundedVec<u8, KeyLimit>) ++ (BoundedVec<u8, ValueLimit>, Deposit).
+	StorageInfoAttribute = types.NewStorageMapInfo("Nfts", "Attribute", sc.NewOption[sc.U32](nil), 20+21+16+2+nfts.KeyLimit+2+nfts.ValueLimit+16)
+)
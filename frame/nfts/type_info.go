@@ -0,0 +1,48 @@
+package nfts
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// CollectionDetailsTypeInfo is the type info of types.CollectionDetails.
+	CollectionDetailsTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_nfts", "types", "CollectionDetails"},
+		"The details of a collection of non-fungible items.",
+		types.NewFieldTypeInfo("owner", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("issuer", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("admin", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("freezer", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("owner_deposit", "DepositBalance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("items", "u32", types.TypeInfoU32),
+		types.NewFieldTypeInfo("item_metadatas", "u32", types.TypeInfoU32),
+		types.NewFieldTypeInfo("attributes", "u32", types.TypeInfoU32),
+	)
+	// ItemApprovalTypeInfo is the type info of types.ItemApproval.
+	ItemApprovalTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_nfts", "types", "ItemApproval"},
+		"An account approved to transfer an item, optionally until a given block.",
+		types.NewFieldTypeInfo("delegate", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("deadline", "Option<BlockNumber>", types.TypeId(metadata.TypesOptionU32)),
+	)
+	// ItemDepositTypeInfo is the type info of types.ItemDeposit.
+	ItemDepositTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_nfts", "types", "ItemDeposit"},
+		"The balance deposited for an item and the account it was reserved from.",
+		types.NewFieldTypeInfo("account", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("amount", "DepositBalance", types.TypeInfoU128),
+	)
+	// ItemDetailsTypeInfo is the type info of types.ItemDetails.
+	ItemDetailsTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_nfts", "types", "ItemDetails"},
+		"The details of a non-fungible item.",
+		types.NewFieldTypeInfo("owner", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("approvals", "Approvals", types.NewSequenceTypeInfo(ItemApprovalTypeInfo)),
+		types.NewFieldTypeInfo("deposit", "Deposit", ItemDepositTypeInfo),
+		types.NewFieldTypeInfo("is_transfer_locked", "bool", types.TypeInfoBool),
+	)
+	// ItemMetadataTypeInfo is the type info of types.NftMetadata.
+	ItemMetadataTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_nfts", "types", "ItemMetadata"},
+		"The metadata of a collection or an item.",
+		types.NewFieldTypeInfo("deposit", "Deposit", types.TypeInfoU128),
+		types.NewFieldTypeInfo("data", "BoundedVec<u8, StringLimit>", types.TypeId(metadata.TypesSequenceU8)),
+	)
+)
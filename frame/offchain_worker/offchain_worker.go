@@ -1,35 +1,38 @@
 package offchain_worker
 
 import (
-	"bytes"
-
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/api"
 	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/types"
-	"github.com/LimeChain/gosemble/utils"
+)
+
+// Api is the `OffchainWorkerApi` runtime API, which runs the offchain workers of the modules.
+var Api = api.New("OffchainWorkerApi", 2, "The offchain worker api.",
+	api.NewMethod1("offchain_worker",
+		api.NewArg("header", types.TypeId(metadata.TypesHeader), types.DecodeHeader),
+		types.TypeId(metadata.TypesEmptyTuple),
+		"Starts the off-chain task for given block header.",
+		OffchainWorker),
 )
 
 // OffchainWorker starts an off-chain task for an imported block.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded header of the block.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#id-offchainworkerapi_offchain_worker)
-func OffchainWorker(dataPtr int32, dataLen int32) {
-	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(b)
-
-	header := types.DecodeHeader(buffer)
-
+func OffchainWorker(header types.Header) sc.Empty {
 	system.Initialize(header.Number, header.ParentHash, header.Digest)
 
 	hash := hashing.Blake256(header.Bytes())
 
 	system.StorageSetBlockHash(header.Number, types.NewBlake2bHash(sc.BytesToSequenceU8(hash)...))
 
-	// TODO:
-	/*
-		<AllPalletsWithSystem as OffchainWorker<System::BlockNumber>>::offchain_worker(*header.number(),)
-	*/
+	for _, index := range config.ModuleIndices() {
+		if module, ok := config.Modules[index].(types.OffchainWorkerModule); ok {
+			module.OffchainWorker(header.Number)
+		}
+	}
+
+	return sc.Empty{}
 }
@@ -0,0 +1,151 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/preimage"
+	pallet "github.com/LimeChain/gosemble/frame/preimage"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type NotePreimageCall struct {
+	primitives.Callable
+}
+
+func NewNotePreimageCall(args sc.VaryingData) NotePreimageCall {
+	call := NotePreimageCall{
+		Callable: primitives.Callable{
+			ModuleId:   preimage.ModuleIndex,
+			FunctionId: preimage.FunctionNotePreimageIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c NotePreimageCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeSequence[sc.U8](buffer),
+	)
+	return c
+}
+
+func (c NotePreimageCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c NotePreimageCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c NotePreimageCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c NotePreimageCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ NotePreimageCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "note_preimage",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("bytes", "Vec<u8>", primitives.TypeId(metadata.TypesSequenceU8)),
+		},
+		Docs: "Register a preimage on-chain.",
+	}
+}
+
+func (c NotePreimageCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// The range of component `s` is `[0, 4194304]`.
+func (_ NotePreimageCall) BaseWeight(args ...any) types.Weight {
+	// Minimum execution time: 29_140 nanoseconds.
+	// Standard Error: 1
+	s := sc.U64(0)
+	if len(args) != 0 {
+		if callArgs, ok := args[0].(sc.VaryingData); ok && len(callArgs) != 0 {
+			s = sc.U64(len(callArgs[0].(sc.Sequence[sc.U8])))
+		}
+	}
+
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoStatusFor, system.StorageInfoAccount)
+	return types.WeightFromParts(29_509_000, 0).
+		SaturatingAdd(types.WeightFromParts(1_726, 0).SaturatingMul(s)).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ NotePreimageCall) IsInherent() bool {
+	return false
+}
+
+func (_ NotePreimageCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ NotePreimageCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ NotePreimageCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ NotePreimageCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return notePreimage(origin, args[0].(sc.Sequence[sc.U8]))
+}
+
+// notePreimage registers a preimage on-chain.
+//
+// If the preimage was previously requested, no fees or deposits are taken for providing
+// the preimage. Otherwise, a deposit is taken proportional to the size of the preimage.
+func notePreimage(origin types.RuntimeOrigin, bytes sc.Sequence[sc.U8]) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	maybeSender, err := system.EnsureSignedOrRoot(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	systemRequested, err := pallet.NotePreimage(bytes, maybeSender)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	if systemRequested {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: false,
+			Ok: types.PostDispatchInfo{
+				PaysFee: types.PaysNo,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
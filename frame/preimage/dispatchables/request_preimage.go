@@ -0,0 +1,126 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/preimage"
+	pallet "github.com/LimeChain/gosemble/frame/preimage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type RequestPreimageCall struct {
+	primitives.Callable
+}
+
+func NewRequestPreimageCall(args sc.VaryingData) RequestPreimageCall {
+	call := RequestPreimageCall{
+		Callable: primitives.Callable{
+			ModuleId:   preimage.ModuleIndex,
+			FunctionId: preimage.FunctionRequestPreimageIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c RequestPreimageCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeH256(buffer),
+	)
+	return c
+}
+
+func (c RequestPreimageCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c RequestPreimageCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c RequestPreimageCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c RequestPreimageCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ RequestPreimageCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "request_preimage",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+		},
+		Docs: "Request a preimage be uploaded to the chain without paying any fees or deposits.",
+	}
+}
+
+func (c RequestPreimageCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ RequestPreimageCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 18_010 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoStatusFor)
+	return types.WeightFromParts(18_010_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ RequestPreimageCall) IsInherent() bool {
+	return false
+}
+
+func (_ RequestPreimageCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ RequestPreimageCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ RequestPreimageCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ RequestPreimageCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := requestPreimage(origin, args[0].(types.H256))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// requestPreimage requests a preimage be uploaded to the chain without paying any fees or deposits.
+// If the preimage has already been noted, it is kept until the request is cleared.
+// Can only be called by the manager (root).
+func requestPreimage(origin types.RuntimeOrigin, hash types.H256) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	pallet.RequestPreimage(hash)
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/preimage"
+	pallet "github.com/LimeChain/gosemble/frame/preimage"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type UnnotePreimageCall struct {
+	primitives.Callable
+}
+
+func NewUnnotePreimageCall(args sc.VaryingData) UnnotePreimageCall {
+	call := UnnotePreimageCall{
+		Callable: primitives.Callable{
+			ModuleId:   preimage.ModuleIndex,
+			FunctionId: preimage.FunctionUnnotePreimageIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c UnnotePreimageCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeH256(buffer),
+	)
+	return c
+}
+
+func (c UnnotePreimageCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c UnnotePreimageCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c UnnotePreimageCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c UnnotePreimageCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ UnnotePreimageCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "unnote_preimage",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+		},
+		Docs: "Clear an unrequested preimage from the runtime storage.",
+	}
+}
+
+func (c UnnotePreimageCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ UnnotePreimageCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 35_214 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoStatusFor, system.StorageInfoAccount)
+	return types.WeightFromParts(35_214_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ UnnotePreimageCall) IsInherent() bool {
+	return false
+}
+
+func (_ UnnotePreimageCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ UnnotePreimageCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ UnnotePreimageCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ UnnotePreimageCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := unnotePreimage(origin, args[0].(types.H256))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// unnotePreimage clears an unrequested preimage from the runtime storage.
+// If the preimage was noted by a signed account, only that account can clear it
+// and its deposit is returned.
+func unnotePreimage(origin types.RuntimeOrigin, hash types.H256) types.DispatchError {
+	maybeSender, err := ensureSignedOrManager(origin)
+	if err != nil {
+		return err
+	}
+
+	return pallet.UnnotePreimage(hash, maybeSender)
+}
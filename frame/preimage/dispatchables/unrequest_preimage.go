@@ -0,0 +1,125 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/preimage"
+	pallet "github.com/LimeChain/gosemble/frame/preimage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type UnrequestPreimageCall struct {
+	primitives.Callable
+}
+
+func NewUnrequestPreimageCall(args sc.VaryingData) UnrequestPreimageCall {
+	call := UnrequestPreimageCall{
+		Callable: primitives.Callable{
+			ModuleId:   preimage.ModuleIndex,
+			FunctionId: preimage.FunctionUnrequestPreimageIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c UnrequestPreimageCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeH256(buffer),
+	)
+	return c
+}
+
+func (c UnrequestPreimageCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c UnrequestPreimageCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c UnrequestPreimageCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c UnrequestPreimageCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ UnrequestPreimageCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "unrequest_preimage",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+		},
+		Docs: "Clear a previously made request for a preimage.",
+	}
+}
+
+func (c UnrequestPreimageCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ UnrequestPreimageCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 26_441 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoStatusFor)
+	return types.WeightFromParts(26_441_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ UnrequestPreimageCall) IsInherent() bool {
+	return false
+}
+
+func (_ UnrequestPreimageCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ UnrequestPreimageCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ UnrequestPreimageCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ UnrequestPreimageCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := unrequestPreimage(origin, args[0].(types.H256))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// requestPreimage requests a preimage be uploaded to the chain without paying any fees or deposits.
+// If the preimage requests has already been provided on-chain, we unreserve any deposit
+// a user may have paid, and take the control of the preimage out of their hands.
+// Can only be called by the manager (root).
+func unrequestPreimage(origin types.RuntimeOrigin, hash types.H256) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.UnrequestPreimage(hash)
+}
@@ -0,0 +1,13 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Preimage module errors.
+const (
+	ErrorTooBig sc.U8 = iota
+	ErrorAlreadyNoted
+	ErrorNotAuthorized
+	ErrorNotNoted
+	ErrorRequested
+	ErrorNotRequested
+)
@@ -0,0 +1,54 @@
+package events
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/preimage"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Preimage module events.
+const (
+	EventNoted sc.U8 = iota
+	EventRequested
+	EventCleared
+)
+
+func NewEventNoted(hash types.H256) types.Event {
+	return types.NewEvent(preimage.ModuleIndex, EventNoted, hash)
+}
+
+func NewEventRequested(hash types.H256) types.Event {
+	return types.NewEvent(preimage.ModuleIndex, EventRequested, hash)
+}
+
+func NewEventCleared(hash types.H256) types.Event {
+	return types.NewEvent(preimage.ModuleIndex, EventCleared, hash)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	module := sc.DecodeU8(buffer)
+	if module != preimage.ModuleIndex {
+		log.Critical("invalid preimage.Event module")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventNoted:
+		hash := types.DecodeH256(buffer)
+		return NewEventNoted(hash)
+	case EventRequested:
+		hash := types.DecodeH256(buffer)
+		return NewEventRequested(hash)
+	case EventCleared:
+		hash := types.DecodeH256(buffer)
+		return NewEventCleared(hash)
+	default:
+		log.Critical("invalid preimage.Event type")
+	}
+
+	panic("unreachable")
+}
@@ -0,0 +1,109 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/preimage"
+	pallet "github.com/LimeChain/gosemble/frame/preimage"
+	"github.com/LimeChain/gosemble/frame/preimage/dispatchables"
+	"github.com/LimeChain/gosemble/frame/preimage/errors"
+	"github.com/LimeChain/gosemble/frame/preimage/events"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type PreimageModule struct {
+	functions map[sc.U8]primitives.Call
+}
+
+func NewPreimageModule() PreimageModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[preimage.FunctionNotePreimageIndex] = dispatchables.NewNotePreimageCall(nil)
+	functions[preimage.FunctionUnnotePreimageIndex] = dispatchables.NewUnnotePreimageCall(nil)
+	functions[preimage.FunctionRequestPreimageIndex] = dispatchables.NewRequestPreimageCall(nil)
+	functions[preimage.FunctionUnrequestPreimageIndex] = dispatchables.NewUnrequestPreimageCall(nil)
+
+	return PreimageModule{
+		functions: functions,
+	}
+}
+
+func (pm PreimageModule) Functions() map[sc.U8]primitives.Call {
+	return pm.functions
+}
+
+func (pm PreimageModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (pm PreimageModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (pm PreimageModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Storage of preimages of hashes, such as encoded calls."}
+}
+
+func (pm PreimageModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "Preimage",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "Preimage",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"StatusFor",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncIdentity},
+						sc.ToCompact(metadata.TypesH256),
+						sc.ToCompact(registry.Register(pallet.RequestStatusTypeInfo))),
+					"The request status of a given hash."),
+				primitives.NewMetadataModuleStorageEntry(
+					"PreimageFor",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncIdentity},
+						sc.ToCompact(registry.Register(primitives.NewTupleTypeInfo(primitives.TypeId(metadata.TypesH256), primitives.TypeInfoU32))),
+						sc.ToCompact(metadata.TypesSequenceU8)),
+					""),
+			},
+		}),
+		Call:      sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(pm.callsTypeInfo()))),
+		Event:     sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(eventTypeInfo()))),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(errorsTypeInfo()))),
+		Index:     preimage.ModuleIndex,
+	}
+}
+
+func (pm PreimageModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_preimage", "pallet", "Call"}, pm.functions)
+}
+
+func eventTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_preimage", "pallet", "Event"},
+		"The events of the module.",
+		primitives.NewVariantTypeInfo("Noted", events.EventNoted, "A preimage has been noted.",
+			primitives.NewFieldTypeInfo("hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+		),
+		primitives.NewVariantTypeInfo("Requested", events.EventRequested, "A preimage has been requested.",
+			primitives.NewFieldTypeInfo("hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+		),
+		primitives.NewVariantTypeInfo("Cleared", events.EventCleared, "A preimage has been cleared.",
+			primitives.NewFieldTypeInfo("hash", "T::Hash", primitives.TypeId(metadata.TypesH256)),
+		),
+	)
+}
+
+func errorsTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_preimage", "pallet", "Error"},
+		"The errors of the module.",
+		primitives.NewVariantTypeInfo("TooBig", errors.ErrorTooBig, "Preimage is too large to store on-chain."),
+		primitives.NewVariantTypeInfo("AlreadyNoted", errors.ErrorAlreadyNoted, "Preimage has already been noted on-chain."),
+		primitives.NewVariantTypeInfo("NotAuthorized", errors.ErrorNotAuthorized, "The user is not authorized to perform this action."),
+		primitives.NewVariantTypeInfo("NotNoted", errors.ErrorNotNoted, "The preimage cannot be removed since it has not yet been noted."),
+		primitives.NewVariantTypeInfo("Requested", errors.ErrorRequested, "A preimage may not be removed when there are outstanding requests."),
+		primitives.NewVariantTypeInfo("NotRequested", errors.ErrorNotRequested, "The preimage request cannot be removed since no outstanding requests exist."),
+	)
+}
@@ -0,0 +1,335 @@
+package preimage
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/preimage"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/preimage/errors"
+	"github.com/LimeChain/gosemble/frame/preimage/events"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// NotePreimage stores `data` as a preimage. If the preimage has not been requested and `maybeDepositor`
+// is set, a deposit is reserved from the depositor.
+// Returns whether the preimage was requested.
+func NotePreimage(data sc.Sequence[sc.U8], maybeDepositor sc.Option[types.AccountId]) (sc.Bool, types.DispatchError) {
+	if len(data) > preimage.MaxSize {
+		return false, types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   preimage.ModuleIndex,
+			Error:   sc.U32(errors.ErrorTooBig),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	hash := Hash(data)
+	length := sc.U32(len(data))
+
+	var status types.RequestStatus
+	maybeStatus := StorageGetStatusFor(hash)
+
+	if maybeStatus.HasValue && maybeStatus.Value.IsRequested {
+		status = types.NewRequestStatusRequested(maybeStatus.Value.Deposit, maybeStatus.Value.Count, sc.NewOption[sc.U32](length))
+	} else if maybeStatus.HasValue && maybeDepositor.HasValue {
+		return false, types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   preimage.ModuleIndex,
+			Error:   sc.U32(errors.ErrorAlreadyNoted),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	} else if maybeStatus.HasValue {
+		status = types.NewRequestStatusRequested(maybeStatus.Value.Deposit, 1, sc.NewOption[sc.U32](length))
+	} else if maybeDepositor.HasValue {
+		deposit := Deposit(length)
+		err := dispatchables.Reserve(maybeDepositor.Value, deposit)
+		if err != nil {
+			return false, err
+		}
+
+		status = types.NewRequestStatusUnrequested(
+			types.AccountDeposit{
+				Who:    maybeDepositor.Value,
+				Amount: sc.NewU128FromBigInt(deposit),
+			},
+			length,
+		)
+	} else {
+		status = types.NewRequestStatusRequested(sc.NewOption[types.AccountDeposit](nil), 1, sc.NewOption[sc.U32](length))
+	}
+
+	StorageSetStatusFor(hash, status)
+	StorageSetPreimageFor(hash, length, data)
+
+	system.DepositEvent(events.NewEventNoted(hash))
+
+	return status.IsRequested, nil
+}
+
+// UnnotePreimage clears a preimage noted by `maybeCheckOwner` and returns its deposit.
+// If `maybeCheckOwner` is not set, the preimage is unrequested instead.
+func UnnotePreimage(hash types.H256, maybeCheckOwner sc.Option[types.AccountId]) types.DispatchError {
+	maybeStatus := StorageGetStatusFor(hash)
+	if !maybeStatus.HasValue {
+		return types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   preimage.ModuleIndex,
+			Error:   sc.U32(errors.ErrorNotNoted),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+	status := maybeStatus.Value
+
+	if status.IsRequested && !status.Deposit.HasValue {
+		if maybeCheckOwner.HasValue {
+			return types.NewDispatchErrorModule(types.CustomModuleError{
+				Index:   preimage.ModuleIndex,
+				Error:   sc.U32(errors.ErrorNotAuthorized),
+				Message: sc.NewOption[sc.Str](nil),
+			})
+		}
+
+		return UnrequestPreimage(hash)
+	}
+
+	deposit := status.Deposit.Value
+	if maybeCheckOwner.HasValue && !isOwner(maybeCheckOwner.Value, deposit) {
+		return types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   preimage.ModuleIndex,
+			Error:   sc.U32(errors.ErrorNotAuthorized),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	dispatchables.Unreserve(deposit.Who, deposit.Amount.ToBigInt())
+
+	if status.IsRequested {
+		StorageSetStatusFor(hash, types.NewRequestStatusRequested(sc.NewOption[types.AccountDeposit](nil), status.Count, status.Len))
+		return nil
+	}
+
+	remove(hash, status.Len.Value)
+
+	return nil
+}
+
+// RequestPreimage adds a request for the preimage of `hash`.
+// Any deposit held for the preimage is kept until it is unnoted.
+func RequestPreimage(hash types.H256) {
+	var status types.RequestStatus
+	maybeStatus := StorageGetStatusFor(hash)
+
+	if maybeStatus.HasValue && maybeStatus.Value.IsRequested {
+		status = types.NewRequestStatusRequested(maybeStatus.Value.Deposit, maybeStatus.Value.Count.SaturatingAdd(1), maybeStatus.Value.Len)
+	} else if maybeStatus.HasValue {
+		status = types.NewRequestStatusRequested(maybeStatus.Value.Deposit, 1, maybeStatus.Value.Len)
+	} else {
+		status = types.NewRequestStatusRequested(sc.NewOption[types.AccountDeposit](nil), 1, sc.NewOption[sc.U32](nil))
+	}
+
+	StorageSetStatusFor(hash, status)
+
+	if status.Count == 1 {
+		system.DepositEvent(events.NewEventRequested(hash))
+	}
+}
+
+// UnrequestPreimage removes a request for the preimage of `hash`.
+// When the last request is removed, the preimage is cleared unless it has a deposit.
+func UnrequestPreimage(hash types.H256) types.DispatchError {
+	maybeStatus := StorageGetStatusFor(hash)
+	if !maybeStatus.HasValue || !maybeStatus.Value.IsRequested {
+		return types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   preimage.ModuleIndex,
+			Error:   sc.U32(errors.ErrorNotRequested),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+	status := maybeStatus.Value
+
+	if status.Count > 1 {
+		StorageSetStatusFor(hash, types.NewRequestStatusRequested(status.Deposit, status.Count-1, status.Len))
+	} else if status.Deposit.HasValue && status.Len.HasValue {
+		StorageSetStatusFor(hash, types.NewRequestStatusUnrequested(status.Deposit.Value, status.Len.Value))
+	} else if status.Len.HasValue {
+		remove(hash, status.Len.Value)
+	} else {
+		StorageClearStatusFor(hash)
+	}
+
+	return nil
+}
+
+// Deposit returns the amount held for noting a preimage of `length` bytes.
+func Deposit(length sc.U32) *big.Int {
+	byteDeposit := new(big.Int).Mul(preimage.ByteDeposit, big.NewInt(int64(length)))
+	return new(big.Int).Add(preimage.BaseDeposit, byteDeposit)
+}
+
+// Hash returns the hash under which `data` is stored.
+func Hash(data sc.Sequence[sc.U8]) types.H256 {
+	hash := hashing.Blake256(sc.SequenceU8ToBytes(data))
+	return types.NewH256(sc.BytesToSequenceU8(hash)...)
+}
+
+// isOwner checks whether `who` has paid the deposit.
+func isOwner(who types.AccountId, deposit types.AccountDeposit) sc.Bool {
+	return sc.Bool(reflect.DeepEqual(who, deposit.Who))
+}
+
+// remove clears the preimage and its status.
+func remove(hash types.H256, length sc.U32) {
+	StorageClearStatusFor(hash)
+	StorageClearPreimageFor(hash, length)
+
+	system.DepositEvent(events.NewEventCleared(hash))
+}
+
+// QueryPreimage is used by other modules to look up preimages (e.g. calls) by their hash.
+type QueryPreimage interface {
+	// Len returns the length of the preimage of `hash`, if it is known.
+	Len(hash types.H256) sc.Option[sc.U32]
+	// Fetch returns the preimage of `hash`.
+	Fetch(hash types.H256, length sc.Option[sc.U32]) (sc.Sequence[sc.U8], types.DispatchError)
+	// IsRequested returns whether there is an outstanding request for the preimage of `hash`.
+	IsRequested(hash types.H256) sc.Bool
+	// Request makes sure the preimage of `hash` is kept, once it is noted.
+	Request(hash types.H256)
+	// Unrequest removes a request made through Request.
+	Unrequest(hash types.H256)
+	// Peek returns the call referenced by `bounded`, without dropping it.
+	Peek(bounded types.Bounded) (types.Call, sc.Option[sc.U32], types.DispatchError)
+	// Realize returns the call referenced by `bounded` and drops it.
+	Realize(bounded types.Bounded) (types.Call, sc.Option[sc.U32], types.DispatchError)
+	// Drop removes the request for the data referenced by `bounded`, if it was stored by hash.
+	Drop(bounded types.Bounded)
+}
+
+// StorePreimage is used by other modules to store preimages (e.g. calls) by their hash.
+type StorePreimage interface {
+	QueryPreimage
+	// Note stores `data` as a requested preimage and returns its hash.
+	Note(data sc.Sequence[sc.U8]) (types.H256, types.DispatchError)
+	// Unnote clears a preimage stored through Note.
+	Unnote(hash types.H256)
+	// Bound returns a reference to `call`, which is stored inline if it is small enough
+	// and noted as a preimage otherwise.
+	Bound(call types.Call) (types.Bounded, types.DispatchError)
+}
+
+// Preimages implements QueryPreimage and StorePreimage using the preimage module storage.
+type Preimages struct{}
+
+func NewPreimages() Preimages {
+	return Preimages{}
+}
+
+func (p Preimages) Len(hash types.H256) sc.Option[sc.U32] {
+	maybeStatus := StorageGetStatusFor(hash)
+	if !maybeStatus.HasValue {
+		return sc.NewOption[sc.U32](nil)
+	}
+
+	return maybeStatus.Value.Len
+}
+
+func (p Preimages) Fetch(hash types.H256, length sc.Option[sc.U32]) (sc.Sequence[sc.U8], types.DispatchError) {
+	if !length.HasValue {
+		length = p.Len(hash)
+		if !length.HasValue {
+			return nil, types.NewDispatchErrorUnavailable()
+		}
+	}
+
+	data := StorageGetPreimageFor(hash, length.Value)
+	if !data.HasValue {
+		return nil, types.NewDispatchErrorUnavailable()
+	}
+
+	return data.Value, nil
+}
+
+func (p Preimages) IsRequested(hash types.H256) sc.Bool {
+	maybeStatus := StorageGetStatusFor(hash)
+	return maybeStatus.HasValue && maybeStatus.Value.IsRequested
+}
+
+func (p Preimages) Request(hash types.H256) {
+	RequestPreimage(hash)
+}
+
+func (p Preimages) Unrequest(hash types.H256) {
+	UnrequestPreimage(hash)
+}
+
+func (p Preimages) Peek(bounded types.Bounded) (types.Call, sc.Option[sc.U32], types.DispatchError) {
+	var data sc.Sequence[sc.U8]
+
+	if bounded.IsInline() {
+		data = bounded.AsInline()
+	} else {
+		fetched, err := p.Fetch(bounded.Hash(), bounded.Len())
+		if err != nil {
+			return nil, sc.NewOption[sc.U32](nil), err
+		}
+		data = fetched
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(data))
+	call, decodeErr := support.TryDecodeCall(buffer)
+	if decodeErr != nil || buffer.Len() != 0 {
+		return nil, sc.NewOption[sc.U32](nil), types.NewDispatchErrorCorruption()
+	}
+
+	return call, sc.NewOption[sc.U32](sc.U32(len(data))), nil
+}
+
+func (p Preimages) Realize(bounded types.Bounded) (types.Call, sc.Option[sc.U32], types.DispatchError) {
+	call, length, err := p.Peek(bounded)
+	if err != nil {
+		return nil, length, err
+	}
+
+	p.Drop(bounded)
+
+	return call, length, nil
+}
+
+func (p Preimages) Drop(bounded types.Bounded) {
+	if bounded.LookupNeeded() {
+		p.Unrequest(bounded.Hash())
+	}
+}
+
+func (p Preimages) Note(data sc.Sequence[sc.U8]) (types.H256, types.DispatchError) {
+	hash := Hash(data)
+
+	_, err := NotePreimage(data, sc.NewOption[types.AccountId](nil))
+	if err != nil {
+		return types.H256{}, err
+	}
+
+	return hash, nil
+}
+
+func (p Preimages) Unnote(hash types.H256) {
+	UnnotePreimage(hash, sc.NewOption[types.AccountId](nil))
+}
+
+func (p Preimages) Bound(call types.Call) (types.Bounded, types.DispatchError) {
+	data := sc.BytesToSequenceU8(call.Bytes())
+
+	if len(data) <= types.MaxBoundedInlineLen {
+		return types.NewBoundedInline(data), nil
+	}
+
+	hash, err := p.Note(data)
+	if err != nil {
+		return types.Bounded{}, err
+	}
+
+	return types.NewBoundedLookup(hash, sc.U32(len(data))), nil
+}
@@ -0,0 +1,70 @@
+package preimage
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetStatusFor returns the request status of a given hash.
+func StorageGetStatusFor(hash types.H256) sc.Option[types.RequestStatus] {
+	option := storage.Get(keyStatusFor(hash))
+	if !option.HasValue {
+		return sc.NewOption[types.RequestStatus](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.RequestStatus](types.DecodeRequestStatus(buffer))
+}
+
+func StorageSetStatusFor(hash types.H256, status types.RequestStatus) {
+	storage.Set(keyStatusFor(hash), status.Bytes())
+}
+
+func StorageClearStatusFor(hash types.H256) {
+	storage.Clear(keyStatusFor(hash))
+}
+
+// StorageGetPreimageFor returns the preimage of a given hash and length.
+func StorageGetPreimageFor(hash types.H256, length sc.U32) sc.Option[sc.Sequence[sc.U8]] {
+	option := storage.Get(keyPreimageFor(hash, length))
+	if !option.HasValue {
+		return option
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[sc.Sequence[sc.U8]](sc.DecodeSequence[sc.U8](buffer))
+}
+
+func StorageSetPreimageFor(hash types.H256, length sc.U32, preimage sc.Sequence[sc.U8]) {
+	storage.Set(keyPreimageFor(hash, length), preimage.Bytes())
+}
+
+func StorageClearPreimageFor(hash types.H256, length sc.U32) {
+	storage.Clear(keyPreimageFor(hash, length))
+}
+
+// keyStatusFor returns the storage key of `StatusFor`, which uses the identity hasher.
+func keyStatusFor(hash types.H256) []byte {
+	preimageHash := hashing.Twox128(constants.KeyPreimage)
+	statusForHash := hashing.Twox128(constants.KeyStatusFor)
+
+	key := append(preimageHash, statusForHash...)
+	return append(key, hash.Bytes()...)
+}
+
+// keyPreimageFor returns the storage key of `PreimageFor`, which uses the identity hasher.
+func keyPreimageFor(hash types.H256, length sc.U32) []byte {
+	preimageHash := hashing.Twox128(constants.KeyPreimage)
+	preimageForHash := hashing.Twox128(constants.KeyPreimageFor)
+
+	key := append(preimageHash, preimageForHash...)
+	key = append(key, hash.Bytes()...)
+	return append(key, length.Bytes()...)
+}
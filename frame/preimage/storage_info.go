@@ -0,0 +1,16 @@
+package preimage
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/preimage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// StorageInfoStatusFor describes the `StatusFor` map, whose entries are
+	// Identity(H256) ++ RequestStatus.
+	StorageInfoStatusFor = types.NewStorageMapInfo("Preimage", "StatusFor", sc.NewOption[sc.U32](nil), 32+59)
+	// StorageInfoPreimageFor describes the `PreimageFor` map, whose entries are
+	// Identity((H256, u32)) ++ BoundedVec<u8, MaxSize>.
+	StorageInfoPreimageFor = types.NewStorageMapInfo("Preimage", "PreimageFor", sc.NewOption[sc.U32](nil), 36+4+preimage.MaxSize)
+)
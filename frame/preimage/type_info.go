@@ -0,0 +1,23 @@
+package preimage
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// RequestStatusTypeInfo is the type info of types.RequestStatus.
+	RequestStatusTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_preimage", "RequestStatus"},
+		"A type to note whether a preimage is owned by a user or the system.",
+		types.NewVariantTypeInfo("Unrequested", types.RequestStatusUnrequested, "RequestStatus.Unrequested",
+			types.NewFieldTypeInfo("deposit", "(AccountId, Balance)", types.NewTupleTypeInfo(types.TypeId(metadata.TypesAddress32), types.TypeInfoU128)),
+			types.NewFieldTypeInfo("len", "u32", types.TypeInfoU32),
+		),
+		types.NewVariantTypeInfo("Requested", types.RequestStatusRequested, "RequestStatus.Requested",
+			types.NewFieldTypeInfo("deposit", "Option<(AccountId, Balance)>", types.NewOptionTypeInfo(types.NewTupleTypeInfo(types.TypeId(metadata.TypesAddress32), types.TypeInfoU128))),
+			types.NewFieldTypeInfo("count", "u32", types.TypeInfoU32),
+			types.NewFieldTypeInfo("len", "Option<u32>", types.TypeId(metadata.TypesOptionU32)),
+		),
+	)
+)
@@ -0,0 +1,24 @@
+package randomness_collective_flip
+
+import (
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/randomness_collective_flip"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OnInitialize adds the parent hash to the random material. Once the material is full,
+// the parent hash replaces the oldest hash in it.
+func OnInitialize(n types.BlockNumber) types.Weight {
+	parentHash := types.H256{FixedSequence: system.StorageGetParentHash().FixedSequence}
+
+	material := StorageGetRandomMaterial()
+	if len(material) < randomness_collective_flip.RandomMaterialLen {
+		material = append(material, parentHash)
+	} else {
+		material[blockNumberToIndex(n)] = parentHash
+	}
+	StorageSetRandomMaterial(material)
+
+	return constants.DbWeight.ReadsWrites(1, 1)
+}
@@ -0,0 +1,58 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/randomness_collective_flip"
+	pallet "github.com/LimeChain/gosemble/frame/randomness_collective_flip"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type RandomnessCollectiveFlipModule struct {
+}
+
+func NewRandomnessCollectiveFlipModule() RandomnessCollectiveFlipModule {
+	return RandomnessCollectiveFlipModule{}
+}
+
+func (rcfm RandomnessCollectiveFlipModule) Functions() map[sc.U8]primitives.Call {
+	return map[sc.U8]primitives.Call{}
+}
+
+func (rcfm RandomnessCollectiveFlipModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (rcfm RandomnessCollectiveFlipModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// OnInitialize adds the parent hash of the block to the random material.
+func (rcfm RandomnessCollectiveFlipModule) OnInitialize(n primitives.BlockNumber) primitives.Weight {
+	return pallet.OnInitialize(n)
+}
+
+func (rcfm RandomnessCollectiveFlipModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Low-influence randomness from the hashes of the previous blocks."}
+}
+
+func (rcfm RandomnessCollectiveFlipModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "RandomnessCollectiveFlip",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "RandomnessCollectiveFlip",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"RandomMaterial",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSequenceH256)),
+					"Series of block headers from the last 81 blocks that acts as random seed material. This is arranged as a ring buffer with `block_number % 81` being the index into the `Vec` of the oldest hash."),
+			},
+		}),
+		Call:      sc.NewOption[sc.Compact](nil),
+		Event:     sc.NewOption[sc.Compact](nil),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
+		Error:     sc.NewOption[sc.Compact](nil),
+		Index:     randomness_collective_flip.ModuleIndex,
+	}
+}
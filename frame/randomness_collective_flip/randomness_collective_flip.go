@@ -0,0 +1,71 @@
+package randomness_collective_flip
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/randomness_collective_flip"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// RandomnessCollectiveFlip generates randomness from the hashes of the last 81 blocks.
+//
+// It is not secure: the author of a block can influence the value by choosing whether to
+// produce the block, and all values are known to observers of the chain in advance.
+// Use it only where low-influence randomness is acceptable, or in tests.
+type RandomnessCollectiveFlip struct{}
+
+// Random mixes the subject with each hash of the random material, starting with the hash
+// of the current block index, and returns the triplet mix of the results. The returned block
+// number is the oldest block whose hash is part of the material.
+func (_ RandomnessCollectiveFlip) Random(subject []byte) (types.H256, types.BlockNumber) {
+	blockNumber := system.StorageGetBlockNumber()
+
+	since := types.BlockNumber(0)
+	if blockNumber > randomness_collective_flip.RandomMaterialLen {
+		since = blockNumber - randomness_collective_flip.RandomMaterialLen
+	}
+
+	material := StorageGetRandomMaterial()
+	if len(material) == 0 {
+		return types.NewH256(make([]sc.U8, 32)...), since
+	}
+
+	encodedSubject := sc.BytesToSequenceU8(subject).Bytes()
+	index := int(blockNumberToIndex(blockNumber))
+
+	hashes := make([][]byte, randomness_collective_flip.RandomMaterialLen)
+	for i := range hashes {
+		hash := material[(index+i)%len(material)]
+
+		encoded := append([]byte{byte(int8(i))}, encodedSubject...)
+		encoded = append(encoded, sc.FixedSequenceU8ToBytes(hash.FixedSequence)...)
+
+		hashes[i] = hashing.Blake256(encoded)
+	}
+
+	return types.NewH256(sc.BytesToSequenceU8(tripletMix(hashes))...), since
+}
+
+// tripletMix takes the bitwise majority of each consecutive triplet of hashes and XORs the results.
+func tripletMix(hashes [][]byte) []byte {
+	result := make([]byte, 32)
+
+	for i := 0; i+2 < len(hashes); i += 3 {
+		a, b, c := hashes[i], hashes[i+1], hashes[i+2]
+		for j := range result {
+			result[j] ^= (a[j] & b[j]) | (b[j] & c[j]) | (a[j] & c[j])
+		}
+	}
+
+	return result
+}
+
+// blockNumberToIndex returns the index in the random material of the parent hash of block `n`.
+func blockNumberToIndex(n types.BlockNumber) types.BlockNumber {
+	if n == 0 {
+		return 0
+	}
+
+	return (n - 1) % randomness_collective_flip.RandomMaterialLen
+}
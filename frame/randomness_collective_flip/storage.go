@@ -0,0 +1,26 @@
+package randomness_collective_flip
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetRandomMaterial returns the hashes of the last blocks, used to generate randomness.
+func StorageGetRandomMaterial() sc.Sequence[types.H256] {
+	return storage.GetDecode(keyRandomMaterial(), func(buffer *bytes.Buffer) sc.Sequence[types.H256] {
+		return sc.DecodeSequenceWith(buffer, types.DecodeH256)
+	})
+}
+
+func StorageSetRandomMaterial(material sc.Sequence[types.H256]) {
+	storage.Set(keyRandomMaterial(), material.Bytes())
+}
+
+func keyRandomMaterial() []byte {
+	return append(hashing.Twox128(constants.KeyRandomnessCollectiveFlip), hashing.Twox128(constants.KeyRandomMaterial)...)
+}
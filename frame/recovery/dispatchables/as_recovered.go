@@ -0,0 +1,142 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	pallet "github.com/LimeChain/gosemble/frame/recovery"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type AsRecoveredCall struct {
+	primitives.Callable
+}
+
+func NewAsRecoveredCall(args sc.VaryingData) AsRecoveredCall {
+	call := AsRecoveredCall{
+		Callable: primitives.Callable{
+			ModuleId:   recovery.ModuleIndex,
+			FunctionId: recovery.FunctionAsRecoveredIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c AsRecoveredCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+		support.DecodeCall(buffer),
+	)
+	return c
+}
+
+func (c AsRecoveredCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c AsRecoveredCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c AsRecoveredCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c AsRecoveredCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ AsRecoveredCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "as_recovered",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("account", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("call", "Box<<T as Config>::RuntimeCall>", primitives.TypeId(metadata.RuntimeCall)),
+		},
+		Docs: "Send a call through a recovered account.",
+	}
+}
+
+func (c AsRecoveredCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ AsRecoveredCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 9_800 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(0)
+	e := types.ProofWeight(pallet.StorageInfoProxy)
+	weight := types.WeightFromParts(10_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+
+	// The weight of the inner call is added, once the arguments are decoded.
+	if len(b) == 0 {
+		return weight
+	}
+	args, ok := b[0].(sc.VaryingData)
+	if !ok || len(args) < 2 {
+		return weight
+	}
+
+	return weight.SaturatingAdd(types.GetDispatchInfo(args[1].(types.Call)).Weight)
+}
+
+func (_ AsRecoveredCall) IsInherent() bool {
+	return false
+}
+
+func (_ AsRecoveredCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ AsRecoveredCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ AsRecoveredCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ AsRecoveredCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := asRecovered(origin, args[0].(types.MultiAddress), args[1].(types.Call))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// asRecovered dispatches `call` on behalf of the recovered `account`. The sender must be allowed to
+// act on behalf of the account.
+func asRecovered(origin types.RuntimeOrigin, account types.MultiAddress, call types.Call) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	lost, e := types.DefaultAccountIdLookup().Lookup(account)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.AsRecovered(origin.AsSigned(), lost, call)
+}
@@ -0,0 +1,128 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	pallet "github.com/LimeChain/gosemble/frame/recovery"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CancelRecoveredCall struct {
+	primitives.Callable
+}
+
+func NewCancelRecoveredCall(args sc.VaryingData) CancelRecoveredCall {
+	call := CancelRecoveredCall{
+		Callable: primitives.Callable{
+			ModuleId:   recovery.ModuleIndex,
+			FunctionId: recovery.FunctionCancelRecoveredIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c CancelRecoveredCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c CancelRecoveredCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c CancelRecoveredCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c CancelRecoveredCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c CancelRecoveredCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ CancelRecoveredCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "cancel_recovered",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("account", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Cancel the ability to use `as_recovered` for `account`.",
+	}
+}
+
+func (c CancelRecoveredCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ CancelRecoveredCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 11_760 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoProxy, system.StorageInfoAccount)
+	return types.WeightFromParts(12_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ CancelRecoveredCall) IsInherent() bool {
+	return false
+}
+
+func (_ CancelRecoveredCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ CancelRecoveredCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ CancelRecoveredCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ CancelRecoveredCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := cancelRecovered(origin, args[0].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// cancelRecovered stops the sender from acting on behalf of the recovered `account`.
+func cancelRecovered(origin types.RuntimeOrigin, account types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	lost, e := types.DefaultAccountIdLookup().Lookup(account)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.CancelRecovered(origin.AsSigned(), lost)
+}
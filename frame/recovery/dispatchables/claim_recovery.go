@@ -0,0 +1,128 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	pallet "github.com/LimeChain/gosemble/frame/recovery"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ClaimRecoveryCall struct {
+	primitives.Callable
+}
+
+func NewClaimRecoveryCall(args sc.VaryingData) ClaimRecoveryCall {
+	call := ClaimRecoveryCall{
+		Callable: primitives.Callable{
+			ModuleId:   recovery.ModuleIndex,
+			FunctionId: recovery.FunctionClaimRecoveryIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ClaimRecoveryCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c ClaimRecoveryCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ClaimRecoveryCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ClaimRecoveryCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ClaimRecoveryCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ClaimRecoveryCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "claim_recovery",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("account", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Allow a successful rescuer to claim their recovered account.",
+	}
+}
+
+func (c ClaimRecoveryCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ClaimRecoveryCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 22_540 nanoseconds.
+	r := constants.DbWeight.Reads(4)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoRecoverable, pallet.StorageInfoActiveRecoveries, pallet.StorageInfoProxy, system.StorageInfoAccount)
+	return types.WeightFromParts(23_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ClaimRecoveryCall) IsInherent() bool {
+	return false
+}
+
+func (_ ClaimRecoveryCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ClaimRecoveryCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ClaimRecoveryCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ClaimRecoveryCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := claimRecovery(origin, args[0].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// claimRecovery completes the recovery of `account` by the sender.
+func claimRecovery(origin types.RuntimeOrigin, account types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	lost, e := types.DefaultAccountIdLookup().Lookup(account)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.ClaimRecovery(origin.AsSigned(), lost)
+}
@@ -0,0 +1,128 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	pallet "github.com/LimeChain/gosemble/frame/recovery"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CloseRecoveryCall struct {
+	primitives.Callable
+}
+
+func NewCloseRecoveryCall(args sc.VaryingData) CloseRecoveryCall {
+	call := CloseRecoveryCall{
+		Callable: primitives.Callable{
+			ModuleId:   recovery.ModuleIndex,
+			FunctionId: recovery.FunctionCloseRecoveryIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c CloseRecoveryCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c CloseRecoveryCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c CloseRecoveryCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c CloseRecoveryCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c CloseRecoveryCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ CloseRecoveryCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "close_recovery",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("rescuer", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "As the controller of a recoverable account, close an active recovery process for your account.",
+	}
+}
+
+func (c CloseRecoveryCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ CloseRecoveryCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 42_140 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoActiveRecoveries, system.StorageInfoAccount, system.StorageInfoAccount)
+	return types.WeightFromParts(43_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ CloseRecoveryCall) IsInherent() bool {
+	return false
+}
+
+func (_ CloseRecoveryCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ CloseRecoveryCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ CloseRecoveryCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ CloseRecoveryCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := closeRecovery(origin, args[0].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// closeRecovery cancels the recovery of the sender by `rescuer`, taking the deposit of the rescuer.
+func closeRecovery(origin types.RuntimeOrigin, rescuer types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	rescuerAccount, e := types.DefaultAccountIdLookup().Lookup(rescuer)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.CloseRecovery(origin.AsSigned(), rescuerAccount)
+}
@@ -0,0 +1,127 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	pallet "github.com/LimeChain/gosemble/frame/recovery"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CreateRecoveryCall struct {
+	primitives.Callable
+}
+
+func NewCreateRecoveryCall(args sc.VaryingData) CreateRecoveryCall {
+	call := CreateRecoveryCall{
+		Callable: primitives.Callable{
+			ModuleId:   recovery.ModuleIndex,
+			FunctionId: recovery.FunctionCreateRecoveryIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c CreateRecoveryCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeSequenceWith(buffer, types.DecodeAccountId),
+		sc.DecodeU16(buffer),
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c CreateRecoveryCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c CreateRecoveryCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c CreateRecoveryCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c CreateRecoveryCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ CreateRecoveryCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "create_recovery",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("friends", "Vec<T::AccountId>", primitives.TypeId(metadata.TypesSequenceAddress32)),
+			primitives.NewFieldTypeInfo("threshold", "u16", primitives.TypeInfoU16),
+			primitives.NewFieldTypeInfo("delay_period", "BlockNumberFor<T>", primitives.TypeInfoU32),
+		},
+		Docs: "Create a recovery configuration for your account. This makes your account recoverable.",
+	}
+}
+
+func (c CreateRecoveryCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ CreateRecoveryCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 25_480 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoRecoverable, system.StorageInfoAccount)
+	return types.WeightFromParts(26_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ CreateRecoveryCall) IsInherent() bool {
+	return false
+}
+
+func (_ CreateRecoveryCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ CreateRecoveryCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ CreateRecoveryCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ CreateRecoveryCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := createRecovery(origin, args[0].(sc.Sequence[types.AccountId]), args[1].(sc.U16), args[2].(sc.U32))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// createRecovery makes the sender recoverable by `threshold` of its sorted `friends`.
+func createRecovery(origin types.RuntimeOrigin, friends sc.Sequence[types.AccountId], threshold sc.U16, delayPeriod sc.U32) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.CreateRecovery(origin.AsSigned(), friends, threshold, delayPeriod)
+}
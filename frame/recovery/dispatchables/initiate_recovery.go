@@ -0,0 +1,128 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	pallet "github.com/LimeChain/gosemble/frame/recovery"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type InitiateRecoveryCall struct {
+	primitives.Callable
+}
+
+func NewInitiateRecoveryCall(args sc.VaryingData) InitiateRecoveryCall {
+	call := InitiateRecoveryCall{
+		Callable: primitives.Callable{
+			ModuleId:   recovery.ModuleIndex,
+			FunctionId: recovery.FunctionInitiateRecoveryIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c InitiateRecoveryCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c InitiateRecoveryCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c InitiateRecoveryCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c InitiateRecoveryCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c InitiateRecoveryCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ InitiateRecoveryCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "initiate_recovery",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("account", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Initiate the process for recovering a recoverable account.",
+	}
+}
+
+func (c InitiateRecoveryCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ InitiateRecoveryCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 28_420 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoRecoverable, pallet.StorageInfoActiveRecoveries, system.StorageInfoAccount)
+	return types.WeightFromParts(29_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ InitiateRecoveryCall) IsInherent() bool {
+	return false
+}
+
+func (_ InitiateRecoveryCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ InitiateRecoveryCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ InitiateRecoveryCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ InitiateRecoveryCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := initiateRecovery(origin, args[0].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// initiateRecovery starts the recovery of `account` by the sender.
+func initiateRecovery(origin types.RuntimeOrigin, account types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	lost, e := types.DefaultAccountIdLookup().Lookup(account)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.InitiateRecovery(origin.AsSigned(), lost)
+}
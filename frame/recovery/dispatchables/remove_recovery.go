@@ -0,0 +1,117 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	pallet "github.com/LimeChain/gosemble/frame/recovery"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type RemoveRecoveryCall struct {
+	primitives.Callable
+}
+
+func NewRemoveRecoveryCall(args sc.VaryingData) RemoveRecoveryCall {
+	call := RemoveRecoveryCall{
+		Callable: primitives.Callable{
+			ModuleId:   recovery.ModuleIndex,
+			FunctionId: recovery.FunctionRemoveRecoveryIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c RemoveRecoveryCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData()
+	return c
+}
+
+func (c RemoveRecoveryCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c RemoveRecoveryCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c RemoveRecoveryCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c RemoveRecoveryCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ RemoveRecoveryCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "remove_recovery",
+		Docs: "Remove the recovery process for your account. Recovered accounts are still accessible.",
+	}
+}
+
+func (c RemoveRecoveryCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ RemoveRecoveryCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 40_180 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoRecoverable, pallet.StorageInfoActiveRecoveries, system.StorageInfoAccount)
+	return types.WeightFromParts(41_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ RemoveRecoveryCall) IsInherent() bool {
+	return false
+}
+
+func (_ RemoveRecoveryCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ RemoveRecoveryCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ RemoveRecoveryCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ RemoveRecoveryCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := removeRecovery(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// removeRecovery removes the recovery configuration of the sender.
+func removeRecovery(origin types.RuntimeOrigin) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.RemoveRecovery(origin.AsSigned())
+}
@@ -0,0 +1,136 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	pallet "github.com/LimeChain/gosemble/frame/recovery"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetRecoveredCall struct {
+	primitives.Callable
+}
+
+func NewSetRecoveredCall(args sc.VaryingData) SetRecoveredCall {
+	call := SetRecoveredCall{
+		Callable: primitives.Callable{
+			ModuleId:   recovery.ModuleIndex,
+			FunctionId: recovery.FunctionSetRecoveredIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetRecoveredCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c SetRecoveredCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetRecoveredCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetRecoveredCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetRecoveredCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetRecoveredCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_recovered",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("lost", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("rescuer", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Allow ROOT to bypass the recovery process and set an a rescuer account for a lost account directly.",
+	}
+}
+
+func (c SetRecoveredCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetRecoveredCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 10_780 nanoseconds.
+	r := constants.DbWeight.Reads(0)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(system.StorageInfoAccount)
+	return types.WeightFromParts(11_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetRecoveredCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetRecoveredCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetRecoveredCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetRecoveredCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetRecoveredCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setRecovered(origin, args[0].(types.MultiAddress), args[1].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setRecovered allows `rescuer` to act on behalf of `lost`. Must be called by the force origin.
+func setRecovered(origin types.RuntimeOrigin, lost types.MultiAddress, rescuer types.MultiAddress) types.DispatchError {
+	err := pallet.ForceOrigin.EnsureOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	lostAccount, e := types.DefaultAccountIdLookup().Lookup(lost)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	rescuerAccount, e := types.DefaultAccountIdLookup().Lookup(rescuer)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.SetRecovered(lostAccount, rescuerAccount)
+}
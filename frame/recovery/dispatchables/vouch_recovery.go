@@ -0,0 +1,134 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	pallet "github.com/LimeChain/gosemble/frame/recovery"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type VouchRecoveryCall struct {
+	primitives.Callable
+}
+
+func NewVouchRecoveryCall(args sc.VaryingData) VouchRecoveryCall {
+	call := VouchRecoveryCall{
+		Callable: primitives.Callable{
+			ModuleId:   recovery.ModuleIndex,
+			FunctionId: recovery.FunctionVouchRecoveryIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c VouchRecoveryCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c VouchRecoveryCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c VouchRecoveryCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c VouchRecoveryCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c VouchRecoveryCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ VouchRecoveryCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "vouch_recovery",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("lost", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+			primitives.NewFieldTypeInfo("rescuer", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Allow a \"friend\" of a recoverable account to vouch for an active recovery process for that account.",
+	}
+}
+
+func (c VouchRecoveryCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ VouchRecoveryCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 18_620 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoRecoverable, pallet.StorageInfoActiveRecoveries)
+	return types.WeightFromParts(19_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ VouchRecoveryCall) IsInherent() bool {
+	return false
+}
+
+func (_ VouchRecoveryCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ VouchRecoveryCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ VouchRecoveryCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ VouchRecoveryCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := vouchRecovery(origin, args[0].(types.MultiAddress), args[1].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// vouchRecovery vouches for the recovery of `lost` by `rescuer`. The sender must be a friend of `lost`.
+func vouchRecovery(origin types.RuntimeOrigin, lost types.MultiAddress, rescuer types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	lostAccount, e := types.DefaultAccountIdLookup().Lookup(lost)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	rescuerAccount, e := types.DefaultAccountIdLookup().Lookup(rescuer)
+	if e != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	return pallet.VouchRecovery(origin.AsSigned(), lostAccount, rescuerAccount)
+}
@@ -0,0 +1,23 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Recovery module errors.
+const (
+	ErrorNotAllowed sc.U8 = iota
+	ErrorZeroThreshold
+	ErrorNotEnoughFriends
+	ErrorMaxFriends
+	ErrorNotSorted
+	ErrorNotRecoverable
+	ErrorAlreadyRecoverable
+	ErrorAlreadyStarted
+	ErrorNotStarted
+	ErrorNotFriend
+	ErrorDelayPeriod
+	ErrorAlreadyVouched
+	ErrorThreshold
+	ErrorStillActive
+	ErrorAlreadyProxy
+	ErrorBadState
+)
@@ -0,0 +1,83 @@
+package events
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Recovery module events.
+const (
+	EventRecoveryCreated sc.U8 = iota
+	EventRecoveryInitiated
+	EventRecoveryVouched
+	EventRecoveryClosed
+	EventAccountRecovered
+	EventRecoveryRemoved
+)
+
+func NewEventRecoveryCreated(account types.PublicKey) types.Event {
+	return types.NewEvent(recovery.ModuleIndex, EventRecoveryCreated, account)
+}
+
+func NewEventRecoveryInitiated(lost types.PublicKey, rescuer types.PublicKey) types.Event {
+	return types.NewEvent(recovery.ModuleIndex, EventRecoveryInitiated, lost, rescuer)
+}
+
+func NewEventRecoveryVouched(lost types.PublicKey, rescuer types.PublicKey, sender types.PublicKey) types.Event {
+	return types.NewEvent(recovery.ModuleIndex, EventRecoveryVouched, lost, rescuer, sender)
+}
+
+func NewEventRecoveryClosed(lost types.PublicKey, rescuer types.PublicKey) types.Event {
+	return types.NewEvent(recovery.ModuleIndex, EventRecoveryClosed, lost, rescuer)
+}
+
+func NewEventAccountRecovered(lost types.PublicKey, rescuer types.PublicKey) types.Event {
+	return types.NewEvent(recovery.ModuleIndex, EventAccountRecovered, lost, rescuer)
+}
+
+func NewEventRecoveryRemoved(lost types.PublicKey) types.Event {
+	return types.NewEvent(recovery.ModuleIndex, EventRecoveryRemoved, lost)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	module := sc.DecodeU8(buffer)
+	if module != recovery.ModuleIndex {
+		log.Critical("invalid recovery.Event module")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventRecoveryCreated:
+		account := types.DecodePublicKey(buffer)
+		return NewEventRecoveryCreated(account)
+	case EventRecoveryInitiated:
+		lost := types.DecodePublicKey(buffer)
+		rescuer := types.DecodePublicKey(buffer)
+		return NewEventRecoveryInitiated(lost, rescuer)
+	case EventRecoveryVouched:
+		lost := types.DecodePublicKey(buffer)
+		rescuer := types.DecodePublicKey(buffer)
+		sender := types.DecodePublicKey(buffer)
+		return NewEventRecoveryVouched(lost, rescuer, sender)
+	case EventRecoveryClosed:
+		lost := types.DecodePublicKey(buffer)
+		rescuer := types.DecodePublicKey(buffer)
+		return NewEventRecoveryClosed(lost, rescuer)
+	case EventAccountRecovered:
+		lost := types.DecodePublicKey(buffer)
+		rescuer := types.DecodePublicKey(buffer)
+		return NewEventAccountRecovered(lost, rescuer)
+	case EventRecoveryRemoved:
+		lost := types.DecodePublicKey(buffer)
+		return NewEventRecoveryRemoved(lost)
+	default:
+		log.Critical("invalid recovery.Event type")
+	}
+
+	panic("unreachable")
+}
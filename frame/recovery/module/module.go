@@ -0,0 +1,171 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	pallet "github.com/LimeChain/gosemble/frame/recovery"
+	"github.com/LimeChain/gosemble/frame/recovery/dispatchables"
+	"github.com/LimeChain/gosemble/frame/recovery/errors"
+	"github.com/LimeChain/gosemble/frame/recovery/events"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type RecoveryModule struct {
+	functions map[sc.U8]primitives.Call
+}
+
+func NewRecoveryModule() RecoveryModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[recovery.FunctionAsRecoveredIndex] = dispatchables.NewAsRecoveredCall(nil)
+	functions[recovery.FunctionSetRecoveredIndex] = dispatchables.NewSetRecoveredCall(nil)
+	functions[recovery.FunctionCreateRecoveryIndex] = dispatchables.NewCreateRecoveryCall(nil)
+	functions[recovery.FunctionInitiateRecoveryIndex] = dispatchables.NewInitiateRecoveryCall(nil)
+	functions[recovery.FunctionVouchRecoveryIndex] = dispatchables.NewVouchRecoveryCall(nil)
+	functions[recovery.FunctionClaimRecoveryIndex] = dispatchables.NewClaimRecoveryCall(nil)
+	functions[recovery.FunctionCloseRecoveryIndex] = dispatchables.NewCloseRecoveryCall(nil)
+	functions[recovery.FunctionRemoveRecoveryIndex] = dispatchables.NewRemoveRecoveryCall(nil)
+	functions[recovery.FunctionCancelRecoveredIndex] = dispatchables.NewCancelRecoveredCall(nil)
+
+	return RecoveryModule{
+		functions: functions,
+	}
+}
+
+func (rm RecoveryModule) Functions() map[sc.U8]primitives.Call {
+	return rm.functions
+}
+
+func (rm RecoveryModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (rm RecoveryModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (rm RecoveryModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Recovery of lost accounts vouched by a set of friends."}
+}
+
+func (rm RecoveryModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "Recovery",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "Recovery",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"Recoverable",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(registry.Register(pallet.RecoveryConfigTypeInfo))),
+					"The set of recoverable accounts and their recovery configuration."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ActiveRecoveries",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64, primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(registry.Register(primitives.NewTupleTypeInfo(primitives.TypeId(metadata.TypesAddress32), primitives.TypeId(metadata.TypesAddress32)))),
+						sc.ToCompact(registry.Register(pallet.ActiveRecoveryTypeInfo))),
+					"Active recovery attempts."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Proxy",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(metadata.TypesAddress32)),
+					"The list of allowed proxy accounts."),
+			},
+		}),
+		Call:  sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(rm.callsTypeInfo()))),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(eventTypeInfo()))),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"ConfigDepositBase",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(recovery.ConfigDepositBase).Bytes()),
+				"The base amount of currency needed to reserve for creating a recovery configuration.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"FriendDepositFactor",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(recovery.FriendDepositFactor).Bytes()),
+				"The amount of currency needed per additional user when creating a recovery configuration.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxFriends",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(recovery.MaxFriends).Bytes()),
+				"The maximum amount of friends allowed in a recovery configuration.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"RecoveryDeposit",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(recovery.RecoveryDeposit).Bytes()),
+				"The base amount of currency needed to reserve for starting a recovery.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(errorsTypeInfo()))),
+		Index: recovery.ModuleIndex,
+	}
+}
+
+func (rm RecoveryModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_recovery", "pallet", "Call"}, rm.functions)
+}
+
+func eventTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_recovery", "pallet", "Event"},
+		"The events of the module.",
+		primitives.NewVariantTypeInfo("RecoveryCreated", events.EventRecoveryCreated, "A recovery process has been set up for an account.",
+			primitives.NewFieldTypeInfo("account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("RecoveryInitiated", events.EventRecoveryInitiated, "A recovery process has been initiated for lost account by rescuer account.",
+			primitives.NewFieldTypeInfo("lost_account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("rescuer_account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("RecoveryVouched", events.EventRecoveryVouched, "A recovery process for lost account by rescuer account has been vouched for by sender.",
+			primitives.NewFieldTypeInfo("lost_account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("rescuer_account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("sender", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("RecoveryClosed", events.EventRecoveryClosed, "A recovery process for lost account by rescuer account has been closed.",
+			primitives.NewFieldTypeInfo("lost_account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("rescuer_account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("AccountRecovered", events.EventAccountRecovered, "Lost account has been successfully recovered by rescuer account.",
+			primitives.NewFieldTypeInfo("lost_account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("rescuer_account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("RecoveryRemoved", events.EventRecoveryRemoved, "A recovery process has been removed for an account.",
+			primitives.NewFieldTypeInfo("lost_account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+	)
+}
+
+func errorsTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_recovery", "pallet", "Error"},
+		"The errors of the module.",
+		primitives.NewVariantTypeInfo("NotAllowed", errors.ErrorNotAllowed, "User is not allowed to make a call on behalf of this account"),
+		primitives.NewVariantTypeInfo("ZeroThreshold", errors.ErrorZeroThreshold, "Threshold must be greater than zero"),
+		primitives.NewVariantTypeInfo("NotEnoughFriends", errors.ErrorNotEnoughFriends, "Friends list must be greater than zero and threshold"),
+		primitives.NewVariantTypeInfo("MaxFriends", errors.ErrorMaxFriends, "Friends list must be less than max friends"),
+		primitives.NewVariantTypeInfo("NotSorted", errors.ErrorNotSorted, "Friends list must be sorted and free of duplicates"),
+		primitives.NewVariantTypeInfo("NotRecoverable", errors.ErrorNotRecoverable, "This account is not set up for recovery"),
+		primitives.NewVariantTypeInfo("AlreadyRecoverable", errors.ErrorAlreadyRecoverable, "This account is already set up for recovery"),
+		primitives.NewVariantTypeInfo("AlreadyStarted", errors.ErrorAlreadyStarted, "A recovery process has already started for this account"),
+		primitives.NewVariantTypeInfo("NotStarted", errors.ErrorNotStarted, "A recovery process has not started for this rescuer"),
+		primitives.NewVariantTypeInfo("NotFriend", errors.ErrorNotFriend, "This account is not a friend who can vouch"),
+		primitives.NewVariantTypeInfo("DelayPeriod", errors.ErrorDelayPeriod, "The friend must wait until the delay period to vouch for this recovery"),
+		primitives.NewVariantTypeInfo("AlreadyVouched", errors.ErrorAlreadyVouched, "This user has already vouched for this recovery"),
+		primitives.NewVariantTypeInfo("Threshold", errors.ErrorThreshold, "The threshold for recovering this account has not been met"),
+		primitives.NewVariantTypeInfo("StillActive", errors.ErrorStillActive, "There are still active recovery attempts that need to be closed"),
+		primitives.NewVariantTypeInfo("AlreadyProxy", errors.ErrorAlreadyProxy, "This account is already set up for recovery"),
+		primitives.NewVariantTypeInfo("BadState", errors.ErrorBadState, "Some internal state is broken."),
+	)
+}
@@ -0,0 +1,16 @@
+package module
+
+import (
+	pallet "github.com/LimeChain/gosemble/frame/recovery"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageInfo describes the storage items of the module whose values have a maximum encoded length.
+// The sizes of the map entries include their hashed keys.
+func (rm RecoveryModule) StorageInfo() []primitives.StorageInfo {
+	return []primitives.StorageInfo{
+		pallet.StorageInfoRecoverable,
+		pallet.StorageInfoActiveRecoveries,
+		pallet.StorageInfoProxy,
+	}
+}
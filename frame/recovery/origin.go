@@ -0,0 +1,9 @@
+package recovery
+
+import (
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ForceOrigin is the origin which can set a rescuer for a lost account without a recovery.
+var ForceOrigin types.EnsureOrigin = system.EnsureRoot{}
@@ -0,0 +1,286 @@
+package recovery
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/recovery/errors"
+	"github.com/LimeChain/gosemble/frame/recovery/events"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// AsRecovered dispatches `call` with the signed origin of `account`, which `who` must have recovered.
+func AsRecovered(who types.AccountId, account types.AccountId, call types.Call) types.DispatchError {
+	proxy := StorageGetProxy(who)
+	if !bool(proxy.HasValue) || !reflect.DeepEqual(proxy.Value, account) {
+		return newRecoveryError(errors.ErrorNotAllowed)
+	}
+
+	result := support.DispatchCall(call, types.NewRuntimeOriginSigned(account))
+	if result.HasError {
+		return result.Err.Error
+	}
+
+	return nil
+}
+
+// SetRecovered allows `rescuer` to act on behalf of `lost`, bypassing the recovery process.
+func SetRecovered(lost types.AccountId, rescuer types.AccountId) types.DispatchError {
+	if err := system.IncConsumers(rescuer); err != nil {
+		return newRecoveryError(errors.ErrorBadState)
+	}
+
+	StorageSetProxy(rescuer, lost)
+
+	system.DepositEvent(events.NewEventAccountRecovered(lost.FixedSequence, rescuer.FixedSequence))
+
+	return nil
+}
+
+// CreateRecovery makes `who` recoverable by `threshold` of `friends`, `delayPeriod` blocks after
+// a recovery is initiated. The deposit depends on the number of friends.
+func CreateRecovery(who types.AccountId, friends sc.Sequence[types.AccountId], threshold sc.U16, delayPeriod sc.U32) types.DispatchError {
+	if StorageGetRecoverable(who).HasValue {
+		return newRecoveryError(errors.ErrorAlreadyRecoverable)
+	}
+
+	if threshold == 0 {
+		return newRecoveryError(errors.ErrorZeroThreshold)
+	}
+
+	if len(friends) == 0 || int(threshold) > len(friends) {
+		return newRecoveryError(errors.ErrorNotEnoughFriends)
+	}
+
+	if len(friends) > recovery.MaxFriends {
+		return newRecoveryError(errors.ErrorMaxFriends)
+	}
+
+	if !isSortedAndUnique(friends) {
+		return newRecoveryError(errors.ErrorNotSorted)
+	}
+
+	deposit := configDeposit(len(friends))
+	if err := dispatchables.Reserve(who, deposit); err != nil {
+		return err
+	}
+
+	StorageSetRecoverable(who, types.RecoveryConfig{
+		DelayPeriod: delayPeriod,
+		Deposit:     sc.NewU128FromBigInt(deposit),
+		Friends:     friends,
+		Threshold:   threshold,
+	})
+
+	system.DepositEvent(events.NewEventRecoveryCreated(who.FixedSequence))
+
+	return nil
+}
+
+// InitiateRecovery starts the recovery of `account` by `who`, reserving the recovery deposit.
+func InitiateRecovery(who types.AccountId, account types.AccountId) types.DispatchError {
+	if !StorageGetRecoverable(account).HasValue {
+		return newRecoveryError(errors.ErrorNotRecoverable)
+	}
+
+	if StorageGetActiveRecoveries(account, who).HasValue {
+		return newRecoveryError(errors.ErrorAlreadyStarted)
+	}
+
+	if err := dispatchables.Reserve(who, recovery.RecoveryDeposit); err != nil {
+		return err
+	}
+
+	StorageSetActiveRecoveries(account, who, types.ActiveRecovery{
+		Created: system.StorageGetBlockNumber(),
+		Deposit: sc.NewU128FromBigInt(recovery.RecoveryDeposit),
+		Friends: sc.Sequence[types.AccountId]{},
+	})
+
+	system.DepositEvent(events.NewEventRecoveryInitiated(account.FixedSequence, who.FixedSequence))
+
+	return nil
+}
+
+// VouchRecovery records that `who`, a friend of `lost`, vouches for the recovery by `rescuer`.
+func VouchRecovery(who types.AccountId, lost types.AccountId, rescuer types.AccountId) types.DispatchError {
+	config := StorageGetRecoverable(lost)
+	if !config.HasValue {
+		return newRecoveryError(errors.ErrorNotRecoverable)
+	}
+
+	activeRecovery := StorageGetActiveRecoveries(lost, rescuer)
+	if !activeRecovery.HasValue {
+		return newRecoveryError(errors.ErrorNotStarted)
+	}
+
+	if !contains(config.Value.Friends, who) {
+		return newRecoveryError(errors.ErrorNotFriend)
+	}
+
+	friends, inserted := insertSorted(activeRecovery.Value.Friends, who)
+	if !inserted {
+		return newRecoveryError(errors.ErrorAlreadyVouched)
+	}
+
+	active := activeRecovery.Value
+	active.Friends = friends
+	StorageSetActiveRecoveries(lost, rescuer, active)
+
+	system.DepositEvent(events.NewEventRecoveryVouched(lost.FixedSequence, rescuer.FixedSequence, who.FixedSequence))
+
+	return nil
+}
+
+// ClaimRecovery allows `who` to act on behalf of `account`, once the delay period of the recovery
+// has passed and enough friends have vouched for it.
+func ClaimRecovery(who types.AccountId, account types.AccountId) types.DispatchError {
+	config := StorageGetRecoverable(account)
+	if !config.HasValue {
+		return newRecoveryError(errors.ErrorNotRecoverable)
+	}
+
+	activeRecovery := StorageGetActiveRecoveries(account, who)
+	if !activeRecovery.HasValue {
+		return newRecoveryError(errors.ErrorNotStarted)
+	}
+
+	if StorageGetProxy(who).HasValue {
+		return newRecoveryError(errors.ErrorAlreadyProxy)
+	}
+
+	now := system.StorageGetBlockNumber()
+	if now < activeRecovery.Value.Created+config.Value.DelayPeriod {
+		return newRecoveryError(errors.ErrorDelayPeriod)
+	}
+
+	if len(activeRecovery.Value.Friends) < int(config.Value.Threshold) {
+		return newRecoveryError(errors.ErrorThreshold)
+	}
+
+	if err := system.IncConsumers(who); err != nil {
+		return newRecoveryError(errors.ErrorBadState)
+	}
+
+	StorageSetProxy(who, account)
+
+	system.DepositEvent(events.NewEventAccountRecovered(account.FixedSequence, who.FixedSequence))
+
+	return nil
+}
+
+// CloseRecovery cancels the recovery of `who` by `rescuer`. The deposit of the rescuer is moved to `who`.
+func CloseRecovery(who types.AccountId, rescuer types.AccountId) types.DispatchError {
+	activeRecovery := StorageGetActiveRecoveries(who, rescuer)
+	if !activeRecovery.HasValue {
+		return newRecoveryError(errors.ErrorNotStarted)
+	}
+
+	if err := repatriateReserved(rescuer, who, activeRecovery.Value.Deposit.ToBigInt()); err != nil {
+		return err
+	}
+
+	StorageClearActiveRecoveries(who, rescuer)
+
+	system.DepositEvent(events.NewEventRecoveryClosed(who.FixedSequence, rescuer.FixedSequence))
+
+	return nil
+}
+
+// RemoveRecovery removes the recovery configuration of `who` and unreserves its deposit.
+// All recoveries of `who` must be closed first.
+func RemoveRecovery(who types.AccountId) types.DispatchError {
+	if StorageHasActiveRecoveries(who) {
+		return newRecoveryError(errors.ErrorStillActive)
+	}
+
+	config := StorageGetRecoverable(who)
+	if !config.HasValue {
+		return newRecoveryError(errors.ErrorNotRecoverable)
+	}
+
+	StorageClearRecoverable(who)
+	dispatchables.Unreserve(who, config.Value.Deposit.ToBigInt())
+
+	system.DepositEvent(events.NewEventRecoveryRemoved(who.FixedSequence))
+
+	return nil
+}
+
+// CancelRecovered stops `who` from acting on behalf of the recovered `account`.
+func CancelRecovered(who types.AccountId, account types.AccountId) types.DispatchError {
+	proxy := StorageGetProxy(who)
+	if !bool(proxy.HasValue) || !reflect.DeepEqual(proxy.Value, account) {
+		return newRecoveryError(errors.ErrorNotAllowed)
+	}
+
+	StorageClearProxy(who)
+	system.DecConsumers(who)
+
+	return nil
+}
+
+// configDeposit returns the deposit for a recovery configuration with `friends` friends.
+func configDeposit(friends int) *big.Int {
+	deposit := new(big.Int).Mul(recovery.FriendDepositFactor, big.NewInt(int64(friends)))
+	return deposit.Add(deposit, recovery.ConfigDepositBase)
+}
+
+// repatriateReserved moves up to `amount` from the reserved balance of `from` to the free balance of `to`.
+func repatriateReserved(from types.AccountId, to types.AccountId, amount *big.Int) types.DispatchError {
+	remaining := dispatchables.Unreserve(from, amount)
+	unreserved := new(big.Int).Sub(amount, remaining)
+
+	return dispatchables.Transfer(from, to, sc.NewU128FromBigInt(unreserved), types.ExistenceRequirementAllowDeath)
+}
+
+func isSortedAndUnique(accounts sc.Sequence[types.AccountId]) bool {
+	for i := 1; i < len(accounts); i++ {
+		if bytes.Compare(accounts[i-1].Bytes(), accounts[i].Bytes()) >= 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func contains(accounts sc.Sequence[types.AccountId], who types.AccountId) bool {
+	for _, account := range accounts {
+		if reflect.DeepEqual(account, who) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// insertSorted inserts `who` into the sorted `accounts`. Returns false if it is already present.
+func insertSorted(accounts sc.Sequence[types.AccountId], who types.AccountId) (sc.Sequence[types.AccountId], bool) {
+	i := sort.Search(len(accounts), func(i int) bool {
+		return bytes.Compare(accounts[i].Bytes(), who.Bytes()) >= 0
+	})
+	if i < len(accounts) && reflect.DeepEqual(accounts[i], who) {
+		return accounts, false
+	}
+
+	result := make(sc.Sequence[types.AccountId], 0, len(accounts)+1)
+	result = append(result, accounts[:i]...)
+	result = append(result, who)
+
+	return append(result, accounts[i:]...), true
+}
+
+func newRecoveryError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   recovery.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
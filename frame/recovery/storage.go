@@ -0,0 +1,112 @@
+package recovery
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetRecoverable returns the recovery configuration of an account.
+func StorageGetRecoverable(who types.AccountId) sc.Option[types.RecoveryConfig] {
+	option := storage.Get(keyRecoverable(who))
+	if !option.HasValue {
+		return sc.NewOption[types.RecoveryConfig](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.RecoveryConfig](types.DecodeRecoveryConfig(buffer))
+}
+
+func StorageSetRecoverable(who types.AccountId, config types.RecoveryConfig) {
+	storage.Set(keyRecoverable(who), config.Bytes())
+}
+
+func StorageClearRecoverable(who types.AccountId) {
+	storage.Clear(keyRecoverable(who))
+}
+
+// StorageGetActiveRecoveries returns the recovery of `lost` initiated by `rescuer`, if there is one.
+func StorageGetActiveRecoveries(lost types.AccountId, rescuer types.AccountId) sc.Option[types.ActiveRecovery] {
+	option := storage.Get(keyActiveRecoveries(lost, rescuer))
+	if !option.HasValue {
+		return sc.NewOption[types.ActiveRecovery](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.ActiveRecovery](types.DecodeActiveRecovery(buffer))
+}
+
+func StorageSetActiveRecoveries(lost types.AccountId, rescuer types.AccountId, recovery types.ActiveRecovery) {
+	storage.Set(keyActiveRecoveries(lost, rescuer), recovery.Bytes())
+}
+
+func StorageClearActiveRecoveries(lost types.AccountId, rescuer types.AccountId) {
+	storage.Clear(keyActiveRecoveries(lost, rescuer))
+}
+
+// StorageHasActiveRecoveries returns whether any recovery of `lost` is in progress.
+func StorageHasActiveRecoveries(lost types.AccountId) bool {
+	prefix := keyActiveRecoveriesPrefix(lost)
+
+	next := storage.NextKey(prefix)
+	if !next.HasValue {
+		return false
+	}
+
+	return bytes.HasPrefix(sc.SequenceU8ToBytes(next.Value), prefix)
+}
+
+// StorageGetProxy returns the lost account which `rescuer` is allowed to act on behalf of.
+func StorageGetProxy(rescuer types.AccountId) sc.Option[types.AccountId] {
+	option := storage.Get(keyProxy(rescuer))
+	if !option.HasValue {
+		return sc.NewOption[types.AccountId](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.AccountId](types.DecodeAccountId(buffer))
+}
+
+func StorageSetProxy(rescuer types.AccountId, lost types.AccountId) {
+	storage.Set(keyProxy(rescuer), lost.Bytes())
+}
+
+func StorageClearProxy(rescuer types.AccountId) {
+	storage.Clear(keyProxy(rescuer))
+}
+
+// twox64Concat returns the key of `value` hashed with the twox 64 concat hasher.
+func twox64Concat(value []byte) []byte {
+	return append(hashing.Twox64(value), value...)
+}
+
+// blake2128Concat returns the key of `value` hashed with the blake2 128 concat hasher.
+func blake2128Concat(value []byte) []byte {
+	return append(hashing.Blake128(value), value...)
+}
+
+func keyRecoverable(who types.AccountId) []byte {
+	key := append(hashing.Twox128(constants.KeyRecovery), hashing.Twox128(constants.KeyRecoverable)...)
+	return append(key, twox64Concat(sc.FixedSequenceU8ToBytes(who.FixedSequence))...)
+}
+
+func keyActiveRecoveriesPrefix(lost types.AccountId) []byte {
+	key := append(hashing.Twox128(constants.KeyRecovery), hashing.Twox128(constants.KeyActiveRecoveries)...)
+	return append(key, twox64Concat(sc.FixedSequenceU8ToBytes(lost.FixedSequence))...)
+}
+
+func keyActiveRecoveries(lost types.AccountId, rescuer types.AccountId) []byte {
+	return append(keyActiveRecoveriesPrefix(lost), twox64Concat(sc.FixedSequenceU8ToBytes(rescuer.FixedSequence))...)
+}
+
+func keyProxy(rescuer types.AccountId) []byte {
+	key := append(hashing.Twox128(constants.KeyRecovery), hashing.Twox128(constants.KeyProxy)...)
+	return append(key, blake2128Concat(sc.FixedSequenceU8ToBytes(rescuer.FixedSequence))...)
+}
@@ -0,0 +1,18 @@
+package recovery
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// StorageInfoRecoverable describes the `Recoverable` map, whose entries are
+	// Twox64Concat(AccountId) ++ RecoveryConfig.
+	StorageInfoRecoverable = types.NewStorageMapInfo("Recovery", "Recoverable", sc.NewOption[sc.U32](nil), 40+4+16+1+recovery.MaxFriends*32+2)
+	// StorageInfoActiveRecoveries describes the `ActiveRecoveries` map, whose entries are
+	// Twox64Concat(AccountId) ++ Twox64Concat(AccountId) ++ ActiveRecovery.
+	StorageInfoActiveRecoveries = types.NewStorageMapInfo("Recovery", "ActiveRecoveries", sc.NewOption[sc.U32](nil), 40+40+4+16+1+recovery.MaxFriends*32)
+	// StorageInfoProxy describes the `Proxy` map, whose entries are Blake2_128Concat(AccountId) ++ AccountId.
+	StorageInfoProxy = types.NewStorageMapInfo("Recovery", "Proxy", sc.NewOption[sc.U32](nil), 48+32)
+)
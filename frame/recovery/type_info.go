@@ -0,0 +1,25 @@
+package recovery
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// RecoveryConfigTypeInfo is the type info of types.RecoveryConfig.
+	RecoveryConfigTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_recovery", "RecoveryConfig"},
+		"The configuration for recovering an account.",
+		types.NewFieldTypeInfo("delay_period", "BlockNumber", types.TypeInfoU32),
+		types.NewFieldTypeInfo("deposit", "Balance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("friends", "Friends", types.TypeId(metadata.TypesSequenceAddress32)),
+		types.NewFieldTypeInfo("threshold", "u16", types.TypeInfoU16),
+	)
+	// ActiveRecoveryTypeInfo is the type info of types.ActiveRecovery.
+	ActiveRecoveryTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_recovery", "ActiveRecovery"},
+		"An ongoing recovery of an account by a rescuer.",
+		types.NewFieldTypeInfo("created", "BlockNumber", types.TypeInfoU32),
+		types.NewFieldTypeInfo("deposit", "Balance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("friends", "Friends", types.TypeId(metadata.TypesSequenceAddress32)),
+	)
+)
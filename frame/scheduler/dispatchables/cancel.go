@@ -0,0 +1,124 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/scheduler"
+	"github.com/LimeChain/gosemble/frame/preimage"
+	pallet "github.com/LimeChain/gosemble/frame/scheduler"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CancelCall struct {
+	primitives.Callable
+}
+
+func NewCancelCall(args sc.VaryingData) CancelCall {
+	call := CancelCall{
+		Callable: primitives.Callable{
+			ModuleId:   scheduler.ModuleIndex,
+			FunctionId: scheduler.FunctionCancelIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c CancelCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c CancelCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c CancelCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c CancelCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c CancelCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ CancelCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "cancel",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("when", "T::BlockNumber", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("index", "u32", primitives.TypeInfoU32),
+		},
+		Docs: "Cancel an anonymously scheduled task.",
+	}
+}
+
+func (c CancelCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ CancelCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 29_861 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoAgenda, preimage.StorageInfoStatusFor)
+	return types.WeightFromParts(30_471_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ CancelCall) IsInherent() bool {
+	return false
+}
+
+func (_ CancelCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ CancelCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ CancelCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ CancelCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := cancel(origin, args[0].(sc.U32), args[1].(sc.U32))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// cancel cancels an anonymously scheduled task.
+func cancel(origin types.RuntimeOrigin, when types.BlockNumber, index sc.U32) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.Cancel(when, index)
+}
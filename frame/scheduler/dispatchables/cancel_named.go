@@ -0,0 +1,123 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/scheduler"
+	"github.com/LimeChain/gosemble/frame/preimage"
+	pallet "github.com/LimeChain/gosemble/frame/scheduler"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CancelNamedCall struct {
+	primitives.Callable
+}
+
+func NewCancelNamedCall(args sc.VaryingData) CancelNamedCall {
+	call := CancelNamedCall{
+		Callable: primitives.Callable{
+			ModuleId:   scheduler.ModuleIndex,
+			FunctionId: scheduler.FunctionCancelNamedIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c CancelNamedCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeTaskName(buffer),
+	)
+	return c
+}
+
+func (c CancelNamedCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c CancelNamedCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c CancelNamedCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c CancelNamedCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ CancelNamedCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "cancel_named",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "TaskName", primitives.TypeId(metadata.TypesFixedSequence32U8)),
+		},
+		Docs: "Cancel a named scheduled task.",
+	}
+}
+
+func (c CancelNamedCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ CancelNamedCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 30_968 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoLookup, pallet.StorageInfoAgenda, preimage.StorageInfoStatusFor)
+	return types.WeightFromParts(31_601_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ CancelNamedCall) IsInherent() bool {
+	return false
+}
+
+func (_ CancelNamedCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ CancelNamedCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ CancelNamedCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ CancelNamedCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := cancelNamed(origin, args[0].(types.TaskName))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// cancelNamed cancels a named scheduled task.
+func cancelNamed(origin types.RuntimeOrigin, id types.TaskName) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.CancelNamed(id)
+}
@@ -0,0 +1,136 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/scheduler"
+	"github.com/LimeChain/gosemble/frame/preimage"
+	pallet "github.com/LimeChain/gosemble/frame/scheduler"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ScheduleCall struct {
+	primitives.Callable
+}
+
+func NewScheduleCall(args sc.VaryingData) ScheduleCall {
+	call := ScheduleCall{
+		Callable: primitives.Callable{
+			ModuleId:   scheduler.ModuleIndex,
+			FunctionId: scheduler.FunctionScheduleIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ScheduleCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeOptionWith(buffer, types.DecodeSchedulePeriod),
+		sc.DecodeU8(buffer),
+		support.DecodeCall(buffer),
+	)
+	return c
+}
+
+func (c ScheduleCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ScheduleCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ScheduleCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ScheduleCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ScheduleCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "schedule",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("when", "T::BlockNumber", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("maybe_periodic", "Option<schedule::Period<T::BlockNumber>>", primitives.TypeId(metadata.TypesOptionTupleU32U32)),
+			primitives.NewFieldTypeInfo("priority", "schedule::Priority", primitives.TypeInfoU8),
+			primitives.NewFieldTypeInfo("call", "Box<<T as Config>::RuntimeCall>", primitives.TypeId(metadata.RuntimeCall)),
+		},
+		Docs: "Anonymously schedule a task.",
+	}
+}
+
+func (c ScheduleCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ScheduleCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 32_359 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoAgenda, preimage.StorageInfoStatusFor)
+	return types.WeightFromParts(33_020_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ScheduleCall) IsInherent() bool {
+	return false
+}
+
+func (_ ScheduleCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ScheduleCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ScheduleCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ScheduleCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := schedule(origin, args[0].(sc.U32), args[1].(sc.Option[types.SchedulePeriod]), args[2].(sc.U8), args[3].(types.Call))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// schedule schedules a call to be dispatched with root origin at block `when`.
+func schedule(origin types.RuntimeOrigin, when types.BlockNumber, maybePeriodic sc.Option[types.SchedulePeriod], priority sc.U8, call types.Call) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	bounded, err := preimage.NewPreimages().Bound(call)
+	if err != nil {
+		return err
+	}
+
+	_, err = pallet.Schedule(when, maybePeriodic, priority, types.NewRuntimeOriginRoot(), bounded)
+	return err
+}
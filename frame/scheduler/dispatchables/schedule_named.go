@@ -0,0 +1,138 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/scheduler"
+	"github.com/LimeChain/gosemble/frame/preimage"
+	pallet "github.com/LimeChain/gosemble/frame/scheduler"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ScheduleNamedCall struct {
+	primitives.Callable
+}
+
+func NewScheduleNamedCall(args sc.VaryingData) ScheduleNamedCall {
+	call := ScheduleNamedCall{
+		Callable: primitives.Callable{
+			ModuleId:   scheduler.ModuleIndex,
+			FunctionId: scheduler.FunctionScheduleNamedIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ScheduleNamedCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeTaskName(buffer),
+		sc.DecodeU32(buffer),
+		sc.DecodeOptionWith(buffer, types.DecodeSchedulePeriod),
+		sc.DecodeU8(buffer),
+		support.DecodeCall(buffer),
+	)
+	return c
+}
+
+func (c ScheduleNamedCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ScheduleNamedCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ScheduleNamedCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ScheduleNamedCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ScheduleNamedCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "schedule_named",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("id", "TaskName", primitives.TypeId(metadata.TypesFixedSequence32U8)),
+			primitives.NewFieldTypeInfo("when", "T::BlockNumber", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("maybe_periodic", "Option<schedule::Period<T::BlockNumber>>", primitives.TypeId(metadata.TypesOptionTupleU32U32)),
+			primitives.NewFieldTypeInfo("priority", "schedule::Priority", primitives.TypeInfoU8),
+			primitives.NewFieldTypeInfo("call", "Box<<T as Config>::RuntimeCall>", primitives.TypeId(metadata.RuntimeCall)),
+		},
+		Docs: "Schedule a named task.",
+	}
+}
+
+func (c ScheduleNamedCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ScheduleNamedCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 35_538 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoLookup, pallet.StorageInfoAgenda, preimage.StorageInfoStatusFor)
+	return types.WeightFromParts(36_264_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ScheduleNamedCall) IsInherent() bool {
+	return false
+}
+
+func (_ ScheduleNamedCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ScheduleNamedCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ScheduleNamedCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ScheduleNamedCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := scheduleNamed(origin, args[0].(types.TaskName), args[1].(sc.U32), args[2].(sc.Option[types.SchedulePeriod]), args[3].(sc.U8), args[4].(types.Call))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// scheduleNamed schedules a named call to be dispatched with root origin at block `when`.
+func scheduleNamed(origin types.RuntimeOrigin, id types.TaskName, when types.BlockNumber, maybePeriodic sc.Option[types.SchedulePeriod], priority sc.U8, call types.Call) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	bounded, err := preimage.NewPreimages().Bound(call)
+	if err != nil {
+		return err
+	}
+
+	_, err = pallet.ScheduleNamed(id, when, maybePeriodic, priority, types.NewRuntimeOriginRoot(), bounded)
+	return err
+}
@@ -0,0 +1,12 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Scheduler module errors.
+const (
+	ErrorFailedToSchedule sc.U8 = iota
+	ErrorNotFound
+	ErrorTargetBlockNumberInPast
+	ErrorRescheduleNoChange
+	ErrorNamed
+)
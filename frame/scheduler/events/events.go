@@ -0,0 +1,76 @@
+package events
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/scheduler"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Scheduler module events.
+const (
+	EventScheduled sc.U8 = iota
+	EventCanceled
+	EventDispatched
+	EventCallUnavailable
+	EventPeriodicFailed
+)
+
+func NewEventScheduled(when types.BlockNumber, index sc.U32) types.Event {
+	return types.NewEvent(scheduler.ModuleIndex, EventScheduled, when, index)
+}
+
+func NewEventCanceled(when types.BlockNumber, index sc.U32) types.Event {
+	return types.NewEvent(scheduler.ModuleIndex, EventCanceled, when, index)
+}
+
+func NewEventDispatched(task types.TaskAddress, id sc.Option[types.TaskName], result types.DispatchOutcome) types.Event {
+	return types.NewEvent(scheduler.ModuleIndex, EventDispatched, task, id, result)
+}
+
+func NewEventCallUnavailable(task types.TaskAddress, id sc.Option[types.TaskName]) types.Event {
+	return types.NewEvent(scheduler.ModuleIndex, EventCallUnavailable, task, id)
+}
+
+func NewEventPeriodicFailed(task types.TaskAddress, id sc.Option[types.TaskName]) types.Event {
+	return types.NewEvent(scheduler.ModuleIndex, EventPeriodicFailed, task, id)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	module := sc.DecodeU8(buffer)
+	if module != scheduler.ModuleIndex {
+		log.Critical("invalid scheduler.Event module")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventScheduled:
+		when := sc.DecodeU32(buffer)
+		index := sc.DecodeU32(buffer)
+		return NewEventScheduled(when, index)
+	case EventCanceled:
+		when := sc.DecodeU32(buffer)
+		index := sc.DecodeU32(buffer)
+		return NewEventCanceled(when, index)
+	case EventDispatched:
+		task := types.DecodeTaskAddress(buffer)
+		id := sc.DecodeOptionWith(buffer, types.DecodeTaskName)
+		result := types.DecodeDispatchOutcome(buffer)
+		return NewEventDispatched(task, id, result)
+	case EventCallUnavailable:
+		task := types.DecodeTaskAddress(buffer)
+		id := sc.DecodeOptionWith(buffer, types.DecodeTaskName)
+		return NewEventCallUnavailable(task, id)
+	case EventPeriodicFailed:
+		task := types.DecodeTaskAddress(buffer)
+		id := sc.DecodeOptionWith(buffer, types.DecodeTaskName)
+		return NewEventPeriodicFailed(task, id)
+	default:
+		log.Critical("invalid scheduler.Event type")
+	}
+
+	panic("unreachable")
+}
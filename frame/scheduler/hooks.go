@@ -0,0 +1,15 @@
+package scheduler
+
+import (
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OnInitialize dispatches the tasks scheduled for block `n`.
+func OnInitialize(n types.BlockNumber) types.Weight {
+	agenda := StorageGetAgenda(n)
+	if len(agenda) == 0 {
+		return types.WeightZero()
+	}
+
+	return serviceAgenda(n, agenda)
+}
@@ -0,0 +1,132 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/scheduler"
+	pallet "github.com/LimeChain/gosemble/frame/scheduler"
+	"github.com/LimeChain/gosemble/frame/scheduler/dispatchables"
+	"github.com/LimeChain/gosemble/frame/scheduler/errors"
+	"github.com/LimeChain/gosemble/frame/scheduler/events"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SchedulerModule struct {
+	functions map[sc.U8]primitives.Call
+}
+
+func NewSchedulerModule() SchedulerModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[scheduler.FunctionScheduleIndex] = dispatchables.NewScheduleCall(nil)
+	functions[scheduler.FunctionCancelIndex] = dispatchables.NewCancelCall(nil)
+	functions[scheduler.FunctionScheduleNamedIndex] = dispatchables.NewScheduleNamedCall(nil)
+	functions[scheduler.FunctionCancelNamedIndex] = dispatchables.NewCancelNamedCall(nil)
+
+	return SchedulerModule{
+		functions: functions,
+	}
+}
+
+func (sm SchedulerModule) Functions() map[sc.U8]primitives.Call {
+	return sm.functions
+}
+
+func (sm SchedulerModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (sm SchedulerModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// OnInitialize dispatches the tasks scheduled for the block.
+func (sm SchedulerModule) OnInitialize(n primitives.BlockNumber) primitives.Weight {
+	return pallet.OnInitialize(n)
+}
+
+func (sm SchedulerModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Dispatch of calls at a given block or periodically."}
+}
+
+func (sm SchedulerModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "Scheduler",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "Scheduler",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"Agenda",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.PrimitiveTypesU32),
+						sc.ToCompact(registry.Register(primitives.NewSequenceTypeInfo(primitives.NewOptionTypeInfo(pallet.ScheduledTypeInfo))))),
+					"Items to be executed, indexed by the block number that they should be executed on."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Lookup",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.TypesFixedSequence32U8),
+						sc.ToCompact(metadata.TypesTupleU32U32)),
+					"Lookup from a name to the block number and index of the task."),
+			},
+		}),
+		Call:  sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(sm.callsTypeInfo()))),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(eventTypeInfo()))),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"MaxScheduledPerBlock",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(scheduler.MaxScheduledPerBlock).Bytes()),
+				"The maximum number of scheduled calls in the queue for a single block.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(errorsTypeInfo()))),
+		Index: scheduler.ModuleIndex,
+	}
+}
+
+func (sm SchedulerModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_scheduler", "pallet", "Call"}, sm.functions)
+}
+
+func eventTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_scheduler", "pallet", "Event"},
+		"The events of the module.",
+		primitives.NewVariantTypeInfo("Scheduled", events.EventScheduled, "Scheduled some task.",
+			primitives.NewFieldTypeInfo("when", "T::BlockNumber", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("index", "u32", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("Canceled", events.EventCanceled, "Canceled some task.",
+			primitives.NewFieldTypeInfo("when", "T::BlockNumber", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("index", "u32", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("Dispatched", events.EventDispatched, "Dispatched some task.",
+			primitives.NewFieldTypeInfo("task", "TaskAddress<T::BlockNumber>", primitives.TypeId(metadata.TypesTupleU32U32)),
+			primitives.NewFieldTypeInfo("id", "Option<TaskName>", primitives.NewOptionTypeInfo(primitives.TypeId(metadata.TypesFixedSequence32U8))),
+			primitives.NewFieldTypeInfo("result", "DispatchResult", primitives.TypeId(metadata.TypesDispatchResult)),
+		),
+		primitives.NewVariantTypeInfo("CallUnavailable", events.EventCallUnavailable, "The call for the provided hash was not found so the task has been aborted.",
+			primitives.NewFieldTypeInfo("task", "TaskAddress<T::BlockNumber>", primitives.TypeId(metadata.TypesTupleU32U32)),
+			primitives.NewFieldTypeInfo("id", "Option<TaskName>", primitives.NewOptionTypeInfo(primitives.TypeId(metadata.TypesFixedSequence32U8))),
+		),
+		primitives.NewVariantTypeInfo("PeriodicFailed", events.EventPeriodicFailed, "The given task was unable to be renewed since the agenda is full at that block.",
+			primitives.NewFieldTypeInfo("task", "TaskAddress<T::BlockNumber>", primitives.TypeId(metadata.TypesTupleU32U32)),
+			primitives.NewFieldTypeInfo("id", "Option<TaskName>", primitives.NewOptionTypeInfo(primitives.TypeId(metadata.TypesFixedSequence32U8))),
+		),
+	)
+}
+
+func errorsTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_scheduler", "pallet", "Error"},
+		"The errors of the module.",
+		primitives.NewVariantTypeInfo("FailedToSchedule", errors.ErrorFailedToSchedule, "Failed to schedule a call"),
+		primitives.NewVariantTypeInfo("NotFound", errors.ErrorNotFound, "Cannot find the scheduled call."),
+		primitives.NewVariantTypeInfo("TargetBlockNumberInPast", errors.ErrorTargetBlockNumberInPast, "Given target block number is in the past."),
+		primitives.NewVariantTypeInfo("RescheduleNoChange", errors.ErrorRescheduleNoChange, "Reschedule failed because it does not change scheduled time."),
+		primitives.NewVariantTypeInfo("Named", errors.ErrorNamed, "Attempt to use a non-named function on a named task."),
+	)
+}
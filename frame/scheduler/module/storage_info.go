@@ -0,0 +1,15 @@
+package module
+
+import (
+	pallet "github.com/LimeChain/gosemble/frame/scheduler"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageInfo describes the storage items of the module whose values have a maximum encoded length.
+// The sizes of the map entries include their hashed keys.
+func (sm SchedulerModule) StorageInfo() []primitives.StorageInfo {
+	return []primitives.StorageInfo{
+		pallet.StorageInfoAgenda,
+		pallet.StorageInfoLookup,
+	}
+}
@@ -0,0 +1,240 @@
+package scheduler
+
+import (
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/scheduler"
+	"github.com/LimeChain/gosemble/frame/preimage"
+	"github.com/LimeChain/gosemble/frame/scheduler/errors"
+	"github.com/LimeChain/gosemble/frame/scheduler/events"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Schedule schedules `call` to be dispatched with `origin` at block `when`.
+// Returns the address of the task in the agenda.
+func Schedule(when types.BlockNumber, maybePeriodic sc.Option[types.SchedulePeriod], priority sc.U8, origin types.RuntimeOrigin, call types.Bounded) (types.TaskAddress, types.DispatchError) {
+	err := ensureFuture(when)
+	if err != nil {
+		return types.TaskAddress{}, err
+	}
+
+	task := types.Scheduled{
+		MaybeId:       sc.NewOption[types.TaskName](nil),
+		Priority:      priority,
+		Call:          call,
+		MaybePeriodic: normalizePeriodic(maybePeriodic),
+		Origin:        origin,
+	}
+
+	return placeTask(when, task)
+}
+
+// Cancel removes the task at the given address from the agenda.
+func Cancel(when types.BlockNumber, index sc.U32) types.DispatchError {
+	agenda := StorageGetAgenda(when)
+	if int(index) >= len(agenda) || !agenda[index].HasValue {
+		return newSchedulerError(errors.ErrorNotFound)
+	}
+
+	task := agenda[index].Value
+	if task.MaybeId.HasValue {
+		return newSchedulerError(errors.ErrorNamed)
+	}
+
+	removeTask(when, index, agenda)
+
+	return nil
+}
+
+// ScheduleNamed schedules a task, which can later be cancelled by its `id`.
+// Fails if a task with the same `id` is already scheduled.
+func ScheduleNamed(id types.TaskName, when types.BlockNumber, maybePeriodic sc.Option[types.SchedulePeriod], priority sc.U8, origin types.RuntimeOrigin, call types.Bounded) (types.TaskAddress, types.DispatchError) {
+	if StorageGetLookup(id).HasValue {
+		return types.TaskAddress{}, newSchedulerError(errors.ErrorFailedToSchedule)
+	}
+
+	err := ensureFuture(when)
+	if err != nil {
+		return types.TaskAddress{}, err
+	}
+
+	task := types.Scheduled{
+		MaybeId:       sc.NewOption[types.TaskName](id),
+		Priority:      priority,
+		Call:          call,
+		MaybePeriodic: normalizePeriodic(maybePeriodic),
+		Origin:        origin,
+	}
+
+	return placeTask(when, task)
+}
+
+// CancelNamed removes the task with the given `id` from the agenda.
+func CancelNamed(id types.TaskName) types.DispatchError {
+	maybeAddress := StorageGetLookup(id)
+	if !maybeAddress.HasValue {
+		return newSchedulerError(errors.ErrorNotFound)
+	}
+	address := maybeAddress.Value
+
+	agenda := StorageGetAgenda(address.When)
+	if int(address.Index) < len(agenda) && agenda[address.Index].HasValue {
+		removeTask(address.When, address.Index, agenda)
+	} else {
+		StorageClearLookup(id)
+	}
+
+	return nil
+}
+
+// placeTask appends `task` to the agenda of block `when`, reusing a free slot if the agenda is full.
+func placeTask(when types.BlockNumber, task types.Scheduled) (types.TaskAddress, types.DispatchError) {
+	agenda := StorageGetAgenda(when)
+
+	var index sc.U32
+	if len(agenda) < scheduler.MaxScheduledPerBlock {
+		index = sc.U32(len(agenda))
+		agenda = append(agenda, sc.NewOption[types.Scheduled](task))
+	} else {
+		found := false
+		for i, item := range agenda {
+			if !item.HasValue {
+				index = sc.U32(i)
+				agenda[i] = sc.NewOption[types.Scheduled](task)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return types.TaskAddress{}, newSchedulerError(errors.ErrorFailedToSchedule)
+		}
+	}
+
+	StorageSetAgenda(when, agenda)
+
+	address := types.TaskAddress{When: when, Index: index}
+	if task.MaybeId.HasValue {
+		StorageSetLookup(task.MaybeId.Value, address)
+	}
+
+	system.DepositEvent(events.NewEventScheduled(when, index))
+
+	return address, nil
+}
+
+// removeTask removes the task at `index` from the agenda, dropping the preimage of its call.
+func removeTask(when types.BlockNumber, index sc.U32, agenda sc.Sequence[sc.Option[types.Scheduled]]) {
+	task := agenda[index].Value
+
+	preimage.NewPreimages().Drop(task.Call)
+
+	if task.MaybeId.HasValue {
+		StorageClearLookup(task.MaybeId.Value)
+	}
+
+	agenda[index] = sc.NewOption[types.Scheduled](nil)
+	StorageSetAgenda(when, agenda)
+
+	system.DepositEvent(events.NewEventCanceled(when, index))
+}
+
+// serviceAgenda dispatches all tasks scheduled for block `now`, ordered by priority.
+func serviceAgenda(now types.BlockNumber, agenda sc.Sequence[sc.Option[types.Scheduled]]) types.Weight {
+	StorageClearAgenda(now)
+
+	indices := []int{}
+	for i, item := range agenda {
+		if item.HasValue {
+			indices = append(indices, i)
+		}
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		return agenda[indices[i]].Value.Priority < agenda[indices[j]].Value.Priority
+	})
+
+	weight := constants.DbWeight.ReadsWrites(1, 1)
+	for _, i := range indices {
+		weight = weight.SaturatingAdd(serviceTask(now, sc.U32(i), agenda[i].Value))
+	}
+
+	return weight
+}
+
+// serviceTask dispatches a single task and reschedules it if it is periodic.
+func serviceTask(now types.BlockNumber, index sc.U32, task types.Scheduled) types.Weight {
+	address := types.TaskAddress{When: now, Index: index}
+
+	if task.MaybeId.HasValue {
+		StorageClearLookup(task.MaybeId.Value)
+	}
+
+	call, _, err := preimage.NewPreimages().Realize(task.Call)
+	if err != nil {
+		system.DepositEvent(events.NewEventCallUnavailable(address, task.MaybeId))
+		return constants.DbWeight.Reads(1)
+	}
+
+	info := types.GetDispatchInfo(call)
+	result := support.DispatchCall(call, task.Origin)
+
+	var outcome types.DispatchOutcome
+	if result.HasError {
+		outcome = types.NewDispatchOutcome(result.Err.Error)
+	} else {
+		outcome = types.NewDispatchOutcome(nil)
+	}
+	system.DepositEvent(events.NewEventDispatched(address, task.MaybeId, outcome))
+
+	if task.MaybePeriodic.HasValue {
+		periodic := task.MaybePeriodic.Value
+		if periodic.Count > 1 {
+			task.MaybePeriodic = sc.NewOption[types.SchedulePeriod](types.SchedulePeriod{Period: periodic.Period, Count: periodic.Count - 1})
+		} else {
+			task.MaybePeriodic = sc.NewOption[types.SchedulePeriod](nil)
+		}
+
+		// The call is realized again when the task is next dispatched.
+		if task.Call.LookupNeeded() {
+			preimage.NewPreimages().Request(task.Call.Hash())
+		}
+
+		_, err := placeTask(now.SaturatingAdd(periodic.Period), task)
+		if err != nil {
+			system.DepositEvent(events.NewEventPeriodicFailed(address, task.MaybeId))
+		}
+	}
+
+	return types.ExtractActualWeight(&result, &info)
+}
+
+// ensureFuture fails if `when` is not after the current block.
+func ensureFuture(when types.BlockNumber) types.DispatchError {
+	if when <= system.StorageGetBlockNumber() {
+		return newSchedulerError(errors.ErrorTargetBlockNumberInPast)
+	}
+
+	return nil
+}
+
+// normalizePeriodic clears periods that would never repeat the task.
+func normalizePeriodic(maybePeriodic sc.Option[types.SchedulePeriod]) sc.Option[types.SchedulePeriod] {
+	if maybePeriodic.HasValue && (maybePeriodic.Value.Count <= 1 || maybePeriodic.Value.Period == 0) {
+		return sc.NewOption[types.SchedulePeriod](nil)
+	}
+
+	return maybePeriodic
+}
+
+func newSchedulerError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   scheduler.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetAgenda returns the items to be executed, indexed by the block number that they should be executed on.
+func StorageGetAgenda(when types.BlockNumber) sc.Sequence[sc.Option[types.Scheduled]] {
+	return storage.GetDecode(keyAgenda(when), decodeAgenda)
+}
+
+func StorageSetAgenda(when types.BlockNumber, agenda sc.Sequence[sc.Option[types.Scheduled]]) {
+	storage.Set(keyAgenda(when), agenda.Bytes())
+}
+
+func StorageClearAgenda(when types.BlockNumber) {
+	storage.Clear(keyAgenda(when))
+}
+
+// StorageGetLookup returns the address of a named task.
+func StorageGetLookup(id types.TaskName) sc.Option[types.TaskAddress] {
+	option := storage.Get(keyLookup(id))
+	if !option.HasValue {
+		return sc.NewOption[types.TaskAddress](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.TaskAddress](types.DecodeTaskAddress(buffer))
+}
+
+func StorageSetLookup(id types.TaskName, address types.TaskAddress) {
+	storage.Set(keyLookup(id), address.Bytes())
+}
+
+func StorageClearLookup(id types.TaskName) {
+	storage.Clear(keyLookup(id))
+}
+
+func decodeAgenda(buffer *bytes.Buffer) sc.Sequence[sc.Option[types.Scheduled]] {
+	return sc.DecodeSequenceWith(buffer, func(buffer *bytes.Buffer) sc.Option[types.Scheduled] {
+		return sc.DecodeOptionWith(buffer, types.DecodeScheduled)
+	})
+}
+
+// keyAgenda returns the storage key of `Agenda`, which uses the twox64 concat hasher.
+func keyAgenda(when types.BlockNumber) []byte {
+	key := append(hashing.Twox128(constants.KeyScheduler), hashing.Twox128(constants.KeyAgenda)...)
+	key = append(key, hashing.Twox64(when.Bytes())...)
+	return append(key, when.Bytes()...)
+}
+
+// keyLookup returns the storage key of `Lookup`, which uses the twox64 concat hasher.
+func keyLookup(id types.TaskName) []byte {
+	idBytes := sc.FixedSequenceU8ToBytes(id)
+
+	key := append(hashing.Twox128(constants.KeyScheduler), hashing.Twox128(constants.KeyLookup)...)
+	key = append(key, hashing.Twox64(idBytes)...)
+	return append(key, idBytes...)
+}
@@ -0,0 +1,19 @@
+package scheduler
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/scheduler"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// scheduledMaxSize is the maximum encoded length of a types.Scheduled: its name, priority, call,
+// period and origin, which is at most a module index and a signed account.
+const scheduledMaxSize = 33 + 1 + types.MaxBoundedEncodedLen + 9 + 1 + 33
+
+var (
+	// StorageInfoAgenda describes the `Agenda` map, whose entries are
+	// Twox64Concat(BlockNumber) ++ BoundedVec<Option<Scheduled>, MaxScheduledPerBlock>.
+	StorageInfoAgenda = types.NewStorageMapInfo("Scheduler", "Agenda", sc.NewOption[sc.U32](nil), 12+1+scheduler.MaxScheduledPerBlock*(1+scheduledMaxSize))
+	// StorageInfoLookup describes the `Lookup` map, whose entries are Twox64Concat(TaskName) ++ TaskAddress.
+	StorageInfoLookup = types.NewStorageMapInfo("Scheduler", "Lookup", sc.NewOption[sc.U32](nil), 40+8)
+)
@@ -0,0 +1,19 @@
+package scheduler
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// ScheduledTypeInfo is the type info of types.Scheduled.
+	ScheduledTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_scheduler", "Scheduled"},
+		"Information regarding an item to be executed in the future.",
+		types.NewFieldTypeInfo("maybe_id", "Option<Name>", types.NewOptionTypeInfo(types.TypeId(metadata.TypesFixedSequence32U8))),
+		types.NewFieldTypeInfo("priority", "schedule::Priority", types.TypeInfoU8),
+		types.NewFieldTypeInfo("call", "Call", types.TypeId(metadata.TypesBounded)),
+		types.NewFieldTypeInfo("maybe_periodic", "Option<schedule::Period<BlockNumber>>", types.TypeId(metadata.TypesOptionTupleU32U32)),
+		types.NewFieldTypeInfo("origin", "PalletsOrigin", types.TypeId(metadata.TypesOriginCaller)),
+	)
+)
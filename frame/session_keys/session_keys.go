@@ -6,51 +6,50 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants/aura"
 	"github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/api"
 	"github.com/LimeChain/gosemble/primitives/crypto"
 	"github.com/LimeChain/gosemble/primitives/types"
-	"github.com/LimeChain/gosemble/utils"
+)
+
+// Api is the `SessionKeys` runtime API, which generates and decodes the session keys of the node.
+var Api = api.New("SessionKeys", 1, "Session keys runtime api.",
+	api.NewMethod1("generate_session_keys",
+		api.NewArg("seed", types.TypeId(metadata.TypesOptionSequenceU8), decodeSeed),
+		types.TypeId(metadata.TypesSequenceU8),
+		"Generate a set of session keys with optionally using the given seed. Returns the concatenated SCALE encoded public keys.",
+		GenerateSessionKeys),
+	api.NewMethod1("decode_session_keys",
+		api.NewArg("encoded", types.TypeId(metadata.TypesSequenceU8), sc.DecodeSequence[sc.U8]),
+		types.TypeId(metadata.TypesOptionSequenceTupleSequenceU8KeyTypeId),
+		"Decode the given public session keys. Returns the list of public raw public keys + key type.",
+		DecodeSessionKeys),
 )
 
 // GenerateSessionKeys generates a set of session keys with an optional seed.
 // The keys should be stored within the keystore exposed by the Host Api.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded optional seed.
-// Returns a pointer-size of the SCALE-encoded set of keys.
+// Returns the SCALE-encoded set of keys.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#id-sessionkeys_generate_session_keys)
-func GenerateSessionKeys(dataPtr int32, dataLen int32) int64 {
-	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(b)
-
-	seed := sc.DecodeOptionWith(buffer, sc.DecodeSequence[sc.U8])
-
+func GenerateSessionKeys(seed sc.Option[sc.Sequence[sc.U8]]) sc.Sequence[sc.U8] {
 	auraPubKey := crypto.ExtCryptoSr25519GenerateVersion1(aura.KeyTypeId[:], seed.Bytes())
 	grandpaPubKey := crypto.ExtCryptoEd25519GenerateVersion1(grandpa.KeyTypeId[:], seed.Bytes())
 
-	res := sc.BytesToSequenceU8(append(auraPubKey, grandpaPubKey...))
-
-	return utils.BytesToOffsetAndSize(res.Bytes())
+	return sc.BytesToSequenceU8(append(auraPubKey, grandpaPubKey...))
 }
 
 // DecodeSessionKeys decodes the given session keys.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded keys.
-// Returns a pointer-size of the SCALE-encoded set of raw keys and their respective key type.
+// Returns the set of raw keys and their respective key type.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#id-sessionkeys_decode_session_keys)
-func DecodeSessionKeys(dataPtr int32, dataLen int32) int64 {
-	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(b)
-	sequence := sc.DecodeSequenceWith(buffer, sc.DecodeU8)
-
-	buffer = bytes.NewBuffer(sc.SequenceU8ToBytes(sequence))
+func DecodeSessionKeys(encoded sc.Sequence[sc.U8]) sc.Option[sc.Sequence[types.SessionKey]] {
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(encoded))
 	sessionKeys := sc.Sequence[types.SessionKey]{
 		types.NewSessionKey(sc.FixedSequenceU8ToBytes(types.DecodePublicKey(buffer)), aura.KeyTypeId),
 		types.NewSessionKey(sc.FixedSequenceU8ToBytes(types.DecodePublicKey(buffer)), grandpa.KeyTypeId),
 	}
 
-	result := sc.NewOption[sc.Sequence[types.SessionKey]](sessionKeys)
-	return utils.BytesToOffsetAndSize(result.Bytes())
+	return sc.NewOption[sc.Sequence[types.SessionKey]](sessionKeys)
+}
+
+func decodeSeed(buffer *bytes.Buffer) sc.Option[sc.Sequence[sc.U8]] {
+	return sc.DecodeOptionWith(buffer, sc.DecodeSequence[sc.U8])
 }
@@ -0,0 +1,125 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	balances "github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type BondCall struct {
+	primitives.Callable
+}
+
+func NewBondCall(args sc.VaryingData) BondCall {
+	call := BondCall{
+		Callable: primitives.Callable{
+			ModuleId:   staking.ModuleIndex,
+			FunctionId: staking.FunctionBondIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c BondCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeRewardDestination(buffer),
+	)
+	return c
+}
+
+func (c BondCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c BondCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c BondCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c BondCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ BondCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "bond",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("value", "BalanceOf<T>", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+			primitives.NewFieldTypeInfo("payee", "RewardDestination<T::AccountId>", pallet.RewardDestinationTypeInfo),
+		},
+		Docs: "Take the origin account as a stash and lock up `value` of its balance.",
+	}
+}
+
+func (c BondCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ BondCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 46_060 nanoseconds.
+	r := constants.DbWeight.Reads(4)
+	w := constants.DbWeight.Writes(4)
+	e := types.ProofWeight(pallet.StorageInfoLedger, balances.StorageInfoLocks, system.StorageInfoAccount)
+	return types.WeightFromParts(47_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ BondCall) IsInherent() bool {
+	return false
+}
+
+func (_ BondCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ BondCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ BondCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ BondCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := bond(origin, sc.U128(args[0].(sc.Compact)), args[1].(types.RewardDestination))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// bond locks `value` of the free balance of the sender as its stake, paying its rewards to `payee`.
+func bond(origin types.RuntimeOrigin, value sc.U128, payee types.RewardDestination) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.Bond(origin.AsSigned(), value.ToBigInt(), payee)
+}
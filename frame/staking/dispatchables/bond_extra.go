@@ -0,0 +1,123 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	balances "github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type BondExtraCall struct {
+	primitives.Callable
+}
+
+func NewBondExtraCall(args sc.VaryingData) BondExtraCall {
+	call := BondExtraCall{
+		Callable: primitives.Callable{
+			ModuleId:   staking.ModuleIndex,
+			FunctionId: staking.FunctionBondExtraIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c BondExtraCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c BondExtraCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c BondExtraCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c BondExtraCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c BondExtraCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ BondExtraCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "bond_extra",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("max_additional", "BalanceOf<T>", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Add some extra amount that have appeared in the stash `free_balance` into the balance up for staking.",
+	}
+}
+
+func (c BondExtraCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ BondExtraCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 81_340 nanoseconds.
+	r := constants.DbWeight.Reads(3)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoLedger, balances.StorageInfoLocks, system.StorageInfoAccount)
+	return types.WeightFromParts(83_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ BondExtraCall) IsInherent() bool {
+	return false
+}
+
+func (_ BondExtraCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ BondExtraCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ BondExtraCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ BondExtraCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := bondExtra(origin, sc.U128(args[0].(sc.Compact)))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// bondExtra locks up to `maxAdditional` more of the free balance of the sender as its stake.
+func bondExtra(origin types.RuntimeOrigin, maxAdditional sc.U128) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.BondExtra(origin.AsSigned(), maxAdditional.ToBigInt())
+}
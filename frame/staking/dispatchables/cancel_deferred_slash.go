@@ -0,0 +1,125 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CancelDeferredSlashCall struct {
+	primitives.Callable
+}
+
+func NewCancelDeferredSlashCall(args sc.VaryingData) CancelDeferredSlashCall {
+	call := CancelDeferredSlashCall{
+		Callable: primitives.Callable{
+			ModuleId:   staking.ModuleIndex,
+			FunctionId: staking.FunctionCancelDeferredSlashIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c CancelDeferredSlashCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeSequence[sc.U32](buffer),
+	)
+	return c
+}
+
+func (c CancelDeferredSlashCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c CancelDeferredSlashCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c CancelDeferredSlashCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c CancelDeferredSlashCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ CancelDeferredSlashCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "cancel_deferred_slash",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("era", "EraIndex", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("slash_indices", "Vec<u32>", primitives.NewSequenceTypeInfo(primitives.TypeInfoU32)),
+		},
+		Docs: "Cancel enactment of a deferred slash.",
+	}
+}
+
+func (c CancelDeferredSlashCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ CancelDeferredSlashCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 980_000 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoUnappliedSlashes)
+	return types.WeightFromParts(1_000_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ CancelDeferredSlashCall) IsInherent() bool {
+	return false
+}
+
+func (_ CancelDeferredSlashCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ CancelDeferredSlashCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ CancelDeferredSlashCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ CancelDeferredSlashCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := cancelDeferredSlash(origin, args[0].(sc.U32), args[1].(sc.Sequence[sc.U32]))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// cancelDeferredSlash cancels the slashes deferred to `era` at the given indices.
+// Must be called by the slash cancel origin.
+func cancelDeferredSlash(origin types.RuntimeOrigin, era sc.U32, slashIndices sc.Sequence[sc.U32]) types.DispatchError {
+	err := pallet.SlashCancelOrigin.EnsureOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	return pallet.CancelDeferredSlash(era, slashIndices)
+}
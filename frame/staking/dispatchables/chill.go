@@ -0,0 +1,116 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ChillCall struct {
+	primitives.Callable
+}
+
+func NewChillCall(args sc.VaryingData) ChillCall {
+	call := ChillCall{
+		Callable: primitives.Callable{
+			ModuleId:   staking.ModuleIndex,
+			FunctionId: staking.FunctionChillIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ChillCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData()
+	return c
+}
+
+func (c ChillCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ChillCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ChillCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ChillCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ChillCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "chill",
+		Docs: "Declare no desire to either validate or nominate.",
+	}
+}
+
+func (c ChillCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ChillCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 57_820 nanoseconds.
+	r := constants.DbWeight.Reads(3)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoLedger, pallet.StorageInfoNominators, pallet.StorageInfoValidators)
+	return types.WeightFromParts(59_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ChillCall) IsInherent() bool {
+	return false
+}
+
+func (_ ChillCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ChillCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ChillCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ChillCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := chill(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// chill declares that the sender no longer wishes to validate or nominate.
+func chill(origin types.RuntimeOrigin) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.Chill(origin.AsSigned())
+}
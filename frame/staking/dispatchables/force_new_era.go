@@ -0,0 +1,117 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ForceNewEraCall struct {
+	primitives.Callable
+}
+
+func NewForceNewEraCall(args sc.VaryingData) ForceNewEraCall {
+	call := ForceNewEraCall{
+		Callable: primitives.Callable{
+			ModuleId:   staking.ModuleIndex,
+			FunctionId: staking.FunctionForceNewEraIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ForceNewEraCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData()
+	return c
+}
+
+func (c ForceNewEraCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ForceNewEraCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ForceNewEraCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ForceNewEraCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ForceNewEraCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "force_new_era",
+		Docs: "Force there to be a new era at the end of the next session.",
+	}
+}
+
+func (c ForceNewEraCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ForceNewEraCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 1_960 nanoseconds.
+	r := constants.DbWeight.Reads(0)
+	w := constants.DbWeight.Writes(1)
+	return types.WeightFromParts(2_000_000, 0).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ForceNewEraCall) IsInherent() bool {
+	return false
+}
+
+func (_ ForceNewEraCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ForceNewEraCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ForceNewEraCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ForceNewEraCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := forceNewEra(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// forceNewEra forces a new era to be planned at the end of the next session. Must be called by the admin origin.
+func forceNewEra(origin types.RuntimeOrigin) types.DispatchError {
+	err := pallet.AdminOrigin.EnsureOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	pallet.ForceNewEra()
+
+	return nil
+}
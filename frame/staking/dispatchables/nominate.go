@@ -0,0 +1,133 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/staking"
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type NominateCall struct {
+	primitives.Callable
+}
+
+func NewNominateCall(args sc.VaryingData) NominateCall {
+	call := NominateCall{
+		Callable: primitives.Callable{
+			ModuleId:   staking.ModuleIndex,
+			FunctionId: staking.FunctionNominateIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c NominateCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeSequenceWith(buffer, types.DecodeMultiAddress),
+	)
+	return c
+}
+
+func (c NominateCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c NominateCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c NominateCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c NominateCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ NominateCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "nominate",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("targets", "Vec<AccountIdLookupOf<T>>", primitives.NewSequenceTypeInfo(primitives.TypeId(metadata.TypesMultiAddress))),
+		},
+		Docs: "Declare the desire to nominate `targets` for the origin stash.",
+	}
+}
+
+func (c NominateCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ NominateCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 65_660 nanoseconds.
+	r := constants.DbWeight.Reads(6)
+	w := constants.DbWeight.Writes(2)
+	// Each of the nominated validators is proven.
+	e := types.ProofWeight(pallet.StorageInfoLedger, pallet.StorageInfoCurrentEra, pallet.StorageInfoNominators).
+		SaturatingAdd(types.ProofWeight(pallet.StorageInfoValidators).SaturatingMul(staking.MaxNominations))
+	return types.WeightFromParts(67_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ NominateCall) IsInherent() bool {
+	return false
+}
+
+func (_ NominateCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ NominateCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ NominateCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ NominateCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := nominate(origin, args[0].(sc.Sequence[types.MultiAddress]))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// nominate declares the desire of the sender to back the given validators.
+func nominate(origin types.RuntimeOrigin, targets sc.Sequence[types.MultiAddress]) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	accounts := sc.Sequence[types.AccountId]{}
+	for _, target := range targets {
+		account, err := types.DefaultAccountIdLookup().Lookup(target)
+		if err != nil {
+			return types.NewDispatchErrorCannotLookup()
+		}
+		accounts = append(accounts, account)
+	}
+
+	return pallet.Nominate(origin.AsSigned(), accounts)
+}
@@ -0,0 +1,128 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/staking"
+	balances "github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type PayoutStakersCall struct {
+	primitives.Callable
+}
+
+func NewPayoutStakersCall(args sc.VaryingData) PayoutStakersCall {
+	call := PayoutStakersCall{
+		Callable: primitives.Callable{
+			ModuleId:   staking.ModuleIndex,
+			FunctionId: staking.FunctionPayoutStakersIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c PayoutStakersCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeAccountId(buffer),
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c PayoutStakersCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c PayoutStakersCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c PayoutStakersCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c PayoutStakersCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ PayoutStakersCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "payout_stakers",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("validator_stash", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("era", "EraIndex", primitives.TypeInfoU32),
+		},
+		Docs: "Pay out all the stakers behind a single validator for a single era.",
+	}
+}
+
+func (c PayoutStakersCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ PayoutStakersCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 294_000 nanoseconds.
+	r := constants.DbWeight.Reads(9)
+	w := constants.DbWeight.Writes(2)
+	// The validator and each of its rewarded nominators are paid.
+	e := types.ProofWeight(pallet.StorageInfoCurrentEra, pallet.StorageInfoErasValidatorReward, pallet.StorageInfoLedger, pallet.StorageInfoErasStakers, pallet.StorageInfoErasRewardPoints, pallet.StorageInfoErasValidatorPrefs).
+		SaturatingAdd(types.ProofWeight(pallet.StorageInfoPayee, pallet.StorageInfoLedger, balances.StorageInfoLocks, system.StorageInfoAccount).SaturatingMul(staking.MaxNominatorRewardedPerValidator + 1))
+	return types.WeightFromParts(300_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ PayoutStakersCall) IsInherent() bool {
+	return false
+}
+
+func (_ PayoutStakersCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ PayoutStakersCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ PayoutStakersCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ PayoutStakersCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := payoutStakers(origin, args[0].(types.AccountId), args[1].(sc.U32))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// payoutStakers pays out the rewards of `validatorStash` and its nominators for `era`. Anyone can call it.
+func payoutStakers(origin types.RuntimeOrigin, validatorStash types.AccountId, era sc.U32) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.PayoutStakers(validatorStash, era)
+}
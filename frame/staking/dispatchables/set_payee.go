@@ -0,0 +1,121 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetPayeeCall struct {
+	primitives.Callable
+}
+
+func NewSetPayeeCall(args sc.VaryingData) SetPayeeCall {
+	call := SetPayeeCall{
+		Callable: primitives.Callable{
+			ModuleId:   staking.ModuleIndex,
+			FunctionId: staking.FunctionSetPayeeIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetPayeeCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeRewardDestination(buffer),
+	)
+	return c
+}
+
+func (c SetPayeeCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetPayeeCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetPayeeCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetPayeeCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetPayeeCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_payee",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("payee", "RewardDestination<T::AccountId>", pallet.RewardDestinationTypeInfo),
+		},
+		Docs: "(Re-)set the payment target for a stash.",
+	}
+}
+
+func (c SetPayeeCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetPayeeCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 13_720 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoLedger)
+	return types.WeightFromParts(14_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetPayeeCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetPayeeCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetPayeeCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetPayeeCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetPayeeCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setPayee(origin, args[0].(types.RewardDestination))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setPayee sets where the rewards of the sender are paid.
+func setPayee(origin types.RuntimeOrigin, payee types.RewardDestination) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.SetPayee(origin.AsSigned(), payee)
+}
@@ -0,0 +1,122 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetValidatorCountCall struct {
+	primitives.Callable
+}
+
+func NewSetValidatorCountCall(args sc.VaryingData) SetValidatorCountCall {
+	call := SetValidatorCountCall{
+		Callable: primitives.Callable{
+			ModuleId:   staking.ModuleIndex,
+			FunctionId: staking.FunctionSetValidatorCountIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetValidatorCountCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c SetValidatorCountCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetValidatorCountCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetValidatorCountCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetValidatorCountCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SetValidatorCountCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set_validator_count",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("new", "u32", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+		},
+		Docs: "Sets the ideal number of validators.",
+	}
+}
+
+func (c SetValidatorCountCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetValidatorCountCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 1_960 nanoseconds.
+	r := constants.DbWeight.Reads(0)
+	w := constants.DbWeight.Writes(1)
+	return types.WeightFromParts(2_000_000, 0).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetValidatorCountCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetValidatorCountCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetValidatorCountCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetValidatorCountCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetValidatorCountCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setValidatorCount(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setValidatorCount sets the ideal number of validators. Must be called by the admin origin.
+func setValidatorCount(origin types.RuntimeOrigin, count sc.U32) types.DispatchError {
+	err := pallet.AdminOrigin.EnsureOrigin(origin)
+	if err != nil {
+		return err
+	}
+
+	pallet.SetValidatorCount(count)
+
+	return nil
+}
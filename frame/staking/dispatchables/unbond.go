@@ -0,0 +1,123 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	balances "github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type UnbondCall struct {
+	primitives.Callable
+}
+
+func NewUnbondCall(args sc.VaryingData) UnbondCall {
+	call := UnbondCall{
+		Callable: primitives.Callable{
+			ModuleId:   staking.ModuleIndex,
+			FunctionId: staking.FunctionUnbondIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c UnbondCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c UnbondCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c UnbondCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c UnbondCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c UnbondCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ UnbondCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "unbond",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("value", "BalanceOf<T>", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+		},
+		Docs: "Schedule a portion of the stash to be unlocked ready for transfer out after the bond period ends.",
+	}
+}
+
+func (c UnbondCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ UnbondCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 87_220 nanoseconds.
+	r := constants.DbWeight.Reads(6)
+	w := constants.DbWeight.Writes(3)
+	e := types.ProofWeight(pallet.StorageInfoLedger, pallet.StorageInfoCurrentEra, pallet.StorageInfoNominators, pallet.StorageInfoValidators, balances.StorageInfoLocks, system.StorageInfoAccount)
+	return types.WeightFromParts(89_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ UnbondCall) IsInherent() bool {
+	return false
+}
+
+func (_ UnbondCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ UnbondCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ UnbondCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ UnbondCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := unbond(origin, sc.U128(args[0].(sc.Compact)))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// unbond schedules up to `value` of the stake of the sender to be unlocked after the bonding duration.
+func unbond(origin types.RuntimeOrigin, value sc.U128) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.Unbond(origin.AsSigned(), value.ToBigInt())
+}
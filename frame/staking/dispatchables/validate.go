@@ -0,0 +1,121 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ValidateCall struct {
+	primitives.Callable
+}
+
+func NewValidateCall(args sc.VaryingData) ValidateCall {
+	call := ValidateCall{
+		Callable: primitives.Callable{
+			ModuleId:   staking.ModuleIndex,
+			FunctionId: staking.FunctionValidateIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ValidateCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeValidatorPrefs(buffer),
+	)
+	return c
+}
+
+func (c ValidateCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ValidateCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ValidateCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ValidateCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ValidateCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "validate",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("prefs", "ValidatorPrefs", pallet.ValidatorPrefsTypeInfo),
+		},
+		Docs: "Declare the desire to validate for the origin stash.",
+	}
+}
+
+func (c ValidateCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ValidateCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 56_840 nanoseconds.
+	r := constants.DbWeight.Reads(4)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoLedger)
+	return types.WeightFromParts(58_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ValidateCall) IsInherent() bool {
+	return false
+}
+
+func (_ ValidateCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ValidateCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ValidateCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ValidateCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := validate(origin, args[0].(types.ValidatorPrefs))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// validate declares the desire of the sender to validate.
+func validate(origin types.RuntimeOrigin, prefs types.ValidatorPrefs) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.Validate(origin.AsSigned(), prefs)
+}
@@ -0,0 +1,124 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	balances "github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type WithdrawUnbondedCall struct {
+	primitives.Callable
+}
+
+func NewWithdrawUnbondedCall(args sc.VaryingData) WithdrawUnbondedCall {
+	call := WithdrawUnbondedCall{
+		Callable: primitives.Callable{
+			ModuleId:   staking.ModuleIndex,
+			FunctionId: staking.FunctionWithdrawUnbondedIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c WithdrawUnbondedCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c WithdrawUnbondedCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c WithdrawUnbondedCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c WithdrawUnbondedCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c WithdrawUnbondedCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ WithdrawUnbondedCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "withdraw_unbonded",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("num_slashing_spans", "u32", primitives.TypeInfoU32),
+		},
+		Docs: "Remove any unlocked chunks from the `unlocking` queue from our management.",
+	}
+}
+
+func (c WithdrawUnbondedCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ WithdrawUnbondedCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 45_080 nanoseconds.
+	r := constants.DbWeight.Reads(5)
+	w := constants.DbWeight.Writes(3)
+	e := types.ProofWeight(pallet.StorageInfoLedger, pallet.StorageInfoCurrentEra, pallet.StorageInfoNominators, pallet.StorageInfoValidators, balances.StorageInfoLocks, system.StorageInfoAccount)
+	return types.WeightFromParts(46_000_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ WithdrawUnbondedCall) IsInherent() bool {
+	return false
+}
+
+func (_ WithdrawUnbondedCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ WithdrawUnbondedCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ WithdrawUnbondedCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ WithdrawUnbondedCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := withdrawUnbonded(origin, args[0].(sc.U32))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// withdrawUnbonded unlocks the unbonded funds of the sender whose bonding duration is over.
+// The number of slashing spans is kept for compatibility, as slashing spans are not tracked.
+func withdrawUnbonded(origin types.RuntimeOrigin, _ sc.U32) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return pallet.WithdrawUnbonded(origin.AsSigned())
+}
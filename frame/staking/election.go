@@ -0,0 +1,228 @@
+package staking
+
+import (
+	"math/big"
+	"reflect"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Voter is an account that backs the targets it votes for with its stake.
+// Validators vote for themselves.
+type Voter struct {
+	Who     types.AccountId
+	Stake   *big.Int
+	Targets sc.Sequence[types.AccountId]
+}
+
+// Backing is the stake of a voter assigned to an elected target.
+type Backing struct {
+	Who   types.AccountId
+	Stake *big.Int
+}
+
+// Support is an elected target together with the stake backing it.
+type Support struct {
+	Who    types.AccountId
+	Total  *big.Int
+	Voters []Backing
+}
+
+// ElectionProvider elects `count` of the `targets`, distributing the stake of the voters between them.
+type ElectionProvider interface {
+	Elect(targets sc.Sequence[types.AccountId], voters []Voter, count int) []Support
+}
+
+// Election is used to elect the validators of a new era.
+var Election ElectionProvider = SequentialPhragmen{}
+
+// loadPrecision is the denominator of the fixed point loads used by the sequential Phragmén method.
+var loadPrecision = new(big.Int).Exp(big.NewInt(10), big.NewInt(36), nil)
+
+// SequentialPhragmen elects targets with the sequential Phragmén method. In each round, the
+// target with the lowest score is elected, where the score is the load that its voters would
+// carry after the election. The stake of each voter is then split between its elected targets
+// in proportion to the load each of them added.
+type SequentialPhragmen struct{}
+
+type phragmenCandidate struct {
+	who      types.AccountId
+	approval *big.Int
+	score    *big.Int
+	load     *big.Int
+	elected  bool
+}
+
+type phragmenEdge struct {
+	candidate *phragmenCandidate
+	load      *big.Int
+}
+
+type phragmenVoter struct {
+	who   types.AccountId
+	stake *big.Int
+	load  *big.Int
+	edges []*phragmenEdge
+}
+
+func (sp SequentialPhragmen) Elect(targets sc.Sequence[types.AccountId], voters []Voter, count int) []Support {
+	candidates := make([]*phragmenCandidate, 0, len(targets))
+	byAccount := map[string]*phragmenCandidate{}
+	for _, target := range targets {
+		candidate := &phragmenCandidate{who: target, approval: big.NewInt(0), load: big.NewInt(0)}
+		candidates = append(candidates, candidate)
+		byAccount[string(sc.FixedSequenceU8ToBytes(target.FixedSequence))] = candidate
+	}
+
+	phragmenVoters := make([]*phragmenVoter, 0, len(voters))
+	for _, voter := range voters {
+		pv := &phragmenVoter{who: voter.Who, stake: voter.Stake, load: big.NewInt(0)}
+		for _, target := range voter.Targets {
+			candidate, ok := byAccount[string(sc.FixedSequenceU8ToBytes(target.FixedSequence))]
+			if !ok {
+				continue
+			}
+			candidate.approval = new(big.Int).Add(candidate.approval, voter.Stake)
+			pv.edges = append(pv.edges, &phragmenEdge{candidate: candidate, load: big.NewInt(0)})
+		}
+		phragmenVoters = append(phragmenVoters, pv)
+	}
+
+	elected := []*phragmenCandidate{}
+	for round := 0; round < count; round++ {
+		for _, candidate := range candidates {
+			if !candidate.elected && candidate.approval.Cmp(constants.Zero) > 0 {
+				candidate.score = new(big.Int).Div(loadPrecision, candidate.approval)
+			}
+		}
+
+		for _, voter := range phragmenVoters {
+			for _, edge := range voter.edges {
+				candidate := edge.candidate
+				if candidate.elected || candidate.approval.Cmp(constants.Zero) == 0 {
+					continue
+				}
+				increase := new(big.Int).Mul(voter.stake, voter.load)
+				increase.Div(increase, candidate.approval)
+				candidate.score = new(big.Int).Add(candidate.score, increase)
+			}
+		}
+
+		var winner *phragmenCandidate
+		for _, candidate := range candidates {
+			if candidate.elected || candidate.approval.Cmp(constants.Zero) == 0 {
+				continue
+			}
+			if winner == nil || candidate.score.Cmp(winner.score) < 0 {
+				winner = candidate
+			}
+		}
+		if winner == nil {
+			break
+		}
+
+		winner.elected = true
+		winner.load = winner.score
+		elected = append(elected, winner)
+
+		for _, voter := range phragmenVoters {
+			for _, edge := range voter.edges {
+				if edge.candidate == winner {
+					edge.load = new(big.Int).Sub(winner.load, voter.load)
+					voter.load = winner.load
+				}
+			}
+		}
+	}
+
+	supports := make([]Support, 0, len(elected))
+	supportOf := map[*phragmenCandidate]int{}
+	for i, candidate := range elected {
+		supports = append(supports, Support{Who: candidate.who, Total: big.NewInt(0)})
+		supportOf[candidate] = i
+	}
+
+	for _, voter := range phragmenVoters {
+		if voter.load.Cmp(constants.Zero) == 0 {
+			continue
+		}
+
+		remaining := new(big.Int).Set(voter.stake)
+		var last *phragmenEdge
+		for _, edge := range voter.edges {
+			if edge.candidate.elected {
+				last = edge
+			}
+		}
+
+		for _, edge := range voter.edges {
+			if !edge.candidate.elected {
+				continue
+			}
+
+			stake := remaining
+			if edge != last {
+				stake = new(big.Int).Mul(voter.stake, edge.load)
+				stake.Div(stake, voter.load)
+				remaining = new(big.Int).Sub(remaining, stake)
+			}
+
+			i := supportOf[edge.candidate]
+			supports[i].Total = new(big.Int).Add(supports[i].Total, stake)
+			supports[i].Voters = append(supports[i].Voters, Backing{Who: voter.who, Stake: stake})
+		}
+	}
+
+	return supports
+}
+
+// exposureFromSupport returns the exposure of an elected validator, with all of its nominators
+// sorted by their stake in descending order. The exposure is complete, so that all nominators
+// are slashed; only the rewards are limited to the nominators with the highest stake.
+func exposureFromSupport(support Support) types.Exposure {
+	own := big.NewInt(0)
+	others := []Backing{}
+	for _, backing := range support.Voters {
+		if reflect.DeepEqual(backing.Who, support.Who) {
+			own = new(big.Int).Add(own, backing.Stake)
+		} else {
+			others = append(others, backing)
+		}
+	}
+
+	sort.SliceStable(others, func(i, j int) bool {
+		return others[i].Stake.Cmp(others[j].Stake) > 0
+	})
+
+	total := new(big.Int).Set(own)
+	exposures := sc.Sequence[types.IndividualExposure]{}
+	for _, backing := range others {
+		total = new(big.Int).Add(total, backing.Stake)
+		exposures = append(exposures, types.IndividualExposure{
+			Who:   backing.Who,
+			Value: sc.NewU128FromBigInt(backing.Stake),
+		})
+	}
+
+	return types.Exposure{
+		Total:  sc.NewU128FromBigInt(total),
+		Own:    sc.NewU128FromBigInt(own),
+		Others: exposures,
+	}
+}
+
+// rewardedNominators returns the nominators of `exposure` with the highest stake, up to `maxNominators`.
+func rewardedNominators(exposure types.Exposure, maxNominators int) sc.Sequence[types.IndividualExposure] {
+	others := append(sc.Sequence[types.IndividualExposure]{}, exposure.Others...)
+	sort.SliceStable(others, func(i, j int) bool {
+		return others[i].Value.ToBigInt().Cmp(others[j].Value.ToBigInt()) > 0
+	})
+	if len(others) > maxNominators {
+		others = others[:maxNominators]
+	}
+
+	return others
+}
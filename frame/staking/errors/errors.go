@@ -0,0 +1,17 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Staking module errors.
+const (
+	ErrorNotStash sc.U8 = iota
+	ErrorAlreadyBonded
+	ErrorInsufficientBond
+	ErrorNoMoreChunks
+	ErrorEmptyTargets
+	ErrorTooManyTargets
+	ErrorBadTarget
+	ErrorInvalidSlashIndex
+	ErrorInvalidEraToReward
+	ErrorAlreadyClaimed
+)
@@ -0,0 +1,151 @@
+package events
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/staking"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Staking module events.
+const (
+	EventEraPaid sc.U8 = iota
+	EventRewarded
+	EventSlashed
+	EventSlashReported
+	EventOldSlashingReportDiscarded
+	EventStakersElected
+	EventBonded
+	EventUnbonded
+	EventWithdrawn
+	EventChilled
+	EventPayoutStarted
+	EventValidatorPrefsSet
+	EventForceEra
+	EventStakingElectionFailed
+)
+
+func NewEventEraPaid(eraIndex sc.U32, validatorPayout types.Balance, remainder types.Balance) types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventEraPaid, eraIndex, validatorPayout, remainder)
+}
+
+func NewEventRewarded(stash types.PublicKey, amount types.Balance) types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventRewarded, stash, amount)
+}
+
+func NewEventSlashed(staker types.PublicKey, amount types.Balance) types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventSlashed, staker, amount)
+}
+
+func NewEventSlashReported(validator types.PublicKey, fraction types.Permill, slashEra sc.U32) types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventSlashReported, validator, fraction, slashEra)
+}
+
+func NewEventOldSlashingReportDiscarded(sessionIndex sc.U32) types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventOldSlashingReportDiscarded, sessionIndex)
+}
+
+func NewEventStakersElected() types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventStakersElected)
+}
+
+func NewEventBonded(stash types.PublicKey, amount types.Balance) types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventBonded, stash, amount)
+}
+
+func NewEventUnbonded(stash types.PublicKey, amount types.Balance) types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventUnbonded, stash, amount)
+}
+
+func NewEventWithdrawn(stash types.PublicKey, amount types.Balance) types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventWithdrawn, stash, amount)
+}
+
+func NewEventChilled(stash types.PublicKey) types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventChilled, stash)
+}
+
+func NewEventPayoutStarted(eraIndex sc.U32, validatorStash types.PublicKey) types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventPayoutStarted, eraIndex, validatorStash)
+}
+
+func NewEventValidatorPrefsSet(stash types.PublicKey, prefs types.ValidatorPrefs) types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventValidatorPrefsSet, stash, prefs)
+}
+
+func NewEventForceEra(mode types.Forcing) types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventForceEra, mode)
+}
+
+func NewEventStakingElectionFailed() types.Event {
+	return types.NewEvent(staking.ModuleIndex, EventStakingElectionFailed)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	module := sc.DecodeU8(buffer)
+	if module != staking.ModuleIndex {
+		log.Critical("invalid staking.Event module")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventEraPaid:
+		eraIndex := sc.DecodeU32(buffer)
+		validatorPayout := sc.DecodeU128(buffer)
+		remainder := sc.DecodeU128(buffer)
+		return NewEventEraPaid(eraIndex, validatorPayout, remainder)
+	case EventRewarded:
+		stash := types.DecodePublicKey(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventRewarded(stash, amount)
+	case EventSlashed:
+		staker := types.DecodePublicKey(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventSlashed(staker, amount)
+	case EventSlashReported:
+		validator := types.DecodePublicKey(buffer)
+		fraction := types.DecodePermill(buffer)
+		slashEra := sc.DecodeU32(buffer)
+		return NewEventSlashReported(validator, fraction, slashEra)
+	case EventOldSlashingReportDiscarded:
+		sessionIndex := sc.DecodeU32(buffer)
+		return NewEventOldSlashingReportDiscarded(sessionIndex)
+	case EventStakersElected:
+		return NewEventStakersElected()
+	case EventBonded:
+		stash := types.DecodePublicKey(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventBonded(stash, amount)
+	case EventUnbonded:
+		stash := types.DecodePublicKey(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventUnbonded(stash, amount)
+	case EventWithdrawn:
+		stash := types.DecodePublicKey(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventWithdrawn(stash, amount)
+	case EventChilled:
+		stash := types.DecodePublicKey(buffer)
+		return NewEventChilled(stash)
+	case EventPayoutStarted:
+		eraIndex := sc.DecodeU32(buffer)
+		validatorStash := types.DecodePublicKey(buffer)
+		return NewEventPayoutStarted(eraIndex, validatorStash)
+	case EventValidatorPrefsSet:
+		stash := types.DecodePublicKey(buffer)
+		prefs := types.DecodeValidatorPrefs(buffer)
+		return NewEventValidatorPrefsSet(stash, prefs)
+	case EventForceEra:
+		mode := sc.DecodeU8(buffer)
+		return NewEventForceEra(mode)
+	case EventStakingElectionFailed:
+		return NewEventStakingElectionFailed()
+	default:
+		log.Critical("invalid staking.Event type")
+	}
+
+	panic("unreachable")
+}
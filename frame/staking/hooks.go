@@ -0,0 +1,33 @@
+package staking
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OnInitialize rotates the session at the start of every session period.
+func OnInitialize(n types.BlockNumber) types.Weight {
+	if n == 0 || n%staking.SessionPeriod != 0 {
+		return constants.DbWeight.Reads(0)
+	}
+
+	rotateSession(n)
+
+	return constants.DbWeight.ReadsWrites(12, 8)
+}
+
+// OnFinalize sets the start of the active era to the timestamp of its first block.
+func OnFinalize() {
+	active := StorageGetActiveEra()
+	if !active.HasValue || active.Value.Start.HasValue {
+		return
+	}
+
+	now := storage.GetDecode(append(hashing.Twox128(constants.KeyTimestamp), hashing.Twox128(constants.KeyNow)...), sc.DecodeU64)
+	active.Value.Start = sc.NewOption[sc.U64](now)
+	StorageSetActiveEra(active.Value)
+}
@@ -0,0 +1,316 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/staking"
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	"github.com/LimeChain/gosemble/frame/staking/dispatchables"
+	"github.com/LimeChain/gosemble/frame/staking/errors"
+	"github.com/LimeChain/gosemble/frame/staking/events"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type StakingModule struct {
+	functions map[sc.U8]primitives.Call
+}
+
+func NewStakingModule() StakingModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[staking.FunctionBondIndex] = dispatchables.NewBondCall(nil)
+	functions[staking.FunctionBondExtraIndex] = dispatchables.NewBondExtraCall(nil)
+	functions[staking.FunctionUnbondIndex] = dispatchables.NewUnbondCall(nil)
+	functions[staking.FunctionWithdrawUnbondedIndex] = dispatchables.NewWithdrawUnbondedCall(nil)
+	functions[staking.FunctionValidateIndex] = dispatchables.NewValidateCall(nil)
+	functions[staking.FunctionNominateIndex] = dispatchables.NewNominateCall(nil)
+	functions[staking.FunctionChillIndex] = dispatchables.NewChillCall(nil)
+	functions[staking.FunctionSetPayeeIndex] = dispatchables.NewSetPayeeCall(nil)
+	functions[staking.FunctionSetValidatorCountIndex] = dispatchables.NewSetValidatorCountCall(nil)
+	functions[staking.FunctionForceNewEraIndex] = dispatchables.NewForceNewEraCall(nil)
+	functions[staking.FunctionCancelDeferredSlashIndex] = dispatchables.NewCancelDeferredSlashCall(nil)
+	functions[staking.FunctionPayoutStakersIndex] = dispatchables.NewPayoutStakersCall(nil)
+
+	return StakingModule{
+		functions: functions,
+	}
+}
+
+func (sm StakingModule) Functions() map[sc.U8]primitives.Call {
+	return sm.functions
+}
+
+func (sm StakingModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (sm StakingModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// OnInitialize rotates the session at the start of each session.
+func (sm StakingModule) OnInitialize(n primitives.BlockNumber) primitives.Weight {
+	return pallet.OnInitialize(n)
+}
+
+// OnFinalize sets the start of the active era to the timestamp of its first block.
+func (sm StakingModule) OnFinalize(_ primitives.BlockNumber) {
+	pallet.OnFinalize()
+}
+
+func (sm StakingModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Nominated proof-of-stake with eras, rewards and slashing."}
+}
+
+func (sm StakingModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "Staking",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "Staking",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"ValidatorCount",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+					"The ideal number of active validators."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Ledger",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(registry.Register(pallet.StakingLedgerTypeInfo))),
+					"Map from all (unlocked) \"controller\" accounts to the info regarding the staking."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Payee",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(registry.Register(pallet.RewardDestinationTypeInfo))),
+					"Where the reward payment should be made. Keyed by stash."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Validators",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(registry.Register(pallet.ValidatorPrefsTypeInfo))),
+					"The map from (wannabe) validator stash key to the preferences of that validator."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Nominators",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.TypesAddress32),
+						sc.ToCompact(registry.Register(pallet.NominationsTypeInfo))),
+					"The map from nominator stash key to their nomination preferences, namely the validators that they wish to support."),
+				primitives.NewMetadataModuleStorageEntry(
+					"CurrentEra",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+					"The current era index."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ActiveEra",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(pallet.ActiveEraInfoTypeInfo))),
+					"The active era information, it holds index and start."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ErasStartSessionIndex",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.PrimitiveTypesU32),
+						sc.ToCompact(metadata.PrimitiveTypesU32)),
+					"The session index at which the era start for the last `HistoryDepth` eras."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ErasStakers",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64, primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(registry.Register(primitives.NewTupleTypeInfo(primitives.TypeInfoU32, primitives.TypeId(metadata.TypesAddress32)))),
+						sc.ToCompact(registry.Register(pallet.ExposureTypeInfo))),
+					"Exposure of validator at era."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ErasValidatorPrefs",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64, primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(registry.Register(primitives.NewTupleTypeInfo(primitives.TypeInfoU32, primitives.TypeId(metadata.TypesAddress32)))),
+						sc.ToCompact(registry.Register(pallet.ValidatorPrefsTypeInfo))),
+					"Similar to `ErasStakers`, this holds the preferences of validators."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ErasValidatorReward",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.PrimitiveTypesU32),
+						sc.ToCompact(metadata.PrimitiveTypesU128)),
+					"The total validator era payout for the last `HistoryDepth` eras."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ErasRewardPoints",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.PrimitiveTypesU32),
+						sc.ToCompact(registry.Register(pallet.EraRewardPointsTypeInfo))),
+					"Rewards for the last `HistoryDepth` eras."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ErasTotalStake",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.PrimitiveTypesU32),
+						sc.ToCompact(metadata.PrimitiveTypesU128)),
+					"The total amount staked for the last `HistoryDepth` eras."),
+				primitives.NewMetadataModuleStorageEntry(
+					"ForceEra",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(pallet.ForcingTypeInfo))),
+					"Mode of era forcing."),
+				primitives.NewMetadataModuleStorageEntry(
+					"CurrentPlannedSession",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+					"The last planned session scheduled by the session pallet."),
+				primitives.NewMetadataModuleStorageEntry(
+					"UnappliedSlashes",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.PrimitiveTypesU32),
+						sc.ToCompact(registry.Register(primitives.NewSequenceTypeInfo(pallet.UnappliedSlashTypeInfo)))),
+					"All unapplied slashes that are queued for later."),
+				primitives.NewMetadataModuleStorageEntry(
+					"BondedEras",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(primitives.NewSequenceTypeInfo(primitives.TypeId(metadata.TypesTupleU32U32))))),
+					"A mapping from still-bonded eras to the first session index of that era."),
+			},
+		}),
+		Call:  sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(sm.callsTypeInfo()))),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(eventTypeInfo()))),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"SessionsPerEra",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(staking.SessionsPerEra.Bytes()),
+				"Number of sessions per era.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"BondingDuration",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(staking.BondingDuration.Bytes()),
+				"Number of eras that staked funds must remain bonded for.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"SlashDeferDuration",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(staking.SlashDeferDuration.Bytes()),
+				"Number of eras that slashes are deferred by, after computation.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"HistoryDepth",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(staking.HistoryDepth.Bytes()),
+				"Number of eras to keep in history.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxNominations",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(staking.MaxNominations).Bytes()),
+				"Maximum number of nominations per nominator.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxUnlockingChunks",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(staking.MaxUnlockingChunks).Bytes()),
+				"The maximum number of `unlocking` chunks a `StakingLedger` can have.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxNominatorRewardedPerValidator",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(staking.MaxNominatorRewardedPerValidator).Bytes()),
+				"The maximum number of nominators rewarded for each validator.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(errorsTypeInfo()))),
+		Index: staking.ModuleIndex,
+	}
+}
+
+func (sm StakingModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_staking", "pallet", "Call"}, sm.functions)
+}
+
+func eventTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_staking", "pallet", "Event"},
+		"The events of the module.",
+		primitives.NewVariantTypeInfo("EraPaid", events.EventEraPaid, "The era payout has been set; the first balance is the validator-payout; the second is the remainder from the maximum amount of reward.",
+			primitives.NewFieldTypeInfo("era_index", "EraIndex", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("validator_payout", "BalanceOf<T>", primitives.TypeInfoU128),
+			primitives.NewFieldTypeInfo("remainder", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Rewarded", events.EventRewarded, "The nominator has been rewarded by this amount.",
+			primitives.NewFieldTypeInfo("stash", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Slashed", events.EventSlashed, "A staker (validator or nominator) has been slashed by the given amount.",
+			primitives.NewFieldTypeInfo("staker", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("SlashReported", events.EventSlashReported, "A slash for the given validator, for the given percentage of their stake, at the given era as been reported.",
+			primitives.NewFieldTypeInfo("validator", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("fraction", "Perbill", primitives.TypeInfoPermill),
+			primitives.NewFieldTypeInfo("slash_era", "EraIndex", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("OldSlashingReportDiscarded", events.EventOldSlashingReportDiscarded, "An old slashing report from a prior era was discarded because it could not be processed.",
+			primitives.NewFieldTypeInfo("session_index", "SessionIndex", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("StakersElected", events.EventStakersElected, "A new set of stakers was elected."),
+		primitives.NewVariantTypeInfo("Bonded", events.EventBonded, "An account has bonded this amount.",
+			primitives.NewFieldTypeInfo("stash", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Unbonded", events.EventUnbonded, "An account has unbonded this amount.",
+			primitives.NewFieldTypeInfo("stash", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Withdrawn", events.EventWithdrawn, "An account has called `withdraw_unbonded` and removed unbonding chunks worth `Balance` from the unlocking queue.",
+			primitives.NewFieldTypeInfo("stash", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Chilled", events.EventChilled, "An account has stopped participating as either a validator or nominator.",
+			primitives.NewFieldTypeInfo("stash", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("PayoutStarted", events.EventPayoutStarted, "The stakers' rewards are getting paid.",
+			primitives.NewFieldTypeInfo("era_index", "EraIndex", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("validator_stash", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("ValidatorPrefsSet", events.EventValidatorPrefsSet, "A validator has set their preferences.",
+			primitives.NewFieldTypeInfo("stash", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("prefs", "ValidatorPrefs", pallet.ValidatorPrefsTypeInfo),
+		),
+		primitives.NewVariantTypeInfo("ForceEra", events.EventForceEra, "A new force era mode was set.",
+			primitives.NewFieldTypeInfo("mode", "Forcing", pallet.ForcingTypeInfo),
+		),
+		primitives.NewVariantTypeInfo("StakingElectionFailed", events.EventStakingElectionFailed, "The election failed. No new era is planned."),
+	)
+}
+
+func errorsTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_staking", "pallet", "Error"},
+		"The errors of the module.",
+		primitives.NewVariantTypeInfo("NotStash", errors.ErrorNotStash, "Not a stash account."),
+		primitives.NewVariantTypeInfo("AlreadyBonded", errors.ErrorAlreadyBonded, "Stash is already bonded."),
+		primitives.NewVariantTypeInfo("InsufficientBond", errors.ErrorInsufficientBond, "Cannot have a validator or nominator role, with value less than the minimum defined by governance."),
+		primitives.NewVariantTypeInfo("NoMoreChunks", errors.ErrorNoMoreChunks, "Can not schedule more unlock chunks."),
+		primitives.NewVariantTypeInfo("EmptyTargets", errors.ErrorEmptyTargets, "Targets cannot be empty."),
+		primitives.NewVariantTypeInfo("TooManyTargets", errors.ErrorTooManyTargets, "Too many nomination targets supplied."),
+		primitives.NewVariantTypeInfo("BadTarget", errors.ErrorBadTarget, "A nomination target was supplied that was blocked or otherwise not a validator."),
+		primitives.NewVariantTypeInfo("InvalidSlashIndex", errors.ErrorInvalidSlashIndex, "Slash record index out of bounds."),
+		primitives.NewVariantTypeInfo("InvalidEraToReward", errors.ErrorInvalidEraToReward, "Invalid era to reward."),
+		primitives.NewVariantTypeInfo("AlreadyClaimed", errors.ErrorAlreadyClaimed, "Rewards for this era have already been claimed for this validator."),
+	)
+}
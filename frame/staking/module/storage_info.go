@@ -0,0 +1,28 @@
+package module
+
+import (
+	pallet "github.com/LimeChain/gosemble/frame/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageInfo describes the storage items of the module whose values have a maximum encoded length.
+// The sizes of the map entries include their hashed keys.
+func (sm StakingModule) StorageInfo() []primitives.StorageInfo {
+	return []primitives.StorageInfo{
+		pallet.StorageInfoLedger,
+		pallet.StorageInfoPayee,
+		pallet.StorageInfoValidators,
+		pallet.StorageInfoNominators,
+		pallet.StorageInfoValidatorCount,
+		pallet.StorageInfoCurrentEra,
+		pallet.StorageInfoActiveEra,
+		pallet.StorageInfoCurrentPlannedSession,
+		pallet.StorageInfoErasStartSessionIndex,
+		pallet.StorageInfoErasValidatorPrefs,
+		pallet.StorageInfoErasValidatorReward,
+		pallet.StorageInfoErasRewardPoints,
+		pallet.StorageInfoErasTotalStake,
+		pallet.StorageInfoForceEra,
+		pallet.StorageInfoBondedEras,
+	}
+}
@@ -0,0 +1,13 @@
+package staking
+
+import (
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// AdminOrigin is the origin which can set the validator count and force new eras.
+	AdminOrigin types.EnsureOrigin = system.EnsureRoot{}
+	// SlashCancelOrigin is the origin which can cancel deferred slashes.
+	SlashCancelOrigin types.EnsureOrigin = system.EnsureRoot{}
+)
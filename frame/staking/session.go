@@ -0,0 +1,226 @@
+package staking
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/staking/events"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// SessionManager is notified about the rotation of sessions.
+type SessionManager interface {
+	// NewSession plans the session `newIndex`, returning its validators if they changed.
+	NewSession(newIndex sc.U32) sc.Option[sc.Sequence[types.AccountId]]
+	// EndSession is called when the session `endIndex` ends.
+	EndSession(endIndex sc.U32)
+	// StartSession is called when the session `startIndex` starts.
+	StartSession(startIndex sc.U32)
+}
+
+// Manager progresses the eras as sessions rotate. A new era is planned every
+// `SessionsPerEra` sessions, and becomes active one session after it is planned.
+type Manager struct{}
+
+func (m Manager) NewSession(newIndex sc.U32) sc.Option[sc.Sequence[types.AccountId]] {
+	StorageSetCurrentPlannedSession(newIndex)
+
+	current := StorageGetCurrentEra()
+	if !current.HasValue {
+		return tryTriggerNewEra(newIndex)
+	}
+
+	startSession := StorageGetErasStartSessionIndex(current.Value)
+	eraLength := newIndex
+	if startSession.HasValue && startSession.Value <= newIndex {
+		eraLength = newIndex - startSession.Value
+	}
+
+	switch StorageGetForceEra() {
+	case types.ForcingForceNew:
+	case types.ForcingForceAlways:
+	case types.ForcingForceNone:
+		return sc.NewOption[sc.Sequence[types.AccountId]](nil)
+	default:
+		if eraLength < staking.SessionsPerEra {
+			return sc.NewOption[sc.Sequence[types.AccountId]](nil)
+		}
+	}
+
+	validators := tryTriggerNewEra(newIndex)
+	if validators.HasValue && StorageGetForceEra() == types.ForcingForceNew {
+		StorageSetForceEra(types.ForcingNotForcing)
+	}
+
+	return validators
+}
+
+func (m Manager) EndSession(endIndex sc.U32) {
+	active := StorageGetActiveEra()
+	if !active.HasValue {
+		return
+	}
+
+	nextStart := StorageGetErasStartSessionIndex(active.Value.Index + 1)
+	if nextStart.HasValue && nextStart.Value == endIndex+1 {
+		endEra(active.Value)
+	}
+}
+
+func (m Manager) StartSession(startIndex sc.U32) {
+	next := sc.U32(0)
+	active := StorageGetActiveEra()
+	if active.HasValue {
+		next = active.Value.Index + 1
+	}
+
+	nextStart := StorageGetErasStartSessionIndex(next)
+	if nextStart.HasValue && nextStart.Value == startIndex {
+		startEra(next, startIndex)
+	}
+}
+
+// Session is notified about the rotation of sessions. Until a session module exists,
+// sessions rotate every `SessionPeriod` blocks from the staking `on_initialize` hook.
+var Session SessionManager = Manager{}
+
+// CurrentSessionIndex returns the index of the session at block `n`.
+func CurrentSessionIndex(n types.BlockNumber) sc.U32 {
+	return n / staking.SessionPeriod
+}
+
+// rotateSession ends the current session, starts the next one and plans the one after it.
+func rotateSession(n types.BlockNumber) {
+	index := CurrentSessionIndex(n)
+
+	Session.EndSession(index - 1)
+	Session.StartSession(index)
+	Session.NewSession(index + 1)
+}
+
+// tryTriggerNewEra elects the validators of a new era that starts at `startSession`.
+// No era is planned if not enough validators are elected.
+func tryTriggerNewEra(startSession sc.U32) sc.Option[sc.Sequence[types.AccountId]] {
+	supports := elect()
+	if len(supports) < staking.MinimumValidatorCount {
+		system.DepositEvent(events.NewEventStakingElectionFailed())
+		return sc.NewOption[sc.Sequence[types.AccountId]](nil)
+	}
+
+	era := sc.U32(0)
+	current := StorageGetCurrentEra()
+	if current.HasValue {
+		era = current.Value + 1
+	}
+
+	StorageSetCurrentEra(era)
+	StorageSetErasStartSessionIndex(era, startSession)
+
+	if era > staking.HistoryDepth {
+		StorageClearEraInformation(era - staking.HistoryDepth - 1)
+	}
+
+	total := big.NewInt(0)
+	validators := sc.Sequence[types.AccountId]{}
+	for _, support := range supports {
+		exposure := exposureFromSupport(support)
+		total = new(big.Int).Add(total, exposure.Total.ToBigInt())
+
+		prefs := StorageGetValidators(support.Who)
+		StorageSetErasStakers(era, support.Who, exposure)
+		StorageSetErasValidatorPrefs(era, support.Who, prefs.Value)
+
+		validators = append(validators, support.Who)
+	}
+	StorageSetErasTotalStake(era, sc.NewU128FromBigInt(total))
+
+	system.DepositEvent(events.NewEventStakersElected())
+
+	return sc.NewOption[sc.Sequence[types.AccountId]](validators)
+}
+
+// elect runs the election with the active bonds of the validators and nominators.
+// Validators back themselves with their own bond.
+func elect() []Support {
+	targets := StorageGetValidatorStashes()
+
+	voters := []Voter{}
+	for _, validator := range targets {
+		ledger := StorageGetLedger(validator)
+		if !ledger.HasValue {
+			continue
+		}
+		voters = append(voters, Voter{
+			Who:     validator,
+			Stake:   ledger.Value.Active.ToBigInt(),
+			Targets: sc.Sequence[types.AccountId]{validator},
+		})
+	}
+
+	for _, nominator := range StorageGetNominatorStashes() {
+		ledger := StorageGetLedger(nominator)
+		nominations := StorageGetNominators(nominator)
+		if !ledger.HasValue || !nominations.HasValue {
+			continue
+		}
+		voters = append(voters, Voter{
+			Who:     nominator,
+			Stake:   ledger.Value.Active.ToBigInt(),
+			Targets: nominations.Value.Targets,
+		})
+	}
+
+	return Election.Elect(targets, voters, int(StorageGetValidatorCount()))
+}
+
+// startEra activates `era`, which started at `startSession`, and applies the slashes deferred to it.
+func startEra(era sc.U32, startSession sc.U32) {
+	StorageSetActiveEra(types.ActiveEraInfo{
+		Index: era,
+		Start: sc.NewOption[sc.U64](nil),
+	})
+
+	bondedEras := append(StorageGetBondedEras(), types.BondedEra{Era: era, StartSession: startSession})
+	if era > staking.BondingDuration {
+		first := era - staking.BondingDuration
+		pruned := sc.Sequence[types.BondedEra]{}
+		for _, bonded := range bondedEras {
+			if bonded.Era >= first {
+				pruned = append(pruned, bonded)
+			}
+		}
+		bondedEras = pruned
+	}
+	StorageSetBondedEras(bondedEras)
+
+	applyUnappliedSlashes(era)
+}
+
+// endEra computes the payout of the active era from its duration. The total issuance
+// is inflated by `YearlyInflation` per year of eras.
+func endEra(active types.ActiveEraInfo) {
+	if !active.Start.HasValue {
+		return
+	}
+
+	now := storage.GetDecode(append(hashing.Twox128(constants.KeyTimestamp), hashing.Twox128(constants.KeyNow)...), sc.DecodeU64)
+	duration := sc.U64(0)
+	if now > active.Start.Value {
+		duration = now - active.Start.Value
+	}
+
+	yearlyPayout := staking.YearlyInflation.MulFloor(dispatchables.StorageGetTotalIssuance().ToBigInt())
+	payout := new(big.Int).Mul(yearlyPayout, new(big.Int).SetUint64(uint64(duration)))
+	payout.Div(payout, new(big.Int).SetUint64(uint64(staking.MillisecondsPerYear)))
+
+	eraPayout := sc.NewU128FromBigInt(payout)
+	StorageSetErasValidatorReward(active.Index, eraPayout)
+
+	system.DepositEvent(events.NewEventEraPaid(active.Index, eraPayout, sc.NewU128FromUint64(0)))
+}
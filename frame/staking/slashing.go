@@ -0,0 +1,226 @@
+package staking
+
+import (
+	"math/big"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/staking/errors"
+	"github.com/LimeChain/gosemble/frame/staking/events"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/treasury"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Offence is a misbehaviour of validators in a session.
+type Offence interface {
+	// Offenders returns the validators that committed the offence.
+	Offenders() sc.Sequence[types.AccountId]
+	// SessionIndex returns the session in which the offence was committed.
+	SessionIndex() sc.U32
+	// SlashFraction returns the fraction of the exposure of each offender that is slashed,
+	// given the number of offenders and the number of validators in the session.
+	SlashFraction(offendersCount sc.U32, validatorSetCount sc.U32) types.Permill
+}
+
+// ReportOffence reports an offence, slashing the offenders and their nominators.
+// A part of the slash is paid out to the reporters.
+func ReportOffence(reporters sc.Sequence[types.AccountId], offence Offence) {
+	offenders := offence.Offenders()
+	fraction := offence.SlashFraction(sc.U32(len(offenders)), validatorSetCount())
+
+	OnOffence(offenders, reporters, fraction, offence.SessionIndex())
+}
+
+// OnOffence slashes the exposure of `offenders` in the era of `session` by `fraction` and chills them.
+// The slashes are applied `SlashDeferDuration` eras after the active era, unless they are cancelled before.
+func OnOffence(offenders sc.Sequence[types.AccountId], reporters sc.Sequence[types.AccountId], fraction types.Permill, session sc.U32) {
+	active := StorageGetActiveEra()
+	if !active.HasValue {
+		return
+	}
+
+	slashEra := sc.NewOption[sc.U32](nil)
+	for _, bonded := range StorageGetBondedEras() {
+		if bonded.StartSession <= session {
+			slashEra = sc.NewOption[sc.U32](bonded.Era)
+		}
+	}
+	if !slashEra.HasValue {
+		system.DepositEvent(events.NewEventOldSlashingReportDiscarded(session))
+		return
+	}
+
+	for _, offender := range offenders {
+		exposure := StorageGetErasStakers(slashEra.Value, offender)
+		if exposure.Total.ToBigInt().Cmp(constants.Zero) == 0 {
+			continue
+		}
+
+		total := fraction.MulFloor(exposure.Own.ToBigInt())
+		slash := types.UnappliedSlash{
+			Validator: offender,
+			Own:       sc.NewU128FromBigInt(total),
+			Others:    sc.Sequence[types.StakingSlash]{},
+			Reporters: reporters,
+		}
+		for _, nominator := range exposure.Others {
+			value := fraction.MulFloor(nominator.Value.ToBigInt())
+			total = new(big.Int).Add(total, value)
+			slash.Others = append(slash.Others, types.StakingSlash{Who: nominator.Who, Value: sc.NewU128FromBigInt(value)})
+		}
+
+		if len(reporters) > 0 {
+			slash.Payout = sc.NewU128FromBigInt(staking.SlashRewardFraction.MulFloor(total))
+		} else {
+			slash.Payout = sc.NewU128FromUint64(0)
+		}
+
+		chill(offender)
+
+		if staking.SlashDeferDuration == 0 {
+			applySlash(slash)
+		} else {
+			applyAt := active.Value.Index + staking.SlashDeferDuration
+			StorageSetUnappliedSlashes(applyAt, append(StorageGetUnappliedSlashes(applyAt), slash))
+		}
+
+		system.DepositEvent(events.NewEventSlashReported(offender.FixedSequence, fraction, slashEra.Value))
+	}
+}
+
+// CancelDeferredSlash cancels the slashes deferred to `era` at the given indices.
+func CancelDeferredSlash(era sc.U32, indices sc.Sequence[sc.U32]) types.DispatchError {
+	if len(indices) == 0 {
+		return newStakingError(errors.ErrorEmptyTargets)
+	}
+
+	sorted := append(sc.Sequence[sc.U32]{}, indices...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	slashes := StorageGetUnappliedSlashes(era)
+	for i, index := range sorted {
+		if int(index) >= len(slashes) || (i > 0 && sorted[i-1] == index) {
+			return newStakingError(errors.ErrorInvalidSlashIndex)
+		}
+	}
+
+	remaining := sc.Sequence[types.UnappliedSlash]{}
+	next := 0
+	for i, slash := range slashes {
+		if next < len(sorted) && int(sorted[next]) == i {
+			next++
+			continue
+		}
+		remaining = append(remaining, slash)
+	}
+
+	if len(remaining) == 0 {
+		StorageClearUnappliedSlashes(era)
+	} else {
+		StorageSetUnappliedSlashes(era, remaining)
+	}
+
+	return nil
+}
+
+// applyUnappliedSlashes applies the slashes deferred to `era`.
+func applyUnappliedSlashes(era sc.U32) {
+	for _, slash := range StorageGetUnappliedSlashes(era) {
+		applySlash(slash)
+	}
+	StorageClearUnappliedSlashes(era)
+}
+
+// applySlash slashes the validator and its nominators. The reporters share the payout
+// and the rest of the slashed funds go to the treasury.
+func applySlash(slash types.UnappliedSlash) {
+	slashed := slashStaker(slash.Validator, slash.Own.ToBigInt())
+	for _, other := range slash.Others {
+		slashed = new(big.Int).Add(slashed, slashStaker(other.Who, other.Value.ToBigInt()))
+	}
+
+	payout := slash.Payout.ToBigInt()
+	if payout.Cmp(slashed) > 0 {
+		payout = slashed
+	}
+
+	if len(slash.Reporters) > 0 {
+		share := new(big.Int).Div(payout, big.NewInt(int64(len(slash.Reporters))))
+		for _, reporter := range slash.Reporters {
+			paid := dispatchables.DepositCreating(reporter, sc.NewU128FromBigInt(share))
+			slashed = new(big.Int).Sub(slashed, paid.ToBigInt())
+		}
+	}
+
+	treasury.OnUnbalanced(sc.NewU128FromBigInt(slashed))
+}
+
+// slashStaker slashes up to `value` from the bond of `stash`, taking from the active bond first
+// and then from the most recent unlocking chunks. Returns the slashed amount.
+func slashStaker(stash types.AccountId, value *big.Int) *big.Int {
+	ledger := StorageGetLedger(stash)
+	if !ledger.HasValue || value.Cmp(constants.Zero) == 0 {
+		return big.NewInt(0)
+	}
+
+	remaining := new(big.Int).Set(value)
+
+	active := ledger.Value.Active.ToBigInt()
+	take := minBigInt(active, remaining)
+	ledger.Value.Active = sc.NewU128FromBigInt(new(big.Int).Sub(active, take))
+	remaining.Sub(remaining, take)
+
+	for i := len(ledger.Value.Unlocking) - 1; i >= 0 && remaining.Cmp(constants.Zero) > 0; i-- {
+		chunk := ledger.Value.Unlocking[i].Value.ToBigInt()
+		take = minBigInt(chunk, remaining)
+		ledger.Value.Unlocking[i].Value = sc.NewU128FromBigInt(new(big.Int).Sub(chunk, take))
+		remaining.Sub(remaining, take)
+	}
+
+	unlocking := sc.Sequence[types.UnlockChunk]{}
+	for _, chunk := range ledger.Value.Unlocking {
+		if chunk.Value.ToBigInt().Cmp(constants.Zero) > 0 {
+			unlocking = append(unlocking, chunk)
+		}
+	}
+	ledger.Value.Unlocking = unlocking
+
+	amount := new(big.Int).Sub(value, remaining)
+	if amount.Cmp(constants.Zero) == 0 {
+		return amount
+	}
+
+	ledger.Value.Total = sc.NewU128FromBigInt(new(big.Int).Sub(ledger.Value.Total.ToBigInt(), amount))
+	updateLedger(ledger.Value)
+
+	withdrawn, err := dispatchables.Withdraw(stash, sc.NewU128FromBigInt(amount), sc.U8(types.ReasonsAll), types.ExistenceRequirementAllowDeath)
+	if err != nil {
+		return big.NewInt(0)
+	}
+
+	system.DepositEvent(events.NewEventSlashed(stash.FixedSequence, withdrawn))
+
+	return withdrawn.ToBigInt()
+}
+
+// validatorSetCount returns the number of validators elected in the active era.
+func validatorSetCount() sc.U32 {
+	active := StorageGetActiveEra()
+	if !active.HasValue {
+		return 0
+	}
+
+	return sc.U32(len(iterKeys(keyErasStakersPrefix(active.Value.Index))))
+}
+
+func minBigInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+
+	return b
+}
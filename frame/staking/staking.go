@@ -0,0 +1,506 @@
+package staking
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/staking/errors"
+	"github.com/LimeChain/gosemble/frame/staking/events"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Bond locks `value` of the free balance of `stash`, which becomes its own controller.
+// The locked amount is capped at the free balance.
+func Bond(stash types.AccountId, value *big.Int, payee types.RewardDestination) types.DispatchError {
+	if StorageGetLedger(stash).HasValue {
+		return newStakingError(errors.ErrorAlreadyBonded)
+	}
+
+	if value.Cmp(staking.MinimumBond) < 0 {
+		return newStakingError(errors.ErrorInsufficientBond)
+	}
+
+	free := freeBalance(stash)
+	if value.Cmp(free) > 0 {
+		value = free
+	}
+
+	StorageSetPayee(stash, payee)
+
+	bonded := sc.NewU128FromBigInt(value)
+	updateLedger(types.StakingLedger{
+		Stash:          stash,
+		Total:          bonded,
+		Active:         bonded,
+		Unlocking:      sc.Sequence[types.UnlockChunk]{},
+		ClaimedRewards: sc.Sequence[sc.U32]{},
+	})
+
+	system.DepositEvent(events.NewEventBonded(stash.FixedSequence, bonded))
+
+	return nil
+}
+
+// BondExtra locks up to `maxAdditional` more of the free balance of `stash`.
+func BondExtra(stash types.AccountId, maxAdditional *big.Int) types.DispatchError {
+	ledger, err := ledgerOf(stash)
+	if err != nil {
+		return err
+	}
+
+	extra := new(big.Int).Sub(freeBalance(stash), ledger.Total.ToBigInt())
+	if extra.Cmp(maxAdditional) > 0 {
+		extra = maxAdditional
+	}
+	if extra.Cmp(constants.Zero) < 0 {
+		extra = big.NewInt(0)
+	}
+
+	ledger.Total = sc.NewU128FromBigInt(new(big.Int).Add(ledger.Total.ToBigInt(), extra))
+	ledger.Active = sc.NewU128FromBigInt(new(big.Int).Add(ledger.Active.ToBigInt(), extra))
+	if ledger.Active.ToBigInt().Cmp(staking.MinimumBond) < 0 {
+		return newStakingError(errors.ErrorInsufficientBond)
+	}
+
+	updateLedger(ledger)
+
+	system.DepositEvent(events.NewEventBonded(stash.FixedSequence, sc.NewU128FromBigInt(extra)))
+
+	return nil
+}
+
+// Unbond schedules up to `value` of the active bond of `stash` to be unlocked after the bonding duration.
+// If the remaining active bond is below the minimum, it is unbonded as well and the stash is chilled.
+func Unbond(stash types.AccountId, value *big.Int) types.DispatchError {
+	ledger, err := ledgerOf(stash)
+	if err != nil {
+		return err
+	}
+
+	if len(ledger.Unlocking) >= staking.MaxUnlockingChunks {
+		return newStakingError(errors.ErrorNoMoreChunks)
+	}
+
+	active := ledger.Active.ToBigInt()
+	if value.Cmp(active) > 0 {
+		value = active
+	}
+
+	remaining := new(big.Int).Sub(active, value)
+	if remaining.Cmp(staking.MinimumBond) < 0 {
+		value = active
+		remaining = big.NewInt(0)
+	}
+
+	if value.Cmp(constants.Zero) == 0 {
+		return nil
+	}
+
+	ledger.Active = sc.NewU128FromBigInt(remaining)
+
+	era := currentEra() + staking.BondingDuration
+	merged := false
+	for i, chunk := range ledger.Unlocking {
+		if chunk.Era == era {
+			ledger.Unlocking[i].Value = sc.NewU128FromBigInt(new(big.Int).Add(chunk.Value.ToBigInt(), value))
+			merged = true
+		}
+	}
+	if !merged {
+		ledger.Unlocking = append(ledger.Unlocking, types.UnlockChunk{Value: sc.NewU128FromBigInt(value), Era: era})
+	}
+
+	updateLedger(ledger)
+
+	if remaining.Cmp(constants.Zero) == 0 {
+		chill(stash)
+	}
+
+	system.DepositEvent(events.NewEventUnbonded(stash.FixedSequence, sc.NewU128FromBigInt(value)))
+
+	return nil
+}
+
+// WithdrawUnbonded unlocks the funds of `stash` whose bonding duration is over.
+// The ledger is removed once nothing is left bonded.
+func WithdrawUnbonded(stash types.AccountId) types.DispatchError {
+	ledger, err := ledgerOf(stash)
+	if err != nil {
+		return err
+	}
+
+	era := currentEra()
+	withdrawn := big.NewInt(0)
+	unlocking := sc.Sequence[types.UnlockChunk]{}
+	for _, chunk := range ledger.Unlocking {
+		if chunk.Era > era {
+			unlocking = append(unlocking, chunk)
+			continue
+		}
+		withdrawn = new(big.Int).Add(withdrawn, chunk.Value.ToBigInt())
+	}
+
+	ledger.Unlocking = unlocking
+	ledger.Total = sc.NewU128FromBigInt(new(big.Int).Sub(ledger.Total.ToBigInt(), withdrawn))
+
+	if len(ledger.Unlocking) == 0 && ledger.Active.ToBigInt().Cmp(constants.Zero) == 0 {
+		killStash(stash)
+	} else {
+		updateLedger(ledger)
+	}
+
+	if withdrawn.Cmp(constants.Zero) > 0 {
+		system.DepositEvent(events.NewEventWithdrawn(stash.FixedSequence, sc.NewU128FromBigInt(withdrawn)))
+	}
+
+	return nil
+}
+
+// Validate declares the desire of `stash` to validate with the given preferences.
+func Validate(stash types.AccountId, prefs types.ValidatorPrefs) types.DispatchError {
+	ledger, err := ledgerOf(stash)
+	if err != nil {
+		return err
+	}
+
+	if ledger.Active.ToBigInt().Cmp(staking.MinimumBond) < 0 {
+		return newStakingError(errors.ErrorInsufficientBond)
+	}
+
+	StorageClearNominators(stash)
+	StorageSetValidators(stash, prefs)
+
+	system.DepositEvent(events.NewEventValidatorPrefsSet(stash.FixedSequence, prefs))
+
+	return nil
+}
+
+// Nominate declares the desire of `stash` to back the given validators.
+func Nominate(stash types.AccountId, targets sc.Sequence[types.AccountId]) types.DispatchError {
+	ledger, err := ledgerOf(stash)
+	if err != nil {
+		return err
+	}
+
+	if ledger.Active.ToBigInt().Cmp(staking.MinimumBond) < 0 {
+		return newStakingError(errors.ErrorInsufficientBond)
+	}
+
+	if len(targets) == 0 {
+		return newStakingError(errors.ErrorEmptyTargets)
+	}
+
+	if len(targets) > staking.MaxNominations {
+		return newStakingError(errors.ErrorTooManyTargets)
+	}
+
+	previous := sc.Sequence[types.AccountId]{}
+	nominations := StorageGetNominators(stash)
+	if nominations.HasValue {
+		previous = nominations.Value.Targets
+	}
+
+	unique := sc.Sequence[types.AccountId]{}
+	for _, target := range targets {
+		if containsAccount(unique, target) {
+			continue
+		}
+
+		prefs := StorageGetValidators(target)
+		if !prefs.HasValue {
+			return newStakingError(errors.ErrorBadTarget)
+		}
+		if bool(prefs.Value.Blocked) && !containsAccount(previous, target) {
+			return newStakingError(errors.ErrorBadTarget)
+		}
+
+		unique = append(unique, target)
+	}
+
+	StorageClearValidators(stash)
+	StorageSetNominators(stash, types.Nominations{
+		Targets:     unique,
+		SubmittedIn: currentEra(),
+		Suppressed:  false,
+	})
+
+	return nil
+}
+
+// Chill declares that `stash` no longer wishes to validate or nominate.
+func Chill(stash types.AccountId) types.DispatchError {
+	_, err := ledgerOf(stash)
+	if err != nil {
+		return err
+	}
+
+	chill(stash)
+
+	return nil
+}
+
+// SetPayee sets where the rewards of `stash` are paid.
+func SetPayee(stash types.AccountId, payee types.RewardDestination) types.DispatchError {
+	_, err := ledgerOf(stash)
+	if err != nil {
+		return err
+	}
+
+	StorageSetPayee(stash, payee)
+
+	return nil
+}
+
+// SetValidatorCount sets the ideal number of validators to elect.
+func SetValidatorCount(count sc.U32) {
+	StorageSetValidatorCount(count)
+}
+
+// ForceNewEra forces a new era to be planned at the end of the next session.
+func ForceNewEra() {
+	StorageSetForceEra(types.ForcingForceNew)
+
+	system.DepositEvent(events.NewEventForceEra(types.ForcingForceNew))
+}
+
+// PayoutStakers pays out the rewards of `validator` and its rewarded nominators for `era`.
+// The era payout is split between the validators in proportion to their reward points.
+// A validator takes its commission before the rest is split in proportion to the exposed stake.
+func PayoutStakers(validator types.AccountId, era sc.U32) types.DispatchError {
+	current := StorageGetCurrentEra()
+	if !current.HasValue || era >= current.Value || era+staking.HistoryDepth < current.Value {
+		return newStakingError(errors.ErrorInvalidEraToReward)
+	}
+
+	eraPayout := StorageGetErasValidatorReward(era)
+	if !eraPayout.HasValue {
+		return newStakingError(errors.ErrorInvalidEraToReward)
+	}
+
+	ledger, err := ledgerOf(validator)
+	if err != nil {
+		return err
+	}
+
+	claimed := sc.Sequence[sc.U32]{}
+	for _, claimedEra := range ledger.ClaimedRewards {
+		if claimedEra == era {
+			return newStakingError(errors.ErrorAlreadyClaimed)
+		}
+		if claimedEra+staking.HistoryDepth >= current.Value {
+			claimed = append(claimed, claimedEra)
+		}
+	}
+	claimed = append(claimed, era)
+	sort.Slice(claimed, func(i, j int) bool { return claimed[i] < claimed[j] })
+	ledger.ClaimedRewards = claimed
+	StorageSetLedger(validator, ledger)
+
+	exposure := StorageGetErasStakers(era, validator)
+	points := StorageGetErasRewardPoints(era)
+
+	validatorPoints := sc.U32(0)
+	for _, individual := range points.Individual {
+		if reflect.DeepEqual(individual.Validator, validator) {
+			validatorPoints = individual.Points
+		}
+	}
+	if validatorPoints == 0 || points.Total == 0 {
+		return nil
+	}
+
+	validatorPayout := new(big.Int).Mul(eraPayout.Value.ToBigInt(), big.NewInt(int64(validatorPoints)))
+	validatorPayout.Div(validatorPayout, big.NewInt(int64(points.Total)))
+
+	commission := StorageGetErasValidatorPrefs(era, validator).Commission.MulFloor(validatorPayout)
+	leftover := new(big.Int).Sub(validatorPayout, commission)
+
+	system.DepositEvent(events.NewEventPayoutStarted(era, validator.FixedSequence))
+
+	total := exposure.Total.ToBigInt()
+	if total.Cmp(constants.Zero) == 0 {
+		makePayout(validator, commission)
+		return nil
+	}
+
+	validatorStakingPayout := new(big.Int).Mul(leftover, exposure.Own.ToBigInt())
+	validatorStakingPayout.Div(validatorStakingPayout, total)
+	makePayout(validator, new(big.Int).Add(commission, validatorStakingPayout))
+
+	// Only the nominators with the highest stake are rewarded. The share of the others is not paid out.
+	for _, nominator := range rewardedNominators(exposure, staking.MaxNominatorRewardedPerValidator) {
+		payout := new(big.Int).Mul(leftover, nominator.Value.ToBigInt())
+		payout.Div(payout, total)
+		makePayout(nominator.Who, payout)
+	}
+
+	return nil
+}
+
+// RewardByIds adds reward points to validators in the active era.
+func RewardByIds(validators sc.Sequence[types.AccountId], points sc.U32) {
+	active := StorageGetActiveEra()
+	if !active.HasValue {
+		return
+	}
+
+	rewardPoints := StorageGetErasRewardPoints(active.Value.Index)
+	for _, validator := range validators {
+		rewardPoints.Total += points
+
+		found := false
+		for i, individual := range rewardPoints.Individual {
+			if reflect.DeepEqual(individual.Validator, validator) {
+				rewardPoints.Individual[i].Points += points
+				found = true
+			}
+		}
+		if !found {
+			rewardPoints.Individual = append(rewardPoints.Individual, types.ValidatorRewardPoints{Validator: validator, Points: points})
+		}
+	}
+
+	sort.Slice(rewardPoints.Individual, func(i, j int) bool {
+		return bytes.Compare(
+			sc.FixedSequenceU8ToBytes(rewardPoints.Individual[i].Validator.FixedSequence),
+			sc.FixedSequenceU8ToBytes(rewardPoints.Individual[j].Validator.FixedSequence),
+		) < 0
+	})
+
+	StorageSetErasRewardPoints(active.Value.Index, rewardPoints)
+}
+
+// AuthorshipEventHandler rewards the author of each block with era reward points.
+type AuthorshipEventHandler struct{}
+
+func (aeh AuthorshipEventHandler) NoteAuthor(author types.AccountId) {
+	RewardByIds(sc.Sequence[types.AccountId]{author}, staking.RewardPointsPerBlock)
+}
+
+// makePayout pays `amount` to the reward destination of `stash`. The paid amount is newly minted.
+func makePayout(stash types.AccountId, amount *big.Int) {
+	if amount.Cmp(constants.Zero) == 0 {
+		return
+	}
+
+	value := sc.NewU128FromBigInt(amount)
+	paid := sc.NewU128FromUint64(0)
+
+	payee := StorageGetPayee(stash)
+	switch payee.Variant {
+	case types.RewardDestinationStaked:
+		deposited, err := dispatchables.DepositIntoExisting(stash, value)
+		if err != nil {
+			return
+		}
+		paid = deposited
+
+		ledger := StorageGetLedger(stash)
+		if ledger.HasValue {
+			ledger.Value.Total = sc.NewU128FromBigInt(new(big.Int).Add(ledger.Value.Total.ToBigInt(), amount))
+			ledger.Value.Active = sc.NewU128FromBigInt(new(big.Int).Add(ledger.Value.Active.ToBigInt(), amount))
+			updateLedger(ledger.Value)
+		}
+	case types.RewardDestinationStash, types.RewardDestinationController:
+		deposited, err := dispatchables.DepositIntoExisting(stash, value)
+		if err != nil {
+			return
+		}
+		paid = deposited
+	case types.RewardDestinationAccount:
+		paid = dispatchables.DepositCreating(payee.Account, value)
+	case types.RewardDestinationNone:
+		return
+	}
+
+	if paid.ToBigInt().Cmp(constants.Zero) == 0 {
+		return
+	}
+
+	dispatchables.NewPositiveImbalance(paid).Drop()
+
+	system.DepositEvent(events.NewEventRewarded(stash.FixedSequence, paid))
+}
+
+// updateLedger stores the ledger and locks its total bond.
+func updateLedger(ledger types.StakingLedger) {
+	dispatchables.SetLock(lockId(), ledger.Stash, ledger.Total, types.ReasonsAll)
+	StorageSetLedger(ledger.Stash, ledger)
+}
+
+// killStash removes all staking information of `stash` and unlocks its funds.
+func killStash(stash types.AccountId) {
+	chill(stash)
+	StorageClearLedger(stash)
+	StorageClearPayee(stash)
+	dispatchables.RemoveLock(lockId(), stash)
+}
+
+// chill removes `stash` from the validators and nominators.
+func chill(stash types.AccountId) {
+	chilled := false
+	if StorageGetValidators(stash).HasValue {
+		StorageClearValidators(stash)
+		chilled = true
+	}
+	if StorageGetNominators(stash).HasValue {
+		StorageClearNominators(stash)
+		chilled = true
+	}
+
+	if chilled {
+		system.DepositEvent(events.NewEventChilled(stash.FixedSequence))
+	}
+}
+
+func ledgerOf(stash types.AccountId) (types.StakingLedger, types.DispatchError) {
+	ledger := StorageGetLedger(stash)
+	if !ledger.HasValue {
+		return types.StakingLedger{}, newStakingError(errors.ErrorNotStash)
+	}
+
+	return ledger.Value, nil
+}
+
+// currentEra returns the latest planned era, or 0 if no era has been planned yet.
+func currentEra() sc.U32 {
+	era := StorageGetCurrentEra()
+	if !era.HasValue {
+		return 0
+	}
+
+	return era.Value
+}
+
+func freeBalance(who types.AccountId) *big.Int {
+	return system.StorageGetAccount(who.FixedSequence).Data.Free.ToBigInt()
+}
+
+func containsAccount(accounts sc.Sequence[types.AccountId], account types.AccountId) bool {
+	for _, a := range accounts {
+		if reflect.DeepEqual(a, account) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func lockId() types.LockIdentifier {
+	return types.NewLockIdentifier(sc.BytesToSequenceU8(staking.LockId[:])...)
+}
+
+func newStakingError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   staking.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
@@ -0,0 +1,398 @@
+package staking
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetLedger returns the ledger of a bonded stash.
+func StorageGetLedger(stash types.AccountId) sc.Option[types.StakingLedger] {
+	option := storage.Get(keyLedger(stash))
+	if !option.HasValue {
+		return sc.NewOption[types.StakingLedger](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.StakingLedger](types.DecodeStakingLedger(buffer))
+}
+
+func StorageSetLedger(stash types.AccountId, ledger types.StakingLedger) {
+	storage.Set(keyLedger(stash), ledger.Bytes())
+}
+
+func StorageClearLedger(stash types.AccountId) {
+	storage.Clear(keyLedger(stash))
+}
+
+// StorageGetPayee returns where the rewards of a stash are paid.
+func StorageGetPayee(stash types.AccountId) types.RewardDestination {
+	return storage.GetDecode(keyPayee(stash), types.DecodeRewardDestination)
+}
+
+func StorageSetPayee(stash types.AccountId, payee types.RewardDestination) {
+	storage.Set(keyPayee(stash), payee.Bytes())
+}
+
+func StorageClearPayee(stash types.AccountId) {
+	storage.Clear(keyPayee(stash))
+}
+
+// StorageGetValidators returns the preferences of a stash that wishes to validate.
+func StorageGetValidators(stash types.AccountId) sc.Option[types.ValidatorPrefs] {
+	option := storage.Get(keyValidators(stash))
+	if !option.HasValue {
+		return sc.NewOption[types.ValidatorPrefs](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.ValidatorPrefs](types.DecodeValidatorPrefs(buffer))
+}
+
+func StorageSetValidators(stash types.AccountId, prefs types.ValidatorPrefs) {
+	storage.Set(keyValidators(stash), prefs.Bytes())
+}
+
+func StorageClearValidators(stash types.AccountId) {
+	storage.Clear(keyValidators(stash))
+}
+
+// StorageGetValidatorStashes returns the stashes of all accounts that wish to validate.
+func StorageGetValidatorStashes() sc.Sequence[types.AccountId] {
+	prefix := append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyValidators)...)
+
+	stashes := sc.Sequence[types.AccountId]{}
+	for _, key := range iterKeys(prefix) {
+		stashes = append(stashes, accountFromKey(key))
+	}
+
+	return stashes
+}
+
+// StorageGetNominators returns the nominations of a stash that wishes to nominate.
+func StorageGetNominators(stash types.AccountId) sc.Option[types.Nominations] {
+	option := storage.Get(keyNominators(stash))
+	if !option.HasValue {
+		return sc.NewOption[types.Nominations](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.Nominations](types.DecodeNominations(buffer))
+}
+
+func StorageSetNominators(stash types.AccountId, nominations types.Nominations) {
+	storage.Set(keyNominators(stash), nominations.Bytes())
+}
+
+func StorageClearNominators(stash types.AccountId) {
+	storage.Clear(keyNominators(stash))
+}
+
+// StorageGetNominatorStashes returns the stashes of all accounts that wish to nominate.
+func StorageGetNominatorStashes() sc.Sequence[types.AccountId] {
+	prefix := append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyNominators)...)
+
+	stashes := sc.Sequence[types.AccountId]{}
+	for _, key := range iterKeys(prefix) {
+		stashes = append(stashes, accountFromKey(key))
+	}
+
+	return stashes
+}
+
+// StorageGetValidatorCount returns the ideal number of validators to elect.
+func StorageGetValidatorCount() sc.U32 {
+	return storage.GetDecode(keyValidatorCount(), sc.DecodeU32)
+}
+
+func StorageSetValidatorCount(count sc.U32) {
+	storage.Set(keyValidatorCount(), count.Bytes())
+}
+
+// StorageGetCurrentEra returns the latest planned era. It is the active era,
+// or the one after it if its validators are already elected.
+func StorageGetCurrentEra() sc.Option[sc.U32] {
+	return storage.GetDecode(keyCurrentEra(), sc.DecodeOption[sc.U32])
+}
+
+func StorageSetCurrentEra(era sc.U32) {
+	storage.Set(keyCurrentEra(), sc.NewOption[sc.U32](era).Bytes())
+}
+
+// StorageGetActiveEra returns the era whose validators are currently validating.
+func StorageGetActiveEra() sc.Option[types.ActiveEraInfo] {
+	option := storage.Get(keyActiveEra())
+	if !option.HasValue {
+		return sc.NewOption[types.ActiveEraInfo](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.ActiveEraInfo](types.DecodeActiveEraInfo(buffer))
+}
+
+func StorageSetActiveEra(info types.ActiveEraInfo) {
+	storage.Set(keyActiveEra(), info.Bytes())
+}
+
+// StorageGetCurrentPlannedSession returns the session for which the validators were last elected.
+func StorageGetCurrentPlannedSession() sc.U32 {
+	return storage.GetDecode(keyCurrentPlannedSession(), sc.DecodeU32)
+}
+
+func StorageSetCurrentPlannedSession(session sc.U32) {
+	storage.Set(keyCurrentPlannedSession(), session.Bytes())
+}
+
+// StorageGetErasStartSessionIndex returns the session at which an era starts.
+func StorageGetErasStartSessionIndex(era sc.U32) sc.Option[sc.U32] {
+	option := storage.Get(keyErasStartSessionIndex(era))
+	if !option.HasValue {
+		return sc.NewOption[sc.U32](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[sc.U32](sc.DecodeU32(buffer))
+}
+
+func StorageSetErasStartSessionIndex(era sc.U32, session sc.U32) {
+	storage.Set(keyErasStartSessionIndex(era), session.Bytes())
+}
+
+func StorageClearErasStartSessionIndex(era sc.U32) {
+	storage.Clear(keyErasStartSessionIndex(era))
+}
+
+// StorageGetErasStakers returns the exposure of a validator in an era.
+// An empty exposure is returned if the validator was not elected in the era.
+func StorageGetErasStakers(era sc.U32, validator types.AccountId) types.Exposure {
+	return storage.GetDecode(keyErasStakers(era, validator), types.DecodeExposure)
+}
+
+func StorageSetErasStakers(era sc.U32, validator types.AccountId, exposure types.Exposure) {
+	storage.Set(keyErasStakers(era, validator), exposure.Bytes())
+}
+
+// StorageGetErasValidatorPrefs returns the preferences of a validator in an era.
+func StorageGetErasValidatorPrefs(era sc.U32, validator types.AccountId) types.ValidatorPrefs {
+	return storage.GetDecode(keyErasValidatorPrefs(era, validator), types.DecodeValidatorPrefs)
+}
+
+func StorageSetErasValidatorPrefs(era sc.U32, validator types.AccountId, prefs types.ValidatorPrefs) {
+	storage.Set(keyErasValidatorPrefs(era, validator), prefs.Bytes())
+}
+
+// StorageGetErasValidatorReward returns the total payout of the validators in an era.
+// It is set at the end of the era.
+func StorageGetErasValidatorReward(era sc.U32) sc.Option[types.Balance] {
+	option := storage.Get(keyErasValidatorReward(era))
+	if !option.HasValue {
+		return sc.NewOption[types.Balance](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.Balance](sc.DecodeU128(buffer))
+}
+
+func StorageSetErasValidatorReward(era sc.U32, reward types.Balance) {
+	storage.Set(keyErasValidatorReward(era), reward.Bytes())
+}
+
+// StorageGetErasRewardPoints returns the reward points earned by the validators in an era.
+func StorageGetErasRewardPoints(era sc.U32) types.EraRewardPoints {
+	return storage.GetDecode(keyErasRewardPoints(era), types.DecodeEraRewardPoints)
+}
+
+func StorageSetErasRewardPoints(era sc.U32, points types.EraRewardPoints) {
+	storage.Set(keyErasRewardPoints(era), points.Bytes())
+}
+
+// StorageGetErasTotalStake returns the total stake backing the validators in an era.
+func StorageGetErasTotalStake(era sc.U32) types.Balance {
+	return storage.GetDecode(keyErasTotalStake(era), sc.DecodeU128)
+}
+
+func StorageSetErasTotalStake(era sc.U32, total types.Balance) {
+	storage.Set(keyErasTotalStake(era), total.Bytes())
+}
+
+// StorageClearEraInformation removes the exposures, preferences and rewards of an era.
+func StorageClearEraInformation(era sc.U32) {
+	limit := sc.NewOption[sc.U32](nil).Bytes()
+	storage.ClearPrefix(keyErasStakersPrefix(era), limit)
+	storage.ClearPrefix(keyErasValidatorPrefsPrefix(era), limit)
+	storage.Clear(keyErasValidatorReward(era))
+	storage.Clear(keyErasRewardPoints(era))
+	storage.Clear(keyErasTotalStake(era))
+	storage.Clear(keyErasStartSessionIndex(era))
+}
+
+// StorageGetForceEra returns the mode of era forcing.
+func StorageGetForceEra() types.Forcing {
+	return storage.GetDecode(keyForceEra(), sc.DecodeU8)
+}
+
+func StorageSetForceEra(mode types.Forcing) {
+	storage.Set(keyForceEra(), mode.Bytes())
+}
+
+// StorageGetUnappliedSlashes returns the slashes to be applied at the start of an era.
+func StorageGetUnappliedSlashes(era sc.U32) sc.Sequence[types.UnappliedSlash] {
+	return storage.GetDecode(keyUnappliedSlashes(era), func(buffer *bytes.Buffer) sc.Sequence[types.UnappliedSlash] {
+		return sc.DecodeSequenceWith(buffer, types.DecodeUnappliedSlash)
+	})
+}
+
+func StorageSetUnappliedSlashes(era sc.U32, slashes sc.Sequence[types.UnappliedSlash]) {
+	storage.Set(keyUnappliedSlashes(era), slashes.Bytes())
+}
+
+func StorageClearUnappliedSlashes(era sc.U32) {
+	storage.Clear(keyUnappliedSlashes(era))
+}
+
+// StorageGetBondedEras returns the eras within the bonding duration and the sessions they started at.
+// Offences committed in earlier eras can no longer be slashed.
+func StorageGetBondedEras() sc.Sequence[types.BondedEra] {
+	return storage.GetDecode(keyBondedEras(), func(buffer *bytes.Buffer) sc.Sequence[types.BondedEra] {
+		return sc.DecodeSequenceWith(buffer, types.DecodeBondedEra)
+	})
+}
+
+func StorageSetBondedEras(eras sc.Sequence[types.BondedEra]) {
+	storage.Set(keyBondedEras(), eras.Bytes())
+}
+
+// iterKeys returns all storage keys that start with `prefix`.
+func iterKeys(prefix []byte) [][]byte {
+	keys := [][]byte{}
+
+	current := prefix
+	for {
+		next := storage.NextKey(current)
+		if !next.HasValue {
+			break
+		}
+
+		key := sc.SequenceU8ToBytes(next.Value)
+		if !bytes.HasPrefix(key, prefix) {
+			break
+		}
+
+		keys = append(keys, key)
+		current = key
+	}
+
+	return keys
+}
+
+// accountFromKey returns the account at the end of a map key.
+func accountFromKey(key []byte) types.AccountId {
+	return types.NewAccountId(sc.BytesToSequenceU8(key[len(key)-types.AccountIdLength:])...)
+}
+
+// blake2128Concat returns the key of `value` hashed with the blake2 128 concat hasher.
+func blake2128Concat(value []byte) []byte {
+	return append(hashing.Blake128(value), value...)
+}
+
+// twox64Concat returns the key of `value` hashed with the twox 64 concat hasher.
+func twox64Concat(value []byte) []byte {
+	return append(hashing.Twox64(value), value...)
+}
+
+func keyLedger(stash types.AccountId) []byte {
+	key := append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyLedger)...)
+	return append(key, blake2128Concat(sc.FixedSequenceU8ToBytes(stash.FixedSequence))...)
+}
+
+func keyPayee(stash types.AccountId) []byte {
+	key := append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyPayee)...)
+	return append(key, twox64Concat(sc.FixedSequenceU8ToBytes(stash.FixedSequence))...)
+}
+
+func keyValidators(stash types.AccountId) []byte {
+	key := append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyValidators)...)
+	return append(key, twox64Concat(sc.FixedSequenceU8ToBytes(stash.FixedSequence))...)
+}
+
+func keyNominators(stash types.AccountId) []byte {
+	key := append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyNominators)...)
+	return append(key, twox64Concat(sc.FixedSequenceU8ToBytes(stash.FixedSequence))...)
+}
+
+func keyValidatorCount() []byte {
+	return append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyValidatorCount)...)
+}
+
+func keyCurrentEra() []byte {
+	return append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyCurrentEra)...)
+}
+
+func keyActiveEra() []byte {
+	return append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyActiveEra)...)
+}
+
+func keyCurrentPlannedSession() []byte {
+	return append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyCurrentPlannedSession)...)
+}
+
+func keyErasStartSessionIndex(era sc.U32) []byte {
+	key := append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyErasStartSessionIndex)...)
+	return append(key, twox64Concat(era.Bytes())...)
+}
+
+func keyErasStakersPrefix(era sc.U32) []byte {
+	key := append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyErasStakers)...)
+	return append(key, twox64Concat(era.Bytes())...)
+}
+
+func keyErasStakers(era sc.U32, validator types.AccountId) []byte {
+	return append(keyErasStakersPrefix(era), twox64Concat(sc.FixedSequenceU8ToBytes(validator.FixedSequence))...)
+}
+
+func keyErasValidatorPrefsPrefix(era sc.U32) []byte {
+	key := append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyErasValidatorPrefs)...)
+	return append(key, twox64Concat(era.Bytes())...)
+}
+
+func keyErasValidatorPrefs(era sc.U32, validator types.AccountId) []byte {
+	return append(keyErasValidatorPrefsPrefix(era), twox64Concat(sc.FixedSequenceU8ToBytes(validator.FixedSequence))...)
+}
+
+func keyErasValidatorReward(era sc.U32) []byte {
+	key := append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyErasValidatorReward)...)
+	return append(key, twox64Concat(era.Bytes())...)
+}
+
+func keyErasRewardPoints(era sc.U32) []byte {
+	key := append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyErasRewardPoints)...)
+	return append(key, twox64Concat(era.Bytes())...)
+}
+
+func keyErasTotalStake(era sc.U32) []byte {
+	key := append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyErasTotalStake)...)
+	return append(key, twox64Concat(era.Bytes())...)
+}
+
+func keyForceEra() []byte {
+	return append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyForceEra)...)
+}
+
+func keyUnappliedSlashes(era sc.U32) []byte {
+	key := append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyUnappliedSlashes)...)
+	return append(key, twox64Concat(era.Bytes())...)
+}
+
+func keyBondedEras() []byte {
+	return append(hashing.Twox128(constants.KeyStaking), hashing.Twox128(constants.KeyBondedEras)...)
+}
@@ -0,0 +1,63 @@
+package staking
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/aura"
+	"github.com/LimeChain/gosemble/constants/staking"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// exposureMaxSize is the encoded length of a types.Exposure of MaxNominatorRewardedPerValidator
+// nominators. Exposures hold all the nominators of a validator, so their length is not bounded;
+// the size covers the nominators that are rewarded.
+const exposureMaxSize = 16 + 16 + 2 + staking.MaxNominatorRewardedPerValidator*48
+
+// unappliedSlashMaxSize is the encoded length of a types.UnappliedSlash of the nominators covered by
+// exposureMaxSize, without reporters, which only the offences reported by accounts have.
+const unappliedSlashMaxSize = 32 + 16 + 2 + staking.MaxNominatorRewardedPerValidator*48 + 1 + 16
+
+var (
+	// StorageInfoLedger describes the `Ledger` map, whose entries are Blake2_128Concat(AccountId) ++
+	// StakingLedger, with at most MaxUnlockingChunks chunks and HistoryDepth claimed eras.
+	StorageInfoLedger = types.NewStorageMapInfo("Staking", "Ledger", sc.NewOption[sc.U32](nil), 48+32+16+16+1+staking.MaxUnlockingChunks*20+2+staking.HistoryDepth*4)
+	// StorageInfoPayee describes the `Payee` map, whose entries are Twox64Concat(AccountId) ++ RewardDestination.
+	StorageInfoPayee = types.NewStorageMapInfo("Staking", "Payee", sc.NewOption[sc.U32](nil), 40+33)
+	// StorageInfoValidators describes the `Validators` map, whose entries are Twox64Concat(AccountId) ++ ValidatorPrefs.
+	StorageInfoValidators = types.NewStorageMapInfo("Staking", "Validators", sc.NewOption[sc.U32](nil), 40+5)
+	// StorageInfoNominators describes the `Nominators` map, whose entries are Twox64Concat(AccountId) ++
+	// Nominations, with at most MaxNominations targets.
+	StorageInfoNominators = types.NewStorageMapInfo("Staking", "Nominators", sc.NewOption[sc.U32](nil), 40+1+staking.MaxNominations*32+4+1)
+	// StorageInfoValidatorCount describes the `ValidatorCount` value.
+	StorageInfoValidatorCount = types.NewStorageValueInfo("Staking", "ValidatorCount", 4)
+	// StorageInfoCurrentEra describes the `CurrentEra` value.
+	StorageInfoCurrentEra = types.NewStorageValueInfo("Staking", "CurrentEra", 5)
+	// StorageInfoActiveEra describes the `ActiveEra` value.
+	StorageInfoActiveEra = types.NewStorageValueInfo("Staking", "ActiveEra", 13)
+	// StorageInfoCurrentPlannedSession describes the `CurrentPlannedSession` value.
+	StorageInfoCurrentPlannedSession = types.NewStorageValueInfo("Staking", "CurrentPlannedSession", 4)
+	// StorageInfoErasStartSessionIndex describes the `ErasStartSessionIndex` map, whose entries are
+	// Twox64Concat(EraIndex) ++ SessionIndex.
+	StorageInfoErasStartSessionIndex = types.NewStorageMapInfo("Staking", "ErasStartSessionIndex", sc.NewOption[sc.U32](nil), 12+4)
+	// StorageInfoErasStakers describes the `ErasStakers` map, whose entries are Twox64Concat(EraIndex) ++
+	// Twox64Concat(AccountId) ++ Exposure. It is not part of the storage info of the module, see exposureMaxSize.
+	StorageInfoErasStakers = types.NewStorageMapInfo("Staking", "ErasStakers", sc.NewOption[sc.U32](nil), 12+40+exposureMaxSize)
+	// StorageInfoErasValidatorPrefs describes the `ErasValidatorPrefs` map, whose entries are
+	// Twox64Concat(EraIndex) ++ Twox64Concat(AccountId) ++ ValidatorPrefs.
+	StorageInfoErasValidatorPrefs = types.NewStorageMapInfo("Staking", "ErasValidatorPrefs", sc.NewOption[sc.U32](nil), 12+40+5)
+	// StorageInfoErasValidatorReward describes the `ErasValidatorReward` map, whose entries are
+	// Twox64Concat(EraIndex) ++ Balance.
+	StorageInfoErasValidatorReward = types.NewStorageMapInfo("Staking", "ErasValidatorReward", sc.NewOption[sc.U32](nil), 12+16)
+	// StorageInfoErasRewardPoints describes the `ErasRewardPoints` map, whose entries are Twox64Concat(EraIndex) ++
+	// EraRewardPoints. Only block authors earn points, so there are at most MaxAuthorities individual points.
+	StorageInfoErasRewardPoints = types.NewStorageMapInfo("Staking", "ErasRewardPoints", sc.NewOption[sc.U32](nil), 12+4+2+aura.MaxAuthorities*36)
+	// StorageInfoErasTotalStake describes the `ErasTotalStake` map, whose entries are Twox64Concat(EraIndex) ++ Balance.
+	StorageInfoErasTotalStake = types.NewStorageMapInfo("Staking", "ErasTotalStake", sc.NewOption[sc.U32](nil), 12+16)
+	// StorageInfoForceEra describes the `ForceEra` value.
+	StorageInfoForceEra = types.NewStorageValueInfo("Staking", "ForceEra", 1)
+	// StorageInfoUnappliedSlashes describes the `UnappliedSlashes` map, whose entries are Twox64Concat(EraIndex) ++
+	// Vec<UnappliedSlash>, with a slash of each of at most MaxAuthorities validators. It is not part of
+	// the storage info of the module, see unappliedSlashMaxSize.
+	StorageInfoUnappliedSlashes = types.NewStorageMapInfo("Staking", "UnappliedSlashes", sc.NewOption[sc.U32](nil), 12+2+aura.MaxAuthorities*unappliedSlashMaxSize)
+	// StorageInfoBondedEras describes the `BondedEras` value, a BoundedVec<(EraIndex, SessionIndex), BondingDuration + 1>.
+	StorageInfoBondedEras = types.NewStorageValueInfo("Staking", "BondedEras", 1+(staking.BondingDuration+1)*8)
+)
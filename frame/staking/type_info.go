@@ -0,0 +1,91 @@
+package staking
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// RewardDestinationTypeInfo is the type info of types.RewardDestination.
+	RewardDestinationTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_staking", "RewardDestination"},
+		"A destination account for payment.",
+		types.NewVariantTypeInfo("Staked", types.RewardDestinationStaked, "RewardDestination.Staked"),
+		types.NewVariantTypeInfo("Stash", types.RewardDestinationStash, "RewardDestination.Stash"),
+		types.NewVariantTypeInfo("Controller", types.RewardDestinationController, "RewardDestination.Controller"),
+		types.NewVariantTypeInfo("Account", types.RewardDestinationAccount, "RewardDestination.Account",
+			types.NewFieldTypeInfo("", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		),
+		types.NewVariantTypeInfo("None", types.RewardDestinationNone, "RewardDestination.None"),
+	)
+	// ValidatorPrefsTypeInfo is the type info of types.ValidatorPrefs.
+	ValidatorPrefsTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_staking", "ValidatorPrefs"},
+		"The preferences of a validator.",
+		types.NewFieldTypeInfo("commission", "Perbill", types.NewCompactTypeInfo(types.TypeInfoPermill)),
+		types.NewFieldTypeInfo("blocked", "bool", types.TypeInfoBool),
+	)
+	// UnlockChunkTypeInfo is the type info of types.UnlockChunk.
+	UnlockChunkTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_staking", "UnlockChunk"},
+		"Funds that are unbonded and become withdrawable at the start of `Era`.",
+		types.NewFieldTypeInfo("value", "Balance", types.NewCompactTypeInfo(types.TypeInfoU128)),
+		types.NewFieldTypeInfo("era", "EraIndex", types.NewCompactTypeInfo(types.TypeInfoU32)),
+	)
+	// StakingLedgerTypeInfo is the type info of types.StakingLedger.
+	StakingLedgerTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_staking", "StakingLedger"},
+		"The ledger of a bonded stash.",
+		types.NewFieldTypeInfo("stash", "T::AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("total", "BalanceOf<T>", types.NewCompactTypeInfo(types.TypeInfoU128)),
+		types.NewFieldTypeInfo("active", "BalanceOf<T>", types.NewCompactTypeInfo(types.TypeInfoU128)),
+		types.NewFieldTypeInfo("unlocking", "BoundedVec<UnlockChunk<BalanceOf<T>>, T::MaxUnlockingChunks>", types.NewSequenceTypeInfo(UnlockChunkTypeInfo)),
+		types.NewFieldTypeInfo("claimed_rewards", "BoundedVec<EraIndex, T::HistoryDepth>", types.NewSequenceTypeInfo(types.TypeInfoU32)),
+	)
+	// NominationsTypeInfo is the type info of types.Nominations.
+	NominationsTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_staking", "Nominations"},
+		"The validators a nominator backs.",
+		types.NewFieldTypeInfo("targets", "Nominations<T>", types.TypeId(metadata.TypesSequenceAddress32)),
+		types.NewFieldTypeInfo("submitted_in", "EraIndex", types.TypeInfoU32),
+		types.NewFieldTypeInfo("suppressed", "bool", types.TypeInfoBool),
+	)
+	// ActiveEraInfoTypeInfo is the type info of types.ActiveEraInfo.
+	ActiveEraInfoTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_staking", "ActiveEraInfo"},
+		"The index of the active era and the timestamp at which it started.",
+		types.NewFieldTypeInfo("index", "EraIndex", types.TypeInfoU32),
+		types.NewFieldTypeInfo("start", "Option<u64>", types.NewOptionTypeInfo(types.TypeInfoU64)),
+	)
+	// IndividualExposureTypeInfo is the type info of types.IndividualExposure.
+	IndividualExposureTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"sp_staking", "IndividualExposure"},
+		"The stake of a nominator exposed to a validator.",
+		types.NewFieldTypeInfo("who", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("value", "Balance", types.NewCompactTypeInfo(types.TypeInfoU128)),
+	)
+	// ExposureTypeInfo is the type info of types.Exposure.
+	ExposureTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"sp_staking", "Exposure"},
+		"The stake backing an elected validator.",
+		types.NewFieldTypeInfo("total", "Balance", types.NewCompactTypeInfo(types.TypeInfoU128)),
+		types.NewFieldTypeInfo("own", "Balance", types.NewCompactTypeInfo(types.TypeInfoU128)),
+		types.NewFieldTypeInfo("others", "Vec<IndividualExposure<AccountId, Balance>>", types.NewSequenceTypeInfo(IndividualExposureTypeInfo)),
+	)
+	// EraRewardPointsTypeInfo is the type info of types.EraRewardPoints.
+	EraRewardPointsTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_staking", "EraRewardPoints"},
+		"The reward points of an era.",
+		types.NewFieldTypeInfo("total", "RewardPoint", types.TypeInfoU32),
+		types.NewFieldTypeInfo("individual", "BTreeMap<AccountId, RewardPoint>", types.NewSequenceTypeInfo(types.NewTupleTypeInfo(types.TypeId(metadata.TypesAddress32), types.TypeInfoU32))),
+	)
+	// ForcingTypeInfo is the type info of types.Forcing.
+	ForcingTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_staking", "Forcing"},
+		"The mode of era forcing.",
+		types.NewVariantTypeInfo("NotForcing", types.ForcingNotForcing, "Forcing.NotForcing"),
+		types.NewVariantTypeInfo("ForceNew", types.ForcingForceNew, "Forcing.ForceNew"),
+		types.NewVariantTypeInfo("ForceNone", types.ForcingForceNone, "Forcing.ForceNone"),
+		types.NewVariantTypeInfo("ForceAlways", types.ForcingForceAlways, "Forcing.ForceAlways"),
+	)
+	// UnappliedSlashTypeInfo is the type info of types.UnappliedSlash.
+	UnappliedSlashTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_staking", "UnappliedSlash"},
+		"A slash of a validator and its nominators, waiting to be applied.",
+		types.NewFieldTypeInfo("validator", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("own", "Balance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("others", "Vec<(AccountId, Balance)>", types.NewSequenceTypeInfo(types.NewTupleTypeInfo(types.TypeId(metadata.TypesAddress32), types.TypeInfoU128))),
+		types.NewFieldTypeInfo("reporters", "Vec<AccountId>", types.TypeId(metadata.TypesSequenceAddress32)),
+		types.NewFieldTypeInfo("payout", "Balance", types.TypeInfoU128),
+	)
+)
@@ -0,0 +1,159 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/state_trie_migration"
+	pallet "github.com/LimeChain/gosemble/frame/state_trie_migration"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ContinueMigrateCall struct {
+	types.Callable
+}
+
+func NewContinueMigrateCall(args sc.VaryingData) ContinueMigrateCall {
+	call := ContinueMigrateCall{
+		Callable: types.Callable{
+			ModuleId:   state_trie_migration.ModuleIndex,
+			FunctionId: state_trie_migration.FunctionContinueMigrateIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ContinueMigrateCall) DecodeArgs(buffer *bytes.Buffer) types.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMigrationLimits(buffer),
+		sc.DecodeU32(buffer),
+		types.DecodeMigrationTask(buffer),
+	)
+	return c
+}
+
+func (c ContinueMigrateCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ContinueMigrateCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ContinueMigrateCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ContinueMigrateCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c ContinueMigrateCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ContinueMigrateCall) CallInfo() types.CallInfo {
+	return types.CallInfo{
+		Name: "continue_migrate",
+		Args: []types.FieldTypeInfo{
+			types.NewFieldTypeInfo("limits", "MigrationLimits", pallet.MigrationLimitsTypeInfo),
+			types.NewFieldTypeInfo("real_size_upper", "u32", types.TypeInfoU32),
+			types.NewFieldTypeInfo("witness_task", "MigrationTask<T>", pallet.MigrationTaskTypeInfo),
+		},
+		Docs: "Continue the migration for the given `limits`. The dispatch origin of this call can be any signed account. This transaction has NO MONETARY INCENTIVES. Calling it will not reward anyone. Albeit, upon successful execution, the transaction fee is returned.",
+	}
+}
+
+// The migration of `limits.item` keys whose values have a total size of `real_size_upper` is
+// added to the weight.
+func (_ ContinueMigrateCall) BaseWeight(args ...any) types.Weight {
+	// Storage: StateTrieMigration SignedMigrationMaxLimits (r:1 w:0)
+	// Storage: StateTrieMigration MigrationProcess (r:1 w:1)
+	// Storage: System Account (r:1 w:1)
+	// Proof Size summary in bytes:
+	//  Measured:  `211`
+	//  Estimated: `3593`
+	// Minimum execution time: 37_530 nanoseconds.
+	migration := types.WeightZero()
+	if len(args) != 0 {
+		if callArgs, ok := args[0].(sc.VaryingData); ok && len(callArgs) > 1 {
+			limits := callArgs[0].(types.MigrationLimits)
+			migration = pallet.MigrationWeight(limits.Item, callArgs[1].(sc.U32))
+		}
+	}
+
+	r := constants.DbWeight.Reads(3)
+	w := constants.DbWeight.Writes(2)
+	return types.WeightFromParts(38_179_000, 3593).
+		SaturatingAdd(migration).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ContinueMigrateCall) IsInherent() bool {
+	return false
+}
+
+func (_ ContinueMigrateCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ContinueMigrateCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ContinueMigrateCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ContinueMigrateCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return continueMigrate(origin, args[0].(types.MigrationLimits), args[1].(sc.U32), args[2].(types.MigrationTask))
+}
+
+// continueMigrate migrates keys of the state trie within `limits`, after the progress of
+// `witnessTask`, which must be the current state of the migration. The caller reserves a deposit,
+// which is slashed if the values migrated are larger than `realSizeUpper`. Honest callers pay no
+// fee, and are charged only for the keys migrated.
+func continueMigrate(origin types.RuntimeOrigin, limits types.MigrationLimits, realSizeUpper sc.U32, witnessTask types.MigrationTask) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	if !origin.IsSignedOrigin() {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: types.NewDispatchErrorBadOrigin(),
+			},
+		}
+	}
+
+	weight, err := pallet.ContinueMigrate(origin.AsSigned(), limits, realSizeUpper, witnessTask)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	if !weight.HasValue {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: false,
+			Ok:       types.PostDispatchInfo{},
+		}
+	}
+
+	actualWeight := ContinueMigrateCall{}.BaseWeight().SaturatingAdd(weight.Value)
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok: types.PostDispatchInfo{
+			ActualWeight: sc.NewOption[types.Weight](actualWeight),
+			PaysFee:      types.PaysNo,
+		},
+	}
+}
@@ -0,0 +1,122 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/state_trie_migration"
+	pallet "github.com/LimeChain/gosemble/frame/state_trie_migration"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ControlAutoMigrationCall struct {
+	types.Callable
+}
+
+func NewControlAutoMigrationCall(args sc.VaryingData) ControlAutoMigrationCall {
+	call := ControlAutoMigrationCall{
+		Callable: types.Callable{
+			ModuleId:   state_trie_migration.ModuleIndex,
+			FunctionId: state_trie_migration.FunctionControlAutoMigrationIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ControlAutoMigrationCall) DecodeArgs(buffer *bytes.Buffer) types.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeOptionWith(buffer, types.DecodeMigrationLimits),
+	)
+	return c
+}
+
+func (c ControlAutoMigrationCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ControlAutoMigrationCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ControlAutoMigrationCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ControlAutoMigrationCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c ControlAutoMigrationCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ControlAutoMigrationCall) CallInfo() types.CallInfo {
+	return types.CallInfo{
+		Name: "control_auto_migration",
+		Args: []types.FieldTypeInfo{
+			types.NewFieldTypeInfo("maybe_config", "Option<MigrationLimits>", types.NewOptionTypeInfo(pallet.MigrationLimitsTypeInfo)),
+		},
+		Docs: "Control the automatic migration. The dispatch origin of this call must be the control origin.",
+	}
+}
+
+func (_ ControlAutoMigrationCall) BaseWeight(b ...any) types.Weight {
+	// Storage: StateTrieMigration AutoLimits (r:0 w:1)
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `0`
+	// Minimum execution time: 6_910 nanoseconds.
+	w := constants.DbWeight.Writes(1)
+	return types.WeightFromParts(7_177_000, 0).SaturatingAdd(w)
+}
+
+func (_ ControlAutoMigrationCall) IsInherent() bool {
+	return false
+}
+
+func (_ ControlAutoMigrationCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ControlAutoMigrationCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ControlAutoMigrationCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ControlAutoMigrationCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := controlAutoMigration(origin, args[0].(sc.Option[types.MigrationLimits]))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// controlAutoMigration enables the automatic migration with `limits` per block, or disables it if
+// `limits` is `None`.
+func controlAutoMigration(origin types.RuntimeOrigin, limits sc.Option[types.MigrationLimits]) types.DispatchError {
+	if err := pallet.ControlOrigin.EnsureOrigin(origin); err != nil {
+		return err
+	}
+
+	pallet.ControlAutoMigration(limits)
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/state_trie_migration"
+	pallet "github.com/LimeChain/gosemble/frame/state_trie_migration"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ForceSetProgressCall struct {
+	types.Callable
+}
+
+func NewForceSetProgressCall(args sc.VaryingData) ForceSetProgressCall {
+	call := ForceSetProgressCall{
+		Callable: types.Callable{
+			ModuleId:   state_trie_migration.ModuleIndex,
+			FunctionId: state_trie_migration.FunctionForceSetProgressIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ForceSetProgressCall) DecodeArgs(buffer *bytes.Buffer) types.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMigrationProgress(buffer),
+		types.DecodeMigrationProgress(buffer),
+	)
+	return c
+}
+
+func (c ForceSetProgressCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ForceSetProgressCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ForceSetProgressCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ForceSetProgressCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c ForceSetProgressCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ForceSetProgressCall) CallInfo() types.CallInfo {
+	return types.CallInfo{
+		Name: "force_set_progress",
+		Args: []types.FieldTypeInfo{
+			types.NewFieldTypeInfo("progress_top", "ProgressOf<T>", pallet.ProgressTypeInfo),
+			types.NewFieldTypeInfo("progress_child", "ProgressOf<T>", pallet.ProgressTypeInfo),
+		},
+		Docs: "Forcefully set the progress the running migration. This is only useful in one case: the next key to migrate is too big to be migrated with a signed account, in a parachain context, and we simply want to skip it.",
+	}
+}
+
+func (_ ForceSetProgressCall) BaseWeight(b ...any) types.Weight {
+	// Storage: StateTrieMigration MigrationProcess (r:1 w:1)
+	// Proof Size summary in bytes:
+	//  Measured:  `76`
+	//  Estimated: `2527`
+	// Minimum execution time: 8_260 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	return types.WeightFromParts(8_552_000, 2527).SaturatingAdd(r).SaturatingAdd(w)
+}
+
+func (_ ForceSetProgressCall) IsInherent() bool {
+	return false
+}
+
+func (_ ForceSetProgressCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ForceSetProgressCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ForceSetProgressCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ForceSetProgressCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := forceSetProgress(origin, args[0].(types.MigrationProgress), args[1].(types.MigrationProgress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// forceSetProgress sets the progress of the migration, so that keys which cannot be migrated,
+// like keys longer than MaxKeyLen, are skipped.
+func forceSetProgress(origin types.RuntimeOrigin, progressTop types.MigrationProgress, progressChild types.MigrationProgress) types.DispatchError {
+	if err := pallet.ControlOrigin.EnsureOrigin(origin); err != nil {
+		return err
+	}
+
+	pallet.ForceSetProgress(progressTop, progressChild)
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/state_trie_migration"
+	pallet "github.com/LimeChain/gosemble/frame/state_trie_migration"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+type MigrateCustomChildCall struct {
+	types.Callable
+}
+
+func NewMigrateCustomChildCall(args sc.VaryingData) MigrateCustomChildCall {
+	call := MigrateCustomChildCall{
+		Callable: types.Callable{
+			ModuleId:   state_trie_migration.ModuleIndex,
+			FunctionId: state_trie_migration.FunctionMigrateCustomChildIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c MigrateCustomChildCall) DecodeArgs(buffer *bytes.Buffer) types.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeSequence[sc.U8](buffer),
+		sc.DecodeSequenceWith(buffer, sc.DecodeSequence[sc.U8]),
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c MigrateCustomChildCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c MigrateCustomChildCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c MigrateCustomChildCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c MigrateCustomChildCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c MigrateCustomChildCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ MigrateCustomChildCall) CallInfo() types.CallInfo {
+	return types.CallInfo{
+		Name: "migrate_custom_child",
+		Args: []types.FieldTypeInfo{
+			types.NewFieldTypeInfo("root", "Vec<u8>", types.NewSequenceTypeInfo(types.TypeInfoU8)),
+			types.NewFieldTypeInfo("child_keys", "Vec<Vec<u8>>", types.NewSequenceTypeInfo(types.NewSequenceTypeInfo(types.TypeInfoU8))),
+			types.NewFieldTypeInfo("total_size", "u32", types.TypeInfoU32),
+		},
+		Docs: "Migrate the list of child keys by iterating each of them one by one. All of the given child keys must be present under one `child_root`. This does not affect the global migration process tracker ([`MigrationProcess`]), and should only be used in case any keys are leftover due to a bug.",
+	}
+}
+
+// The migration of the keys whose values have a total size of `total_size` is added to the weight.
+func (_ MigrateCustomChildCall) BaseWeight(args ...any) types.Weight {
+	// Storage: StateTrieMigration SignedMigrationMaxLimits (r:1 w:0)
+	// Storage: System Account (r:1 w:1)
+	// Proof Size summary in bytes:
+	//  Measured:  `106`
+	//  Estimated: `3593`
+	// Minimum execution time: 33_870 nanoseconds.
+	migration := types.WeightZero()
+	if len(args) != 0 {
+		if callArgs, ok := args[0].(sc.VaryingData); ok && len(callArgs) > 2 {
+			keys := callArgs[1].(sc.Sequence[sc.Sequence[sc.U8]])
+			migration = pallet.MigrationWeight(sc.U32(len(keys)), callArgs[2].(sc.U32))
+		}
+	}
+
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	return types.WeightFromParts(34_490_000, 3593).
+		SaturatingAdd(migration).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ MigrateCustomChildCall) IsInherent() bool {
+	return false
+}
+
+func (_ MigrateCustomChildCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ MigrateCustomChildCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ MigrateCustomChildCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ MigrateCustomChildCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return migrateCustomChild(origin, args[0].(sc.Sequence[sc.U8]), args[1].(sc.Sequence[sc.Sequence[sc.U8]]), args[2].(sc.U32))
+}
+
+// migrateCustomChild migrates `keys` of the child trie whose root is at the key `root` of the top
+// trie, regardless of the progress of the migration. The caller reserves a deposit, which is
+// slashed if the values migrated are not of `totalSize`. Honest callers pay no fee.
+func migrateCustomChild(origin types.RuntimeOrigin, root sc.Sequence[sc.U8], keys sc.Sequence[sc.Sequence[sc.U8]], totalSize sc.U32) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	if !origin.IsSignedOrigin() {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: types.NewDispatchErrorBadOrigin(),
+			},
+		}
+	}
+
+	migrated, err := pallet.MigrateCustomChild(origin.AsSigned(), root, keys, totalSize)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	if !migrated {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: false,
+			Ok:       types.PostDispatchInfo{},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok: types.PostDispatchInfo{
+			PaysFee: types.PaysNo,
+		},
+	}
+}
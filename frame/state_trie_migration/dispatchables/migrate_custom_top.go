@@ -0,0 +1,151 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/state_trie_migration"
+	pallet "github.com/LimeChain/gosemble/frame/state_trie_migration"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+type MigrateCustomTopCall struct {
+	types.Callable
+}
+
+func NewMigrateCustomTopCall(args sc.VaryingData) MigrateCustomTopCall {
+	call := MigrateCustomTopCall{
+		Callable: types.Callable{
+			ModuleId:   state_trie_migration.ModuleIndex,
+			FunctionId: state_trie_migration.FunctionMigrateCustomTopIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c MigrateCustomTopCall) DecodeArgs(buffer *bytes.Buffer) types.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeSequenceWith(buffer, sc.DecodeSequence[sc.U8]),
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c MigrateCustomTopCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c MigrateCustomTopCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c MigrateCustomTopCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c MigrateCustomTopCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c MigrateCustomTopCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ MigrateCustomTopCall) CallInfo() types.CallInfo {
+	return types.CallInfo{
+		Name: "migrate_custom_top",
+		Args: []types.FieldTypeInfo{
+			types.NewFieldTypeInfo("keys", "Vec<Vec<u8>>", types.NewSequenceTypeInfo(types.NewSequenceTypeInfo(types.TypeInfoU8))),
+			types.NewFieldTypeInfo("witness_size", "u32", types.TypeInfoU32),
+		},
+		Docs: "Migrate the list of top keys by iterating each of them one by one. This does not affect the global migration process tracker ([`MigrationProcess`]), and should only be used in case any keys are leftover due to a bug.",
+	}
+}
+
+// The migration of the keys whose values have a total size of `witness_size` is added to the weight.
+func (_ MigrateCustomTopCall) BaseWeight(args ...any) types.Weight {
+	// Storage: StateTrieMigration SignedMigrationMaxLimits (r:1 w:0)
+	// Storage: System Account (r:1 w:1)
+	// Proof Size summary in bytes:
+	//  Measured:  `106`
+	//  Estimated: `3593`
+	// Minimum execution time: 33_410 nanoseconds.
+	migration := types.WeightZero()
+	if len(args) != 0 {
+		if callArgs, ok := args[0].(sc.VaryingData); ok && len(callArgs) > 1 {
+			keys := callArgs[0].(sc.Sequence[sc.Sequence[sc.U8]])
+			migration = pallet.MigrationWeight(sc.U32(len(keys)), callArgs[1].(sc.U32))
+		}
+	}
+
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	return types.WeightFromParts(34_020_000, 3593).
+		SaturatingAdd(migration).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ MigrateCustomTopCall) IsInherent() bool {
+	return false
+}
+
+func (_ MigrateCustomTopCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ MigrateCustomTopCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ MigrateCustomTopCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ MigrateCustomTopCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return migrateCustomTop(origin, args[0].(sc.Sequence[sc.Sequence[sc.U8]]), args[1].(sc.U32))
+}
+
+// migrateCustomTop migrates `keys` of the top trie, regardless of the progress of the migration.
+// The caller reserves a deposit, which is slashed if the values migrated are larger than
+// `witnessSize`. Honest callers pay no fee.
+func migrateCustomTop(origin types.RuntimeOrigin, keys sc.Sequence[sc.Sequence[sc.U8]], witnessSize sc.U32) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	if !origin.IsSignedOrigin() {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: types.NewDispatchErrorBadOrigin(),
+			},
+		}
+	}
+
+	migrated, err := pallet.MigrateCustomTop(origin.AsSigned(), keys, witnessSize)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	if !migrated {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: false,
+			Ok:       types.PostDispatchInfo{},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok: types.PostDispatchInfo{
+			PaysFee: types.PaysNo,
+		},
+	}
+}
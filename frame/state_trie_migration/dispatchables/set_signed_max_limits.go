@@ -0,0 +1,121 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/state_trie_migration"
+	pallet "github.com/LimeChain/gosemble/frame/state_trie_migration"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetSignedMaxLimitsCall struct {
+	types.Callable
+}
+
+func NewSetSignedMaxLimitsCall(args sc.VaryingData) SetSignedMaxLimitsCall {
+	call := SetSignedMaxLimitsCall{
+		Callable: types.Callable{
+			ModuleId:   state_trie_migration.ModuleIndex,
+			FunctionId: state_trie_migration.FunctionSetSignedMaxLimitsIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetSignedMaxLimitsCall) DecodeArgs(buffer *bytes.Buffer) types.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMigrationLimits(buffer),
+	)
+	return c
+}
+
+func (c SetSignedMaxLimitsCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetSignedMaxLimitsCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetSignedMaxLimitsCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetSignedMaxLimitsCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c SetSignedMaxLimitsCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetSignedMaxLimitsCall) CallInfo() types.CallInfo {
+	return types.CallInfo{
+		Name: "set_signed_max_limits",
+		Args: []types.FieldTypeInfo{
+			types.NewFieldTypeInfo("limits", "MigrationLimits", pallet.MigrationLimitsTypeInfo),
+		},
+		Docs: "Set the maximum limit of the signed migration.",
+	}
+}
+
+func (_ SetSignedMaxLimitsCall) BaseWeight(b ...any) types.Weight {
+	// Storage: StateTrieMigration SignedMigrationMaxLimits (r:0 w:1)
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `0`
+	// Minimum execution time: 7_012 nanoseconds.
+	w := constants.DbWeight.Writes(1)
+	return types.WeightFromParts(7_331_000, 0).SaturatingAdd(w)
+}
+
+func (_ SetSignedMaxLimitsCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetSignedMaxLimitsCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SetSignedMaxLimitsCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetSignedMaxLimitsCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetSignedMaxLimitsCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setSignedMaxLimits(origin, args[0].(types.MigrationLimits))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setSignedMaxLimits sets the maximum limits of the signed migrations, which allows them.
+func setSignedMaxLimits(origin types.RuntimeOrigin, limits types.MigrationLimits) types.DispatchError {
+	if err := pallet.ControlOrigin.EnsureOrigin(origin); err != nil {
+		return err
+	}
+
+	pallet.SetSignedMaxLimits(limits)
+
+	return nil
+}
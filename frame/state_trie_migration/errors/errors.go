@@ -0,0 +1,13 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// State trie migration module errors.
+const (
+	ErrorMaxSignedLimits sc.U8 = iota
+	ErrorKeyTooLong
+	ErrorNotEnoughFunds
+	ErrorBadWitness
+	ErrorSignedMigrationNotAllowed
+	ErrorBadChildRoot
+)
@@ -0,0 +1,64 @@
+package events
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/state_trie_migration"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// State trie migration module events.
+const (
+	EventMigrated sc.U8 = iota
+	EventSlashed
+	EventAutoMigrationFinished
+	EventHalted
+)
+
+func NewEventMigrated(top sc.U32, child sc.U32, compute types.MigrationCompute) types.Event {
+	return types.NewEvent(state_trie_migration.ModuleIndex, EventMigrated, top, child, compute)
+}
+
+func NewEventSlashed(who types.PublicKey, amount types.Balance) types.Event {
+	return types.NewEvent(state_trie_migration.ModuleIndex, EventSlashed, who, amount)
+}
+
+func NewEventAutoMigrationFinished() types.Event {
+	return types.NewEvent(state_trie_migration.ModuleIndex, EventAutoMigrationFinished)
+}
+
+func NewEventHalted(err sc.U8) types.Event {
+	return types.NewEvent(state_trie_migration.ModuleIndex, EventHalted, err)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	module := sc.DecodeU8(buffer)
+	if module != state_trie_migration.ModuleIndex {
+		log.Critical("invalid state_trie_migration.Event module")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventMigrated:
+		top := sc.DecodeU32(buffer)
+		child := sc.DecodeU32(buffer)
+		compute := types.DecodeMigrationCompute(buffer)
+		return NewEventMigrated(top, child, compute)
+	case EventSlashed:
+		who := types.DecodePublicKey(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventSlashed(who, amount)
+	case EventAutoMigrationFinished:
+		return NewEventAutoMigrationFinished()
+	case EventHalted:
+		err := sc.DecodeU8(buffer)
+		return NewEventHalted(err)
+	default:
+		log.Critical("invalid state_trie_migration.Event type")
+	}
+
+	panic("unreachable")
+}
@@ -0,0 +1,34 @@
+package state_trie_migration
+
+import (
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/state_trie_migration/events"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OnInitialize migrates keys of the state trie within the limits of the automatic migration, if it
+// is enabled. The automatic migration is disabled once all keys are migrated.
+func OnInitialize() types.Weight {
+	limits := StorageGetAutoLimits()
+	if !limits.HasValue {
+		return constants.DbWeight.Reads(1)
+	}
+
+	task := StorageGetMigrationProcess()
+	m, halt := migrateUntilExhaustion(&task, limits.Value)
+	StorageSetMigrationProcess(task)
+
+	system.DepositEvent(events.NewEventMigrated(m.topItems, m.childItems, types.NewMigrationComputeAuto()))
+
+	if task.Finished() {
+		StorageClearAutoLimits()
+		system.DepositEvent(events.NewEventAutoMigrationFinished())
+	}
+
+	if halt.HasValue {
+		haltMigration(halt.Value)
+	}
+
+	return MigrationWeight(m.items(), m.size).SaturatingAdd(constants.DbWeight.ReadsWrites(2, 2))
+}
@@ -0,0 +1,138 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/state_trie_migration"
+	pallet "github.com/LimeChain/gosemble/frame/state_trie_migration"
+	"github.com/LimeChain/gosemble/frame/state_trie_migration/dispatchables"
+	"github.com/LimeChain/gosemble/frame/state_trie_migration/errors"
+	"github.com/LimeChain/gosemble/frame/state_trie_migration/events"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type StateTrieMigrationModule struct {
+	functions map[sc.U8]primitives.Call
+}
+
+func NewStateTrieMigrationModule() StateTrieMigrationModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[state_trie_migration.FunctionControlAutoMigrationIndex] = dispatchables.NewControlAutoMigrationCall(nil)
+	functions[state_trie_migration.FunctionContinueMigrateIndex] = dispatchables.NewContinueMigrateCall(nil)
+	functions[state_trie_migration.FunctionMigrateCustomTopIndex] = dispatchables.NewMigrateCustomTopCall(nil)
+	functions[state_trie_migration.FunctionMigrateCustomChildIndex] = dispatchables.NewMigrateCustomChildCall(nil)
+	functions[state_trie_migration.FunctionSetSignedMaxLimitsIndex] = dispatchables.NewSetSignedMaxLimitsCall(nil)
+	functions[state_trie_migration.FunctionForceSetProgressIndex] = dispatchables.NewForceSetProgressCall(nil)
+
+	return StateTrieMigrationModule{
+		functions: functions,
+	}
+}
+
+func (sm StateTrieMigrationModule) Functions() map[sc.U8]primitives.Call {
+	return sm.functions
+}
+
+func (sm StateTrieMigrationModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (sm StateTrieMigrationModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// OnInitialize migrates the next keys of the automatic migration, if one is running.
+func (sm StateTrieMigrationModule) OnInitialize(_ primitives.BlockNumber) primitives.Weight {
+	return pallet.OnInitialize()
+}
+
+func (sm StateTrieMigrationModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Migration of the state trie to the current state version, automatically in each block or by signed calls."}
+}
+
+func (sm StateTrieMigrationModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "StateTrieMigration",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "StateTrieMigration",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"MigrationProcess",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(pallet.MigrationTaskTypeInfo))),
+					"Migration progress. This stores the snapshot of the last migrated keys. It can be set into motion and move forward by any of the means provided by this pallet."),
+				primitives.NewMetadataModuleStorageEntry(
+					"AutoLimits",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(pallet.MigrationLimitsTypeInfo))),
+					"The limits that are imposed on automatic migrations. If set to None, then no automatic migration happens."),
+				primitives.NewMetadataModuleStorageEntry(
+					"SignedMigrationMaxLimits",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(pallet.MigrationLimitsTypeInfo))),
+					"The maximum limits that the signed migration could use. If not set, no signed submission is allowed."),
+			},
+		}),
+		Call:  sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(sm.callsTypeInfo()))),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(eventTypeInfo()))),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"MaxKeyLen",
+				sc.ToCompact(registry.Register(primitives.TypeInfoU32)),
+				sc.BytesToSequenceU8(sc.U32(state_trie_migration.MaxKeyLen).Bytes()),
+				"Maximal number of bytes that a key can have. The migration halts with KeyTooLong on a longer key.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"SignedDepositPerItem",
+				sc.ToCompact(registry.Register(primitives.TypeInfoU128)),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(state_trie_migration.SignedDepositPerItem).Bytes()),
+				"The amount of deposit collected per item in advance, for signed migrations.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"SignedDepositBase",
+				sc.ToCompact(registry.Register(primitives.TypeInfoU128)),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(state_trie_migration.SignedDepositBase).Bytes()),
+				"The base value of the deposit collected in advance, for signed migrations.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(errorsTypeInfo()))),
+		Index: state_trie_migration.ModuleIndex,
+	}
+}
+
+func (sm StateTrieMigrationModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_state_trie_migration", "pallet", "Call"}, sm.functions)
+}
+
+func eventTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_state_trie_migration", "pallet", "Event"},
+		"The events of the module.",
+		primitives.NewVariantTypeInfo("Migrated", events.EventMigrated, "Given number of `(top, child)` keys were migrated respectively, with the given `compute`.",
+			primitives.NewFieldTypeInfo("top", "u32", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("child", "u32", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("compute", "MigrationCompute", pallet.MigrationComputeTypeInfo),
+		),
+		primitives.NewVariantTypeInfo("Slashed", events.EventSlashed, "Some account got slashed by the given amount.",
+			primitives.NewFieldTypeInfo("who", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+			primitives.NewFieldTypeInfo("amount", "BalanceOf<T>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("AutoMigrationFinished", events.EventAutoMigrationFinished, "The auto migration task finished."),
+		primitives.NewVariantTypeInfo("Halted", events.EventHalted, "Migration got halted due to an error or miss-configuration.",
+			primitives.NewFieldTypeInfo("error", "Error<T>", errorsTypeInfo()),
+		),
+	)
+}
+
+func errorsTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_state_trie_migration", "pallet", "Error"},
+		"The errors of the module.",
+		primitives.NewVariantTypeInfo("MaxSignedLimits", errors.ErrorMaxSignedLimits, "Max signed limits not respected."),
+		primitives.NewVariantTypeInfo("KeyTooLong", errors.ErrorKeyTooLong, "A key was longer than the configured maximum. The migration is halted, and the key has to be skipped with `force_set_progress`."),
+		primitives.NewVariantTypeInfo("NotEnoughFunds", errors.ErrorNotEnoughFunds, "Submitter does not have enough funds."),
+		primitives.NewVariantTypeInfo("BadWitness", errors.ErrorBadWitness, "Bad witness data provided."),
+		primitives.NewVariantTypeInfo("SignedMigrationNotAllowed", errors.ErrorSignedMigrationNotAllowed, "Signed migration is not allowed because the maximum limit is not set yet."),
+		primitives.NewVariantTypeInfo("BadChildRoot", errors.ErrorBadChildRoot, "Bad child root provided."),
+	)
+}
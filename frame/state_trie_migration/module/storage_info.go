@@ -0,0 +1,15 @@
+package module
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageInfo describes the storage items of the module whose values have a maximum encoded length.
+func (sm StateTrieMigrationModule) StorageInfo() []primitives.StorageInfo {
+	return []primitives.StorageInfo{
+		// Two progresses with keys of up to MaxKeyLen bytes, and three u32 counters.
+		primitives.NewStorageValueInfo("StateTrieMigration", "MigrationProcess", 2*(1+2+512)+12),
+		primitives.NewStorageValueInfo("StateTrieMigration", "AutoLimits", 8),
+		primitives.NewStorageValueInfo("StateTrieMigration", "SignedMigrationMaxLimits", 8),
+	}
+}
@@ -0,0 +1,10 @@
+package state_trie_migration
+
+import (
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ControlOrigin is the origin which controls the automatic migration, the limits of the signed
+// migrations and the progress of the migration.
+var ControlOrigin types.EnsureOrigin = system.EnsureRoot{}
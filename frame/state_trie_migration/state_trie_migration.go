@@ -0,0 +1,402 @@
+// Package state_trie_migration migrates the state trie of a chain to the current state version,
+// by reading and writing back each of its values, so that the values are stored with the
+// layout of the current version. Under the V1 layout, values longer than 32 bytes are hashed in
+// the trie nodes, which makes the storage proofs of the nodes smaller.
+//
+// The keys are migrated automatically in each block, within the limits set by the control origin,
+// or by signed calls, whose callers reserve a deposit which is slashed if they declare wrong sizes.
+package state_trie_migration
+
+import (
+	"bytes"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/state_trie_migration"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/state_trie_migration/errors"
+	"github.com/LimeChain/gosemble/frame/state_trie_migration/events"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/api"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Api is the `StateTrieMigrationApi` runtime API, which reports the status of the migration.
+var Api = api.New("StateTrieMigrationApi", 1, "The API to query the status of the migration of the state trie.",
+	api.NewMethod("migration_status",
+		MigrationStatusTypeInfo,
+		"Returns the progress of the migration and the limits of the automatic and the signed migrations.",
+		Status),
+)
+
+// MigrationStatus is the status of the migration of the state trie.
+type MigrationStatus struct {
+	// The progress of the migration.
+	Task types.MigrationTask
+	// The limits of the automatic migration in each block, if it is enabled.
+	AutoLimits sc.Option[types.MigrationLimits]
+	// The maximum limits of the signed migrations, if they are allowed.
+	SignedMaxLimits sc.Option[types.MigrationLimits]
+}
+
+func (ms MigrationStatus) Encode(buffer *bytes.Buffer) {
+	ms.Task.Encode(buffer)
+	ms.AutoLimits.Encode(buffer)
+	ms.SignedMaxLimits.Encode(buffer)
+}
+
+func (ms MigrationStatus) Bytes() []byte {
+	return sc.EncodedBytes(ms)
+}
+
+// Status returns the status of the migration of the state trie.
+func Status() MigrationStatus {
+	return MigrationStatus{
+		Task:            StorageGetMigrationProcess(),
+		AutoLimits:      StorageGetAutoLimits(),
+		SignedMaxLimits: StorageGetSignedMigrationMaxLimits(),
+	}
+}
+
+// ControlAutoMigration enables the automatic migration with `limits` per block, or disables it.
+func ControlAutoMigration(limits sc.Option[types.MigrationLimits]) {
+	if !limits.HasValue {
+		StorageClearAutoLimits()
+		return
+	}
+
+	StorageSetAutoLimits(limits.Value)
+}
+
+// SetSignedMaxLimits sets the maximum limits of the signed migrations.
+func SetSignedMaxLimits(limits types.MigrationLimits) {
+	StorageSetSignedMigrationMaxLimits(limits)
+}
+
+// ForceSetProgress sets the progress of the migration of the top trie and of the current child trie.
+func ForceSetProgress(progressTop types.MigrationProgress, progressChild types.MigrationProgress) {
+	task := StorageGetMigrationProcess()
+	task.ProgressTop = progressTop
+	task.ProgressChild = progressChild
+	StorageSetMigrationProcess(task)
+}
+
+// ContinueMigrate migrates keys of the state trie within `limits` on behalf of `who`, who reserves
+// a deposit for the keys. `witnessTask` must be the current state of the migration, and
+// `realSizeUpper` an upper bound of the size of the values migrated, on which the weight of the
+// call is based. If the size of the values migrated is larger, the deposit is slashed.
+// Returns the weight of migrating the keys, or `None` if the deposit was slashed.
+func ContinueMigrate(who types.AccountId, limits types.MigrationLimits, realSizeUpper sc.U32, witnessTask types.MigrationTask) (sc.Option[types.Weight], types.DispatchError) {
+	maxLimits, err := signedMigrationMaxLimits()
+	if err != nil {
+		return sc.NewOption[types.Weight](nil), err
+	}
+
+	if limits.Size > maxLimits.Size || limits.Item > maxLimits.Item {
+		return sc.NewOption[types.Weight](nil), newStateTrieMigrationError(errors.ErrorMaxSignedLimits)
+	}
+
+	task := StorageGetMigrationProcess()
+	if !bytes.Equal(task.Bytes(), witnessTask.Bytes()) {
+		return sc.NewOption[types.Weight](nil), newStateTrieMigrationError(errors.ErrorBadWitness)
+	}
+
+	deposit := depositFor(limits.Item)
+	if err := dispatchables.Reserve(who, deposit); err != nil {
+		return sc.NewOption[types.Weight](nil), newStateTrieMigrationError(errors.ErrorNotEnoughFunds)
+	}
+
+	m, halt := migrateUntilExhaustion(&task, limits)
+	if realSizeUpper < m.size {
+		slash(who, deposit)
+		return sc.NewOption[types.Weight](nil), nil
+	}
+	dispatchables.Unreserve(who, deposit)
+
+	StorageSetMigrationProcess(task)
+	system.DepositEvent(events.NewEventMigrated(m.topItems, m.childItems, types.NewMigrationComputeSigned()))
+
+	if halt.HasValue {
+		haltMigration(halt.Value)
+	}
+
+	return sc.NewOption[types.Weight](MigrationWeight(m.items(), m.size)), nil
+}
+
+// MigrateCustomTop migrates `keys` of the top trie on behalf of `who`, who reserves a deposit for
+// the keys. The number of keys is bounded by the item limit of the signed migrations. If the size
+// of the values migrated is larger than `witnessSize`, the deposit is slashed.
+// Returns whether the keys were migrated without slashing.
+func MigrateCustomTop(who types.AccountId, keys sc.Sequence[sc.Sequence[sc.U8]], witnessSize sc.U32) (bool, types.DispatchError) {
+	if err := ensureSignedMigrationItems(sc.U32(len(keys))); err != nil {
+		return false, err
+	}
+
+	deposit := depositFor(sc.U32(len(keys)))
+	if err := dispatchables.Reserve(who, deposit); err != nil {
+		return false, newStateTrieMigrationError(errors.ErrorNotEnoughFunds)
+	}
+
+	size := sc.U32(0)
+	for _, key := range keys {
+		size = size.SaturatingAdd(migrateTopKey(sc.SequenceU8ToBytes(key)))
+	}
+
+	if size > witnessSize {
+		slash(who, deposit)
+		return false, nil
+	}
+	dispatchables.Unreserve(who, deposit)
+
+	system.DepositEvent(events.NewEventMigrated(sc.U32(len(keys)), 0, types.NewMigrationComputeSigned()))
+
+	return true, nil
+}
+
+// MigrateCustomChild migrates `keys` of the child trie whose root is at the key `root` of the top
+// trie, on behalf of `who`, who reserves a deposit for the keys. The number of keys is bounded by
+// the item limit of the signed migrations. If the size of the values migrated is not `totalSize`,
+// the deposit is slashed.
+// Returns whether the keys were migrated without slashing.
+func MigrateCustomChild(who types.AccountId, root sc.Sequence[sc.U8], keys sc.Sequence[sc.Sequence[sc.U8]], totalSize sc.U32) (bool, types.DispatchError) {
+	if err := ensureSignedMigrationItems(sc.U32(len(keys))); err != nil {
+		return false, err
+	}
+
+	rootKey := sc.SequenceU8ToBytes(root)
+	if !isChildRoot(rootKey) {
+		return false, newStateTrieMigrationError(errors.ErrorBadChildRoot)
+	}
+
+	deposit := depositFor(sc.U32(len(keys)))
+	if err := dispatchables.Reserve(who, deposit); err != nil {
+		return false, newStateTrieMigrationError(errors.ErrorNotEnoughFunds)
+	}
+
+	storageKey := childStorageKey(rootKey)
+	size := sc.U32(0)
+	for _, key := range keys {
+		size = size.SaturatingAdd(migrateChildKey(storageKey, sc.SequenceU8ToBytes(key)))
+	}
+
+	if size != totalSize {
+		slash(who, deposit)
+		return false, nil
+	}
+	dispatchables.Unreserve(who, deposit)
+
+	system.DepositEvent(events.NewEventMigrated(0, sc.U32(len(keys)), types.NewMigrationComputeSigned()))
+
+	return true, nil
+}
+
+// signedMigrationMaxLimits returns the maximum limits of the signed migrations, or an error if
+// they are not set, in which case signed migrations are not allowed.
+func signedMigrationMaxLimits() (types.MigrationLimits, types.DispatchError) {
+	maxLimits := StorageGetSignedMigrationMaxLimits()
+	if !maxLimits.HasValue {
+		return types.MigrationLimits{}, newStateTrieMigrationError(errors.ErrorSignedMigrationNotAllowed)
+	}
+
+	return maxLimits.Value, nil
+}
+
+// ensureSignedMigrationItems checks that a signed migration of `items` keys is within the item
+// limit of the signed migrations.
+func ensureSignedMigrationItems(items sc.U32) types.DispatchError {
+	maxLimits, err := signedMigrationMaxLimits()
+	if err != nil {
+		return err
+	}
+
+	if items > maxLimits.Item {
+		return newStateTrieMigrationError(errors.ErrorMaxSignedLimits)
+	}
+
+	return nil
+}
+
+// migration migrates the keys of the state trie following the progress of a task. It counts the
+// keys and the size of the values it migrates, to keep them within the limits of the migration.
+type migration struct {
+	task       *types.MigrationTask
+	size       sc.U32
+	topItems   sc.U32
+	childItems sc.U32
+}
+
+// migrateUntilExhaustion migrates the keys after the progress of `task`, until all keys are
+// migrated or `limits` are exhausted. The last key migrated may exceed the size limit.
+// Returns the migration, and the error which halts the migration, if any.
+func migrateUntilExhaustion(task *types.MigrationTask, limits types.MigrationLimits) (migration, sc.Option[sc.U8]) {
+	m := migration{task: task}
+
+	for !m.exhausted(limits) && !task.Finished() {
+		if halt := m.tick(); halt.HasValue {
+			return m, halt
+		}
+	}
+
+	return m, sc.NewOption[sc.U8](nil)
+}
+
+func (m *migration) items() sc.U32 {
+	return m.topItems.SaturatingAdd(m.childItems)
+}
+
+func (m *migration) exhausted(limits types.MigrationLimits) bool {
+	return m.items() >= limits.Item || m.size >= limits.Size
+}
+
+// tick migrates the next key. The keys of a child trie are migrated after the key of its root in
+// the top trie, before the next key of the top trie.
+func (m *migration) tick() sc.Option[sc.U8] {
+	top, child := m.task.ProgressTop, m.task.ProgressChild
+
+	switch {
+	case top.IsComplete():
+		return sc.NewOption[sc.U8](nil)
+	case top.IsToStart():
+		return m.migrateTop()
+	case child.IsLastKey():
+		return m.migrateChild()
+	case child.IsToStart():
+		if isChildRoot(sc.SequenceU8ToBytes(top.AsLastKey())) {
+			return m.migrateChild()
+		}
+		return m.migrateTop()
+	default:
+		m.task.ProgressChild = types.NewMigrationProgressToStart()
+		return m.migrateTop()
+	}
+}
+
+// migrateTop migrates the next key of the top trie. The roots of the child tries are not written,
+// they are updated by the host when the child tries are migrated.
+func (m *migration) migrateTop() sc.Option[sc.U8] {
+	last := []byte{}
+	if m.task.ProgressTop.IsLastKey() {
+		last = sc.SequenceU8ToBytes(m.task.ProgressTop.AsLastKey())
+	}
+
+	next := storage.NextKey(last)
+	if !next.HasValue {
+		m.task.ProgressTop = types.NewMigrationProgressComplete()
+		return sc.NewOption[sc.U8](nil)
+	}
+
+	if len(next.Value) > state_trie_migration.MaxKeyLen {
+		return sc.NewOption[sc.U8](errors.ErrorKeyTooLong)
+	}
+
+	key := sc.SequenceU8ToBytes(next.Value)
+	if !isChildRoot(key) {
+		m.addSize(migrateTopKey(key))
+	}
+
+	m.topItems++
+	m.task.TopItems++
+	m.task.ProgressTop = types.NewMigrationProgressLastKey(next.Value)
+
+	return sc.NewOption[sc.U8](nil)
+}
+
+// migrateChild migrates the next key of the child trie whose root is at the last key of the top trie.
+func (m *migration) migrateChild() sc.Option[sc.U8] {
+	storageKey := childStorageKey(sc.SequenceU8ToBytes(m.task.ProgressTop.AsLastKey()))
+
+	last := []byte{}
+	if m.task.ProgressChild.IsLastKey() {
+		last = sc.SequenceU8ToBytes(m.task.ProgressChild.AsLastKey())
+	}
+
+	next := storage.ChildNextKey(storageKey, last)
+	if !next.HasValue {
+		m.task.ProgressChild = types.NewMigrationProgressComplete()
+		return sc.NewOption[sc.U8](nil)
+	}
+
+	if len(next.Value) > state_trie_migration.MaxKeyLen {
+		return sc.NewOption[sc.U8](errors.ErrorKeyTooLong)
+	}
+
+	m.addSize(migrateChildKey(storageKey, sc.SequenceU8ToBytes(next.Value)))
+
+	m.childItems++
+	m.task.ChildItems++
+	m.task.ProgressChild = types.NewMigrationProgressLastKey(next.Value)
+
+	return sc.NewOption[sc.U8](nil)
+}
+
+func (m *migration) addSize(size sc.U32) {
+	m.size = m.size.SaturatingAdd(size)
+	m.task.Size = m.task.Size.SaturatingAdd(size)
+}
+
+// migrateTopKey writes back the value of `key` of the top trie, if any.
+// Returns the size of the value.
+func migrateTopKey(key []byte) sc.U32 {
+	value := storage.Get(key)
+	if !value.HasValue {
+		return 0
+	}
+
+	storage.Set(key, sc.SequenceU8ToBytes(value.Value))
+
+	return sc.U32(len(value.Value))
+}
+
+// migrateChildKey writes back the value of `key` of the child trie of `storageKey`, if any.
+// Returns the size of the value.
+func migrateChildKey(storageKey []byte, key []byte) sc.U32 {
+	value := storage.ChildGet(storageKey, key)
+	if !value.HasValue {
+		return 0
+	}
+
+	storage.ChildSet(storageKey, key, sc.SequenceU8ToBytes(value.Value))
+
+	return sc.U32(len(value.Value))
+}
+
+// isChildRoot returns whether `key` of the top trie holds the root of a default child trie.
+func isChildRoot(key []byte) bool {
+	return bytes.HasPrefix(key, constants.KeyDefaultChildStorage)
+}
+
+// childStorageKey returns the storage key of the child trie whose root is at `key` of the top trie.
+func childStorageKey(key []byte) []byte {
+	return key[len(constants.KeyDefaultChildStorage):]
+}
+
+// haltMigration stops the automatic migration because of `err`.
+func haltMigration(err sc.U8) {
+	StorageClearAutoLimits()
+	system.DepositEvent(events.NewEventHalted(err))
+}
+
+// depositFor returns the deposit of a signed migration of `items` keys.
+func depositFor(items sc.U32) *big.Int {
+	perItem := new(big.Int).Mul(state_trie_migration.SignedDepositPerItem, big.NewInt(int64(items)))
+	return new(big.Int).Add(state_trie_migration.SignedDepositBase, perItem)
+}
+
+// slash slashes the reserved `deposit` of `who` and burns it.
+func slash(who types.AccountId, deposit *big.Int) {
+	slashed, _ := dispatchables.SlashReserved(who, deposit)
+	amount := sc.NewU128FromBigInt(slashed)
+	dispatchables.NewNegativeImbalance(amount).Drop()
+
+	system.DepositEvent(events.NewEventSlashed(who.FixedSequence, amount))
+}
+
+func newStateTrieMigrationError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   state_trie_migration.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
@@ -0,0 +1,66 @@
+package state_trie_migration
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetMigrationProcess returns the state of the migration of the state trie.
+func StorageGetMigrationProcess() types.MigrationTask {
+	return storage.GetDecodeOnEmpty(keyMigrationProcess(), types.DecodeMigrationTask, types.NewMigrationTask())
+}
+
+func StorageSetMigrationProcess(task types.MigrationTask) {
+	storage.Set(keyMigrationProcess(), task.Bytes())
+}
+
+// StorageGetAutoLimits returns the limits of the automatic migration in each block, if it is enabled.
+func StorageGetAutoLimits() sc.Option[types.MigrationLimits] {
+	return getLimits(keyAutoLimits())
+}
+
+func StorageSetAutoLimits(limits types.MigrationLimits) {
+	storage.Set(keyAutoLimits(), limits.Bytes())
+}
+
+func StorageClearAutoLimits() {
+	storage.Clear(keyAutoLimits())
+}
+
+// StorageGetSignedMigrationMaxLimits returns the maximum limits of the signed migrations, if they
+// are allowed.
+func StorageGetSignedMigrationMaxLimits() sc.Option[types.MigrationLimits] {
+	return getLimits(keySignedMigrationMaxLimits())
+}
+
+func StorageSetSignedMigrationMaxLimits(limits types.MigrationLimits) {
+	storage.Set(keySignedMigrationMaxLimits(), limits.Bytes())
+}
+
+func getLimits(key []byte) sc.Option[types.MigrationLimits] {
+	option := storage.Get(key)
+	if !option.HasValue {
+		return sc.NewOption[types.MigrationLimits](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.MigrationLimits](types.DecodeMigrationLimits(buffer))
+}
+
+func keyMigrationProcess() []byte {
+	return append(hashing.Twox128(constants.KeyStateTrieMigration), hashing.Twox128(constants.KeyMigrationProcess)...)
+}
+
+func keyAutoLimits() []byte {
+	return append(hashing.Twox128(constants.KeyStateTrieMigration), hashing.Twox128(constants.KeyAutoLimits)...)
+}
+
+func keySignedMigrationMaxLimits() []byte {
+	return append(hashing.Twox128(constants.KeyStateTrieMigration), hashing.Twox128(constants.KeySignedMigrationMaxLimits)...)
+}
@@ -0,0 +1,46 @@
+package state_trie_migration
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// MigrationLimitsTypeInfo is the type info of types.MigrationLimits.
+	MigrationLimitsTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_state_trie_migration", "pallet", "MigrationLimits"},
+		"The limits of a migration.",
+		types.NewFieldTypeInfo("size", "u32", types.TypeInfoU32),
+		types.NewFieldTypeInfo("item", "u32", types.TypeInfoU32),
+	)
+	// ProgressTypeInfo is the type info of types.MigrationProgress.
+	ProgressTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_state_trie_migration", "pallet", "Progress"},
+		"The progress of migrating the keys of a trie.",
+		types.NewVariantTypeInfo("ToStart", types.MigrationProgressToStart, ""),
+		types.NewVariantTypeInfo("LastKey", types.MigrationProgressLastKey, "",
+			types.NewFieldTypeInfo("", "BoundedVec<u8, MaxKeyLen>", types.NewSequenceTypeInfo(types.TypeInfoU8)),
+		),
+		types.NewVariantTypeInfo("Complete", types.MigrationProgressComplete, ""),
+	)
+	// MigrationTaskTypeInfo is the type info of types.MigrationTask.
+	MigrationTaskTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_state_trie_migration", "pallet", "MigrationTask"},
+		"The state of the migration of the state trie.",
+		types.NewFieldTypeInfo("progress_top", "ProgressOf<T>", ProgressTypeInfo),
+		types.NewFieldTypeInfo("progress_child", "ProgressOf<T>", ProgressTypeInfo),
+		types.NewFieldTypeInfo("size", "u32", types.TypeInfoU32),
+		types.NewFieldTypeInfo("top_items", "u32", types.TypeInfoU32),
+		types.NewFieldTypeInfo("child_items", "u32", types.TypeInfoU32),
+	)
+	// MigrationComputeTypeInfo is the type info of types.MigrationCompute.
+	MigrationComputeTypeInfo = types.NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet_state_trie_migration", "pallet", "MigrationCompute"},
+		"How keys of the state trie were migrated.",
+		types.NewVariantTypeInfo("Signed", types.MigrationComputeSigned, "A signed call."),
+		types.NewVariantTypeInfo("Auto", types.MigrationComputeAuto, "The automatic migration, at the start of a block."),
+	)
+	// MigrationStatusTypeInfo is the type info of MigrationStatus.
+	MigrationStatusTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_state_trie_migration", "MigrationStatus"},
+		"The status of the migration of the state trie.",
+		types.NewFieldTypeInfo("task", "MigrationTask<T>", MigrationTaskTypeInfo),
+		types.NewFieldTypeInfo("auto_limits", "Option<MigrationLimits>", types.NewOptionTypeInfo(MigrationLimitsTypeInfo)),
+		types.NewFieldTypeInfo("signed_max_limits", "Option<MigrationLimits>", types.NewOptionTypeInfo(MigrationLimitsTypeInfo)),
+	)
+)
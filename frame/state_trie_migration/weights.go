@@ -0,0 +1,15 @@
+package state_trie_migration
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// MigrationWeight returns the weight of migrating `items` keys whose values have a total size of
+// `size`. Each key is found by reading the next key, then its value is read and written back.
+// The values migrated are part of the proof.
+func MigrationWeight(items sc.U32, size sc.U32) types.Weight {
+	return constants.DbWeight.ReadsWrites(2, 1).SaturatingMul(sc.U64(items)).
+		SaturatingAdd(types.WeightFromParts(1_500, 1).SaturatingMul(sc.U64(size)))
+}
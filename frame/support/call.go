@@ -0,0 +1,124 @@
+package support
+
+import (
+	"bytes"
+	"fmt"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/system"
+	frameSystem "github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/system/errors"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// callDecoder decodes a runtime call from its SCALE encoding.
+// Modules cannot depend on the runtime call decoder directly, as it depends on all modules.
+// It is registered by `execution/types` on initialization.
+var callDecoder func(buffer *bytes.Buffer) (types.Call, error)
+
+// RegisterCallDecoder sets the function used to decode runtime calls.
+func RegisterCallDecoder(decoder func(buffer *bytes.Buffer) (types.Call, error)) {
+	callDecoder = decoder
+}
+
+// MaxCallDepth is the maximum number of calls nested in one another that can be decoded,
+// as Substrate's `MAX_EXTRINSIC_DEPTH`. It bounds the recursion of decoding calls such as
+// the proposals of the collective, the calls of the scheduler or the calls of recovered accounts.
+const MaxCallDepth = 256
+
+var (
+	// callDepth is the number of calls being decoded, including the calls nested in them.
+	callDepth int
+	// nestedCallErr is the first error met while decoding a call nested in the outermost call being decoded.
+	nestedCallErr error
+)
+
+// DecodeCall decodes a runtime call, using the registered call decoder.
+// It aborts execution if the call cannot be decoded.
+//
+// Calls decode the calls nested in their arguments with DecodeCall. For such nested calls, it
+// does not abort, but returns `nil` and makes the TryDecodeCall of the outermost call fail.
+func DecodeCall(buffer *bytes.Buffer) types.Call {
+	call, err := TryDecodeCall(buffer)
+	if err != nil {
+		if callDepth == 0 {
+			log.Critical(err.Error())
+		}
+
+		if nestedCallErr == nil {
+			nestedCallErr = err
+		}
+		return nil
+	}
+
+	return call
+}
+
+// TryDecodeCall decodes a runtime call, using the registered call decoder.
+// Unlike DecodeCall, it returns an error if the call, or any call nested in it, cannot be
+// decoded, which makes it suitable for decoding calls from untrusted storage, such as preimages.
+// Calls nested deeper than MaxCallDepth cannot be decoded.
+func TryDecodeCall(buffer *bytes.Buffer) (types.Call, error) {
+	if callDecoder == nil {
+		log.Critical("call decoder not registered")
+	}
+
+	if callDepth >= MaxCallDepth {
+		return nil, fmt.Errorf("maximum call depth [%d] exceeded", MaxCallDepth)
+	}
+
+	callDepth++
+	call, err := callDecoder(buffer)
+	callDepth--
+
+	if err == nil {
+		err = nestedCallErr
+	}
+
+	if callDepth == 0 {
+		nestedCallErr = nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return call, nil
+}
+
+// DispatchCall dispatches `call` with the given `origin` in a new storage layer.
+// All storage changes made by the call are reverted if it fails.
+//
+// Unless the origin is root, the call must pass both the `BaseCallFilter` of the system module
+// and the filters of the origin. Otherwise, it fails with `CallFiltered`.
+func DispatchCall(call types.Call, origin types.RuntimeOrigin) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	if !bool(origin.IsRootOrigin()) && !(frameSystem.BaseCallFilter(call) && origin.FilterCall(call)) {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: types.NewDispatchErrorModule(types.CustomModuleError{
+					Index:   system.ModuleIndex,
+					Error:   sc.U32(errors.ErrorCallFiltered),
+					Message: sc.NewOption[sc.Str](nil),
+				}),
+			},
+		}
+	}
+
+	var result types.DispatchResultWithPostInfo[types.PostDispatchInfo]
+
+	WithStorageLayer(
+		func() (types.PostDispatchInfo, types.DispatchError) {
+			result = call.Dispatch(origin, call.Args())
+
+			if result.HasError {
+				return types.PostDispatchInfo{}, result.Err.Error
+			}
+
+			return result.Ok, nil
+		},
+	)
+
+	return result
+}
@@ -0,0 +1,95 @@
+package support
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Migration migrates the storage of the runtime when it is upgraded.
+type Migration interface {
+	// OnRuntimeUpgrade migrates the storage. It is executed in the first block of the upgraded
+	// runtime, before any other hook. Returns the weight consumed.
+	OnRuntimeUpgrade() types.Weight
+	// PreUpgrade checks the state before the upgrade. Returns the state which PostUpgrade needs
+	// to check the migrated storage. It is called only by the try-runtime checks.
+	PreUpgrade() ([]byte, error)
+	// PostUpgrade checks the state after the upgrade, with the state returned by PreUpgrade.
+	// It is called only by the try-runtime checks.
+	PostUpgrade(state []byte) error
+}
+
+// VersionedMigration migrates the storage of a module from one storage version to another. It
+// runs its migration only if the on-chain storage version of the module is `From`, and sets the
+// on-chain storage version to `To` afterwards, so that the migration is executed once.
+type VersionedMigration struct {
+	Module    types.VersionedModule
+	From      types.StorageVersion
+	To        types.StorageVersion
+	Migration Migration
+}
+
+// NewVersionedMigration returns `migration`, executed only if `module` is at storage version `from`.
+func NewVersionedMigration(module types.VersionedModule, from types.StorageVersion, to types.StorageVersion, migration Migration) VersionedMigration {
+	return VersionedMigration{
+		Module:    module,
+		From:      from,
+		To:        to,
+		Migration: migration,
+	}
+}
+
+// OnRuntimeUpgrade executes the migration if the on-chain storage version is `From`.
+// Returns the weight of the migration and of reading and writing the storage version.
+func (vm VersionedMigration) OnRuntimeUpgrade() types.Weight {
+	onChain := OnChainStorageVersion(vm.Module)
+	if onChain != vm.From {
+		log.Info(fmt.Sprintf("skipping migration from storage version %d to %d, the on-chain storage version is %d", vm.From, vm.To, onChain))
+		return constants.DbWeight.Reads(1)
+	}
+
+	weight := vm.Migration.OnRuntimeUpgrade()
+	PutStorageVersion(vm.Module, vm.To)
+
+	return weight.SaturatingAdd(constants.DbWeight.ReadsWrites(1, 1))
+}
+
+// PreUpgrade checks the state before the upgrade, if the migration is executed.
+// The first byte of the returned state tells if the migration is executed.
+func (vm VersionedMigration) PreUpgrade() ([]byte, error) {
+	if OnChainStorageVersion(vm.Module) != vm.From {
+		return []byte{0}, nil
+	}
+
+	state, err := vm.Migration.PreUpgrade()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{1}, state...), nil
+}
+
+// PostUpgrade checks the state after the upgrade and the on-chain storage version, if the
+// migration is executed.
+func (vm VersionedMigration) PostUpgrade(state []byte) error {
+	if len(state) == 0 {
+		return errors.New("invalid versioned migration state")
+	}
+
+	if state[0] == 0 {
+		return nil
+	}
+
+	if err := vm.Migration.PostUpgrade(state[1:]); err != nil {
+		return err
+	}
+
+	if onChain := OnChainStorageVersion(vm.Module); onChain != vm.To {
+		return fmt.Errorf("the on-chain storage version is %d after the migration, expected %d", onChain, vm.To)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package support
+
+import (
+	"errors"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type testModule struct {
+	name sc.Str
+}
+
+func (tm testModule) Name() sc.Str {
+	return tm.name
+}
+
+func (tm testModule) StorageVersion() types.StorageVersion {
+	return 2
+}
+
+// testMigration records the calls of the versioned migration.
+type testMigration struct {
+	executed  *bool
+	postState *[]byte
+	postErr   error
+}
+
+func newTestMigration(postErr error) testMigration {
+	return testMigration{executed: new(bool), postState: new([]byte), postErr: postErr}
+}
+
+func (tm testMigration) OnRuntimeUpgrade() types.Weight {
+	*tm.executed = true
+	return types.WeightFromParts(100, 10)
+}
+
+func (tm testMigration) PreUpgrade() ([]byte, error) {
+	return []byte{7}, nil
+}
+
+func (tm testMigration) PostUpgrade(state []byte) error {
+	*tm.postState = state
+	return tm.postErr
+}
+
+func Test_VersionedMigration_OnRuntimeUpgrade(t *testing.T) {
+	module := testModule{name: "Test_VersionedMigration_OnRuntimeUpgrade"}
+	migration := newTestMigration(nil)
+	PutStorageVersion(module, 1)
+
+	weight := NewVersionedMigration(module, 1, 2, migration).OnRuntimeUpgrade()
+
+	assert.True(t, *migration.executed)
+	assert.Equal(t, types.WeightFromParts(100, 10).SaturatingAdd(constants.DbWeight.ReadsWrites(1, 1)), weight)
+	assert.Equal(t, types.StorageVersion(2), OnChainStorageVersion(module))
+}
+
+func Test_VersionedMigration_OnRuntimeUpgrade_Skip(t *testing.T) {
+	module := testModule{name: "Test_VersionedMigration_OnRuntimeUpgrade_Skip"}
+	migration := newTestMigration(nil)
+	PutStorageVersion(module, 2)
+
+	weight := NewVersionedMigration(module, 1, 2, migration).OnRuntimeUpgrade()
+
+	assert.False(t, *migration.executed)
+	assert.Equal(t, constants.DbWeight.Reads(1), weight)
+	assert.Equal(t, types.StorageVersion(2), OnChainStorageVersion(module))
+}
+
+func Test_VersionedMigration_OnRuntimeUpgrade_NoStorageVersion(t *testing.T) {
+	module := testModule{name: "Test_VersionedMigration_OnRuntimeUpgrade_NoStorageVersion"}
+	migration := newTestMigration(nil)
+
+	NewVersionedMigration(module, 0, 1, migration).OnRuntimeUpgrade()
+
+	assert.True(t, *migration.executed)
+	assert.Equal(t, types.StorageVersion(1), OnChainStorageVersion(module))
+}
+
+func Test_VersionedMigration_PreUpgrade(t *testing.T) {
+	module := testModule{name: "Test_VersionedMigration_PreUpgrade"}
+	versionedMigration := NewVersionedMigration(module, 1, 2, newTestMigration(nil))
+
+	PutStorageVersion(module, 1)
+	state, err := versionedMigration.PreUpgrade()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 7}, state)
+
+	PutStorageVersion(module, 2)
+	state, err = versionedMigration.PreUpgrade()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0}, state)
+}
+
+func Test_VersionedMigration_PostUpgrade(t *testing.T) {
+	module := testModule{name: "Test_VersionedMigration_PostUpgrade"}
+	migration := newTestMigration(nil)
+	PutStorageVersion(module, 2)
+
+	err := NewVersionedMigration(module, 1, 2, migration).PostUpgrade([]byte{1, 7})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{7}, *migration.postState)
+}
+
+func Test_VersionedMigration_PostUpgrade_Skipped(t *testing.T) {
+	module := testModule{name: "Test_VersionedMigration_PostUpgrade_Skipped"}
+	migration := newTestMigration(errors.New("not skipped"))
+
+	err := NewVersionedMigration(module, 1, 2, migration).PostUpgrade([]byte{0})
+
+	assert.NoError(t, err)
+	assert.Nil(t, *migration.postState)
+}
+
+func Test_VersionedMigration_PostUpgrade_WrongStorageVersion(t *testing.T) {
+	module := testModule{name: "Test_VersionedMigration_PostUpgrade_WrongStorageVersion"}
+	PutStorageVersion(module, 1)
+
+	err := NewVersionedMigration(module, 1, 2, newTestMigration(nil)).PostUpgrade([]byte{1, 7})
+
+	assert.EqualError(t, err, "the on-chain storage version is 1 after the migration, expected 2")
+}
+
+func Test_VersionedMigration_PostUpgrade_MigrationCheckFails(t *testing.T) {
+	module := testModule{name: "Test_VersionedMigration_PostUpgrade_MigrationCheckFails"}
+	PutStorageVersion(module, 2)
+
+	err := NewVersionedMigration(module, 1, 2, newTestMigration(errors.New("invalid state"))).PostUpgrade([]byte{1, 7})
+
+	assert.EqualError(t, err, "invalid state")
+}
+
+func Test_VersionedMigration_PostUpgrade_InvalidState(t *testing.T) {
+	module := testModule{name: "Test_VersionedMigration_PostUpgrade_InvalidState"}
+
+	err := NewVersionedMigration(module, 1, 2, newTestMigration(nil)).PostUpgrade([]byte{})
+
+	assert.EqualError(t, err, "invalid versioned migration state")
+}
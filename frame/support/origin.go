@@ -0,0 +1,17 @@
+package support
+
+import "github.com/LimeChain/gosemble/primitives/types"
+
+// EnsureOneOf ensures that the origin passes either the `Left` or the `Right` origin check.
+type EnsureOneOf struct {
+	Left  types.EnsureOrigin
+	Right types.EnsureOrigin
+}
+
+func (e EnsureOneOf) EnsureOrigin(origin types.RuntimeOrigin) types.DispatchError {
+	if err := e.Left.EnsureOrigin(origin); err == nil {
+		return nil
+	}
+
+	return e.Right.EnsureOrigin(origin)
+}
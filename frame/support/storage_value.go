@@ -6,6 +6,7 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
 )
 
 type StorageValue[T sc.Encodable] struct {
@@ -58,3 +59,9 @@ func (sv StorageValue[T]) TakeExact() T {
 
 	return storage.TakeDecode(append(prefixHash, nameHash...), sv.decodeFunc)
 }
+
+// StorageInfo returns the info of the storage value, whose value has a maximum encoded length of
+// `maxSize`, from which the proof size of accessing it is estimated.
+func (sv StorageValue[T]) StorageInfo(maxSize sc.U32) types.StorageInfo {
+	return types.NewStorageValueInfo(string(sv.prefix), string(sv.name), maxSize)
+}
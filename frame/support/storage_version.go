@@ -0,0 +1,25 @@
+package support
+
+import (
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OnChainStorageVersion returns the version of the storage of `module` on chain. It is 0 if no
+// version is stored.
+func OnChainStorageVersion(module types.VersionedModule) types.StorageVersion {
+	return storage.GetDecode(storageVersionKey(module), types.DecodeStorageVersion)
+}
+
+// PutStorageVersion sets the version of the storage of `module` on chain.
+func PutStorageVersion(module types.VersionedModule, version types.StorageVersion) {
+	storage.Set(storageVersionKey(module), version.Bytes())
+}
+
+// storageVersionKey returns the key of the storage version of `module`,
+// Twox128(module) ++ Twox128(":__STORAGE_VERSION__:").
+func storageVersionKey(module types.VersionedModule) []byte {
+	return append(hashing.Twox128([]byte(module.Name())), hashing.Twox128(constants.KeyStorageVersion)...)
+}
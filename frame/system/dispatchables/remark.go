@@ -5,6 +5,7 @@ import (
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants/system"
+	pallet "github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/primitives/types"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
@@ -62,11 +63,16 @@ func (_ RemarkCall) BaseWeight(args ...any) types.Weight {
 	// Proof Size summary in bytes:
 	//  Measured:  `0`
 	//  Estimated: `0`
-	// Minimum execution time: 2_018 nanoseconds.
-	// Standard Error: 0
-	b := sc.Sequence[sc.U8]{} // should be args[0], but since it is empty, it should not be created, otherwise the verification will fail.
-	w := types.WeightFromParts(362, 0).SaturatingMul(sc.U64(len(b)))
-	return types.WeightFromParts(2_091_000, 0).SaturatingAdd(w)
+	b := sc.U64(0)
+	if len(args) != 0 {
+		if callArgs, ok := args[0].(sc.VaryingData); ok && len(callArgs) != 0 {
+			if remark, ok := callArgs[0].(sc.Sequence[sc.U8]); ok {
+				b = sc.U64(len(remark))
+			}
+		}
+	}
+
+	return weightRemark(b)
 }
 
 func (_ RemarkCall) IsInherent() bool {
@@ -74,7 +80,7 @@ func (_ RemarkCall) IsInherent() bool {
 }
 
 func (_ RemarkCall) WeightInfo(baseWeight types.Weight) types.Weight {
-	return types.WeightFromParts(baseWeight.RefTime, 0)
+	return baseWeight
 }
 
 func (_ RemarkCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
@@ -91,7 +97,7 @@ func (_ RemarkCall) Dispatch(origin types.RuntimeOrigin, _ sc.VaryingData) types
 
 // remark makes some on-chain remark.
 func remark(origin types.RuntimeOrigin) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
-	_, err := ensureSignedOrRoot(origin)
+	_, err := pallet.EnsureSignedOrRoot(origin)
 	if err != nil {
 		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
 			HasError: true,
@@ -106,17 +112,3 @@ func remark(origin types.RuntimeOrigin) types.DispatchResultWithPostInfo[types.P
 		Ok:       types.PostDispatchInfo{},
 	}
 }
-
-// Ensure that the origin `o` represents either a signed extrinsic (i.e. transaction) or the root.
-// Returns `Ok` with the account that signed the extrinsic, `None` if it was root,  or an `Err`
-// otherwise.
-func ensureSignedOrRoot(o types.RawOrigin) (ok sc.Option[types.Address32], err types.DispatchError) {
-	if o.IsRootOrigin() {
-		ok = sc.NewOption[types.Address32](nil)
-	} else if o.IsSignedOrigin() {
-		ok = sc.NewOption[types.Address32](o.VaryingData[1])
-	} else {
-		err = types.NewDispatchErrorBadOrigin()
-	}
-	return ok, err
-}
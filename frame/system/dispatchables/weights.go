@@ -0,0 +1,18 @@
+// The weight is the Substrate constant of the `remark` benchmark, which is declared in
+// module/benchmarks.go. It has not been benchmarked against this runtime yet; running the
+// benchmarks of the module replaces this file with the generated weights.
+
+package dispatchables
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// weightRemark is the weight of the `remark` benchmark.
+// The range of component `b` is `[0, 3932160]`.
+// The remark is part of the block body, so each of its bytes adds one byte to the proof.
+func weightRemark(b sc.U64) types.Weight {
+	return types.WeightFromParts(2_091_000, 0).
+		SaturatingAdd(types.WeightFromParts(362, 1).SaturatingMul(b))
+}
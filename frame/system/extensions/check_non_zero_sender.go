@@ -7,16 +7,16 @@ import (
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
-var ZeroAddress = primitives.NewAddress32(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+var ZeroAddress = primitives.NewAccountId(make([]sc.U8, primitives.AccountIdLength)...)
 
-type CheckNonZeroAddress primitives.Address32
+type CheckNonZeroAddress primitives.AccountId
 
 func (a CheckNonZeroAddress) AdditionalSigned() (ok sc.Empty, err primitives.TransactionValidityError) {
 	ok = sc.Empty{}
 	return ok, err
 }
 
-func (who CheckNonZeroAddress) Validate(_who *primitives.Address32, _call *primitives.Call, _info *primitives.DispatchInfo, _length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
+func (who CheckNonZeroAddress) Validate(_who *primitives.AccountId, _call *primitives.Call, _info *primitives.DispatchInfo, _length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
 	// TODO:
 	// Not sure when this is possible.
 	// Checks signed transactions but will fail
@@ -31,7 +31,7 @@ func (who CheckNonZeroAddress) Validate(_who *primitives.Address32, _call *primi
 	return ok, err
 }
 
-func (a CheckNonZeroAddress) PreDispatch(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
+func (a CheckNonZeroAddress) PreDispatch(who *primitives.AccountId, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
 	_, err = a.Validate(who, call, info, length)
 	return ok, err
 }
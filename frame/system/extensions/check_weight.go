@@ -14,7 +14,7 @@ func (_ CheckWeight) AdditionalSigned() (ok sc.Empty, err primitives.Transaction
 	return ok, err
 }
 
-func (_ CheckWeight) Validate(_who *primitives.Address32, _call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
+func (_ CheckWeight) Validate(_who *primitives.AccountId, _call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
 	return DoValidate(info, length)
 }
 
@@ -22,7 +22,7 @@ func (_ CheckWeight) ValidateUnsigned(_call *primitives.Call, info *primitives.D
 	return DoValidate(info, length)
 }
 
-func (_ CheckWeight) PreDispatch(_who *primitives.Address32, _call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
+func (_ CheckWeight) PreDispatch(_who *primitives.AccountId, _call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
 	_, err = DoPreDispatch(info, length)
 	return ok, err
 }
@@ -0,0 +1,36 @@
+package system
+
+import (
+	"testing"
+
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CalculateConsumedWeight_ProofSize(t *testing.T) {
+	blockWeights := system.DefaultBlockWeights()
+	normal := blockWeights.Get(primitives.NewDispatchClassNormal())
+	info := &primitives.DispatchInfo{
+		Weight: primitives.WeightFromParts(1_000, 3_593),
+		Class:  primitives.NewDispatchClassNormal(),
+	}
+
+	consumed, err := CalculateConsumedWeight(blockWeights, primitives.ConsumedWeight{}, info)
+
+	assert.Nil(t, err)
+	assert.Equal(t, info.Weight.SaturatingAdd(normal.BaseExtrinsic), consumed.Normal)
+}
+
+func Test_CalculateConsumedWeight_ProofSizeExhaustsResources(t *testing.T) {
+	blockWeights := system.DefaultBlockWeights()
+	normal := blockWeights.Get(primitives.NewDispatchClassNormal())
+	info := &primitives.DispatchInfo{
+		Weight: primitives.WeightFromParts(1_000, normal.MaxTotal.Value.ProofSize+1),
+		Class:  primitives.NewDispatchClassNormal(),
+	}
+
+	_, err := CalculateConsumedWeight(blockWeights, primitives.ConsumedWeight{}, info)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionExhaustsResources()), err)
+}
@@ -0,0 +1,38 @@
+package checks
+
+import (
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// AdditionalSigned returns the data that the system checks add to the signed payload of a
+// transaction with `era`. These checks do not depend on the transaction payment, so the
+// payload can also be built by the offchain workers.
+func AdditionalSigned(era primitives.Era) (ok primitives.AdditionalSigned, err primitives.TransactionValidityError) {
+	ok = primitives.AdditionalSigned{} // FormatVersion: primitives.ExtrinsicFormatVersion
+
+	specVersion, err := CheckSpecVersion{}.AdditionalSigned()
+	if err != nil {
+		return ok, err
+	}
+	ok.SpecVersion = specVersion
+
+	transactionVersion, err := CheckTxVersion{}.AdditionalSigned()
+	if err != nil {
+		return ok, err
+	}
+	ok.TransactionVersion = transactionVersion
+
+	genesisHash, err := CheckGenesis{}.AdditionalSigned()
+	if err != nil {
+		return ok, err
+	}
+	ok.GenesisHash = genesisHash
+
+	blockHash, err := CheckMortality(era).AdditionalSigned()
+	if err != nil {
+		return ok, err
+	}
+	ok.BlockHash = blockHash
+
+	return ok, err
+}
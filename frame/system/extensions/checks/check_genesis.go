@@ -1,4 +1,4 @@
-package system
+package checks
 
 import (
 	sc "github.com/LimeChain/goscale"
@@ -13,12 +13,12 @@ func (_ CheckGenesis) AdditionalSigned() (ok primitives.H256, err primitives.Tra
 	return ok, err
 }
 
-func (_ CheckGenesis) Validate(_who *primitives.Address32, _call *primitives.Call, _info *primitives.DispatchInfo, _length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
+func (_ CheckGenesis) Validate(_who *primitives.AccountId, _call *primitives.Call, _info *primitives.DispatchInfo, _length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
 	ok = primitives.DefaultValidTransaction()
 	return ok, err
 }
 
-func (g CheckGenesis) PreDispatch(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
+func (g CheckGenesis) PreDispatch(who *primitives.AccountId, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
 	_, err = g.Validate(who, call, info, length)
 	return ok, err
 }
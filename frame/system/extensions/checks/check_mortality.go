@@ -1,4 +1,4 @@
-package system
+package checks
 
 import (
 	sc "github.com/LimeChain/goscale"
@@ -23,7 +23,7 @@ func (e CheckMortality) AdditionalSigned() (ok primitives.H256, err primitives.T
 // TODO: to be able to provide a custom implementation of the Validate function
 type CheckMortality primitives.Era
 
-func (e CheckMortality) Validate(_who *primitives.Address32, _call *primitives.Call, _info *primitives.DispatchInfo, _length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
+func (e CheckMortality) Validate(_who *primitives.AccountId, _call *primitives.Call, _info *primitives.DispatchInfo, _length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
 	currentU64 := sc.U64(system.StorageGetBlockNumber()) // TODO: per module implementation
 
 	validTill := primitives.Era(e).Death(currentU64)
@@ -34,7 +34,7 @@ func (e CheckMortality) Validate(_who *primitives.Address32, _call *primitives.C
 	return ok, err
 }
 
-func (e CheckMortality) PreDispatch(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
+func (e CheckMortality) PreDispatch(who *primitives.AccountId, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
 	_, err = e.Validate(who, call, info, length)
 	return ok, err
 }
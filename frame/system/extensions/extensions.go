@@ -2,7 +2,8 @@ package system
 
 import (
 	sc "github.com/LimeChain/goscale"
-	"github.com/LimeChain/gosemble/frame/transaction_payment"
+	"github.com/LimeChain/gosemble/frame/asset_tx_payment"
+	"github.com/LimeChain/gosemble/frame/system/extensions/checks"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
@@ -27,37 +28,11 @@ import (
 type Extra primitives.SignedExtra
 
 func (e Extra) AdditionalSigned() (ok primitives.AdditionalSigned, err primitives.TransactionValidityError) {
-	ok = primitives.AdditionalSigned{} // FormatVersion: primitives.ExtrinsicFormatVersion
-
-	specVersion, err := CheckSpecVersion{}.AdditionalSigned()
-	if err != nil {
-		return ok, err
-	}
-	ok.SpecVersion = specVersion
-
-	transactionVersion, err := CheckTxVersion{}.AdditionalSigned()
-	if err != nil {
-		return ok, err
-	}
-	ok.TransactionVersion = transactionVersion
-
-	genesisHash, err := CheckGenesis{}.AdditionalSigned()
-	if err != nil {
-		return ok, err
-	}
-	ok.GenesisHash = genesisHash
-
-	blockHash, err := CheckMortality(e.Era).AdditionalSigned()
-	if err != nil {
-		return ok, err
-	}
-	ok.BlockHash = blockHash
-
-	return ok, err
+	return checks.AdditionalSigned(e.Era)
 }
 
 // Information on a transaction's validity and, if valid, on how it relates to other transactions.
-func (e Extra) Validate(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
+func (e Extra) Validate(who *primitives.AccountId, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
 	valid := primitives.DefaultValidTransaction()
 
 	ok, err = CheckNonZeroAddress(*who).Validate(who, call, info, length)
@@ -70,7 +45,7 @@ func (e Extra) Validate(who *primitives.Address32, call *primitives.Call, info *
 	// TODO: CheckTxVersion<Runtime>
 	// TODO: CheckGenesis<Runtime>
 
-	ok, err = CheckMortality(e.Era).Validate(who, call, info, length)
+	ok, err = checks.CheckMortality(e.Era).Validate(who, call, info, length)
 	if err != nil {
 		return ok, err
 	}
@@ -88,7 +63,7 @@ func (e Extra) Validate(who *primitives.Address32, call *primitives.Call, info *
 	}
 	valid = valid.CombineWith(ok)
 
-	ok, err = transaction_payment.ChargeTransactionPayment(e.Fee).Validate(who, call, info, length)
+	ok, err = e.chargeAssetTxPayment().Validate(who, call, info, length)
 	if err != nil {
 		return ok, err
 	}
@@ -112,7 +87,7 @@ func (e Extra) ValidateUnsigned(call *primitives.Call, info *primitives.Dispatch
 // Do any pre-flight stuff for a signed transaction.
 //
 // Make sure to perform the same checks as in [`Validate`].
-func (e Extra) PreDispatch(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
+func (e Extra) PreDispatch(who *primitives.AccountId, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
 	_, err = CheckNonZeroAddress(*who).PreDispatch(who, call, info, length)
 	if err != nil {
 		return ok, err
@@ -122,7 +97,7 @@ func (e Extra) PreDispatch(who *primitives.Address32, call *primitives.Call, inf
 	// TODO: CheckTxVersion<Runtime>
 	// TODO: CheckGenesis<Runtime>
 
-	_, err = CheckMortality(e.Era).PreDispatch(who, call, info, length)
+	_, err = checks.CheckMortality(e.Era).PreDispatch(who, call, info, length)
 	if err != nil {
 		return ok, err
 	}
@@ -137,7 +112,7 @@ func (e Extra) PreDispatch(who *primitives.Address32, call *primitives.Call, inf
 		return ok, err
 	}
 
-	pre, err := transaction_payment.ChargeTransactionPayment(e.Fee).PreDispatch(who, call, info, length)
+	pre, err := e.chargeAssetTxPayment().PreDispatch(who, call, info, length)
 	if err != nil {
 		return ok, err
 	}
@@ -153,10 +128,17 @@ func (e Extra) PreDispatchUnsigned(call *primitives.Call, info *primitives.Dispa
 func (e Extra) PostDispatch(pre sc.Option[primitives.Pre], info *primitives.DispatchInfo, postInfo *primitives.PostDispatchInfo, length sc.Compact, result *primitives.DispatchResult) (primitives.Pre, primitives.TransactionValidityError) {
 	_, err := CheckWeight{}.PostDispatch(pre, info, postInfo, length, result)
 
-	_, err = transaction_payment.ChargeTransactionPayment(e.Fee).PostDispatch(pre, info, postInfo, length, result)
+	_, err = e.chargeAssetTxPayment().PostDispatch(pre, info, postInfo, length, result)
 	if err != nil {
 		return primitives.Pre{}, err
 	}
 
 	return primitives.Pre{}, err
 }
+
+func (e Extra) chargeAssetTxPayment() asset_tx_payment.ChargeAssetTxPayment {
+	return asset_tx_payment.ChargeAssetTxPayment{
+		Tip:     e.Fee,
+		AssetId: e.AssetId,
+	}
+}
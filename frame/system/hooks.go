@@ -4,12 +4,12 @@ import (
 	"github.com/LimeChain/gosemble/primitives/types"
 )
 
-func onCreatedAccount(who types.Address32) {
+func onCreatedAccount(who types.AccountId) {
 	// hook on creating new account, currently not used in Substrate
 	//T::OnNewAccount::on_new_account(&who);
 	DepositEvent(NewEventNewAccount(who.FixedSequence))
 }
 
-func onKilledAccount(who types.Address32) {
+func onKilledAccount(who types.AccountId) {
 	DepositEvent(NewEventKilledAccount(who.FixedSequence))
 }
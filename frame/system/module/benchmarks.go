@@ -0,0 +1,28 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/system/dispatchables"
+	"github.com/LimeChain/gosemble/primitives/benchmarking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// remarkMaxLength is the maximum length of a remark, which is the part of the maximum block
+// length available to normal extrinsics.
+const remarkMaxLength = constants.FiveMbPerBlockPerExtrinsic / 4 * 3
+
+func (sm SystemModule) Benchmarks() []benchmarking.Benchmark {
+	return []benchmarking.Benchmark{
+		benchmarking.New("remark", benchmarkRemark, benchmarking.NewComponent("b", 0, remarkMaxLength)),
+	}
+}
+
+func benchmarkRemark(b *benchmarking.B) error {
+	remark := make([]byte, b.Value("b"))
+	caller := benchmarking.Account("caller", 0)
+
+	call := dispatchables.NewRemarkCall(sc.NewVaryingData(sc.BytesToSequenceU8(remark)))
+
+	return b.Dispatch(primitives.NewRuntimeOriginSigned(caller), call)
+}
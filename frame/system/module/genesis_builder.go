@@ -0,0 +1,43 @@
+package module
+
+import (
+	"bytes"
+	"encoding/json"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// hash69 is the hash of the parent of the genesis block, as in Substrate.
+var hash69 = primitives.NewBlake2bHash(sc.BytesToSequenceU8(bytes.Repeat([]byte{69}, 32))...)
+
+type gcSystem struct {
+	// Code is the Wasm code of the runtime.
+	Code primitives.HexBytes `json:"code"`
+}
+
+func (sm SystemModule) CreateDefaultConfig() ([]byte, error) {
+	return json.Marshal(gcSystem{Code: primitives.HexBytes{}})
+}
+
+func (sm SystemModule) BuildConfig(config []byte) error {
+	gc := gcSystem{}
+	if err := json.Unmarshal(config, &gc); err != nil {
+		return err
+	}
+
+	system.StorageSetBlockHash(0, hash69)
+	system.StorageSetParentHash(hash69)
+	system.StorageSetLastRuntimeUpgrade(primitives.LastRuntimeUpgradeInfo{
+		SpecVersion: sc.ToCompact(constants.RuntimeVersion.SpecVersion),
+		SpecName:    constants.RuntimeVersion.SpecName,
+	})
+
+	if len(gc.Code) > 0 {
+		system.StorageSetCode(gc.Code)
+	}
+
+	return nil
+}
@@ -36,7 +36,11 @@ func (sm SystemModule) ValidateUnsigned(_ primitives.TransactionSource, _ primit
 	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
 }
 
-func (sm SystemModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
+func (sm SystemModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"The low-level types, storage and functions of the runtime."}
+}
+
+func (sm SystemModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
 	metadataModule := primitives.MetadataModule{
 		Name: "System",
 		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
@@ -170,7 +174,9 @@ func (sm SystemModule) Metadata() (sc.Sequence[primitives.MetadataType], primiti
 		Index: cs.ModuleIndex,
 	}
 
-	return sm.metadataTypes(), metadataModule
+	registry.Add(sm.metadataTypes()...)
+
+	return metadataModule
 }
 
 func (sm SystemModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
@@ -0,0 +1,24 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageInfo describes the storage items of the module whose values have a maximum encoded length.
+// The sizes of the map entries include their hashed keys.
+func (sm SystemModule) StorageInfo() []primitives.StorageInfo {
+	return []primitives.StorageInfo{
+		system.StorageInfoAccount,
+		primitives.NewStorageValueInfo("System", "ExtrinsicCount", 4),
+		primitives.NewStorageValueInfo("System", "BlockWeight", 48),
+		primitives.NewStorageValueInfo("System", "AllExtrinsicsLen", 4),
+		// Twox64Concat(BlockNumber) ++ Hash
+		primitives.NewStorageMapInfo("System", "BlockHash", sc.NewOption[sc.U32](nil), 12+32),
+		primitives.NewStorageValueInfo("System", "Number", 4),
+		primitives.NewStorageValueInfo("System", "ParentHash", 32),
+		primitives.NewStorageValueInfo("System", "EventCount", 4),
+		primitives.NewStorageValueInfo("System", "ExecutionPhase", 5),
+	}
+}
@@ -0,0 +1,96 @@
+package offchain
+
+import (
+	"bytes"
+	"errors"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/system/extensions/checks"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	offchainprimitives "github.com/LimeChain/gosemble/primitives/offchain"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// extrinsicFormatVersion and extrinsicBitSigned match the encoding of the unchecked extrinsics
+	// decoded by the runtime.
+	extrinsicFormatVersion = sc.U8(4)
+	extrinsicBitSigned     = sc.U8(0b1000_0000)
+)
+
+var (
+	ErrNoLocalAccount     = errors.New("no local account for the key type")
+	ErrAdditionalSigned   = errors.New("the additional signed data is not available")
+	ErrSigningFailed      = errors.New("failed to sign the transaction")
+	ErrSubmissionRejected = errors.New("the transaction was rejected by the pool")
+)
+
+// SendUnsignedTransaction submits `call` to the transaction pool as an unsigned extrinsic.
+// The module of the call must accept it in ValidateUnsigned.
+func SendUnsignedTransaction(call sc.Encodable) error {
+	buffer := &bytes.Buffer{}
+	extrinsicFormatVersion.Encode(buffer)
+	call.Encode(buffer)
+
+	return submit(buffer.Bytes())
+}
+
+// SendSignedTransaction signs `call` with the first key of `keyTypeId` in the local keystore and
+// submits it to the transaction pool, paying the fees from the account of the key. The key is an
+// sr25519 key, or an ecdsa key for Ethereum accounts. Returns the account that signed the transaction.
+func SendSignedTransaction(keyTypeId [4]byte, call sc.Encodable) (types.AccountId, error) {
+	key, signer, ok := localAccount(keyTypeId)
+	if !ok {
+		return types.AccountId{}, ErrNoLocalAccount
+	}
+
+	extra := types.SignedExtra{
+		Era:     types.NewImmortalEra(),
+		Nonce:   system.StorageGetAccount(signer.FixedSequence).Nonce,
+		Fee:     sc.NewU128FromUint64(0),
+		AssetId: sc.NewOption[types.AssetId](nil),
+	}
+
+	// The payload is signed with the additional data of the signed extensions, as in NewSignedPayload.
+	additionalSigned, err := checks.AdditionalSigned(extra.Era)
+	if err != nil {
+		return signer, ErrAdditionalSigned
+	}
+
+	payload := &bytes.Buffer{}
+	call.Encode(payload)
+	extra.Encode(payload)
+	additionalSigned.Encode(payload)
+
+	// Payloads longer than 256 bytes are signed by their hash, as in SignedPayload.UsingEncoded.
+	message := payload.Bytes()
+	if len(message) > 256 {
+		message = hashing.Blake256(message)
+	}
+
+	signature := sign(keyTypeId, key, message)
+	if !signature.HasValue {
+		return signer, ErrSigningFailed
+	}
+
+	buffer := &bytes.Buffer{}
+	(extrinsicFormatVersion | extrinsicBitSigned).Encode(buffer)
+	types.ExtrinsicSignature{
+		Signer:    types.NewMultiAddressId(signer),
+		Signature: signature.Value,
+		Extra:     extra,
+	}.Encode(buffer)
+	call.Encode(buffer)
+
+	return signer, submit(buffer.Bytes())
+}
+
+// submit submits the extrinsic, prefixed with its length, to the transaction pool.
+func submit(extrinsic []byte) error {
+	if !offchainprimitives.SubmitTransaction(sc.BytesToSequenceU8(extrinsic).Bytes()) {
+		return ErrSubmissionRejected
+	}
+
+	return nil
+}
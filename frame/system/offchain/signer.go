@@ -0,0 +1,29 @@
+//go:build !ethereum
+
+package offchain
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/crypto"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// localAccount returns the first sr25519 key of `keyTypeId` in the local keystore and its account.
+func localAccount(keyTypeId [4]byte) (types.PublicKey, types.AccountId, bool) {
+	keys := crypto.ExtCryptoSr25519PublicKeysVersion1(keyTypeId[:])
+	if len(keys) == 0 {
+		return nil, types.AccountId{}, false
+	}
+
+	return keys[0], types.AccountIdFromPublicKey(keys[0]), true
+}
+
+// sign signs `message` with the sr25519 `key` of `keyTypeId`.
+func sign(keyTypeId [4]byte, key types.PublicKey, message []byte) sc.Option[types.MultiSignature] {
+	signature := crypto.ExtCryptoSr25519SignVersion1(keyTypeId[:], sc.FixedSequenceU8ToBytes(key), message)
+	if !signature.HasValue {
+		return sc.NewOption[types.MultiSignature](nil)
+	}
+
+	return sc.NewOption[types.MultiSignature](types.NewMultiSignatureSr25519(types.NewSr25519(signature.Value...)))
+}
@@ -0,0 +1,47 @@
+//go:build ethereum
+
+package offchain
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/crypto"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// localAccount returns the first ecdsa key of `keyTypeId` in the local keystore and its Ethereum account.
+// The keystore holds compressed keys, so the uncompressed key which the account is derived from is
+// recovered from a signature of the key itself.
+func localAccount(keyTypeId [4]byte) (types.PublicKey, types.AccountId, bool) {
+	keys := crypto.ExtCryptoEcdsaPublicKeysVersion1(keyTypeId[:])
+	if len(keys) == 0 {
+		return nil, types.AccountId{}, false
+	}
+
+	key := sc.FixedSequenceU8ToBytes(keys[0])
+	hash := hashing.Keccak256(key)
+
+	signature := crypto.ExtCryptoEcdsaSignPrehashedVersion1(keyTypeId[:], key, hash)
+	if !signature.HasValue {
+		return nil, types.AccountId{}, false
+	}
+
+	publicKey := crypto.ExtCryptoSecp256k1EcdsaRecoverVersion2(sc.FixedSequenceU8ToBytes(signature.Value), hash)
+	if !publicKey.HasValue {
+		return nil, types.AccountId{}, false
+	}
+
+	address := types.EthereumAddress(sc.FixedSequenceU8ToBytes(publicKey.Value))
+
+	return keys[0], types.NewAccountId(sc.BytesToSequenceU8(address)...), true
+}
+
+// sign signs the keccak 256 hash of `message` with the ecdsa `key` of `keyTypeId`.
+func sign(keyTypeId [4]byte, key types.PublicKey, message []byte) sc.Option[types.MultiSignature] {
+	signature := crypto.ExtCryptoEcdsaSignPrehashedVersion1(keyTypeId[:], sc.FixedSequenceU8ToBytes(key), hashing.Keccak256(message))
+	if !signature.HasValue {
+		return sc.NewOption[types.MultiSignature](nil)
+	}
+
+	return sc.NewOption[types.MultiSignature](types.NewMultiSignatureEthereum(types.NewEthereumSignature(signature.Value...)))
+}
@@ -0,0 +1,81 @@
+package system
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// BaseCallFilter is consulted before dispatching any call with a non-root origin.
+// Calls it rejects fail with `CallFiltered`. By default, all calls are allowed.
+var BaseCallFilter types.CallFilter = func(call types.Call) bool {
+	return true
+}
+
+// EnsureRoot ensures that the origin is root.
+type EnsureRoot struct{}
+
+func (_ EnsureRoot) EnsureOrigin(origin types.RuntimeOrigin) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return nil
+}
+
+// EnsureSigned ensures that the origin is signed by any account.
+type EnsureSigned struct{}
+
+func (_ EnsureSigned) EnsureOrigin(origin types.RuntimeOrigin) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return nil
+}
+
+// EnsureSignedBy ensures that the origin is signed by one of the accounts returned by `Members`.
+type EnsureSignedBy struct {
+	Members func() sc.Sequence[types.AccountId]
+}
+
+func (e EnsureSignedBy) EnsureOrigin(origin types.RuntimeOrigin) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	who := origin.AsSigned()
+	for _, member := range e.Members() {
+		if reflect.DeepEqual(member, who) {
+			return nil
+		}
+	}
+
+	return types.NewDispatchErrorBadOrigin()
+}
+
+// EnsureNone ensures that the origin is none, i.e. the call is an inherent or an unsigned transaction.
+type EnsureNone struct{}
+
+func (_ EnsureNone) EnsureOrigin(origin types.RuntimeOrigin) types.DispatchError {
+	if !origin.IsNoneOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return nil
+}
+
+// EnsureSignedOrRoot ensures that the origin represents either a signed extrinsic (i.e. transaction) or the root.
+// Returns the account that signed the extrinsic, `None` if it was root, or an error otherwise.
+func EnsureSignedOrRoot(origin types.RuntimeOrigin) (sc.Option[types.AccountId], types.DispatchError) {
+	if origin.IsRootOrigin() {
+		return sc.NewOption[types.AccountId](nil), nil
+	}
+
+	if origin.IsSignedOrigin() {
+		return sc.NewOption[types.AccountId](origin.AsSigned()), nil
+	}
+
+	return sc.NewOption[types.AccountId](nil), types.NewDispatchErrorBadOrigin()
+}
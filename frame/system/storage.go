@@ -56,6 +56,10 @@ func StorageSetExtrinsicCount(extrinsicIndex sc.U32) {
 	storage.Set(append(systemHash, extrinsicCountHash...), extrinsicIndex.Bytes())
 }
 
+// StorageInfoAccount describes the `Account` map, whose entries are
+// Blake2_128Concat(AccountId) ++ AccountInfo.
+var StorageInfoAccount = types.NewStorageMapInfo("System", "Account", sc.NewOption[sc.U32](nil), 48+80)
+
 func StorageGetAccount(who types.PublicKey) types.AccountInfo {
 	systemHash := hashing.Twox128(constants.KeySystem)
 	accountHash := hashing.Twox128(constants.KeyAccount)
@@ -82,6 +86,19 @@ func StorageSetAccount(who types.PublicKey, account types.AccountInfo) {
 	storage.Set(key, account.Bytes())
 }
 
+func StorageClearAccount(who types.PublicKey) {
+	systemHash := hashing.Twox128(constants.KeySystem)
+	accountHash := hashing.Twox128(constants.KeyAccount)
+
+	whoBytes := sc.FixedSequenceU8ToBytes(who)
+
+	key := append(systemHash, accountHash...)
+	key = append(key, hashing.Blake128(whoBytes)...)
+	key = append(key, whoBytes...)
+
+	storage.Clear(key)
+}
+
 // Map of block numbers to block hashes.
 func StorageGetBlockHash(blockNumber sc.U32) types.Blake2bHash {
 	// Module prefix
@@ -251,3 +268,14 @@ func StorageSetParentHash(parentHash types.Blake2bHash) {
 	parentHashHash := hashing.Twox128(constants.KeyParentHash)
 	storage.Set(append(systemHash, parentHashHash...), parentHash.Bytes())
 }
+
+// StorageSetCode sets the Wasm code of the runtime.
+func StorageSetCode(code []byte) {
+	storage.Set(constants.KeyCode, code)
+}
+
+func StorageSetLastRuntimeUpgrade(info types.LastRuntimeUpgradeInfo) {
+	systemHash := hashing.Twox128(constants.KeySystem)
+	lastRuntimeUpgradeHash := hashing.Twox128(constants.KeyLastRuntimeUpgrade)
+	storage.Set(append(systemHash, lastRuntimeUpgradeHash...), info.Bytes())
+}
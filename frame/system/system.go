@@ -40,7 +40,7 @@ func Finalize() types.Header {
 	}
 
 	buf := &bytes.Buffer{}
-	extrinsicsRootBytes := trie.Blake2256OrderedRoot(append(sc.ToCompact(uint64(extrinsicCount)).Bytes(), extrinsics...), constants.StorageVersion)
+	extrinsicsRootBytes := trie.Blake2256OrderedRoot(append(sc.ToCompact(uint64(extrinsicCount)).Bytes(), extrinsics...), constants.ExtrinsicsRootStateVersion)
 	buf.Write(extrinsicsRootBytes)
 	extrinsicsRoot := types.DecodeH256(buf)
 	buf.Reset()
@@ -138,7 +138,7 @@ func NoteAppliedExtrinsic(r *types.DispatchResultWithPostInfo[types.PostDispatch
 	storage.Set(append(keySystemHash, keyExecutionPhaseHash...), types.NewExtrinsicPhaseApply(nextExtrinsicIndex).Bytes())
 }
 
-func Mutate(who types.Address32, f func(who *types.AccountInfo) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
+func Mutate(who types.AccountId, f func(who *types.AccountInfo) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
 	accountInfo := StorageGetAccount(who.FixedSequence)
 
 	result := f(&accountInfo)
@@ -158,7 +158,7 @@ func Mutate(who types.Address32, f func(who *types.AccountInfo) sc.Result[sc.Enc
 	return result
 }
 
-func TryMutateExists(who types.Address32, f func(who *types.AccountData) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
+func TryMutateExists(who types.AccountId, f func(who *types.AccountData) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
 	account := StorageGetAccount(who.FixedSequence)
 	wasProviding := false
 	if !reflect.DeepEqual(account.Data, types.AccountData{}) {
@@ -207,7 +207,7 @@ func TryMutateExists(who types.Address32, f func(who *types.AccountData) sc.Resu
 	return result
 }
 
-func AccountTryMutateExists(who types.Address32, f func(who *types.AccountInfo) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
+func AccountTryMutateExists(who types.AccountId, f func(who *types.AccountInfo) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
 	account := StorageGetAccount(who.FixedSequence)
 
 	result := f(&account)
@@ -219,7 +219,7 @@ func AccountTryMutateExists(who types.Address32, f func(who *types.AccountInfo)
 	return result
 }
 
-func incProviders(who types.Address32) types.IncRefStatus {
+func incProviders(who types.AccountId) types.IncRefStatus {
 	result := Mutate(who, func(a *types.AccountInfo) sc.Result[sc.Encodable] {
 		if a.Providers == 0 && a.Sufficients == 0 {
 			a.Providers = 1
@@ -246,7 +246,7 @@ func incProviders(who types.Address32) types.IncRefStatus {
 	return result.Value.(types.IncRefStatus)
 }
 
-func decProviders(who types.Address32) (types.DecRefStatus, types.DispatchError) {
+func decProviders(who types.AccountId) (types.DecRefStatus, types.DispatchError) {
 	result := AccountTryMutateExists(who, func(account *types.AccountInfo) sc.Result[sc.Encodable] {
 		if account.Providers == 0 {
 			log.Warn("Logic error: Unexpected underflow in reducing provider")
@@ -282,12 +282,107 @@ func decProviders(who types.Address32) (types.DecRefStatus, types.DispatchError)
 	return result.Value.(types.DecRefStatus), nil
 }
 
-func CanDecProviders(who types.Address32) bool {
+func CanDecProviders(who types.AccountId) bool {
 	acc := StorageGetAccount(who.FixedSequence)
 
 	return acc.Consumers == 0 || acc.Providers > 1
 }
 
+// IncSufficients increments the self-sufficient reference counter on an account.
+// An account with only sufficient references is kept alive, even without any providers.
+func IncSufficients(who types.AccountId) types.IncRefStatus {
+	result := Mutate(who, func(a *types.AccountInfo) sc.Result[sc.Encodable] {
+		if a.Providers == 0 && a.Sufficients == 0 {
+			a.Sufficients = 1
+			onCreatedAccount(who)
+
+			return sc.Result[sc.Encodable]{
+				HasError: false,
+				Value:    types.IncRefStatusCreated,
+			}
+		}
+
+		// saturating_add
+		if a.Sufficients < math.MaxUint32 {
+			a.Sufficients += 1
+		}
+
+		return sc.Result[sc.Encodable]{
+			HasError: false,
+			Value:    types.IncRefStatusExisted,
+		}
+	})
+
+	return result.Value.(types.IncRefStatus)
+}
+
+// DecSufficients decrements the self-sufficient reference counter on an account.
+// The account is reaped if this was its last reference.
+func DecSufficients(who types.AccountId) types.DecRefStatus {
+	account := StorageGetAccount(who.FixedSequence)
+
+	if account.Sufficients == 0 {
+		log.Warn("Logic error: Unexpected underflow in reducing sufficients")
+	}
+
+	if account.Providers == 0 && account.Sufficients <= 1 {
+		StorageClearAccount(who.FixedSequence)
+		onKilledAccount(who)
+
+		return types.DecRefStatusReaped
+	}
+
+	if account.Sufficients > 0 {
+		account.Sufficients -= 1
+	}
+	StorageSetAccount(who.FixedSequence, account)
+
+	return types.DecRefStatusExists
+}
+
+// IncConsumers increments the reference counter on an account.
+// Fails if the account has no providers.
+func IncConsumers(who types.AccountId) types.DispatchError {
+	result := Mutate(who, func(a *types.AccountInfo) sc.Result[sc.Encodable] {
+		if a.Providers == 0 {
+			return sc.Result[sc.Encodable]{
+				HasError: true,
+				Value:    types.NewDispatchErrorNoProviders(),
+			}
+		}
+
+		if a.Consumers == math.MaxUint32 {
+			return sc.Result[sc.Encodable]{
+				HasError: true,
+				Value:    types.NewDispatchErrorTooManyConsumers(),
+			}
+		}
+
+		a.Consumers += 1
+
+		return sc.Result[sc.Encodable]{}
+	})
+
+	if result.HasError {
+		return result.Value.(types.DispatchError)
+	}
+
+	return nil
+}
+
+// DecConsumers decrements the reference counter on an account.
+func DecConsumers(who types.AccountId) {
+	Mutate(who, func(a *types.AccountInfo) sc.Result[sc.Encodable] {
+		if a.Consumers > 0 {
+			a.Consumers -= 1
+		} else {
+			log.Warn("Logic error: Unexpected underflow in reducing consumer")
+		}
+
+		return sc.Result[sc.Encodable]{}
+	})
+}
+
 // RegisterExtraWeightUnchecked - Inform the system pallet of some additional weight that should be accounted for, in the
 // current block.
 //
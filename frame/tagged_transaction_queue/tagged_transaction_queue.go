@@ -1,41 +1,32 @@
 package tagged_transaction_queue
 
 import (
-	"bytes"
-
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/execution/types"
 	"github.com/LimeChain/gosemble/frame/executive"
+	"github.com/LimeChain/gosemble/primitives/api"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
-	"github.com/LimeChain/gosemble/utils"
 )
 
-type TaggedTransactionQueue interface {
-	ValidateTransaction(dataPtr int32, dataLen int32) int64
-}
+// Api is the `TaggedTransactionQueue` runtime API, used by the transaction queue to validate transactions.
+var Api = api.New("TaggedTransactionQueue", 3, "The `TaggedTransactionQueue` api trait for interfering with the transaction queue.",
+	api.NewMethod3("validate_transaction",
+		api.NewArg("source", primitives.TypeId(metadata.TypesTransactionSource), primitives.DecodeTransactionSource),
+		api.NewArg("tx", primitives.TypeId(metadata.UncheckedExtrinsic), types.DecodeUncheckedExtrinsic),
+		api.NewArg("block_hash", primitives.TypeId(metadata.TypesH256), primitives.DecodeBlake2bHash),
+		primitives.TypeId(metadata.TypesTransactionValidity),
+		"Validate the transaction.",
+		ValidateTransaction),
+)
 
 // ValidateTransaction validates an extrinsic at a given block.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded tx source, extrinsic and block hash.
-// Returns a pointer-size of the SCALE-encoded result whether the extrinsic is valid.
+// Returns the result whether the extrinsic is valid.
 // [Specification](https://spec.polkadot.network/#sect-rte-validate-transaction)
-func ValidateTransaction(dataPtr int32, dataLen int32) int64 {
-	data := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(data)
-
-	txSource := primitives.DecodeTransactionSource(buffer)
-	tx := types.DecodeUncheckedExtrinsic(buffer)
-	blockHash := primitives.DecodeBlake2bHash(buffer)
-
+func ValidateTransaction(txSource primitives.TransactionSource, tx types.UncheckedExtrinsic, blockHash primitives.Blake2bHash) primitives.TransactionValidityResult {
 	ok, err := executive.ValidateTransaction(txSource, tx, blockHash)
-
-	var res primitives.TransactionValidityResult
 	if err != nil {
-		res = primitives.NewTransactionValidityResult(err)
-	} else {
-		res = primitives.NewTransactionValidityResult(ok)
+		return primitives.NewTransactionValidityResult(err)
 	}
 
-	return utils.BytesToOffsetAndSize(res.Bytes())
+	return primitives.NewTransactionValidityResult(ok)
 }
@@ -65,7 +65,7 @@ func (_ TestCall) IsInherent() bool {
 }
 
 func (_ TestCall) WeightInfo(baseWeight types.Weight) types.Weight {
-	return types.WeightFromParts(baseWeight.RefTime, 0)
+	return baseWeight
 }
 
 func (_ TestCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
@@ -3,6 +3,7 @@ package module
 import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants/testable"
+	pallet "github.com/LimeChain/gosemble/frame/testable"
 	"github.com/LimeChain/gosemble/frame/testable/dispatchables"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
@@ -32,9 +33,17 @@ func (tm TestableModule) ValidateUnsigned(_ primitives.TransactionSource, _ prim
 	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
 }
 
-func (tm TestableModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
+func (tm TestableModule) OffchainWorker(n primitives.BlockNumber) {
+	pallet.OffchainWorker(n)
+}
+
+func (tm TestableModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"Calls for testing the storage and transactional behaviour of the runtime."}
+}
+
+func (tm TestableModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
 	// TODO: types
-	return sc.Sequence[primitives.MetadataType]{}, primitives.MetadataModule{
+	return primitives.MetadataModule{
 		Name:      "Testable",
 		Storage:   sc.Option[primitives.MetadataModuleStorage]{},
 		Call:      sc.NewOption[sc.Compact](nil),
@@ -0,0 +1,27 @@
+package testable
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/testable"
+	"github.com/LimeChain/gosemble/frame/system/dispatchables"
+	"github.com/LimeChain/gosemble/frame/system/offchain"
+	"github.com/LimeChain/gosemble/primitives/log"
+	offchainprimitives "github.com/LimeChain/gosemble/primitives/offchain"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OffchainWorker submits the remark in the persistent offchain storage as a signed transaction
+// of the local account, so that the signed submissions can be tested end to end.
+func OffchainWorker(_ types.BlockNumber) {
+	remark := offchainprimitives.LocalStorageGet(types.OffchainStorageKindPersistent, testable.OffchainRemarkKey)
+	if !remark.HasValue {
+		return
+	}
+
+	offchainprimitives.LocalStorageClear(types.OffchainStorageKindPersistent, testable.OffchainRemarkKey)
+
+	call := dispatchables.NewRemarkCall(sc.NewVaryingData(remark.Value))
+	if _, err := offchain.SendSignedTransaction(testable.OffchainKeyTypeId, call); err != nil {
+		log.Warn("failed to submit remark: " + err.Error())
+	}
+}
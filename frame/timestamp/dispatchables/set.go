@@ -7,6 +7,7 @@ import (
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/timestamp"
 	"github.com/LimeChain/gosemble/frame/aura"
+	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/log"
 	"github.com/LimeChain/gosemble/primitives/storage"
@@ -57,6 +58,16 @@ func (c SetCall) Args() sc.VaryingData {
 	return c.Callable.Args()
 }
 
+func (_ SetCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "set",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("now", "T::Moment", primitives.NewCompactTypeInfo(primitives.TypeInfoU64)),
+		},
+		Docs: "Set the current time.",
+	}
+}
+
 func (_ SetCall) BaseWeight(b ...any) primitives.Weight {
 	// Storage: Timestamp Now (r:1 w:1)
 	// Proof: Timestamp Now (max_values: Some(1), max_size: Some(8), added: 503, mode: MaxEncodedLen)
@@ -77,7 +88,7 @@ func (_ SetCall) IsInherent() bool {
 }
 
 func (_ SetCall) WeightInfo(baseWeight primitives.Weight) primitives.Weight {
-	return primitives.WeightFromParts(baseWeight.RefTime, 0)
+	return baseWeight
 }
 
 func (_ SetCall) ClassifyDispatch(baseWeight primitives.Weight) primitives.DispatchClass {
@@ -109,11 +120,12 @@ func (_ SetCall) Dispatch(origin primitives.RuntimeOrigin, args sc.VaryingData)
 //     `on_finalize`)
 //   - 1 event handler `on_timestamp_set`. Must be `O(1)`.
 func set(origin primitives.RuntimeOrigin, now sc.U64) primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo] {
-	if !origin.IsNoneOrigin() {
+	err := system.EnsureNone{}.EnsureOrigin(origin)
+	if err != nil {
 		return primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo]{
 			HasError: true,
 			Err: primitives.DispatchErrorWithPostInfo[primitives.PostDispatchInfo]{
-				Error: primitives.NewDispatchErrorBadOrigin(),
+				Error: err,
 			},
 		}
 	}
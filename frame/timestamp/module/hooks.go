@@ -1,4 +1,4 @@
-package timestamp
+package module
 
 import (
 	"github.com/LimeChain/gosemble/constants"
@@ -7,7 +7,8 @@ import (
 	"github.com/LimeChain/gosemble/primitives/storage"
 )
 
-func OnFinalize() {
+// onFinalize checks that the timestamp was set in the block and resets the check for the next block.
+func onFinalize() {
 	timestampHash := hashing.Twox128(constants.KeyTimestamp)
 	didUpdateHash := hashing.Twox128(constants.KeyDidUpdate)
 
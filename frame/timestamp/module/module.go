@@ -2,7 +2,6 @@ package module
 
 import (
 	sc "github.com/LimeChain/goscale"
-	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/constants/timestamp"
 	ts "github.com/LimeChain/gosemble/constants/timestamp"
 	"github.com/LimeChain/gosemble/frame/timestamp/dispatchables"
@@ -34,8 +33,17 @@ func (tm TimestampModule) ValidateUnsigned(_ primitives.TransactionSource, _ pri
 	return primitives.DefaultValidTransaction(), nil
 }
 
-func (tm TimestampModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
-	return tm.metadataTypes(), primitives.MetadataModule{
+// OnFinalize checks that the timestamp was set in the block.
+func (tm TimestampModule) OnFinalize(_ primitives.BlockNumber) {
+	onFinalize()
+}
+
+func (tm TimestampModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"The on-chain time, set by an inherent in each block."}
+}
+
+func (tm TimestampModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
 		Name: "Timestamp",
 		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
 			Prefix: "Timestamp",
@@ -43,21 +51,21 @@ func (tm TimestampModule) Metadata() (sc.Sequence[primitives.MetadataType], prim
 				primitives.NewMetadataModuleStorageEntry(
 					"Now",
 					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU64)),
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(primitives.TypeInfoU64))),
 					"Current time for the current block."),
 				primitives.NewMetadataModuleStorageEntry(
 					"DidUpdate",
 					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesBool)),
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(primitives.TypeInfoBool))),
 					"Did the timestamp get updated in this block?"),
 			},
 		}),
-		Call:  sc.NewOption[sc.Compact](sc.ToCompact(metadata.TimestampCalls)),
+		Call:  sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(tm.callsTypeInfo()))),
 		Event: sc.NewOption[sc.Compact](nil),
 		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
 			primitives.NewMetadataModuleConstant(
 				"MinimumPeriod",
-				sc.ToCompact(metadata.PrimitiveTypesU64),
+				sc.ToCompact(registry.Register(primitives.TypeInfoU64)),
 				sc.BytesToSequenceU8(sc.U64(ts.MinimumPeriod).Bytes()),
 				"The minimum period between blocks. Beware that this is different to the *expected*  period that the block production apparatus provides.",
 			),
@@ -67,17 +75,6 @@ func (tm TimestampModule) Metadata() (sc.Sequence[primitives.MetadataType], prim
 	}
 }
 
-func (tm TimestampModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
-	return sc.Sequence[primitives.MetadataType]{
-		primitives.NewMetadataTypeWithParam(metadata.TimestampCalls, "Timestamp calls", sc.Sequence[sc.Str]{"pallet_timestamp", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
-			sc.Sequence[primitives.MetadataDefinitionVariant]{
-				primitives.NewMetadataDefinitionVariant(
-					"set",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU64, "now", "T::Moment"),
-					},
-					timestamp.FunctionSetIndex,
-					"Set the current time."),
-			}), primitives.NewMetadataEmptyTypeParameter("T")),
-	}
+func (tm TimestampModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_timestamp", "pallet", "Call"}, tm.functions)
 }
@@ -17,7 +17,7 @@ func (ctp ChargeTransactionPayment) AdditionalSigned() (ok sc.Empty, err primiti
 	return sc.Empty{}, nil
 }
 
-func (ctp ChargeTransactionPayment) Validate(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+func (ctp ChargeTransactionPayment) Validate(who *primitives.AccountId, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (primitives.ValidTransaction, primitives.TransactionValidityError) {
 	finalFee, _, err := ctp.withdrawFee(who, call, info, length)
 	if err != nil {
 		return primitives.ValidTransaction{}, err
@@ -25,12 +25,12 @@ func (ctp ChargeTransactionPayment) Validate(who *primitives.Address32, call *pr
 
 	tip := primitives.Balance(ctp)
 	validTransaction := primitives.DefaultValidTransaction()
-	validTransaction.Priority = ctp.getPriority(info, length, tip, finalFee)
+	validTransaction.Priority = ctp.GetPriority(info, length, tip, finalFee)
 
 	return validTransaction, nil
 }
 
-func (ctp ChargeTransactionPayment) PreDispatch(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
+func (ctp ChargeTransactionPayment) PreDispatch(who *primitives.AccountId, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
 	_, imbalance, err := ctp.withdrawFee(who, call, info, length)
 	return primitives.Pre{
 		Tip:       primitives.Balance(ctp),
@@ -42,7 +42,7 @@ func (ctp ChargeTransactionPayment) PreDispatch(who *primitives.Address32, call
 func (ctp ChargeTransactionPayment) PostDispatch(pre sc.Option[primitives.Pre], info *primitives.DispatchInfo, postInfo *primitives.PostDispatchInfo, length sc.Compact, result *primitives.DispatchResult) (primitives.Pre, primitives.TransactionValidityError) {
 	if pre.HasValue {
 		preValue := pre.Value
-		actualFee := computeActualFee(sc.U32(length.ToBigInt().Uint64()), *info, *postInfo, preValue.Tip)
+		actualFee := ComputeActualFee(sc.U32(length.ToBigInt().Uint64()), *info, *postInfo, preValue.Tip)
 		err := correctAndDepositFee(&preValue.Who, actualFee, preValue.Tip, preValue.Imbalance)
 		if err != nil {
 			return primitives.Pre{}, err
@@ -53,21 +53,11 @@ func (ctp ChargeTransactionPayment) PostDispatch(pre sc.Option[primitives.Pre],
 	return primitives.Pre{}, nil
 }
 
-func (ctp ChargeTransactionPayment) getPriority(info *primitives.DispatchInfo, len sc.Compact, tip primitives.Balance, finalFee primitives.Balance) primitives.TransactionPriority {
-	maxBlockWeight := system.DefaultBlockWeights().MaxBlock.RefTime
+func (ctp ChargeTransactionPayment) GetPriority(info *primitives.DispatchInfo, len sc.Compact, tip primitives.Balance, finalFee primitives.Balance) primitives.TransactionPriority {
+	maxBlockWeight := system.DefaultBlockWeights().MaxBlock
 	maxDefaultBlockLength := system.DefaultBlockLength().Max
 	maxBlockLength := sc.U64(*maxDefaultBlockLength.Get(info.Class))
 
-	infoWeight := info.Weight.RefTime
-
-	// info_weight.clamp(1, max_block_weight);
-	boundedWeight := infoWeight
-	if boundedWeight < 1 {
-		boundedWeight = 1
-	} else if boundedWeight > maxBlockWeight {
-		boundedWeight = maxBlockWeight
-	}
-
 	// (len as u64).clamp(1, max_block_length);
 	boundedLength := sc.U64(len.ToBigInt().Uint64())
 	if boundedLength < 1 {
@@ -76,7 +66,9 @@ func (ctp ChargeTransactionPayment) getPriority(info *primitives.DispatchInfo, l
 		boundedLength = maxBlockLength
 	}
 
-	maxTxPerBlockWeight := maxBlockWeight / boundedWeight
+	// The transactions per block are limited by the dimension of the weight which is exhausted first.
+	maxTxPerBlockWeight := maxTxPerBlockDimension(maxBlockWeight.RefTime, info.Weight.RefTime).
+		Min(maxTxPerBlockDimension(maxBlockWeight.ProofSize, info.Weight.ProofSize))
 	maxTxPerBlockLength := maxBlockLength / boundedLength
 
 	maxTxPerBlock := maxTxPerBlockWeight
@@ -105,9 +97,23 @@ func (ctp ChargeTransactionPayment) getPriority(info *primitives.DispatchInfo, l
 	return 0
 }
 
-func (ctp ChargeTransactionPayment) withdrawFee(who *primitives.Address32, _call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (primitives.Balance, sc.Option[primitives.Balance], primitives.TransactionValidityError) {
+// maxTxPerBlockDimension returns how many transactions of `weight` fit in `maxBlockWeight`, for
+// a single dimension of the weight.
+func maxTxPerBlockDimension(maxBlockWeight sc.U64, weight sc.U64) sc.U64 {
+	// weight.clamp(1, max_block_weight);
+	boundedWeight := weight
+	if boundedWeight < 1 {
+		boundedWeight = 1
+	} else if boundedWeight > maxBlockWeight {
+		boundedWeight = maxBlockWeight
+	}
+
+	return maxBlockWeight / boundedWeight
+}
+
+func (ctp ChargeTransactionPayment) withdrawFee(who *primitives.AccountId, _call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (primitives.Balance, sc.Option[primitives.Balance], primitives.TransactionValidityError) {
 	tip := primitives.Balance(ctp)
-	fee := computeFee(sc.U32(length.ToBigInt().Uint64()), *info, tip)
+	fee := ComputeFee(sc.U32(length.ToBigInt().Uint64()), *info, tip)
 
 	imbalance, err := withdrawFee(who, _call, info, fee, sc.NewU128FromBigInt(tip.ToBigInt()))
 	if err != nil {
@@ -117,7 +123,7 @@ func (ctp ChargeTransactionPayment) withdrawFee(who *primitives.Address32, _call
 	return fee, imbalance, nil
 }
 
-func withdrawFee(who *primitives.Address32, _call *primitives.Call, _info *primitives.DispatchInfo, fee primitives.Balance, tip primitives.Balance) (sc.Option[primitives.Balance], primitives.TransactionValidityError) {
+func withdrawFee(who *primitives.AccountId, _call *primitives.Call, _info *primitives.DispatchInfo, fee primitives.Balance, tip primitives.Balance) (sc.Option[primitives.Balance], primitives.TransactionValidityError) {
 	if fee.ToBigInt().Cmp(constants.Zero) == 0 {
 		return sc.NewOption[primitives.Balance](nil), nil
 	}
@@ -137,7 +143,7 @@ func withdrawFee(who *primitives.Address32, _call *primitives.Call, _info *primi
 	return sc.NewOption[primitives.Balance](imbalance), nil
 }
 
-func correctAndDepositFee(who *primitives.Address32, correctedFee primitives.Balance, tip primitives.Balance, alreadyWithdrawn sc.Option[primitives.Balance]) primitives.TransactionValidityError {
+func correctAndDepositFee(who *primitives.AccountId, correctedFee primitives.Balance, tip primitives.Balance, alreadyWithdrawn sc.Option[primitives.Balance]) primitives.TransactionValidityError {
 	if alreadyWithdrawn.HasValue {
 		alreadyPaidNegativeImbalance := alreadyWithdrawn.Value
 		refundAmount := new(big.Int).Sub(alreadyPaidNegativeImbalance.ToBigInt(), correctedFee.ToBigInt())
@@ -151,6 +157,16 @@ func correctAndDepositFee(who *primitives.Address32, correctedFee primitives.Bal
 		if comparison < 0 {
 			return primitives.NewTransactionValidityError(primitives.NewInvalidTransactionPayment())
 		}
+
+		// Split the paid amount into the tip and the fee, and hand them over to the configured handler.
+		adjustedPaid := new(big.Int).Sub(alreadyPaidNegativeImbalance.ToBigInt(), refundPositiveImbalance.ToBigInt())
+		paidTip := tip.ToBigInt()
+		if paidTip.Cmp(adjustedPaid) > 0 {
+			paidTip = adjustedPaid
+		}
+		paidFee := new(big.Int).Sub(adjustedPaid, paidTip)
+
+		OnChargeTransaction.OnUnbalanceds(sc.NewU128FromBigInt(paidFee), sc.NewU128FromBigInt(paidTip))
 	}
 	return nil
 }
@@ -26,8 +26,14 @@ func (tpm TransactionPaymentModule) ValidateUnsigned(_ primitives.TransactionSou
 	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
 }
 
-func (tpm TransactionPaymentModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
-	return tpm.metadataTypes(), primitives.MetadataModule{
+func (tpm TransactionPaymentModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"The fee model and payment of transaction fees."}
+}
+
+func (tpm TransactionPaymentModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	registry.Add(tpm.metadataTypes()...)
+
+	return primitives.MetadataModule{
 		Name: "TransactionPayment",
 		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
 			Prefix: "TransactionPayment",
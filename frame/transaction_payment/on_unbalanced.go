@@ -0,0 +1,73 @@
+package transaction_payment
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/transaction_payment"
+	"github.com/LimeChain/gosemble/frame/authorship"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/treasury"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OnUnbalanced handles the funds withdrawn from the sender of a transaction,
+// once the actual fee is known and the excess has been refunded.
+type OnUnbalanced interface {
+	OnUnbalanceds(fee primitives.Balance, tip primitives.Balance)
+}
+
+// OnChargeTransaction is the handler of the paid transaction fees and tips.
+var OnChargeTransaction OnUnbalanced = DealWithFees{
+	Treasury:       treasury.OnUnbalanced,
+	Author:         authorship.OnUnbalanced,
+	FeesToTreasury: transaction_payment.FeesToTreasury,
+	TipsToTreasury: transaction_payment.TipsToTreasury,
+}
+
+// BurnFees burns both the fee and the tip, decreasing the total issuance.
+type BurnFees struct{}
+
+func (_ BurnFees) OnUnbalanceds(fee primitives.Balance, tip primitives.Balance) {
+	burn(new(big.Int).Add(fee.ToBigInt(), tip.ToBigInt()))
+}
+
+// DealWithFees splits the fee and the tip between the treasury and the block author.
+// Any part without a destination is burned.
+type DealWithFees struct {
+	Treasury       func(amount primitives.Balance)
+	Author         func(amount primitives.Balance)
+	FeesToTreasury primitives.Permill
+	TipsToTreasury primitives.Permill
+}
+
+func (d DealWithFees) OnUnbalanceds(fee primitives.Balance, tip primitives.Balance) {
+	toTreasury := new(big.Int).Add(d.FeesToTreasury.MulFloor(fee.ToBigInt()), d.TipsToTreasury.MulFloor(tip.ToBigInt()))
+	total := new(big.Int).Add(fee.ToBigInt(), tip.ToBigInt())
+	toAuthor := new(big.Int).Sub(total, toTreasury)
+
+	deal(d.Treasury, toTreasury)
+	deal(d.Author, toAuthor)
+}
+
+func deal(destination func(amount primitives.Balance), amount *big.Int) {
+	if amount.Cmp(constants.Zero) == 0 {
+		return
+	}
+
+	if destination == nil {
+		burn(amount)
+		return
+	}
+
+	destination(sc.NewU128FromBigInt(amount))
+}
+
+func burn(amount *big.Int) {
+	if amount.Cmp(constants.Zero) == 0 {
+		return
+	}
+
+	dispatchables.NewNegativeImbalance(sc.NewU128FromBigInt(amount)).Drop()
+}
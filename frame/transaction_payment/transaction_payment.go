@@ -1,127 +1,109 @@
 package transaction_payment
 
 import (
-	"bytes"
 	"math/big"
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/execution/types"
 	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/api"
 	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/storage"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
-	"github.com/LimeChain/gosemble/utils"
 )
 
 var DefaultMultiplierValue = sc.NewU128FromUint64(1)
 var DefaultTip = sc.NewU128FromUint64(0)
 
-// QueryInfo queries the data of an extrinsic.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded extrinsic and its length.
-// Returns a pointer-size of the SCALE-encoded weight, dispatch class and partial fee.
-// [Specification](https://spec.polkadot.network/chap-runtime-api#sect-rte-transactionpaymentapi-query-info)
-func QueryInfo(dataPtr int32, dataLen int32) int64 {
-	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(b)
+// Api is the `TransactionPaymentApi` runtime API, which queries the fees of extrinsics.
+var Api = api.New("TransactionPaymentApi", 3, "The API to query the fees of extrinsics.",
+	api.NewMethod2("query_info",
+		api.NewArg("uxt", primitives.TypeId(metadata.UncheckedExtrinsic), types.DecodeUncheckedExtrinsic),
+		api.NewArg("len", primitives.TypeInfoU32, sc.DecodeU32),
+		primitives.TypeId(metadata.TypesRuntimeDispatchInfo),
+		"Query the dispatch info of an extrinsic.",
+		QueryInfo),
+	api.NewMethod2("query_fee_details",
+		api.NewArg("uxt", primitives.TypeId(metadata.UncheckedExtrinsic), types.DecodeUncheckedExtrinsic),
+		api.NewArg("len", primitives.TypeInfoU32, sc.DecodeU32),
+		primitives.TypeId(metadata.TypesFeeDetails),
+		"Query the fee details of an extrinsic.",
+		QueryFeeDetails),
+)
 
-	ext := types.DecodeUncheckedExtrinsic(buffer)
-	length := sc.DecodeU32(buffer)
+// CallApi is the `TransactionPaymentCallApi` runtime API, which queries the fees of calls.
+var CallApi = api.New("TransactionPaymentCallApi", 3, "The API to query the fees of calls.",
+	api.NewMethod2("query_call_info",
+		api.NewArg("call", primitives.TypeId(metadata.RuntimeCall), types.DecodeCall),
+		api.NewArg("len", primitives.TypeInfoU32, sc.DecodeU32),
+		primitives.TypeId(metadata.TypesRuntimeDispatchInfo),
+		"Query information of a dispatch class, weight, and fee of a given encoded `Call`.",
+		QueryCallInfo),
+	api.NewMethod2("query_call_fee_details",
+		api.NewArg("call", primitives.TypeId(metadata.RuntimeCall), types.DecodeCall),
+		api.NewArg("len", primitives.TypeInfoU32, sc.DecodeU32),
+		primitives.TypeId(metadata.TypesFeeDetails),
+		"Query fee details of a given encoded `Call`.",
+		QueryCallFeeDetails),
+)
 
+// QueryInfo queries the data of an extrinsic with the given length.
+// Returns the weight, dispatch class and partial fee of the extrinsic.
+// [Specification](https://spec.polkadot.network/chap-runtime-api#sect-rte-transactionpaymentapi-query-info)
+func QueryInfo(ext types.UncheckedExtrinsic, length sc.U32) primitives.RuntimeDispatchInfo {
 	dispatchInfo := primitives.GetDispatchInfo(ext.Function)
 
 	partialFee := sc.NewU128FromUint64(0)
 	if ext.IsSigned() {
-		partialFee = computeFee(length, dispatchInfo, DefaultTip)
+		partialFee = ComputeFee(length, dispatchInfo, DefaultTip)
 	}
 
-	runtimeDispatchInfo := primitives.RuntimeDispatchInfo{
+	return primitives.RuntimeDispatchInfo{
 		Weight:     dispatchInfo.Weight,
 		Class:      dispatchInfo.Class,
 		PartialFee: partialFee,
 	}
-
-	return utils.BytesToOffsetAndSize(runtimeDispatchInfo.Bytes())
 }
 
-// QueryFeeDetails queries the detailed fee of an extrinsic.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded extrinsic and its length.
-// Returns a pointer-size of the SCALE-encoded detailed fee.
+// QueryFeeDetails queries the detailed fee of an extrinsic with the given length.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#sect-rte-transactionpaymentapi-query-fee-details)
-func QueryFeeDetails(dataPtr int32, dataLen int32) int64 {
-	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(b)
-
-	ext := types.DecodeUncheckedExtrinsic(buffer)
-	length := sc.DecodeU32(buffer)
-
+func QueryFeeDetails(ext types.UncheckedExtrinsic, length sc.U32) primitives.FeeDetails {
 	dispatchInfo := primitives.GetDispatchInfo(ext.Function)
 
-	var feeDetails primitives.FeeDetails
-	if ext.IsSigned() {
-		feeDetails = computeFeeDetails(length, dispatchInfo, DefaultTip)
-	} else {
-		feeDetails = primitives.FeeDetails{
+	if !ext.IsSigned() {
+		return primitives.FeeDetails{
 			InclusionFee: sc.NewOption[primitives.InclusionFee](nil),
 		}
 	}
 
-	return utils.BytesToOffsetAndSize(feeDetails.Bytes())
+	return computeFeeDetails(length, dispatchInfo, DefaultTip)
 }
 
-// QueryCallInfo queries the data of a dispatch call.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded dispatch call and its length.
-// Returns a pointer-size of the SCALE-encoded weight, dispatch class and partial fee.
+// QueryCallInfo queries the data of a dispatch call with the given length.
+// Returns the weight, dispatch class and partial fee of the call.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#sect-rte-transactionpaymentcallapi-query-call-info)
-func QueryCallInfo(dataPtr int32, dataLen int32) int64 {
-	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(b)
-
-	call := types.DecodeCall(buffer)
-	length := sc.DecodeU32(buffer)
-
+func QueryCallInfo(call primitives.Call, length sc.U32) primitives.RuntimeDispatchInfo {
 	dispatchInfo := primitives.GetDispatchInfo(call)
-	partialFee := computeFee(length, dispatchInfo, DefaultTip)
+	partialFee := ComputeFee(length, dispatchInfo, DefaultTip)
 
-	runtimeDispatchInfo := primitives.RuntimeDispatchInfo{
+	return primitives.RuntimeDispatchInfo{
 		Weight:     dispatchInfo.Weight,
 		Class:      dispatchInfo.Class,
 		PartialFee: partialFee,
 	}
-
-	return utils.BytesToOffsetAndSize(runtimeDispatchInfo.Bytes())
 }
 
-// QueryCallFeeDetails queries the detailed fee of a dispatch call.
-// It takes two arguments:
-// - dataPtr: Pointer to the data in the Wasm memory.
-// - dataLen: Length of the data.
-// which represent the SCALE-encoded dispatch call and its length.
-// Returns a pointer-size of the SCALE-encoded detailed fee.
+// QueryCallFeeDetails queries the detailed fee of a dispatch call with the given length.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#sect-rte-transactionpaymentcallapi-query-call-fee-details)
-func QueryCallFeeDetails(dataPtr int32, dataLen int32) int64 {
-	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
-	buffer := bytes.NewBuffer(b)
-
-	call := types.DecodeCall(buffer)
-	length := sc.DecodeU32(buffer)
-
+func QueryCallFeeDetails(call primitives.Call, length sc.U32) primitives.FeeDetails {
 	dispatchInfo := primitives.GetDispatchInfo(call)
-	feeDetails := computeFeeDetails(length, dispatchInfo, DefaultTip)
 
-	return utils.BytesToOffsetAndSize(feeDetails.Bytes())
+	return computeFeeDetails(length, dispatchInfo, DefaultTip)
 }
 
-func computeFee(len sc.U32, info primitives.DispatchInfo, tip primitives.Balance) primitives.Balance {
+func ComputeFee(len sc.U32, info primitives.DispatchInfo, tip primitives.Balance) primitives.Balance {
 	return computeFeeDetails(len, info, tip).FinalFee()
 }
 
@@ -129,7 +111,7 @@ func computeFeeDetails(len sc.U32, info primitives.DispatchInfo, tip primitives.
 	return computeFeeRaw(len, info.Weight, tip, info.PaysFee, info.Class)
 }
 
-func computeActualFee(len sc.U32, info primitives.DispatchInfo, postInfo primitives.PostDispatchInfo, tip primitives.Balance) primitives.Balance {
+func ComputeActualFee(len sc.U32, info primitives.DispatchInfo, postInfo primitives.PostDispatchInfo, tip primitives.Balance) primitives.Balance {
 	return computeActualFeeDetails(len, info, postInfo, tip).FinalFee()
 }
 
@@ -167,10 +149,26 @@ func lengthToFee(length sc.U32) primitives.Balance {
 	return constants.LengthToFee.WeightToFee(primitives.WeightFromParts(sc.U64(length), 0))
 }
 
+// weightToFee charges the dominant dimension of `weight`, the one which takes the larger portion of
+// the block limits, as ref time.
 func weightToFee(weight primitives.Weight) primitives.Balance {
-	cappedWeight := weight.Min(system.DefaultBlockWeights().MaxBlock)
+	maxBlock := system.DefaultBlockWeights().MaxBlock
+	cappedWeight := weight.Min(maxBlock)
+
+	return constants.WeightToFee.WeightToFee(primitives.WeightFromParts(dominantRefTime(cappedWeight, maxBlock), 0))
+}
+
+// dominantRefTime returns the larger of the ref time of `weight` and its proof size, scaled to ref
+// time by the ratio of the ref time to the proof size of `limit`.
+func dominantRefTime(weight primitives.Weight, limit primitives.Weight) sc.U64 {
+	if limit.ProofSize == 0 {
+		return weight.RefTime
+	}
+
+	bnProofRefTime := new(big.Int).Mul(new(big.Int).SetUint64(uint64(weight.ProofSize)), new(big.Int).SetUint64(uint64(limit.RefTime)))
+	proofRefTime := sc.U64(new(big.Int).Div(bnProofRefTime, new(big.Int).SetUint64(uint64(limit.ProofSize))).Uint64())
 
-	return constants.WeightToFee.WeightToFee(cappedWeight)
+	return weight.RefTime.Max(proofRefTime)
 }
 
 func storageNextFeeMultiplier() sc.U128 {
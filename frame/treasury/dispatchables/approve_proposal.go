@@ -0,0 +1,132 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/treasury"
+	pallet "github.com/LimeChain/gosemble/frame/treasury"
+	"github.com/LimeChain/gosemble/frame/treasury/errors"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ApproveProposalCall struct {
+	primitives.Callable
+}
+
+func NewApproveProposalCall(args sc.VaryingData) ApproveProposalCall {
+	call := ApproveProposalCall{
+		Callable: primitives.Callable{
+			ModuleId:   treasury.ModuleIndex,
+			FunctionId: treasury.FunctionApproveProposalIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ApproveProposalCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c ApproveProposalCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ApproveProposalCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ApproveProposalCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ApproveProposalCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ApproveProposalCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "approve_proposal",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("proposal_id", "ProposalIndex", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+		},
+		Docs: "Approve a proposal. At a later time, the proposal will be allocated to the beneficiary and the original deposit will be returned.",
+	}
+}
+
+func (c ApproveProposalCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ApproveProposalCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 13_944 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.ProofWeight(pallet.StorageInfoProposals, pallet.StorageInfoApprovals)
+	return types.WeightFromParts(14_265_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ApproveProposalCall) IsInherent() bool {
+	return false
+}
+
+func (_ ApproveProposalCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ApproveProposalCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ApproveProposalCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ApproveProposalCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := approveProposal(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// approveProposal approves a proposal. At a later time, the proposal will be allocated to the
+// beneficiary and the original deposit will be returned.
+// Can only be called by root.
+func approveProposal(origin types.RuntimeOrigin, proposalId sc.U32) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	if !pallet.StorageGetProposal(proposalId).HasValue {
+		return types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   treasury.ModuleIndex,
+			Error:   sc.U32(errors.ErrorInvalidIndex),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	return pallet.Approve(proposalId)
+}
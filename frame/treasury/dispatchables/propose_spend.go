@@ -0,0 +1,155 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/treasury"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/system"
+	pallet "github.com/LimeChain/gosemble/frame/treasury"
+	"github.com/LimeChain/gosemble/frame/treasury/errors"
+	"github.com/LimeChain/gosemble/frame/treasury/events"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ProposeSpendCall struct {
+	primitives.Callable
+}
+
+func NewProposeSpendCall(args sc.VaryingData) ProposeSpendCall {
+	call := ProposeSpendCall{
+		Callable: primitives.Callable{
+			ModuleId:   treasury.ModuleIndex,
+			FunctionId: treasury.FunctionProposeSpendIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ProposeSpendCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c ProposeSpendCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ProposeSpendCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ProposeSpendCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ProposeSpendCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ ProposeSpendCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "propose_spend",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("value", "BalanceOf<T, I>", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+			primitives.NewFieldTypeInfo("beneficiary", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Put forward a suggestion for spending.",
+	}
+}
+
+func (c ProposeSpendCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ProposeSpendCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 29_522 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoProposalCount, system.StorageInfoAccount)
+	return types.WeightFromParts(30_141_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ProposeSpendCall) IsInherent() bool {
+	return false
+}
+
+func (_ ProposeSpendCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ ProposeSpendCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ProposeSpendCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ProposeSpendCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := proposeSpend(origin, sc.U128(args[0].(sc.Compact)), args[1].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// proposeSpend puts forward a suggestion for spending. A deposit proportional to the value
+// is reserved and slashed if the proposal is rejected. It is returned once the proposal is awarded.
+func proposeSpend(origin types.RuntimeOrigin, value types.Balance, beneficiary types.MultiAddress) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+	proposer := origin.AsSigned()
+
+	to, err := types.DefaultAccountIdLookup().Lookup(beneficiary)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	bond := pallet.CalculateBond(value.ToBigInt())
+	if dispatchables.Reserve(proposer, bond) != nil {
+		return types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   treasury.ModuleIndex,
+			Error:   sc.U32(errors.ErrorInsufficientProposersBalance),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	index := pallet.StorageGetProposalCount()
+	pallet.StorageSetProposalCount(index + 1)
+	pallet.StorageSetProposal(index, types.TreasuryProposal{
+		Proposer:    proposer,
+		Value:       value,
+		Beneficiary: to,
+		Bond:        sc.NewU128FromBigInt(bond),
+	})
+
+	system.DepositEvent(events.NewEventProposed(index))
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/treasury"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/system"
+	pallet "github.com/LimeChain/gosemble/frame/treasury"
+	"github.com/LimeChain/gosemble/frame/treasury/errors"
+	"github.com/LimeChain/gosemble/frame/treasury/events"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type RejectProposalCall struct {
+	primitives.Callable
+}
+
+func NewRejectProposalCall(args sc.VaryingData) RejectProposalCall {
+	call := RejectProposalCall{
+		Callable: primitives.Callable{
+			ModuleId:   treasury.ModuleIndex,
+			FunctionId: treasury.FunctionRejectProposalIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c RejectProposalCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c RejectProposalCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c RejectProposalCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c RejectProposalCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c RejectProposalCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ RejectProposalCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "reject_proposal",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("proposal_id", "ProposalIndex", primitives.NewCompactTypeInfo(primitives.TypeInfoU32)),
+		},
+		Docs: "Reject a proposed spend. The original deposit will be slashed.",
+	}
+}
+
+func (c RejectProposalCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ RejectProposalCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 44_736 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.ProofWeight(pallet.StorageInfoProposals, system.StorageInfoAccount, system.StorageInfoAccount)
+	return types.WeightFromParts(45_407_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ RejectProposalCall) IsInherent() bool {
+	return false
+}
+
+func (_ RejectProposalCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ RejectProposalCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ RejectProposalCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ RejectProposalCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := rejectProposal(origin, sc.U32(sc.U128(args[0].(sc.Compact)).ToBigInt().Uint64()))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// rejectProposal rejects a proposed spend. The original deposit is slashed and moved to the treasury pot.
+// Can only be called by root.
+func rejectProposal(origin types.RuntimeOrigin, proposalId sc.U32) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	maybeProposal := pallet.StorageGetProposal(proposalId)
+	if !maybeProposal.HasValue {
+		return types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   treasury.ModuleIndex,
+			Error:   sc.U32(errors.ErrorInvalidIndex),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+	proposal := maybeProposal.Value
+	pallet.StorageClearProposal(proposalId)
+
+	slashed, _ := dispatchables.SlashReserved(proposal.Proposer, proposal.Bond.ToBigInt())
+	pallet.OnUnbalanced(sc.NewU128FromBigInt(slashed))
+
+	system.DepositEvent(events.NewEventRejected(proposalId, sc.NewU128FromBigInt(slashed)))
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/treasury"
+	"github.com/LimeChain/gosemble/frame/system"
+	pallet "github.com/LimeChain/gosemble/frame/treasury"
+	"github.com/LimeChain/gosemble/frame/treasury/events"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SpendCall struct {
+	primitives.Callable
+}
+
+func NewSpendCall(args sc.VaryingData) SpendCall {
+	call := SpendCall{
+		Callable: primitives.Callable{
+			ModuleId:   treasury.ModuleIndex,
+			FunctionId: treasury.FunctionSpendIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SpendCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeCompact(buffer),
+		types.DecodeMultiAddress(buffer),
+	)
+	return c
+}
+
+func (c SpendCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SpendCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SpendCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SpendCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (_ SpendCall) CallInfo() primitives.CallInfo {
+	return primitives.CallInfo{
+		Name: "spend",
+		Args: []primitives.FieldTypeInfo{
+			primitives.NewFieldTypeInfo("amount", "BalanceOf<T, I>", primitives.NewCompactTypeInfo(primitives.TypeInfoU128)),
+			primitives.NewFieldTypeInfo("beneficiary", "AccountIdLookupOf<T>", primitives.TypeId(metadata.TypesMultiAddress)),
+		},
+		Docs: "Propose and approve a spend of treasury funds.",
+	}
+}
+
+func (c SpendCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SpendCall) BaseWeight(b ...any) types.Weight {
+	// Minimum execution time: 15_234 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(3)
+	e := types.ProofWeight(pallet.StorageInfoProposalCount, pallet.StorageInfoApprovals)
+	return types.WeightFromParts(15_643_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SpendCall) IsInherent() bool {
+	return false
+}
+
+func (_ SpendCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return baseWeight
+}
+
+func (_ SpendCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SpendCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SpendCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := spend(origin, sc.U128(args[0].(sc.Compact)), args[1].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// spend proposes and approves a spend of treasury funds in one step, without a deposit.
+// Can only be called by root.
+func spend(origin types.RuntimeOrigin, amount types.Balance, beneficiary types.MultiAddress) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	to, err := types.DefaultAccountIdLookup().Lookup(beneficiary)
+	if err != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	index := pallet.StorageGetProposalCount()
+	dispatchErr := pallet.Approve(index)
+	if dispatchErr != nil {
+		return dispatchErr
+	}
+
+	pallet.StorageSetProposalCount(index + 1)
+	pallet.StorageSetProposal(index, types.TreasuryProposal{
+		Proposer:    to,
+		Value:       amount,
+		Beneficiary: to,
+		Bond:        sc.NewU128FromUint64(0),
+	})
+
+	system.DepositEvent(events.NewEventSpendApproved(index, amount, to.FixedSequence))
+
+	return nil
+}
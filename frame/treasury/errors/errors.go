@@ -0,0 +1,12 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Treasury module errors.
+const (
+	ErrorInsufficientProposersBalance sc.U8 = iota
+	ErrorInvalidIndex
+	ErrorTooManyApprovals
+	ErrorInsufficientPermission
+	ErrorProposalNotApproved
+)
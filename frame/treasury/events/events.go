@@ -0,0 +1,99 @@
+package events
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/treasury"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Treasury module events.
+const (
+	EventProposed sc.U8 = iota
+	EventSpending
+	EventAwarded
+	EventRejected
+	EventBurnt
+	EventRollover
+	EventDeposit
+	EventSpendApproved
+)
+
+func NewEventProposed(proposalIndex sc.U32) types.Event {
+	return types.NewEvent(treasury.ModuleIndex, EventProposed, proposalIndex)
+}
+
+func NewEventSpending(budgetRemaining types.Balance) types.Event {
+	return types.NewEvent(treasury.ModuleIndex, EventSpending, budgetRemaining)
+}
+
+func NewEventAwarded(proposalIndex sc.U32, award types.Balance, account types.PublicKey) types.Event {
+	return types.NewEvent(treasury.ModuleIndex, EventAwarded, proposalIndex, award, account)
+}
+
+func NewEventRejected(proposalIndex sc.U32, slashed types.Balance) types.Event {
+	return types.NewEvent(treasury.ModuleIndex, EventRejected, proposalIndex, slashed)
+}
+
+func NewEventBurnt(burntFunds types.Balance) types.Event {
+	return types.NewEvent(treasury.ModuleIndex, EventBurnt, burntFunds)
+}
+
+func NewEventRollover(rolloverBalance types.Balance) types.Event {
+	return types.NewEvent(treasury.ModuleIndex, EventRollover, rolloverBalance)
+}
+
+func NewEventDeposit(value types.Balance) types.Event {
+	return types.NewEvent(treasury.ModuleIndex, EventDeposit, value)
+}
+
+func NewEventSpendApproved(proposalIndex sc.U32, amount types.Balance, beneficiary types.PublicKey) types.Event {
+	return types.NewEvent(treasury.ModuleIndex, EventSpendApproved, proposalIndex, amount, beneficiary)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	module := sc.DecodeU8(buffer)
+	if module != treasury.ModuleIndex {
+		log.Critical("invalid treasury.Event module")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventProposed:
+		proposalIndex := sc.DecodeU32(buffer)
+		return NewEventProposed(proposalIndex)
+	case EventSpending:
+		budgetRemaining := sc.DecodeU128(buffer)
+		return NewEventSpending(budgetRemaining)
+	case EventAwarded:
+		proposalIndex := sc.DecodeU32(buffer)
+		award := sc.DecodeU128(buffer)
+		account := types.DecodePublicKey(buffer)
+		return NewEventAwarded(proposalIndex, award, account)
+	case EventRejected:
+		proposalIndex := sc.DecodeU32(buffer)
+		slashed := sc.DecodeU128(buffer)
+		return NewEventRejected(proposalIndex, slashed)
+	case EventBurnt:
+		burntFunds := sc.DecodeU128(buffer)
+		return NewEventBurnt(burntFunds)
+	case EventRollover:
+		rolloverBalance := sc.DecodeU128(buffer)
+		return NewEventRollover(rolloverBalance)
+	case EventDeposit:
+		value := sc.DecodeU128(buffer)
+		return NewEventDeposit(value)
+	case EventSpendApproved:
+		proposalIndex := sc.DecodeU32(buffer)
+		amount := sc.DecodeU128(buffer)
+		beneficiary := types.DecodePublicKey(buffer)
+		return NewEventSpendApproved(proposalIndex, amount, beneficiary)
+	default:
+		log.Critical("invalid treasury.Event type")
+	}
+
+	panic("unreachable")
+}
@@ -0,0 +1,16 @@
+package treasury
+
+import (
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/treasury"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OnInitialize spends the treasury funds at the start of every spend period.
+func OnInitialize(n types.BlockNumber) types.Weight {
+	if n%treasury.SpendPeriod == 0 {
+		return spendFunds()
+	}
+
+	return constants.DbWeight.Reads(0)
+}
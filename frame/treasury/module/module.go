@@ -0,0 +1,166 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/constants/treasury"
+	pallet "github.com/LimeChain/gosemble/frame/treasury"
+	"github.com/LimeChain/gosemble/frame/treasury/dispatchables"
+	"github.com/LimeChain/gosemble/frame/treasury/errors"
+	"github.com/LimeChain/gosemble/frame/treasury/events"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type TreasuryModule struct {
+	functions map[sc.U8]primitives.Call
+}
+
+func NewTreasuryModule() TreasuryModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[treasury.FunctionProposeSpendIndex] = dispatchables.NewProposeSpendCall(nil)
+	functions[treasury.FunctionRejectProposalIndex] = dispatchables.NewRejectProposalCall(nil)
+	functions[treasury.FunctionApproveProposalIndex] = dispatchables.NewApproveProposalCall(nil)
+	functions[treasury.FunctionSpendIndex] = dispatchables.NewSpendCall(nil)
+
+	return TreasuryModule{
+		functions: functions,
+	}
+}
+
+func (tm TreasuryModule) Functions() map[sc.U8]primitives.Call {
+	return tm.functions
+}
+
+func (tm TreasuryModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (tm TreasuryModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+// OnInitialize spends the approved proposals at the end of each spend period.
+func (tm TreasuryModule) OnInitialize(n primitives.BlockNumber) primitives.Weight {
+	return pallet.OnInitialize(n)
+}
+
+func (tm TreasuryModule) Docs() sc.Sequence[sc.Str] {
+	return sc.Sequence[sc.Str]{"A pot of funds spent on proposals approved by the council."}
+}
+
+func (tm TreasuryModule) Metadata(registry *primitives.MetadataTypeRegistry) primitives.MetadataModule {
+	return primitives.MetadataModule{
+		Name: "Treasury",
+		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
+			Prefix: "Treasury",
+			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
+				primitives.NewMetadataModuleStorageEntry(
+					"ProposalCount",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)),
+					"Number of proposals that have been made."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Proposals",
+					primitives.MetadataModuleStorageEntryModifierOptional,
+					primitives.NewMetadataModuleStorageEntryDefinitionMap(
+						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
+						sc.ToCompact(metadata.PrimitiveTypesU32),
+						sc.ToCompact(registry.Register(pallet.ProposalTypeInfo))),
+					"Proposals that have been made."),
+				primitives.NewMetadataModuleStorageEntry(
+					"Approvals",
+					primitives.MetadataModuleStorageEntryModifierDefault,
+					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(registry.Register(primitives.NewSequenceTypeInfo(primitives.TypeInfoU32)))),
+					"Proposal indices that have been approved but not yet awarded."),
+			},
+		}),
+		Call:  sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(tm.callsTypeInfo()))),
+		Event: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(eventTypeInfo()))),
+		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
+			primitives.NewMetadataModuleConstant(
+				"ProposalBond",
+				sc.ToCompact(registry.Register(primitives.TypeInfoPermill)),
+				sc.BytesToSequenceU8(treasury.ProposalBond.Bytes()),
+				"Fraction of a proposal's value that should be bonded in order to place the proposal.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"ProposalBondMinimum",
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.BytesToSequenceU8(sc.NewU128FromBigInt(treasury.ProposalBondMinimum).Bytes()),
+				"Minimum amount of funds that should be placed in a deposit for making a proposal.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"SpendPeriod",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(treasury.SpendPeriod.Bytes()),
+				"Period between successive spends.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"Burn",
+				sc.ToCompact(registry.Register(primitives.TypeInfoPermill)),
+				sc.BytesToSequenceU8(treasury.Burn.Bytes()),
+				"Percentage of spare funds (if any) that are burnt per spend period.",
+			),
+			primitives.NewMetadataModuleConstant(
+				"MaxApprovals",
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.BytesToSequenceU8(sc.U32(treasury.MaxApprovals).Bytes()),
+				"The maximum number of approvals that can wait in the spending queue.",
+			),
+		},
+		Error: sc.NewOption[sc.Compact](sc.ToCompact(registry.Register(errorsTypeInfo()))),
+		Index: treasury.ModuleIndex,
+	}
+}
+
+func (tm TreasuryModule) callsTypeInfo() primitives.TypeInfo {
+	return primitives.NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet_treasury", "pallet", "Call"}, tm.functions)
+}
+
+func eventTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_treasury", "pallet", "Event"},
+		"The events of the module.",
+		primitives.NewVariantTypeInfo("Proposed", events.EventProposed, "New proposal.",
+			primitives.NewFieldTypeInfo("proposal_index", "ProposalIndex", primitives.TypeInfoU32),
+		),
+		primitives.NewVariantTypeInfo("Spending", events.EventSpending, "We have ended a spend period and will now allocate funds.",
+			primitives.NewFieldTypeInfo("budget_remaining", "BalanceOf<T, I>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Awarded", events.EventAwarded, "Some funds have been allocated.",
+			primitives.NewFieldTypeInfo("proposal_index", "ProposalIndex", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("award", "BalanceOf<T, I>", primitives.TypeInfoU128),
+			primitives.NewFieldTypeInfo("account", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+		primitives.NewVariantTypeInfo("Rejected", events.EventRejected, "A proposal was rejected; funds were slashed.",
+			primitives.NewFieldTypeInfo("proposal_index", "ProposalIndex", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("slashed", "BalanceOf<T, I>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Burnt", events.EventBurnt, "Some of our funds have been burnt.",
+			primitives.NewFieldTypeInfo("burnt_funds", "BalanceOf<T, I>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Rollover", events.EventRollover, "Spending has finished; this is the amount that rolls over until next spend.",
+			primitives.NewFieldTypeInfo("rollover_balance", "BalanceOf<T, I>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("Deposit", events.EventDeposit, "Some funds have been deposited.",
+			primitives.NewFieldTypeInfo("value", "BalanceOf<T, I>", primitives.TypeInfoU128),
+		),
+		primitives.NewVariantTypeInfo("SpendApproved", events.EventSpendApproved, "A new spend proposal has been approved.",
+			primitives.NewFieldTypeInfo("proposal_index", "ProposalIndex", primitives.TypeInfoU32),
+			primitives.NewFieldTypeInfo("amount", "BalanceOf<T, I>", primitives.TypeInfoU128),
+			primitives.NewFieldTypeInfo("beneficiary", "T::AccountId", primitives.TypeId(metadata.TypesAddress32)),
+		),
+	)
+}
+
+func errorsTypeInfo() primitives.TypeInfo {
+	return primitives.NewEnumTypeInfo(
+		sc.Sequence[sc.Str]{"pallet_treasury", "pallet", "Error"},
+		"The errors of the module.",
+		primitives.NewVariantTypeInfo("InsufficientProposersBalance", errors.ErrorInsufficientProposersBalance, "Proposer's balance is too low."),
+		primitives.NewVariantTypeInfo("InvalidIndex", errors.ErrorInvalidIndex, "No proposal or bounty at that index."),
+		primitives.NewVariantTypeInfo("TooManyApprovals", errors.ErrorTooManyApprovals, "Too many approvals in the queue."),
+		primitives.NewVariantTypeInfo("InsufficientPermission", errors.ErrorInsufficientPermission, "The spend origin is valid but the amount it is allowed to spend is lower than the amount to be spent."),
+		primitives.NewVariantTypeInfo("ProposalNotApproved", errors.ErrorProposalNotApproved, "Proposal has not been approved."),
+	)
+}
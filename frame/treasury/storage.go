@@ -0,0 +1,66 @@
+package treasury
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageGetProposalCount returns the number of proposals that have been made.
+func StorageGetProposalCount() sc.U32 {
+	return storage.GetDecode(keyProposalCount(), sc.DecodeU32)
+}
+
+func StorageSetProposalCount(count sc.U32) {
+	storage.Set(keyProposalCount(), count.Bytes())
+}
+
+// StorageGetProposal returns the proposal with the given index.
+func StorageGetProposal(index sc.U32) sc.Option[types.TreasuryProposal] {
+	option := storage.Get(keyProposals(index))
+	if !option.HasValue {
+		return sc.NewOption[types.TreasuryProposal](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+
+	return sc.NewOption[types.TreasuryProposal](types.DecodeTreasuryProposal(buffer))
+}
+
+func StorageSetProposal(index sc.U32, proposal types.TreasuryProposal) {
+	storage.Set(keyProposals(index), proposal.Bytes())
+}
+
+func StorageClearProposal(index sc.U32) {
+	storage.Clear(keyProposals(index))
+}
+
+// StorageGetApprovals returns the indices of the proposals that have been approved but not yet awarded.
+func StorageGetApprovals() sc.Sequence[sc.U32] {
+	return storage.GetDecode(keyApprovals(), func(buffer *bytes.Buffer) sc.Sequence[sc.U32] {
+		return sc.DecodeSequenceWith(buffer, sc.DecodeU32)
+	})
+}
+
+func StorageSetApprovals(approvals sc.Sequence[sc.U32]) {
+	storage.Set(keyApprovals(), approvals.Bytes())
+}
+
+func keyProposalCount() []byte {
+	return append(hashing.Twox128(constants.KeyTreasury), hashing.Twox128(constants.KeyProposalCount)...)
+}
+
+// keyProposals returns the storage key of `Proposals`, which uses the twox64 concat hasher.
+func keyProposals(index sc.U32) []byte {
+	key := append(hashing.Twox128(constants.KeyTreasury), hashing.Twox128(constants.KeyProposals)...)
+	key = append(key, hashing.Twox64(index.Bytes())...)
+	return append(key, index.Bytes()...)
+}
+
+func keyApprovals() []byte {
+	return append(hashing.Twox128(constants.KeyTreasury), hashing.Twox128(constants.KeyApprovals)...)
+}
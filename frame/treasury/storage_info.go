@@ -0,0 +1,17 @@
+package treasury
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/treasury"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// StorageInfoProposalCount describes the `ProposalCount` value.
+	StorageInfoProposalCount = types.NewStorageValueInfo("Treasury", "ProposalCount", 4)
+	// StorageInfoProposals describes the `Proposals` map, whose entries are
+	// Twox64Concat(ProposalIndex) ++ Proposal.
+	StorageInfoProposals = types.NewStorageMapInfo("Treasury", "Proposals", sc.NewOption[sc.U32](nil), 12+96)
+	// StorageInfoApprovals describes the `Approvals` value, a BoundedVec<ProposalIndex, MaxApprovals>.
+	StorageInfoApprovals = types.NewStorageValueInfo("Treasury", "Approvals", 2+treasury.MaxApprovals*4)
+)
@@ -0,0 +1,151 @@
+package treasury
+
+import (
+	"fmt"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/constants/treasury"
+	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/treasury/errors"
+	"github.com/LimeChain/gosemble/frame/treasury/events"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// AccountId returns the account of the treasury pot, derived from the pallet id.
+func AccountId() types.AccountId {
+	accountId := make([]sc.U8, types.AccountIdLength)
+	for i, b := range append([]byte("modl"), treasury.PalletId[:]...) {
+		accountId[i] = sc.U8(b)
+	}
+
+	return types.NewAccountId(accountId...)
+}
+
+// Pot returns the amount of funds in the treasury that can be spent.
+// The existential deposit is not part of the pot, so the treasury account never gets deleted.
+func Pot() *big.Int {
+	free := system.StorageGetAccount(AccountId().FixedSequence).Data.Free.ToBigInt()
+
+	pot := new(big.Int).Sub(free, balances.ExistentialDeposit)
+	if pot.Cmp(constants.Zero) < 0 {
+		return big.NewInt(0)
+	}
+
+	return pot
+}
+
+// OnUnbalanced deposits `amount` into the treasury pot.
+// The amount must already have been removed from another account, so the total issuance is not changed.
+func OnUnbalanced(amount types.Balance) {
+	if amount.ToBigInt().Cmp(constants.Zero) == 0 {
+		return
+	}
+
+	deposited := dispatchables.DepositCreating(AccountId(), amount)
+	if deposited.ToBigInt().Cmp(constants.Zero) == 0 {
+		// The pot does not exist yet and the amount is below the existential deposit.
+		dispatchables.NewNegativeImbalance(amount).Drop()
+		return
+	}
+
+	system.DepositEvent(events.NewEventDeposit(amount))
+}
+
+// Approve adds the proposal with the given index to the approvals queue.
+func Approve(index sc.U32) types.DispatchError {
+	approvals := StorageGetApprovals()
+	if len(approvals) >= treasury.MaxApprovals {
+		return types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   treasury.ModuleIndex,
+			Error:   sc.U32(errors.ErrorTooManyApprovals),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	StorageSetApprovals(append(approvals, index))
+
+	return nil
+}
+
+// CalculateBond returns the amount reserved from the proposer of a spend of `value`.
+func CalculateBond(value *big.Int) *big.Int {
+	bond := treasury.ProposalBond.MulFloor(value)
+	if bond.Cmp(treasury.ProposalBondMinimum) < 0 {
+		return new(big.Int).Set(treasury.ProposalBondMinimum)
+	}
+
+	return bond
+}
+
+// spendFunds pays out the approved proposals that fit into the budget and burns a part of
+// the remaining funds if all approvals were paid.
+func spendFunds() types.Weight {
+	budgetRemaining := Pot()
+	system.DepositEvent(events.NewEventSpending(sc.NewU128FromBigInt(budgetRemaining)))
+
+	accountId := AccountId()
+	missedAny := false
+	remainingApprovals := sc.Sequence[sc.U32]{}
+	approvals := StorageGetApprovals()
+
+	for _, index := range approvals {
+		maybeProposal := StorageGetProposal(index)
+		if !maybeProposal.HasValue {
+			continue
+		}
+
+		proposal := maybeProposal.Value
+		if proposal.Value.ToBigInt().Cmp(budgetRemaining) > 0 {
+			missedAny = true
+			remainingApprovals = append(remainingApprovals, index)
+			continue
+		}
+
+		budgetRemaining = new(big.Int).Sub(budgetRemaining, proposal.Value.ToBigInt())
+		StorageClearProposal(index)
+
+		// Return the bond of the proposer.
+		dispatchables.Unreserve(proposal.Proposer, proposal.Bond.ToBigInt())
+
+		err := dispatchables.Transfer(accountId, proposal.Beneficiary, proposal.Value, types.ExistenceRequirementKeepAlive)
+		if err != nil {
+			log.Warn(fmt.Sprintf("failed to award treasury proposal [%d]", index))
+			continue
+		}
+
+		system.DepositEvent(events.NewEventAwarded(index, proposal.Value, proposal.Beneficiary.FixedSequence))
+	}
+
+	StorageSetApprovals(remainingApprovals)
+
+	if !missedAny {
+		burn := treasury.Burn.MulFloor(budgetRemaining)
+		if burn.Cmp(constants.Zero) > 0 {
+			_, err := dispatchables.Withdraw(accountId, sc.NewU128FromBigInt(burn), sc.U8(types.WithdrawReasonsTransfer), types.ExistenceRequirementKeepAlive)
+			if err != nil {
+				log.Warn("failed to burn treasury funds")
+			} else {
+				budgetRemaining = new(big.Int).Sub(budgetRemaining, burn)
+				dispatchables.NewNegativeImbalance(sc.NewU128FromBigInt(burn)).Drop()
+				system.DepositEvent(events.NewEventBurnt(sc.NewU128FromBigInt(burn)))
+			}
+		}
+	}
+
+	system.DepositEvent(events.NewEventRollover(sc.NewU128FromBigInt(budgetRemaining)))
+
+	p := sc.U64(len(approvals))
+	return types.WeightFromParts(38_222_000, 0).
+		SaturatingAdd(types.WeightFromParts(30_476_000, 0).SaturatingMul(p)).
+		SaturatingAdd(constants.DbWeight.Reads(1)).
+		SaturatingAdd(constants.DbWeight.Reads(3).SaturatingMul(p)).
+		SaturatingAdd(constants.DbWeight.Writes(1)).
+		SaturatingAdd(constants.DbWeight.Writes(3).SaturatingMul(p)).
+		SaturatingAdd(types.ProofWeight(StorageInfoApprovals, system.StorageInfoAccount)).
+		SaturatingAdd(types.ProofWeight(StorageInfoProposals, system.StorageInfoAccount, system.StorageInfoAccount).SaturatingMul(p))
+}
@@ -0,0 +1,18 @@
+package treasury
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	// ProposalTypeInfo is the type info of types.TreasuryProposal.
+	ProposalTypeInfo = types.NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet_treasury", "Proposal"},
+		"A spending proposal.",
+		types.NewFieldTypeInfo("proposer", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("value", "Balance", types.TypeInfoU128),
+		types.NewFieldTypeInfo("beneficiary", "AccountId", types.TypeId(metadata.TypesAddress32)),
+		types.NewFieldTypeInfo("bond", "Balance", types.TypeInfoU128),
+	)
+)
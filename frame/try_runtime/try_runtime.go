@@ -0,0 +1,68 @@
+// Package try_runtime implements the `TryRuntime` runtime API, which tests runtime upgrades and
+// the execution of blocks against the state of a chain.
+package try_runtime
+
+import (
+	"fmt"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/executive"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/api"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Api is the `TryRuntime` runtime API. It is exported only by runtimes built with the
+// `tryruntime` tag, and is called by the gosemble-try-runtime command.
+var Api = api.New("TryRuntime", 1, "Runtime api for testing runtime upgrades and blocks against the state of a chain.",
+	api.NewMethod1("on_runtime_upgrade",
+		api.NewArg("checks", primitives.TypeInfoBool, sc.DecodeBool),
+		primitives.NewTupleTypeInfo(primitives.TypeId(metadata.TypesWeight), primitives.TypeId(metadata.TypesWeight)),
+		"Executes the runtime upgrade, with the pre and post upgrade checks of the migrations if `checks` is true. Returns the weight consumed and the maximum weight of a block.",
+		OnRuntimeUpgrade),
+	api.NewMethod2("execute_block",
+		api.NewArg("block", primitives.TypeId(metadata.TypesBlock), types.DecodeBlock),
+		api.NewArg("state_root_check", primitives.TypeInfoBool, sc.DecodeBool),
+		primitives.TypeId(metadata.TypesWeight),
+		"Executes the given block, checking its state root if `state_root_check` is true. Returns the weight consumed by the block.",
+		ExecuteBlock),
+)
+
+// OnRuntimeUpgrade executes the migrations of the runtime, regardless of whether the runtime
+// version changed. If `checks` is true, the PreUpgrade checks of all migrations are executed
+// before and their PostUpgrade checks after the upgrade. A failed check aborts the execution.
+// Returns the weight consumed by the upgrade and the maximum weight of a block.
+func OnRuntimeUpgrade(checks sc.Bool) sc.VaryingData {
+	states := make([][]byte, len(config.Migrations))
+	if checks {
+		for i, migration := range config.Migrations {
+			state, err := migration.PreUpgrade()
+			if err != nil {
+				log.Critical(fmt.Sprintf("pre-upgrade check of migration %d failed: %v", i, err))
+			}
+			states[i] = state
+		}
+	}
+
+	weight := executive.ExecuteOnRuntimeUpgrade()
+
+	if checks {
+		for i, migration := range config.Migrations {
+			if err := migration.PostUpgrade(states[i]); err != nil {
+				log.Critical(fmt.Sprintf("post-upgrade check of migration %d failed: %v", i, err))
+			}
+		}
+	}
+
+	return sc.NewVaryingData(weight, system.DefaultBlockWeights().MaxBlock)
+}
+
+// ExecuteBlock executes `block`, checking its state root if `stateRootCheck` is true.
+// Returns the weight consumed by the block.
+func ExecuteBlock(block types.Block, stateRootCheck sc.Bool) primitives.Weight {
+	return executive.TryExecuteBlock(block, bool(stateRootCheck))
+}
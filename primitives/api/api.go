@@ -0,0 +1,180 @@
+package api
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/utils"
+)
+
+// Api is a runtime API, with its name, version and methods.
+// Each method is exported by the runtime as `<Name>_<method name>`.
+type Api struct {
+	Name    string
+	Version sc.U32
+	Methods []Method
+	Docs    string
+}
+
+// New returns the runtime API with the given name, version and methods.
+func New(name string, version sc.U32, docs string, methods ...Method) Api {
+	return Api{
+		Name:    name,
+		Version: version,
+		Methods: methods,
+		Docs:    docs,
+	}
+}
+
+// ExportName returns the name under which `method` is exported by the runtime.
+func (a Api) ExportName(method Method) string {
+	return a.Name + "_" + method.Name
+}
+
+// Item returns the entry of the API in the runtime version, with `id` being the Blake2b-8 hash of
+// its name.
+func (a Api) Item(id sc.FixedSequence[sc.U8]) types.ApiItem {
+	return types.ApiItem{
+		Name:    id,
+		Version: a.Version,
+	}
+}
+
+// Metadata returns the metadata of the API. The types of its methods are registered in `registry`.
+func (a Api) Metadata(registry *types.MetadataTypeRegistry) types.RuntimeApiMetadata {
+	methods := sc.Sequence[types.RuntimeApiMethodMetadata]{}
+	for _, method := range a.Methods {
+		methods = append(methods, method.metadata(registry))
+	}
+
+	return types.NewRuntimeApiMetadata(a.Name, methods, a.Docs)
+}
+
+// Param is a parameter of a runtime API method.
+type Param struct {
+	Name string
+	Type types.TypeInfo
+}
+
+// Arg is a parameter of a runtime API method, decoded from the input of the method with `Decode`.
+type Arg[A any] struct {
+	Param
+	Decode func(buffer *bytes.Buffer) A
+}
+
+// NewArg returns the parameter `name` of type `info`, which is decoded with `decode`.
+func NewArg[A any](name string, info types.TypeInfo, decode func(buffer *bytes.Buffer) A) Arg[A] {
+	return Arg[A]{
+		Param:  Param{Name: name, Type: info},
+		Decode: decode,
+	}
+}
+
+// Method is a method of a runtime API. It decodes its arguments from the SCALE-encoded input and
+// returns its SCALE-encoded result.
+type Method struct {
+	Name   string
+	Params []Param
+	Output types.TypeInfo
+	Docs   string
+	call   func(buffer *bytes.Buffer) sc.Encodable
+}
+
+// NewMethod returns a method without parameters, implemented by `fn`.
+func NewMethod[R sc.Encodable](name string, output types.TypeInfo, docs string, fn func() R) Method {
+	return Method{
+		Name:   name,
+		Params: []Param{},
+		Output: output,
+		Docs:   docs,
+		call: func(_ *bytes.Buffer) sc.Encodable {
+			return fn()
+		},
+	}
+}
+
+// NewMethod1 returns a method with a single parameter, implemented by `fn`.
+func NewMethod1[A any, R sc.Encodable](name string, a Arg[A], output types.TypeInfo, docs string, fn func(A) R) Method {
+	return Method{
+		Name:   name,
+		Params: []Param{a.Param},
+		Output: output,
+		Docs:   docs,
+		call: func(buffer *bytes.Buffer) sc.Encodable {
+			return fn(a.Decode(buffer))
+		},
+	}
+}
+
+// NewMethod2 returns a method with two parameters, implemented by `fn`.
+func NewMethod2[A, B any, R sc.Encodable](name string, a Arg[A], b Arg[B], output types.TypeInfo, docs string, fn func(A, B) R) Method {
+	return Method{
+		Name:   name,
+		Params: []Param{a.Param, b.Param},
+		Output: output,
+		Docs:   docs,
+		call: func(buffer *bytes.Buffer) sc.Encodable {
+			argA := a.Decode(buffer)
+			argB := b.Decode(buffer)
+
+			return fn(argA, argB)
+		},
+	}
+}
+
+// NewMethod3 returns a method with three parameters, implemented by `fn`.
+func NewMethod3[A, B, C any, R sc.Encodable](name string, a Arg[A], b Arg[B], c Arg[C], output types.TypeInfo, docs string, fn func(A, B, C) R) Method {
+	return Method{
+		Name:   name,
+		Params: []Param{a.Param, b.Param, c.Param},
+		Output: output,
+		Docs:   docs,
+		call: func(buffer *bytes.Buffer) sc.Encodable {
+			argA := a.Decode(buffer)
+			argB := b.Decode(buffer)
+			argC := c.Decode(buffer)
+
+			return fn(argA, argB, argC)
+		},
+	}
+}
+
+// Call calls the method with its SCALE-encoded arguments and returns its SCALE-encoded result.
+func (m Method) Call(args []byte) []byte {
+	return m.call(bytes.NewBuffer(args)).Bytes()
+}
+
+// Execute is the body of the function exported for the method.
+// It takes two arguments:
+// - dataPtr: Pointer to the data in the Wasm memory.
+// - dataLen: Length of the data.
+// which represent the SCALE-encoded arguments of the method.
+// Returns a pointer-size of the SCALE-encoded result of the method.
+func (m Method) Execute(dataPtr int32, dataLen int32) int64 {
+	args := utils.ToWasmMemorySlice(dataPtr, dataLen)
+
+	return utils.BytesToOffsetAndSize(m.Call(args))
+}
+
+func (m Method) metadata(registry *types.MetadataTypeRegistry) types.RuntimeApiMethodMetadata {
+	params := sc.Sequence[types.RuntimeApiMethodParamMetadata]{}
+	for _, param := range m.Params {
+		params = append(params, types.NewRuntimeApiMethodParamMetadata(param.Name, registry.Register(param.Type)))
+	}
+
+	return types.NewRuntimeApiMethodMetadata(m.Name, params, registry.Register(m.Output), m.Docs)
+}
+
+var registered []Api
+
+// Register registers the runtime APIs implemented by the runtime, in the order in which they are
+// listed in the runtime version.
+func Register(apis ...Api) {
+	registered = append(registered, apis...)
+}
+
+// Registered returns the runtime APIs implemented by the runtime.
+func Registered() []Api {
+	return registered
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	add = NewMethod2("add",
+		NewArg("a", types.TypeInfoU32, sc.DecodeU32),
+		NewArg("b", types.TypeInfoU32, sc.DecodeU32),
+		types.TypeInfoU64,
+		"Adds two numbers.",
+		func(a, b sc.U32) sc.U64 {
+			return sc.U64(a) + sc.U64(b)
+		})
+	answer = NewMethod("answer",
+		types.TypeInfoU32,
+		"Returns the answer.",
+		func() sc.U32 {
+			return 42
+		})
+	targetApi = New("Math", 2, "Math api.", add, answer)
+)
+
+func Test_Method_Call(t *testing.T) {
+	args := append(sc.U32(1).Bytes(), sc.U32(2).Bytes()...)
+
+	assert.Equal(t, sc.U64(3).Bytes(), add.Call(args))
+	assert.Equal(t, sc.U32(42).Bytes(), answer.Call(nil))
+}
+
+func Test_Method_Call_DecodesInOrder(t *testing.T) {
+	method := NewMethod3("concat",
+		NewArg("a", types.TypeInfoU8, sc.DecodeU8),
+		NewArg("b", types.TypeInfoU8, sc.DecodeU8),
+		NewArg("c", types.TypeInfoU8, sc.DecodeU8),
+		types.NewSequenceTypeInfo(types.TypeInfoU8),
+		"",
+		func(a, b, c sc.U8) sc.Sequence[sc.U8] {
+			return sc.Sequence[sc.U8]{a, b, c}
+		})
+
+	assert.Equal(t, sc.Sequence[sc.U8]{1, 2, 3}.Bytes(), method.Call([]byte{1, 2, 3}))
+}
+
+func Test_Api_ExportName(t *testing.T) {
+	assert.Equal(t, "Math_add", targetApi.ExportName(add))
+}
+
+func Test_Api_Item(t *testing.T) {
+	id := sc.NewFixedSequence[sc.U8](8, 1, 2, 3, 4, 5, 6, 7, 8)
+
+	assert.Equal(t, types.ApiItem{Name: id, Version: 2}, targetApi.Item(id))
+}
+
+func Test_Api_Metadata(t *testing.T) {
+	registry := types.NewMetadataTypeRegistry(0)
+
+	result := targetApi.Metadata(registry)
+
+	u32 := registry.Register(types.TypeInfoU32)
+	u64 := registry.Register(types.TypeInfoU64)
+	expect := types.NewRuntimeApiMetadata("Math", sc.Sequence[types.RuntimeApiMethodMetadata]{
+		types.NewRuntimeApiMethodMetadata("add", sc.Sequence[types.RuntimeApiMethodParamMetadata]{
+			types.NewRuntimeApiMethodParamMetadata("a", u32),
+			types.NewRuntimeApiMethodParamMetadata("b", u32),
+		}, u64, "Adds two numbers."),
+		types.NewRuntimeApiMethodMetadata("answer", sc.Sequence[types.RuntimeApiMethodParamMetadata]{}, u32, "Returns the answer."),
+	}, "Math api.")
+
+	assert.Equal(t, expect, result)
+	assert.Equal(t, 2, len(registry.Types()))
+}
+
+func Test_Register(t *testing.T) {
+	defer func() { registered = nil }()
+
+	Register(targetApi)
+
+	assert.Equal(t, []Api{targetApi}, Registered())
+}
@@ -0,0 +1,209 @@
+// Package benchmarking declares the benchmarks of the dispatchables of the modules, from which
+// their weights are generated.
+package benchmarking
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Module is implemented by the modules that declare benchmarks of their dispatchables.
+type Module interface {
+	Benchmarks() []Benchmark
+}
+
+// Component is a parameter of a benchmark, which affects the weight of the benchmarked call,
+// e.g. the length of its arguments. It is benchmarked with values from Min to Max.
+type Component struct {
+	Name sc.Str
+	Min  sc.U32
+	Max  sc.U32
+}
+
+// NewComponent returns the component `name`, with values from `min` to `max`.
+func NewComponent(name string, min sc.U32, max sc.U32) Component {
+	return Component{
+		Name: sc.Str(name),
+		Min:  min,
+		Max:  max,
+	}
+}
+
+func (c Component) Encode(buffer *bytes.Buffer) {
+	c.Name.Encode(buffer)
+	c.Min.Encode(buffer)
+	c.Max.Encode(buffer)
+}
+
+func (c Component) Bytes() []byte {
+	return sc.EncodedBytes(c)
+}
+
+// Benchmark is the benchmark of a dispatchable. Its function sets up the state for the values of
+// the components and dispatches the call with B.Dispatch, which is measured.
+type Benchmark struct {
+	Name       string
+	Components []Component
+	fn         func(b *B) error
+}
+
+// New returns the benchmark `name`, with the given components.
+func New(name string, fn func(b *B) error, components ...Component) Benchmark {
+	return Benchmark{
+		Name:       name,
+		Components: components,
+		fn:         fn,
+	}
+}
+
+// Run runs the benchmark with the values of its components. If `setupOnly` is true, the call is
+// not dispatched, so that the time of the setup can be subtracted from the time of the whole run.
+// The storage changes of the benchmark are reverted.
+// Returns the storage reads and writes of the dispatched call, with the proof size of its reads
+// estimated from `storageInfo`.
+func (bm Benchmark) Run(values []sc.U32, setupOnly bool, storageInfo []types.StorageInfo) (Result, error) {
+	if len(values) != len(bm.Components) {
+		return Result{}, fmt.Errorf("benchmark %s expects %d components, got %d", bm.Name, len(bm.Components), len(values))
+	}
+
+	for i, component := range bm.Components {
+		if values[i] < component.Min || values[i] > component.Max {
+			return Result{}, fmt.Errorf("component %s of benchmark %s is out of range", component.Name, bm.Name)
+		}
+	}
+
+	storage.StartTransaction()
+	defer storage.RollbackTransaction()
+
+	b := &B{
+		components:  bm.Components,
+		values:      values,
+		setupOnly:   setupOnly,
+		storageInfo: storageInfo,
+	}
+	if err := bm.fn(b); err != nil {
+		return Result{}, err
+	}
+
+	if !setupOnly && !b.dispatched {
+		return Result{}, fmt.Errorf("benchmark %s did not dispatch a call", bm.Name)
+	}
+
+	return b.result, nil
+}
+
+// B is passed to the function of a running benchmark.
+type B struct {
+	components  []Component
+	values      []sc.U32
+	setupOnly   bool
+	storageInfo []types.StorageInfo
+	dispatched  bool
+	result      Result
+}
+
+// Value returns the value of the component `name`.
+func (b *B) Value(name string) sc.U32 {
+	for i, component := range b.components {
+		if string(component.Name) == name {
+			return b.values[i]
+		}
+	}
+
+	panic("unknown benchmark component " + name)
+}
+
+// Dispatch dispatches `call` with `origin`, counting its storage reads and writes.
+// The call is not dispatched if only the setup of the benchmark is run.
+// Returns an error if the call fails.
+func (b *B) Dispatch(origin types.RuntimeOrigin, call types.Call) error {
+	if b.dispatched {
+		return errors.New("a benchmark can dispatch a single call")
+	}
+	b.dispatched = true
+
+	if b.setupOnly {
+		return nil
+	}
+
+	storage.StartTracking()
+	result := call.Dispatch(origin, call.Args())
+	reads, writes := storage.StopTracking()
+
+	b.result = Result{
+		Reads:     sc.U32(len(reads)),
+		Writes:    writes,
+		ProofSize: proofSize(reads, b.storageInfo),
+	}
+
+	if result.HasError {
+		return fmt.Errorf("dispatch failed: 0x%s", hex.EncodeToString(result.Err.Error.Bytes()))
+	}
+
+	return nil
+}
+
+// Account returns a deterministic account for benchmarks, derived from its name and index.
+func Account(name string, index sc.U32) types.AccountId {
+	hash := hashing.Blake256(append([]byte(name), index.Bytes()...))
+
+	values := make([]sc.U8, types.AccountIdLength)
+	for i := range values {
+		values[i] = sc.U8(hash[i])
+	}
+
+	return types.NewAccountId(values...)
+}
+
+// proofSize estimates the size of the proof of the storage `reads`. The reads of a described
+// storage item take the worst-case proof size of the item. The reads of other keys are estimated
+// from the length of the key and the value read, as entries of a map without a bound.
+func proofSize(reads []storage.TrackedRead, storageInfo []types.StorageInfo) sc.U64 {
+	size := sc.U64(0)
+
+	for _, read := range reads {
+		info, ok := describedBy(read.Key, storageInfo)
+		if ok {
+			size = size.SaturatingAdd(info.ProofSize())
+			continue
+		}
+
+		size = size.SaturatingAdd(types.StorageProofSize(sc.NewOption[sc.U32](nil), sc.U32(len(read.Key))+read.Size))
+	}
+
+	return size
+}
+
+func describedBy(key []byte, storageInfo []types.StorageInfo) (types.StorageInfo, bool) {
+	for _, info := range storageInfo {
+		if bytes.HasPrefix(key, info.Prefix) {
+			return info, true
+		}
+	}
+
+	return types.StorageInfo{}, false
+}
+
+// Result is the result of a benchmark run.
+type Result struct {
+	Reads     sc.U32
+	Writes    sc.U32
+	ProofSize sc.U64
+}
+
+func (r Result) Encode(buffer *bytes.Buffer) {
+	r.Reads.Encode(buffer)
+	r.Writes.Encode(buffer)
+	r.ProofSize.Encode(buffer)
+}
+
+func (r Result) Bytes() []byte {
+	return sc.EncodedBytes(r)
+}
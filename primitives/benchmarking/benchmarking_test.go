@@ -0,0 +1,57 @@
+package benchmarking
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var targetBenchmark = New("remark", func(b *B) error {
+	return nil
+}, NewComponent("b", 1, 10))
+
+func Test_Benchmark_Run_InvalidComponents(t *testing.T) {
+	_, err := targetBenchmark.Run([]sc.U32{}, false, nil)
+
+	assert.EqualError(t, err, "benchmark remark expects 1 components, got 0")
+}
+
+func Test_Benchmark_Run_OutOfRange(t *testing.T) {
+	_, err := targetBenchmark.Run([]sc.U32{11}, false, nil)
+
+	assert.EqualError(t, err, "component b of benchmark remark is out of range")
+}
+
+func Test_B_Value(t *testing.T) {
+	b := &B{
+		components: []Component{NewComponent("a", 0, 5), NewComponent("b", 0, 5)},
+		values:     []sc.U32{3, 4},
+	}
+
+	assert.Equal(t, sc.U32(3), b.Value("a"))
+	assert.Equal(t, sc.U32(4), b.Value("b"))
+	assert.Panics(t, func() { b.Value("c") })
+}
+
+func Test_B_Dispatch_SetupOnly(t *testing.T) {
+	b := &B{setupOnly: true}
+
+	assert.NoError(t, b.Dispatch(types.RuntimeOrigin{}, nil))
+	assert.True(t, b.dispatched)
+	assert.Error(t, b.Dispatch(types.RuntimeOrigin{}, nil))
+}
+
+func Test_ProofSize(t *testing.T) {
+	storageInfo := []types.StorageInfo{
+		{Prefix: []byte("value"), MaxValues: sc.NewOption[sc.U32](sc.U32(1)), MaxSize: 8},
+	}
+	reads := []storage.TrackedRead{
+		{Key: []byte("value"), Size: 4},
+		{Key: []byte("other"), Size: 3},
+	}
+
+	assert.Equal(t, sc.U64(503+8+5*495), proofSize(reads, storageInfo))
+}
@@ -3,10 +3,32 @@
 package crypto
 
 import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/env"
 	"github.com/LimeChain/gosemble/utils"
 )
 
+// ExtCryptoEcdsaPublicKeysVersion1 returns all ecdsa public keys, in compressed form, for the given key type from the keystore.
+func ExtCryptoEcdsaPublicKeysVersion1(keyTypeId []byte) sc.Sequence[sc.FixedSequence[sc.U8]] {
+	r := env.ExtCryptoEcdsaPublicKeysVersion1(utils.Offset32(keyTypeId))
+	offset, size := utils.Int64ToOffsetAndSize(r)
+	buffer := bytes.NewBuffer(utils.ToWasmMemorySlice(offset, size))
+
+	return sc.DecodeSequenceWith(buffer, decodeEcdsaPublicKey)
+}
+
+// ExtCryptoEcdsaSignPrehashedVersion1 signs the 32 byte message hash with the ecdsa key that corresponds to
+// the given public key and key type in the keystore. Returns none if the key is not in the keystore.
+func ExtCryptoEcdsaSignPrehashedVersion1(keyTypeId []byte, pubKey []byte, messageHash []byte) sc.Option[sc.FixedSequence[sc.U8]] {
+	r := env.ExtCryptoEcdsaSignPrehashedVersion1(utils.Offset32(keyTypeId), utils.Offset32(pubKey), utils.Offset32(messageHash))
+	offset, size := utils.Int64ToOffsetAndSize(r)
+	buffer := bytes.NewBuffer(utils.ToWasmMemorySlice(offset, size))
+
+	return sc.DecodeOptionWith(buffer, decodeEcdsaSignature)
+}
+
 func ExtCryptoEd25519GenerateVersion1(keyTypeId []byte, seed []byte) []byte {
 	r := env.ExtCryptoEd25519GenerateVersion1(utils.Offset32(keyTypeId), utils.BytesToOffsetAndSize(seed))
 	return utils.ToWasmMemorySlice(r, 32)
@@ -18,11 +40,51 @@ func ExtCryptoEd25519VerifyVersion1(signature []byte, message []byte, pubKey []b
 	) == 1
 }
 
+// ExtCryptoSecp256k1EcdsaRecoverVersion2 recovers the 64 byte uncompressed public key, without the
+// 0x04 prefix, which signed the 32 byte message hash. Returns none if the signature is invalid.
+func ExtCryptoSecp256k1EcdsaRecoverVersion2(signature []byte, messageHash []byte) sc.Option[sc.FixedSequence[sc.U8]] {
+	r := env.ExtCryptoSecp256k1EcdsaRecoverVersion2(utils.Offset32(signature), utils.Offset32(messageHash))
+	offset, size := utils.Int64ToOffsetAndSize(r)
+
+	return decodeRecoverResult(bytes.NewBuffer(utils.ToWasmMemorySlice(offset, size)), 64)
+}
+
+// ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2 recovers the 33 byte compressed public key which signed
+// the 32 byte message hash. Returns none if the signature is invalid.
+func ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2(signature []byte, messageHash []byte) sc.Option[sc.FixedSequence[sc.U8]] {
+	r := env.ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2(utils.Offset32(signature), utils.Offset32(messageHash))
+	offset, size := utils.Int64ToOffsetAndSize(r)
+
+	return decodeRecoverResult(bytes.NewBuffer(utils.ToWasmMemorySlice(offset, size)), 33)
+}
+
 func ExtCryptoSr25519GenerateVersion1(keyTypeId []byte, seed []byte) []byte {
 	r := env.ExtCryptoSr25519GenerateVersion1(utils.Offset32(keyTypeId), utils.BytesToOffsetAndSize(seed))
 	return utils.ToWasmMemorySlice(r, 32)
 }
 
+// ExtCryptoSr25519PublicKeysVersion1 returns all sr25519 public keys for the given key type from the keystore.
+func ExtCryptoSr25519PublicKeysVersion1(keyTypeId []byte) sc.Sequence[sc.FixedSequence[sc.U8]] {
+	r := env.ExtCryptoSr25519PublicKeysVersion1(utils.Offset32(keyTypeId))
+	offset, size := utils.Int64ToOffsetAndSize(r)
+	buffer := bytes.NewBuffer(utils.ToWasmMemorySlice(offset, size))
+
+	return sc.DecodeSequenceWith(buffer, decodePublicKey)
+}
+
+// ExtCryptoSr25519SignVersion1 signs the message with the sr25519 key that corresponds to the given
+// public key and key type in the keystore. Returns none if the key is not in the keystore.
+func ExtCryptoSr25519SignVersion1(keyTypeId []byte, pubKey []byte, message []byte) sc.Option[sc.FixedSequence[sc.U8]] {
+	pubKeyOffsetSize := utils.BytesToOffsetAndSize(pubKey)
+	pubKeyOffset, _ := utils.Int64ToOffsetAndSize(pubKeyOffsetSize)
+
+	r := env.ExtCryptoSr25519SignVersion1(utils.Offset32(keyTypeId), pubKeyOffset, utils.BytesToOffsetAndSize(message))
+	offset, size := utils.Int64ToOffsetAndSize(r)
+	buffer := bytes.NewBuffer(utils.ToWasmMemorySlice(offset, size))
+
+	return sc.DecodeOptionWith(buffer, decodeSignature)
+}
+
 func ExtCryptoSr25519VerifyVersion2(signature []byte, message []byte, pubKey []byte) bool {
 	return env.ExtCryptoSr25519VerifyVersion2(
 		argsSigMsgPubKeyAsWasmMemory(signature, message, pubKey),
@@ -48,3 +110,28 @@ func argsSigMsgPubKeyAsWasmMemory(signature []byte, message []byte, pubKey []byt
 
 	return sigOffset, msgOffsetSize, pubKeyOffset
 }
+
+func decodePublicKey(buffer *bytes.Buffer) sc.FixedSequence[sc.U8] {
+	return sc.DecodeFixedSequence[sc.U8](32, buffer)
+}
+
+func decodeSignature(buffer *bytes.Buffer) sc.FixedSequence[sc.U8] {
+	return sc.DecodeFixedSequence[sc.U8](64, buffer)
+}
+
+func decodeEcdsaPublicKey(buffer *bytes.Buffer) sc.FixedSequence[sc.U8] {
+	return sc.DecodeFixedSequence[sc.U8](33, buffer)
+}
+
+func decodeEcdsaSignature(buffer *bytes.Buffer) sc.FixedSequence[sc.U8] {
+	return sc.DecodeFixedSequence[sc.U8](65, buffer)
+}
+
+// decodeRecoverResult decodes the `Result<[u8; size], EcdsaVerifyError>` returned by the recover host functions.
+func decodeRecoverResult(buffer *bytes.Buffer, size int) sc.Option[sc.FixedSequence[sc.U8]] {
+	if sc.DecodeU8(buffer) != 0 {
+		return sc.NewOption[sc.FixedSequence[sc.U8]](nil)
+	}
+
+	return sc.NewOption[sc.FixedSequence[sc.U8]](sc.DecodeFixedSequence[sc.U8](size, buffer))
+}
@@ -6,8 +6,18 @@ import (
 	"crypto/ed25519"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
 )
 
+func ExtCryptoEcdsaPublicKeysVersion1(keyTypeId []byte) sc.Sequence[sc.FixedSequence[sc.U8]] {
+	panic("not implemented")
+}
+
+func ExtCryptoEcdsaSignPrehashedVersion1(keyTypeId []byte, pubKey []byte, messageHash []byte) sc.Option[sc.FixedSequence[sc.U8]] {
+	panic("not implemented")
+}
+
 func ExtCryptoEd25519GenerateVersion1(keyTypeId []byte, seed []byte) []byte {
 	panic("not implemented")
 }
@@ -16,10 +26,36 @@ func ExtCryptoEd25519VerifyVersion1(signature []byte, message []byte, pubKey []b
 	return sc.Bool(ed25519.Verify(pubKey, message, signature))
 }
 
+func ExtCryptoSecp256k1EcdsaRecoverVersion2(signature []byte, messageHash []byte) sc.Option[sc.FixedSequence[sc.U8]] {
+	publicKey, ok := secp256k1EcdsaRecover(signature, messageHash)
+	if !ok {
+		return sc.NewOption[sc.FixedSequence[sc.U8]](nil)
+	}
+
+	return sc.NewOption[sc.FixedSequence[sc.U8]](sc.BytesToFixedSequenceU8(publicKey.SerializeUncompressed()[1:]))
+}
+
+func ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2(signature []byte, messageHash []byte) sc.Option[sc.FixedSequence[sc.U8]] {
+	publicKey, ok := secp256k1EcdsaRecover(signature, messageHash)
+	if !ok {
+		return sc.NewOption[sc.FixedSequence[sc.U8]](nil)
+	}
+
+	return sc.NewOption[sc.FixedSequence[sc.U8]](sc.BytesToFixedSequenceU8(publicKey.SerializeCompressed()))
+}
+
 func ExtCryptoSr25519GenerateVersion1(keyTypeId []byte, seed []byte) []byte {
 	panic("not implemented")
 }
 
+func ExtCryptoSr25519PublicKeysVersion1(keyTypeId []byte) sc.Sequence[sc.FixedSequence[sc.U8]] {
+	panic("not implemented")
+}
+
+func ExtCryptoSr25519SignVersion1(keyTypeId []byte, pubKey []byte, message []byte) sc.Option[sc.FixedSequence[sc.U8]] {
+	panic("not implemented")
+}
+
 func ExtCryptoSr25519VerifyVersion2(signature []byte, message []byte, pubKey []byte) sc.Bool {
 	panic("not implemented")
 }
@@ -31,3 +67,27 @@ func ExtCryptoStartBatchVerify() {
 func ExtCryptoFinishBatchVerify() int32 {
 	panic("not implemented")
 }
+
+// secp256k1EcdsaRecover recovers the public key of a 65 byte `r || s || v` signature, where the
+// recovery id `v` is either 0-3 or 27-30, as the host does.
+func secp256k1EcdsaRecover(signature []byte, messageHash []byte) (*secp256k1.PublicKey, bool) {
+	if len(signature) != 65 || len(messageHash) != 32 {
+		return nil, false
+	}
+
+	v := signature[64]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 3 {
+		return nil, false
+	}
+
+	compact := append([]byte{27 + v}, signature[:64]...)
+	publicKey, _, err := ecdsa.RecoverCompact(compact, messageHash)
+	if err != nil {
+		return nil, false
+	}
+
+	return publicKey, true
+}
@@ -30,3 +30,9 @@ func Blake256(value []byte) []byte {
 	r := env.ExtHashingBlake2256Version1(keyOffsetSize)
 	return utils.ToWasmMemorySlice(r, 32)
 }
+
+func Keccak256(value []byte) []byte {
+	keyOffsetSize := utils.BytesToOffsetAndSize(value)
+	r := env.ExtHashingKeccak256Version1(keyOffsetSize)
+	return utils.ToWasmMemorySlice(r, 32)
+}
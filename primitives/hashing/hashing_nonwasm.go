@@ -25,3 +25,8 @@ func Blake256(value []byte) []byte {
 	h, _ := common.Blake2bHash(value)
 	return h[:]
 }
+
+func Keccak256(value []byte) []byte {
+	h, _ := common.Keccak256(value)
+	return h[:]
+}
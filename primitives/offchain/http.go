@@ -0,0 +1,129 @@
+package offchain
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// httpReadChunkSize is the size of the chunks in which the response body is read.
+const httpReadChunkSize = 4096
+
+// HttpRequest is an HTTP request made by the offchain worker.
+type HttpRequest struct {
+	Method   string
+	Url      string
+	Headers  [][2]string
+	Body     [][]byte
+	Deadline sc.Option[sc.U64]
+}
+
+// NewHttpRequest returns a request with the given method and url, without a body and a deadline.
+func NewHttpRequest(method string, url string) HttpRequest {
+	return HttpRequest{
+		Method:   method,
+		Url:      url,
+		Deadline: sc.NewOption[sc.U64](nil),
+	}
+}
+
+// NewHttpGetRequest returns a GET request to url.
+func NewHttpGetRequest(url string) HttpRequest {
+	return NewHttpRequest("GET", url)
+}
+
+// NewHttpPostRequest returns a POST request to url with the given body.
+func NewHttpPostRequest(url string, body ...[]byte) HttpRequest {
+	return NewHttpRequest("POST", url).WithBody(body...)
+}
+
+func (r HttpRequest) AddHeader(name string, value string) HttpRequest {
+	r.Headers = append(r.Headers, [2]string{name, value})
+	return r
+}
+
+func (r HttpRequest) WithBody(chunks ...[]byte) HttpRequest {
+	r.Body = append(r.Body, chunks...)
+	return r
+}
+
+// WithDeadline sets the timestamp, in milliseconds, until which the request is processed.
+func (r HttpRequest) WithDeadline(deadline sc.U64) HttpRequest {
+	r.Deadline = sc.NewOption[sc.U64](deadline)
+	return r
+}
+
+// Send starts the request, writes its headers and body, and returns it as pending.
+func (r HttpRequest) Send() (HttpPendingRequest, error) {
+	id, ok := HttpRequestStart(r.Method, r.Url)
+	if !ok {
+		return HttpPendingRequest{}, types.NewHttpErrorIoError()
+	}
+
+	for _, header := range r.Headers {
+		if !HttpRequestAddHeader(id, header[0], header[1]) {
+			return HttpPendingRequest{}, types.NewHttpErrorInvalid()
+		}
+	}
+
+	for _, chunk := range r.Body {
+		if err := HttpRequestWriteBody(id, chunk, r.Deadline); err != nil {
+			return HttpPendingRequest{}, err
+		}
+	}
+
+	// An empty chunk finalises the request.
+	if err := HttpRequestWriteBody(id, []byte{}, r.Deadline); err != nil {
+		return HttpPendingRequest{}, err
+	}
+
+	return HttpPendingRequest{Id: id, Deadline: r.Deadline}, nil
+}
+
+// HttpPendingRequest is a sent request whose response is not received yet.
+type HttpPendingRequest struct {
+	Id       types.HttpRequestId
+	Deadline sc.Option[sc.U64]
+}
+
+// Wait blocks until the response of the request is received or its deadline is reached.
+func (p HttpPendingRequest) Wait() (HttpResponse, error) {
+	statuses := HttpResponseWait(sc.Sequence[types.HttpRequestId]{p.Id}, p.Deadline)
+	if len(statuses) != 1 {
+		return HttpResponse{}, types.NewHttpErrorInvalid()
+	}
+
+	status := statuses[0]
+	if !status.IsFinished() {
+		return HttpResponse{}, status.AsError()
+	}
+
+	return HttpResponse{Id: p.Id, Code: status.AsFinished(), Deadline: p.Deadline}, nil
+}
+
+// HttpResponse is the response of a finished request.
+type HttpResponse struct {
+	Id       types.HttpRequestId
+	Code     sc.U16
+	Deadline sc.Option[sc.U64]
+}
+
+func (r HttpResponse) Headers() sc.Sequence[types.HttpHeader] {
+	return HttpResponseHeaders(r.Id)
+}
+
+// Body reads the whole body of the response.
+func (r HttpResponse) Body() ([]byte, error) {
+	body := []byte{}
+	chunk := make([]byte, httpReadChunkSize)
+
+	for {
+		n, err := HttpResponseReadBody(r.Id, chunk, r.Deadline)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return body, nil
+		}
+		body = append(body, chunk[:n]...)
+	}
+}
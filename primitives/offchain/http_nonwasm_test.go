@@ -0,0 +1,70 @@
+//go:build nonwasmenv
+
+package offchain
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_HttpRequest_RoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/price", r.URL.Path)
+		assert.Equal(t, "gosemble", r.Header.Get("X-Worker"))
+		assert.Equal(t, []byte("BTC-USD"), body)
+
+		w.Header().Set("X-Price", "1")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("27000"))
+	}))
+	defer server.Close()
+
+	pending, err := NewHttpPostRequest(server.URL+"/price", []byte("BTC-"), []byte("USD")).
+		AddHeader("X-Worker", "gosemble").
+		Send()
+	assert.NoError(t, err)
+
+	response, err := pending.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U16(http.StatusCreated), response.Code)
+	assert.Contains(t, response.Headers(), types.HttpHeader{
+		Name:  sc.BytesToSequenceU8([]byte("X-Price")),
+		Value: sc.BytesToSequenceU8([]byte("1")),
+	})
+
+	body, err := response.Body()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("27000"), body)
+
+	// The request is removed once its body is read.
+	_, err = response.Body()
+	assert.Equal(t, types.NewHttpErrorInvalid(), err)
+}
+
+func Test_HttpRequest_IoError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	pending, err := NewHttpGetRequest(url).Send()
+	assert.NoError(t, err)
+
+	_, err = pending.Wait()
+	assert.Equal(t, types.NewHttpErrorIoError(), err)
+}
+
+func Test_HttpRequest_InvalidUrl(t *testing.T) {
+	_, err := NewHttpGetRequest("://").Send()
+
+	assert.Equal(t, types.NewHttpErrorIoError(), err)
+}
@@ -0,0 +1,168 @@
+//go:build !nonwasmenv
+
+package offchain
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/env"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/utils"
+)
+
+// IsValidator returns whether the local node is a potential validator. Even if this function
+// returns true, it does not mean that any keys are configured and that the validator is
+// registered in the chain.
+func IsValidator() bool {
+	return env.ExtOffchainIsValidatorVersion1() == 1
+}
+
+// SubmitTransaction submits the SCALE-encoded extrinsic to the transaction pool.
+// Returns whether the extrinsic was accepted by the pool.
+func SubmitTransaction(extrinsic []byte) bool {
+	r := env.ExtOffchainSubmitTransactionVersion1(utils.BytesToOffsetAndSize(extrinsic))
+	offset, size := utils.Int64ToOffsetAndSize(r)
+	result := utils.ToWasmMemorySlice(offset, size)
+
+	return len(result) > 0 && result[0] == 0
+}
+
+// Timestamp returns the current time of the node, in milliseconds since the UNIX epoch.
+func Timestamp() sc.U64 {
+	return sc.U64(env.ExtOffchainTimestampVersion1())
+}
+
+// SleepUntil pauses the execution until the `deadline` timestamp is reached.
+func SleepUntil(deadline sc.U64) {
+	env.ExtOffchainSleepUntilVersion1(int64(deadline))
+}
+
+// RandomSeed returns a random seed generated by the node. It is not deterministic and must
+// not be used in on-chain logic.
+func RandomSeed() []byte {
+	offset := env.ExtOffchainRandomSeedVersion1()
+	return utils.ToWasmMemorySlice(offset, 32)
+}
+
+// LocalStorageGet returns the value of `key` in the offchain local storage of the given kind.
+func LocalStorageGet(kind types.OffchainStorageKind, key []byte) sc.Option[sc.Sequence[sc.U8]] {
+	r := env.ExtOffchainLocalStorageGetVersion1(int32(kind), utils.BytesToOffsetAndSize(key))
+	offset, size := utils.Int64ToOffsetAndSize(r)
+	value := utils.ToWasmMemorySlice(offset, size)
+
+	return sc.DecodeOption[sc.Sequence[sc.U8]](bytes.NewBuffer(value))
+}
+
+// LocalStorageSet sets `value` to `key` in the offchain local storage of the given kind.
+func LocalStorageSet(kind types.OffchainStorageKind, key []byte, value []byte) {
+	env.ExtOffchainLocalStorageSetVersion1(int32(kind), utils.BytesToOffsetAndSize(key), utils.BytesToOffsetAndSize(value))
+}
+
+// LocalStorageClear removes `key` from the offchain local storage of the given kind.
+func LocalStorageClear(kind types.OffchainStorageKind, key []byte) {
+	env.ExtOffchainLocalStorageClearVersion1(int32(kind), utils.BytesToOffsetAndSize(key))
+}
+
+// LocalStorageCompareAndSet sets `newValue` to `key` only if its current value is `oldValue`.
+// A `None` old value means that the key must not be set. Returns whether the value was set.
+func LocalStorageCompareAndSet(kind types.OffchainStorageKind, key []byte, oldValue sc.Option[sc.Sequence[sc.U8]], newValue []byte) bool {
+	r := env.ExtOffchainLocalStorageCompareAndSetVersion1(
+		int32(kind),
+		utils.BytesToOffsetAndSize(key),
+		utils.BytesToOffsetAndSize(oldValue.Bytes()),
+		utils.BytesToOffsetAndSize(newValue),
+	)
+
+	return r == 1
+}
+
+// HttpRequestStart initiates an HTTP request with the given method and uri.
+// The request is not sent until its body is written.
+func HttpRequestStart(method string, uri string) (types.HttpRequestId, bool) {
+	r := env.ExtOffchainHttpRequestStartVersion1(
+		utils.BytesToOffsetAndSize([]byte(method)),
+		utils.BytesToOffsetAndSize([]byte(uri)),
+		utils.BytesToOffsetAndSize([]byte{}),
+	)
+	buffer := bytes.NewBuffer(toWasmMemorySlice(r))
+
+	if !isResultOk(buffer) {
+		return 0, false
+	}
+
+	return sc.DecodeU16(buffer), true
+}
+
+// HttpRequestAddHeader appends a header to a request that is not sent yet.
+func HttpRequestAddHeader(id types.HttpRequestId, name string, value string) bool {
+	r := env.ExtOffchainHttpRequestAddHeaderVersion1(
+		int32(id),
+		utils.BytesToOffsetAndSize([]byte(name)),
+		utils.BytesToOffsetAndSize([]byte(value)),
+	)
+
+	return isResultOk(bytes.NewBuffer(toWasmMemorySlice(r)))
+}
+
+// HttpRequestWriteBody writes a chunk of the request body. Writing an empty chunk
+// finalises the request.
+func HttpRequestWriteBody(id types.HttpRequestId, chunk []byte, deadline sc.Option[sc.U64]) error {
+	r := env.ExtOffchainHttpRequestWriteBodyVersion1(
+		int32(id),
+		utils.BytesToOffsetAndSize(chunk),
+		utils.BytesToOffsetAndSize(deadline.Bytes()),
+	)
+	buffer := bytes.NewBuffer(toWasmMemorySlice(r))
+
+	if !isResultOk(buffer) {
+		return types.DecodeHttpError(buffer)
+	}
+
+	return nil
+}
+
+// HttpResponseWait blocks until the responses of the requests are received or the deadline
+// is reached. Returns the status of each request, in the same order as `ids`.
+func HttpResponseWait(ids sc.Sequence[types.HttpRequestId], deadline sc.Option[sc.U64]) sc.Sequence[types.HttpRequestStatus] {
+	r := env.ExtOffchainHttpResponseWaitVersion1(
+		utils.BytesToOffsetAndSize(ids.Bytes()),
+		utils.BytesToOffsetAndSize(deadline.Bytes()),
+	)
+
+	return sc.DecodeSequenceWith(bytes.NewBuffer(toWasmMemorySlice(r)), types.DecodeHttpRequestStatus)
+}
+
+// HttpResponseHeaders returns the headers of the response of a finished request.
+func HttpResponseHeaders(id types.HttpRequestId) sc.Sequence[types.HttpHeader] {
+	r := env.ExtOffchainHttpResponseHeadersVersion1(int32(id))
+
+	return sc.DecodeSequenceWith(bytes.NewBuffer(toWasmMemorySlice(r)), types.DecodeHttpHeader)
+}
+
+// HttpResponseReadBody reads a chunk of the response body into `buffer`.
+// Returns the number of bytes read, which is 0 once the whole body is read.
+func HttpResponseReadBody(id types.HttpRequestId, buffer []byte, deadline sc.Option[sc.U64]) (sc.U32, error) {
+	r := env.ExtOffchainHttpResponseReadBodyVersion1(
+		int32(id),
+		utils.BytesToOffsetAndSize(buffer),
+		utils.BytesToOffsetAndSize(deadline.Bytes()),
+	)
+	result := bytes.NewBuffer(toWasmMemorySlice(r))
+
+	if !isResultOk(result) {
+		return 0, types.DecodeHttpError(result)
+	}
+
+	return sc.DecodeU32(result), nil
+}
+
+func toWasmMemorySlice(offsetAndSize int64) []byte {
+	offset, size := utils.Int64ToOffsetAndSize(offsetAndSize)
+	return utils.ToWasmMemorySlice(offset, size)
+}
+
+// isResultOk decodes the variant of a SCALE-encoded Result.
+func isResultOk(buffer *bytes.Buffer) bool {
+	return sc.DecodeU8(buffer) == 0
+}
@@ -0,0 +1,184 @@
+//go:build nonwasmenv
+
+package offchain
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+func IsValidator() bool {
+	panic("not implemented")
+}
+
+func SubmitTransaction(extrinsic []byte) bool {
+	panic("not implemented")
+}
+
+func Timestamp() sc.U64 {
+	panic("not implemented")
+}
+
+func SleepUntil(deadline sc.U64) {
+	panic("not implemented")
+}
+
+func RandomSeed() []byte {
+	panic("not implemented")
+}
+
+func LocalStorageGet(kind types.OffchainStorageKind, key []byte) sc.Option[sc.Sequence[sc.U8]] {
+	panic("not implemented")
+}
+
+func LocalStorageSet(kind types.OffchainStorageKind, key []byte, value []byte) {
+	panic("not implemented")
+}
+
+func LocalStorageClear(kind types.OffchainStorageKind, key []byte) {
+	panic("not implemented")
+}
+
+func LocalStorageCompareAndSet(kind types.OffchainStorageKind, key []byte, oldValue sc.Option[sc.Sequence[sc.U8]], newValue []byte) bool {
+	panic("not implemented")
+}
+
+// httpRequests are the HTTP requests of the native build, which are sent with net/http so that
+// the code which makes requests can be unit tested against a local server.
+var (
+	httpRequests      = map[types.HttpRequestId]*nativeHttpRequest{}
+	nextHttpRequestId types.HttpRequestId
+)
+
+// nativeHttpRequest is sent once its body is finalised. `done` is closed when its response is
+// received or it fails.
+type nativeHttpRequest struct {
+	request  *http.Request
+	body     bytes.Buffer
+	sent     bool
+	done     chan struct{}
+	response *http.Response
+	err      error
+}
+
+func HttpRequestStart(method string, uri string) (types.HttpRequestId, bool) {
+	request, err := http.NewRequest(method, uri, nil)
+	if err != nil {
+		return 0, false
+	}
+
+	id := nextHttpRequestId
+	nextHttpRequestId++
+	httpRequests[id] = &nativeHttpRequest{request: request, done: make(chan struct{})}
+
+	return id, true
+}
+
+func HttpRequestAddHeader(id types.HttpRequestId, name string, value string) bool {
+	r, ok := httpRequests[id]
+	if !ok || r.sent {
+		return false
+	}
+
+	r.request.Header.Add(name, value)
+	return true
+}
+
+func HttpRequestWriteBody(id types.HttpRequestId, chunk []byte, deadline sc.Option[sc.U64]) error {
+	r, ok := httpRequests[id]
+	if !ok || r.sent {
+		return types.NewHttpErrorInvalid()
+	}
+
+	if len(chunk) > 0 {
+		r.body.Write(chunk)
+		return nil
+	}
+
+	r.sent = true
+	r.request.Body = io.NopCloser(bytes.NewReader(r.body.Bytes()))
+	r.request.ContentLength = int64(r.body.Len())
+
+	go func() {
+		r.response, r.err = http.DefaultClient.Do(r.request)
+		close(r.done)
+	}()
+
+	return nil
+}
+
+func HttpResponseWait(ids sc.Sequence[types.HttpRequestId], deadline sc.Option[sc.U64]) sc.Sequence[types.HttpRequestStatus] {
+	var timeout <-chan time.Time
+	if deadline.HasValue {
+		timeout = time.After(time.Until(time.UnixMilli(int64(deadline.Value))))
+	}
+
+	statuses := sc.Sequence[types.HttpRequestStatus]{}
+	for _, id := range ids {
+		r, ok := httpRequests[id]
+		if !ok || !r.sent {
+			statuses = append(statuses, types.NewHttpRequestStatusInvalid())
+			continue
+		}
+
+		select {
+		case <-r.done:
+			if r.err != nil {
+				statuses = append(statuses, types.NewHttpRequestStatusIoError())
+			} else {
+				statuses = append(statuses, types.NewHttpRequestStatusFinished(sc.U16(r.response.StatusCode)))
+			}
+		case <-timeout:
+			statuses = append(statuses, types.NewHttpRequestStatusDeadlineReached())
+		}
+	}
+
+	return statuses
+}
+
+func HttpResponseHeaders(id types.HttpRequestId) sc.Sequence[types.HttpHeader] {
+	headers := sc.Sequence[types.HttpHeader]{}
+
+	r, ok := httpRequests[id]
+	if !ok || r.response == nil {
+		return headers
+	}
+
+	for name, values := range r.response.Header {
+		for _, value := range values {
+			headers = append(headers, types.HttpHeader{
+				Name:  sc.BytesToSequenceU8([]byte(name)),
+				Value: sc.BytesToSequenceU8([]byte(value)),
+			})
+		}
+	}
+
+	return headers
+}
+
+func HttpResponseReadBody(id types.HttpRequestId, buffer []byte, deadline sc.Option[sc.U64]) (sc.U32, error) {
+	r, ok := httpRequests[id]
+	if !ok || r.response == nil {
+		return 0, types.NewHttpErrorInvalid()
+	}
+
+	n, err := io.ReadAtLeast(r.response.Body, buffer, 1)
+	if n > 0 {
+		return sc.U32(n), nil
+	}
+
+	// The request is removed once its body is read, as in the host.
+	r.response.Body.Close()
+	delete(httpRequests, id)
+
+	if err != io.EOF {
+		return 0, types.NewHttpErrorIoError()
+	}
+
+	return 0, nil
+}
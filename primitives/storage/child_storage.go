@@ -0,0 +1,58 @@
+//go:build !nonwasmenv
+
+package storage
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/env"
+	"github.com/LimeChain/gosemble/utils"
+)
+
+// ChildClear clears the value of `key` in the default child trie of `storageKey`.
+func ChildClear(storageKey []byte, key []byte) {
+	trackWrite(childKey(storageKey, key))
+	storageKeyOffsetSize := utils.BytesToOffsetAndSize(storageKey)
+	keyOffsetSize := utils.BytesToOffsetAndSize(key)
+	env.ExtDefaultChildStorageClearVersion1(storageKeyOffsetSize, keyOffsetSize)
+}
+
+// ChildGet returns the value of `key` in the default child trie of `storageKey`.
+func ChildGet(storageKey []byte, key []byte) sc.Option[sc.Sequence[sc.U8]] {
+	storageKeyOffsetSize := utils.BytesToOffsetAndSize(storageKey)
+	keyOffsetSize := utils.BytesToOffsetAndSize(key)
+	valueOffsetSize := env.ExtDefaultChildStorageGetVersion1(storageKeyOffsetSize, keyOffsetSize)
+	offset, size := utils.Int64ToOffsetAndSize(valueOffsetSize)
+	value := utils.ToWasmMemorySlice(offset, size)
+	trackRead(childKey(storageKey, key), len(value))
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(value)
+
+	return sc.DecodeOption[sc.Sequence[sc.U8]](buffer)
+}
+
+// ChildNextKey returns the next key after `key` in lexicographic order in the default child
+// trie of `storageKey`, or `None` if there is no such key.
+func ChildNextKey(storageKey []byte, key []byte) sc.Option[sc.Sequence[sc.U8]] {
+	storageKeyOffsetSize := utils.BytesToOffsetAndSize(storageKey)
+	keyOffsetSize := utils.BytesToOffsetAndSize(key)
+	valueOffsetSize := env.ExtDefaultChildStorageNextKeyVersion1(storageKeyOffsetSize, keyOffsetSize)
+	offset, size := utils.Int64ToOffsetAndSize(valueOffsetSize)
+	value := utils.ToWasmMemorySlice(offset, size)
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(value)
+
+	return sc.DecodeOption[sc.Sequence[sc.U8]](buffer)
+}
+
+// ChildSet sets the value of `key` in the default child trie of `storageKey`.
+func ChildSet(storageKey []byte, key []byte, value []byte) {
+	trackWrite(childKey(storageKey, key))
+	storageKeyOffsetSize := utils.BytesToOffsetAndSize(storageKey)
+	keyOffsetSize := utils.BytesToOffsetAndSize(key)
+	valueOffsetSize := utils.BytesToOffsetAndSize(value)
+	env.ExtDefaultChildStorageSetVersion1(storageKeyOffsetSize, keyOffsetSize, valueOffsetSize)
+}
@@ -0,0 +1,23 @@
+//go:build nonwasmenv
+
+package storage
+
+import (
+	sc "github.com/LimeChain/goscale"
+)
+
+func ChildClear(storageKey []byte, key []byte) {
+	panic("not implemented")
+}
+
+func ChildGet(storageKey []byte, key []byte) sc.Option[sc.Sequence[sc.U8]] {
+	panic("not implemented")
+}
+
+func ChildNextKey(storageKey []byte, key []byte) sc.Option[sc.Sequence[sc.U8]] {
+	panic("not implemented")
+}
+
+func ChildSet(storageKey []byte, key []byte, value []byte) {
+	panic("not implemented")
+}
@@ -11,6 +11,7 @@ import (
 )
 
 func Append(key []byte, value []byte) {
+	trackWrite(key)
 	keyOffsetSize := utils.BytesToOffsetAndSize(key)
 	valueOffsetSize := utils.BytesToOffsetAndSize(value)
 	env.ExtStorageAppendVersion1(keyOffsetSize, valueOffsetSize)
@@ -21,17 +22,20 @@ func ChangesRoot(parent_hash int64) int64 {
 }
 
 func Clear(key []byte) {
+	trackWrite(key)
 	keyOffsetSize := utils.BytesToOffsetAndSize(key)
 	env.ExtStorageClearVersion1(keyOffsetSize)
 }
 
 func ClearPrefix(key []byte, limit []byte) {
+	trackWrite(key)
 	keyOffsetSize := utils.BytesToOffsetAndSize(key)
 	limitOffsetSize := utils.BytesToOffsetAndSize(limit)
 	env.ExtStorageClearPrefixVersion2(keyOffsetSize, limitOffsetSize)
 }
 
 func Exists(key []byte) int32 {
+	trackRead(key, 0)
 	keyOffsetSize := utils.BytesToOffsetAndSize(key)
 	return env.ExtStorageExistsVersion1(keyOffsetSize)
 }
@@ -74,8 +78,18 @@ func GetDecodeOnEmpty[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.
 	return decodeFunc(buffer)
 }
 
-func NextKey(key int64) int64 {
-	panic("not implemented")
+// NextKey returns the next key in storage after the given `key` in lexicographic order,
+// or `None` if there is no such key.
+func NextKey(key []byte) sc.Option[sc.Sequence[sc.U8]] {
+	keyOffsetSize := utils.BytesToOffsetAndSize(key)
+	valueOffsetSize := env.ExtStorageNextKeyVersion1(keyOffsetSize)
+	offset, size := utils.Int64ToOffsetAndSize(valueOffsetSize)
+	value := utils.ToWasmMemorySlice(offset, size)
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(value)
+
+	return sc.DecodeOption[sc.Sequence[sc.U8]](buffer)
 }
 
 // StartTransaction Start a new nested transaction.
@@ -133,6 +147,7 @@ func Root(version int32) []byte {
 }
 
 func Set(key []byte, value []byte) {
+	trackWrite(key)
 	keyOffsetSize := utils.BytesToOffsetAndSize(key)
 	valueOffsetSize := utils.BytesToOffsetAndSize(value)
 	env.ExtStorageSetVersion1(keyOffsetSize, valueOffsetSize)
@@ -178,12 +193,14 @@ func get(key []byte) []byte {
 	valueOffsetSize := env.ExtStorageGetVersion1(keyOffsetSize)
 	offset, size := utils.Int64ToOffsetAndSize(valueOffsetSize)
 	value := utils.ToWasmMemorySlice(offset, size)
+	trackRead(key, len(value))
 	return value
 }
 
 // read reads the given key value from storage, placing the value into buffer valueOut depending on offset.
 // The wasm memory slice represents an encoded Option<sc.U32> representing the number of bytes left at supplied offset.
 func read(key []byte, valueOut []byte, offset int32) []byte {
+	trackRead(key, len(valueOut))
 	keyOffsetSize := utils.BytesToOffsetAndSize(key)
 	valueOutOffsetSize := utils.BytesToOffsetAndSize(valueOut)
 
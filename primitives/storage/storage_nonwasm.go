@@ -8,6 +8,10 @@ import (
 	sc "github.com/LimeChain/goscale"
 )
 
+// values is the storage of the native build, which keeps the values in memory so that the code
+// which reads and writes storage can be unit tested without a host.
+var values = map[string][]byte{}
+
 func Append(key []byte, value []byte) {
 	panic("not implemented")
 }
@@ -17,7 +21,7 @@ func ChangesRoot(parent_hash int64) int64 {
 }
 
 func Clear(key []byte) {
-	panic("not implemented")
+	delete(values, string(key))
 }
 
 func ClearPrefix(key []byte, limit []byte) {
@@ -25,22 +29,36 @@ func ClearPrefix(key []byte, limit []byte) {
 }
 
 func Exists(key []byte) int32 {
-	panic("not implemented")
+	if _, ok := values[string(key)]; ok {
+		return 1
+	}
+
+	return 0
 }
 
 func Get(key []byte) sc.Option[sc.Sequence[sc.U8]] {
-	panic("not implemented")
+	value, ok := values[string(key)]
+	if !ok {
+		return sc.NewOption[sc.Sequence[sc.U8]](nil)
+	}
+
+	return sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(value))
 }
 
 func GetDecode[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.Buffer) T) T {
-	panic("not implemented")
+	return GetDecodeOnEmpty(key, decodeFunc, *new(T))
 }
 
 func GetDecodeOnEmpty[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.Buffer) T, onEmpty T) T {
-	panic("not implemented")
+	value, ok := values[string(key)]
+	if !ok {
+		return onEmpty
+	}
+
+	return decodeFunc(bytes.NewBuffer(value))
 }
 
-func NextKey(key int64) int64 {
+func NextKey(key []byte) sc.Option[sc.Sequence[sc.U8]] {
 	panic("not implemented")
 }
 
@@ -53,15 +71,27 @@ func Root(key int32) []byte {
 }
 
 func Set(key []byte, value []byte) {
-	panic("not implemented")
+	values[string(key)] = append([]byte{}, value...)
 }
 
 func TakeBytes(key []byte) []byte {
-	panic("not implemented")
+	value, ok := values[string(key)]
+	if !ok {
+		return nil
+	}
+
+	Clear(key)
+
+	return value
 }
 
 func TakeDecode[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.Buffer) T) T {
-	panic("not implemented")
+	value := TakeBytes(key)
+	if value == nil {
+		return *new(T)
+	}
+
+	return decodeFunc(bytes.NewBuffer(value))
 }
 
 func StartTransaction() {
@@ -0,0 +1,29 @@
+//go:build nonwasmenv
+
+package storage
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Storage_InMemory(t *testing.T) {
+	key := []byte("Test_Storage_InMemory")
+
+	assert.Equal(t, sc.NewOption[sc.Sequence[sc.U8]](nil), Get(key))
+	assert.Equal(t, sc.U32(7), GetDecodeOnEmpty(key, sc.DecodeU32, sc.U32(7)))
+	assert.Equal(t, int32(0), Exists(key))
+
+	Set(key, sc.U32(5).Bytes())
+
+	assert.Equal(t, sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(sc.U32(5).Bytes())), Get(key))
+	assert.Equal(t, sc.U32(5), GetDecode(key, sc.DecodeU32))
+	assert.Equal(t, int32(1), Exists(key))
+
+	assert.Equal(t, sc.U32(5), TakeDecode(key, func(buffer *bytes.Buffer) sc.U32 { return sc.DecodeU32(buffer) }))
+	assert.Nil(t, TakeBytes(key))
+	assert.Equal(t, int32(0), Exists(key))
+}
@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"bytes"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+)
+
+// TrackedRead is a storage key read while tracking, with the encoded length of the value read.
+type TrackedRead struct {
+	Key  []byte
+	Size sc.U32
+}
+
+// tracker records the storage keys read and written while a benchmark is measured.
+// Each key is counted once, as repeated accesses of a key are served from the overlay of the
+// host. A key which is read after it is written is not counted as read.
+type tracker struct {
+	reads  map[string]sc.U32
+	writes map[string]bool
+}
+
+var tracking *tracker
+
+// StartTracking starts tracking the storage reads and writes.
+func StartTracking() {
+	tracking = &tracker{
+		reads:  map[string]sc.U32{},
+		writes: map[string]bool{},
+	}
+}
+
+// StopTracking stops tracking the storage reads and writes.
+// Returns the keys read, ordered by key, and the number of keys written since StartTracking.
+func StopTracking() (reads []TrackedRead, writes sc.U32) {
+	if tracking == nil {
+		return nil, 0
+	}
+
+	for key, size := range tracking.reads {
+		reads = append(reads, TrackedRead{Key: []byte(key), Size: size})
+	}
+	sort.Slice(reads, func(i, j int) bool { return bytes.Compare(reads[i].Key, reads[j].Key) < 0 })
+
+	writes = sc.U32(len(tracking.writes))
+	tracking = nil
+
+	return reads, writes
+}
+
+func trackRead(key []byte, size int) {
+	if tracking == nil || tracking.writes[string(key)] {
+		return
+	}
+
+	if previous, ok := tracking.reads[string(key)]; !ok || sc.U32(size) > previous {
+		tracking.reads[string(key)] = sc.U32(size)
+	}
+}
+
+func trackWrite(key []byte) {
+	if tracking == nil {
+		return
+	}
+
+	tracking.writes[string(key)] = true
+}
+
+// childKey returns the key under which `key` of the default child trie of `storageKey` is tracked,
+// so that it is told apart from the keys of the top trie.
+func childKey(storageKey []byte, key []byte) []byte {
+	prefixed := append(append([]byte{}, constants.KeyDefaultChildStorage...), storageKey...)
+	return append(prefixed, key...)
+}
@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Tracking(t *testing.T) {
+	StartTracking()
+
+	trackRead([]byte("c"), 0)
+	trackRead([]byte("a"), 3)
+	trackRead([]byte("a"), 1)
+	trackWrite([]byte("a"))
+	trackWrite([]byte("b"))
+	trackRead([]byte("b"), 2)
+	trackWrite([]byte("b"))
+
+	reads, writes := StopTracking()
+
+	assert.Equal(t, []TrackedRead{{Key: []byte("a"), Size: 3}, {Key: []byte("c"), Size: 0}}, reads)
+	assert.Equal(t, sc.U32(2), writes)
+}
+
+func Test_Tracking_Stopped(t *testing.T) {
+	trackRead([]byte("a"), 1)
+	trackWrite([]byte("a"))
+
+	reads, writes := StopTracking()
+
+	assert.Empty(t, reads)
+	assert.Equal(t, sc.U32(0), writes)
+}
@@ -0,0 +1,39 @@
+//go:build !ethereum
+
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// AccountId is the account identifier of the runtime, a 32 byte ed25519 or sr25519 public key,
+// or the blake2 256 hash of an ecdsa public key. Build with the `ethereum` tag for 20 byte
+// Ethereum accounts.
+type AccountId = Address32
+
+// AccountIdLength is the size of an encoded AccountId.
+const AccountIdLength = 32
+
+func NewAccountId(values ...sc.U8) AccountId {
+	return NewAddress32(values...)
+}
+
+func DecodeAccountId(buffer *bytes.Buffer) AccountId {
+	return DecodeAddress32(buffer)
+}
+
+// AccountIdFromPublicKey returns the account of a 32 byte sr25519 or ed25519 public key, which is the key itself.
+func AccountIdFromPublicKey(publicKey PublicKey) AccountId {
+	return Address32{FixedSequence: publicKey}
+}
+
+// lookupAccountAddress returns the account of a MultiAddress which holds a 32 byte address.
+func lookupAccountAddress(a MultiAddress) sc.Option[AccountId] {
+	if a.IsAddress32() {
+		return sc.NewOption[AccountId](a.AsAddress32())
+	}
+
+	return sc.NewOption[AccountId](nil)
+}
@@ -0,0 +1,41 @@
+//go:build ethereum
+
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+)
+
+// AccountId is the account identifier of the runtime, a 20 byte Ethereum address (H160),
+// so that accounts can sign extrinsics with an EthereumSignature.
+type AccountId = Address20
+
+// AccountIdLength is the size of an encoded AccountId.
+const AccountIdLength = 20
+
+func NewAccountId(values ...sc.U8) AccountId {
+	return NewAddress20(values...)
+}
+
+func DecodeAccountId(buffer *bytes.Buffer) AccountId {
+	return DecodeAddress20(buffer)
+}
+
+// AccountIdFromPublicKey returns the account of a 32 byte sr25519 or ed25519 public key. As with
+// Ethereum addresses, it is the last 20 bytes of the keccak 256 hash of the key.
+func AccountIdFromPublicKey(publicKey PublicKey) AccountId {
+	hash := hashing.Keccak256(sc.FixedSequenceU8ToBytes(publicKey))
+	return NewAddress20(sc.BytesToSequenceU8(hash[12:])...)
+}
+
+// lookupAccountAddress returns the account of a MultiAddress which holds a 20 byte address.
+func lookupAccountAddress(a MultiAddress) sc.Option[AccountId] {
+	if a.IsAddress20() {
+		return sc.NewOption[AccountId](a.AsAddress20())
+	}
+
+	return sc.NewOption[AccountId](nil)
+}
@@ -0,0 +1,121 @@
+//go:build ethereum
+
+package types
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EthereumSignature_Verify(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		signature   sc.Sequence[sc.U8]
+		msg         sc.Sequence[sc.U8]
+		signer      AccountId
+		expectation sc.Bool
+	}{
+		{
+			label:       "valid",
+			signature:   hexToSequenceU8(ethereumSignatureKey1),
+			msg:         signatureMessage,
+			signer:      NewAccountId(hexToSequenceU8(ethereumAddressKey1)...),
+			expectation: true,
+		},
+		{
+			label:       "valid with recovery id offset by 27",
+			signature:   withRecoveryId(ethereumSignatureKey1, 28),
+			msg:         signatureMessage,
+			signer:      NewAccountId(hexToSequenceU8(ethereumAddressKey1)...),
+			expectation: true,
+		},
+		{
+			label:       "wrong signer",
+			signature:   hexToSequenceU8(ethereumSignatureKey1),
+			msg:         signatureMessage,
+			signer:      NewAccountId(hexToSequenceU8(ethereumAddressKey2)...),
+			expectation: false,
+		},
+		{
+			label:       "wrong message",
+			signature:   hexToSequenceU8(ethereumSignatureKey1),
+			msg:         sc.BytesToSequenceU8([]byte("gossamer")),
+			signer:      NewAccountId(hexToSequenceU8(ethereumAddressKey1)...),
+			expectation: false,
+		},
+		{
+			label:       "malformed recovery id",
+			signature:   withRecoveryId(ethereumSignatureKey1, 9),
+			msg:         signatureMessage,
+			signer:      NewAccountId(hexToSequenceU8(ethereumAddressKey1)...),
+			expectation: false,
+		},
+		{
+			label:       "malformed signature",
+			signature:   make(sc.Sequence[sc.U8], 65),
+			msg:         signatureMessage,
+			signer:      NewAccountId(hexToSequenceU8(ethereumAddressKey1)...),
+			expectation: false,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result := NewEthereumSignature(testExample.signature...).Verify(testExample.msg, testExample.signer)
+
+			assert.Equal(t, testExample.expectation, result)
+		})
+	}
+}
+
+func Test_MultiSignature_Verify(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		signature   MultiSignature
+		signer      AccountId
+		expectation sc.Bool
+	}{
+		{
+			label:       "Ethereum valid",
+			signature:   NewMultiSignatureEthereum(NewEthereumSignature(hexToSequenceU8(ethereumSignatureKey1)...)),
+			signer:      NewAccountId(hexToSequenceU8(ethereumAddressKey1)...),
+			expectation: true,
+		},
+		{
+			label:       "Ethereum wrong signer",
+			signature:   NewMultiSignatureEthereum(NewEthereumSignature(hexToSequenceU8(ethereumSignatureKey1)...)),
+			signer:      NewAccountId(hexToSequenceU8(ethereumAddressKey2)...),
+			expectation: false,
+		},
+		{
+			label:       "Ethereum malformed",
+			signature:   NewMultiSignatureEthereum(NewEthereumSignature(withRecoveryId(ethereumSignatureKey1, 9)...)),
+			signer:      NewAccountId(hexToSequenceU8(ethereumAddressKey1)...),
+			expectation: false,
+		},
+		{
+			// ed25519 signatures need a 32 byte public key, which a 20 byte account is not.
+			label:       "Ed25519 with a 20 byte account",
+			signature:   NewMultiSignatureEd25519(NewEd25519(hexToSequenceU8(ed25519Signature)...)),
+			signer:      NewAccountId(hexToSequenceU8(ed25519PublicKey)[12:]...),
+			expectation: false,
+		},
+		{
+			// ecdsa signers are the 32 byte blake2 256 hash of the public key, which a 20 byte account is not.
+			label:       "Ecdsa with a 20 byte account",
+			signature:   NewMultiSignatureEcdsa(NewEcdsa(hexToSequenceU8(ecdsaSignatureKey1)...)),
+			signer:      NewAccountId(hexToSequenceU8(ecdsaAccountKey1)[12:]...),
+			expectation: false,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result := testExample.signature.Verify(signatureMessage, testExample.signer)
+
+			assert.Equal(t, testExample.expectation, result)
+		})
+	}
+}
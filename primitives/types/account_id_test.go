@@ -0,0 +1,131 @@
+//go:build !ethereum
+
+package types
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Ecdsa_Verify(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		signature   sc.Sequence[sc.U8]
+		msg         sc.Sequence[sc.U8]
+		signer      AccountId
+		expectation sc.Bool
+	}{
+		{
+			label:       "valid",
+			signature:   hexToSequenceU8(ecdsaSignatureKey1),
+			msg:         signatureMessage,
+			signer:      NewAccountId(hexToSequenceU8(ecdsaAccountKey1)...),
+			expectation: true,
+		},
+		{
+			label:       "valid with recovery id offset by 27",
+			signature:   withRecoveryId(ecdsaSignatureKey1, 28),
+			msg:         signatureMessage,
+			signer:      NewAccountId(hexToSequenceU8(ecdsaAccountKey1)...),
+			expectation: true,
+		},
+		{
+			label:       "wrong signer",
+			signature:   hexToSequenceU8(ecdsaSignatureKey1),
+			msg:         signatureMessage,
+			signer:      NewAccountId(hexToSequenceU8(ecdsaAccountKey2)...),
+			expectation: false,
+		},
+		{
+			label:       "wrong message",
+			signature:   hexToSequenceU8(ecdsaSignatureKey1),
+			msg:         sc.BytesToSequenceU8([]byte("gossamer")),
+			signer:      NewAccountId(hexToSequenceU8(ecdsaAccountKey1)...),
+			expectation: false,
+		},
+		{
+			label:       "malformed recovery id",
+			signature:   withRecoveryId(ecdsaSignatureKey1, 9),
+			msg:         signatureMessage,
+			signer:      NewAccountId(hexToSequenceU8(ecdsaAccountKey1)...),
+			expectation: false,
+		},
+		{
+			label:       "malformed signature",
+			signature:   make(sc.Sequence[sc.U8], 65),
+			msg:         signatureMessage,
+			signer:      NewAccountId(hexToSequenceU8(ecdsaAccountKey1)...),
+			expectation: false,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result := NewEcdsa(testExample.signature...).Verify(testExample.msg, testExample.signer)
+
+			assert.Equal(t, testExample.expectation, result)
+		})
+	}
+}
+
+func Test_MultiSignature_Verify(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		signature   MultiSignature
+		signer      AccountId
+		expectation sc.Bool
+	}{
+		{
+			label:       "Ed25519 valid",
+			signature:   NewMultiSignatureEd25519(NewEd25519(hexToSequenceU8(ed25519Signature)...)),
+			signer:      NewAccountId(hexToSequenceU8(ed25519PublicKey)...),
+			expectation: true,
+		},
+		{
+			label:       "Ed25519 wrong signer",
+			signature:   NewMultiSignatureEd25519(NewEd25519(hexToSequenceU8(ed25519Signature)...)),
+			signer:      NewAccountId(hexToSequenceU8(ecdsaAccountKey1)...),
+			expectation: false,
+		},
+		{
+			label:       "Ed25519 malformed",
+			signature:   NewMultiSignatureEd25519(NewEd25519(make(sc.Sequence[sc.U8], 64)...)),
+			signer:      NewAccountId(hexToSequenceU8(ed25519PublicKey)...),
+			expectation: false,
+		},
+		{
+			label:       "Ecdsa valid",
+			signature:   NewMultiSignatureEcdsa(NewEcdsa(hexToSequenceU8(ecdsaSignatureKey1)...)),
+			signer:      NewAccountId(hexToSequenceU8(ecdsaAccountKey1)...),
+			expectation: true,
+		},
+		{
+			label:       "Ecdsa wrong signer",
+			signature:   NewMultiSignatureEcdsa(NewEcdsa(hexToSequenceU8(ecdsaSignatureKey1)...)),
+			signer:      NewAccountId(hexToSequenceU8(ecdsaAccountKey2)...),
+			expectation: false,
+		},
+		{
+			label:       "Ecdsa malformed",
+			signature:   NewMultiSignatureEcdsa(NewEcdsa(withRecoveryId(ecdsaSignatureKey1, 9)...)),
+			signer:      NewAccountId(hexToSequenceU8(ecdsaAccountKey1)...),
+			expectation: false,
+		},
+		{
+			label:       "Ethereum with a 32 byte account",
+			signature:   NewMultiSignatureEthereum(NewEthereumSignature(hexToSequenceU8(ethereumSignatureKey1)...)),
+			signer:      NewAccountId(hexToSequenceU8(ecdsaAccountKey1)...),
+			expectation: false,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result := testExample.signature.Verify(signatureMessage, testExample.signer)
+
+			assert.Equal(t, testExample.expectation, result)
+		})
+	}
+}
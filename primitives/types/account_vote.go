@@ -0,0 +1,137 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+const (
+	AccountVoteStandard sc.U8 = iota
+	AccountVoteSplit
+)
+
+// voteAyeMask is the bit of the encoded vote that marks an aye vote.
+const voteAyeMask = 0x80
+
+// Vote A number of lock periods, plus a vote, one way or the other.
+// It is encoded in a single byte, the most significant bit marks an aye vote.
+type Vote struct {
+	Aye        sc.Bool
+	Conviction Conviction
+}
+
+func (v Vote) Encode(buffer *bytes.Buffer) {
+	encoded := v.Conviction
+	if v.Aye {
+		encoded |= voteAyeMask
+	}
+
+	encoded.Encode(buffer)
+}
+
+func DecodeVote(buffer *bytes.Buffer) Vote {
+	b := sc.DecodeU8(buffer)
+
+	conviction := b &^ voteAyeMask
+	if conviction > ConvictionLocked6x {
+		log.Critical("invalid vote conviction")
+	}
+
+	return Vote{
+		Aye:        b&voteAyeMask == voteAyeMask,
+		Conviction: conviction,
+	}
+}
+
+func (v Vote) Bytes() []byte {
+	return sc.EncodedBytes(v)
+}
+
+// AccountVote A vote for a referendum of a particular account.
+//
+// Standard: a standard vote, one-way (approve or reject) with a given amount of conviction.
+//
+// Split: a split vote with balances given for both ways, and with no conviction, useful for
+// parachains when voting.
+type AccountVote struct {
+	IsSplit sc.Bool
+	Vote    Vote
+	Balance Balance
+	Aye     Balance
+	Nay     Balance
+}
+
+func NewAccountVoteStandard(vote Vote, balance Balance) AccountVote {
+	return AccountVote{
+		IsSplit: false,
+		Vote:    vote,
+		Balance: balance,
+	}
+}
+
+func NewAccountVoteSplit(aye Balance, nay Balance) AccountVote {
+	return AccountVote{
+		IsSplit: true,
+		Aye:     aye,
+		Nay:     nay,
+	}
+}
+
+func (av AccountVote) Encode(buffer *bytes.Buffer) {
+	if !av.IsSplit {
+		AccountVoteStandard.Encode(buffer)
+		av.Vote.Encode(buffer)
+		av.Balance.Encode(buffer)
+		return
+	}
+
+	AccountVoteSplit.Encode(buffer)
+	av.Aye.Encode(buffer)
+	av.Nay.Encode(buffer)
+}
+
+func DecodeAccountVote(buffer *bytes.Buffer) AccountVote {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case AccountVoteStandard:
+		vote := DecodeVote(buffer)
+		balance := sc.DecodeU128(buffer)
+		return NewAccountVoteStandard(vote, balance)
+	case AccountVoteSplit:
+		aye := sc.DecodeU128(buffer)
+		nay := sc.DecodeU128(buffer)
+		return NewAccountVoteSplit(aye, nay)
+	default:
+		log.Critical("invalid AccountVote type")
+	}
+
+	panic("unreachable")
+}
+
+func (av AccountVote) Bytes() []byte {
+	return sc.EncodedBytes(av)
+}
+
+// TotalBalance returns the total balance involved in this vote.
+func (av AccountVote) TotalBalance() Balance {
+	if av.IsSplit {
+		return sc.NewU128FromBigInt(new(big.Int).Add(av.Aye.ToBigInt(), av.Nay.ToBigInt()))
+	}
+
+	return av.Balance
+}
+
+// LockedIf returns the number of lock periods and the balance that should be locked if the
+// referendum's outcome was `approved`. Returns false if nothing should be locked, which is
+// always the case for split votes.
+func (av AccountVote) LockedIf(approved sc.Bool) (sc.U32, Balance, bool) {
+	if !av.IsSplit && av.Vote.Aye == approved {
+		return ConvictionLockPeriods(av.Vote.Conviction), av.Balance, true
+	}
+
+	return 0, sc.NewU128FromUint64(0), false
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	encodedBalance5 = []byte{0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+	encodedBalance7 = []byte{0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+)
+
+func Test_EncodeAccountVote(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		input       AccountVote
+		expectation []byte
+	}{
+		{
+			label:       "Encode AccountVote(Standard) aye",
+			input:       NewAccountVoteStandard(Vote{Aye: true, Conviction: ConvictionLocked2x}, sc.NewU128FromUint64(5)),
+			expectation: append([]byte{0x00, 0x82}, encodedBalance5...),
+		},
+		{
+			label:       "Encode AccountVote(Standard) nay",
+			input:       NewAccountVoteStandard(Vote{Aye: false, Conviction: ConvictionNone}, sc.NewU128FromUint64(5)),
+			expectation: append([]byte{0x00, 0x00}, encodedBalance5...),
+		},
+		{
+			label:       "Encode AccountVote(Split)",
+			input:       NewAccountVoteSplit(sc.NewU128FromUint64(5), sc.NewU128FromUint64(7)),
+			expectation: append(append([]byte{0x01}, encodedBalance5...), encodedBalance7...),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			testExample.input.Encode(buffer)
+
+			assert.Equal(t, testExample.expectation, buffer.Bytes())
+		})
+	}
+}
+
+func Test_DecodeAccountVote(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		input       []byte
+		expectation AccountVote
+	}{
+		{
+			label:       "Decode AccountVote(Standard) aye",
+			input:       append([]byte{0x00, 0x82}, encodedBalance5...),
+			expectation: NewAccountVoteStandard(Vote{Aye: true, Conviction: ConvictionLocked2x}, sc.NewU128FromUint64(5)),
+		},
+		{
+			label:       "Decode AccountVote(Split)",
+			input:       append(append([]byte{0x01}, encodedBalance5...), encodedBalance7...),
+			expectation: NewAccountVoteSplit(sc.NewU128FromUint64(5), sc.NewU128FromUint64(7)),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+			buffer.Write(testExample.input)
+
+			result := DecodeAccountVote(buffer)
+
+			assert.Equal(t, testExample.expectation, result)
+		})
+	}
+}
+
+func Test_AccountVote_LockedIf(t *testing.T) {
+	vote := NewAccountVoteStandard(Vote{Aye: true, Conviction: ConvictionLocked3x}, sc.NewU128FromUint64(5))
+
+	periods, balance, ok := vote.LockedIf(true)
+	assert.True(t, ok)
+	assert.Equal(t, sc.U32(4), periods)
+	assert.Equal(t, sc.NewU128FromUint64(5), balance)
+
+	_, _, ok = vote.LockedIf(false)
+	assert.False(t, ok)
+
+	_, _, ok = NewAccountVoteSplit(sc.NewU128FromUint64(5), sc.NewU128FromUint64(7)).LockedIf(true)
+	assert.False(t, ok)
+}
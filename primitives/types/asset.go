@@ -0,0 +1,182 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+type AssetId = sc.U32
+
+type AssetStatus = sc.U8
+
+const (
+	// AssetStatusLive The asset is active and able to be used.
+	AssetStatusLive AssetStatus = iota
+	// AssetStatusFrozen Transfers, minting and burning of the asset are paused.
+	AssetStatusFrozen
+	// AssetStatusDestroying The asset is being destroyed, its accounts and approvals are being removed.
+	AssetStatusDestroying
+)
+
+// AssetDetails The details of an asset class.
+type AssetDetails struct {
+	// Can change `Owner`, `Issuer`, `Freezer` and `Admin` accounts.
+	Owner AccountId
+	// Can mint tokens.
+	Issuer AccountId
+	// Can thaw tokens, force transfers and burn tokens from any account.
+	Admin AccountId
+	// Can freeze tokens.
+	Freezer AccountId
+	// The total supply across all accounts.
+	Supply Balance
+	// The balance deposited for this asset. This pays for the data stored here.
+	Deposit Balance
+	// The minimum balance of this asset an account may have.
+	MinBalance Balance
+	// If `true`, then any account with this asset is given a provider reference. Otherwise, it
+	// requires a consumer reference.
+	IsSufficient sc.Bool
+	// The total number of accounts.
+	Accounts sc.U32
+	// The total number of accounts for which we have placed a self-sufficient reference.
+	Sufficients sc.U32
+	// The total number of approvals.
+	Approvals sc.U32
+	// The status of the asset.
+	Status AssetStatus
+}
+
+func (ad AssetDetails) Encode(buffer *bytes.Buffer) {
+	ad.Owner.Encode(buffer)
+	ad.Issuer.Encode(buffer)
+	ad.Admin.Encode(buffer)
+	ad.Freezer.Encode(buffer)
+	ad.Supply.Encode(buffer)
+	ad.Deposit.Encode(buffer)
+	ad.MinBalance.Encode(buffer)
+	ad.IsSufficient.Encode(buffer)
+	ad.Accounts.Encode(buffer)
+	ad.Sufficients.Encode(buffer)
+	ad.Approvals.Encode(buffer)
+	ad.Status.Encode(buffer)
+}
+
+func DecodeAssetDetails(buffer *bytes.Buffer) AssetDetails {
+	return AssetDetails{
+		Owner:        DecodeAccountId(buffer),
+		Issuer:       DecodeAccountId(buffer),
+		Admin:        DecodeAccountId(buffer),
+		Freezer:      DecodeAccountId(buffer),
+		Supply:       sc.DecodeU128(buffer),
+		Deposit:      sc.DecodeU128(buffer),
+		MinBalance:   sc.DecodeU128(buffer),
+		IsSufficient: sc.DecodeBool(buffer),
+		Accounts:     sc.DecodeU32(buffer),
+		Sufficients:  sc.DecodeU32(buffer),
+		Approvals:    sc.DecodeU32(buffer),
+		Status:       sc.DecodeU8(buffer),
+	}
+}
+
+func (ad AssetDetails) Bytes() []byte {
+	return sc.EncodedBytes(ad)
+}
+
+type ExistenceReason = sc.U8
+
+const (
+	// ExistenceReasonConsumer A consumer reference was used to create the account.
+	ExistenceReasonConsumer ExistenceReason = iota
+	// ExistenceReasonSufficient The asset is sufficient and a self-sufficient reference was used to create the account.
+	ExistenceReasonSufficient
+)
+
+// AssetAccount The holdings of an account in an asset.
+type AssetAccount struct {
+	// The balance.
+	Balance Balance
+	// Whether the account is frozen.
+	IsFrozen sc.Bool
+	// The reason for the existence of the account.
+	Reason ExistenceReason
+}
+
+func (aa AssetAccount) Encode(buffer *bytes.Buffer) {
+	aa.Balance.Encode(buffer)
+	aa.IsFrozen.Encode(buffer)
+	aa.Reason.Encode(buffer)
+}
+
+func DecodeAssetAccount(buffer *bytes.Buffer) AssetAccount {
+	return AssetAccount{
+		Balance:  sc.DecodeU128(buffer),
+		IsFrozen: sc.DecodeBool(buffer),
+		Reason:   sc.DecodeU8(buffer),
+	}
+}
+
+func (aa AssetAccount) Bytes() []byte {
+	return sc.EncodedBytes(aa)
+}
+
+// AssetApproval Data concerning an approval.
+type AssetApproval struct {
+	// The amount of funds approved for the balance transfer from the owner to some delegated target.
+	Amount Balance
+	// The amount reserved on the owner's account to hold this item in storage.
+	Deposit Balance
+}
+
+func (aa AssetApproval) Encode(buffer *bytes.Buffer) {
+	aa.Amount.Encode(buffer)
+	aa.Deposit.Encode(buffer)
+}
+
+func DecodeAssetApproval(buffer *bytes.Buffer) AssetApproval {
+	return AssetApproval{
+		Amount:  sc.DecodeU128(buffer),
+		Deposit: sc.DecodeU128(buffer),
+	}
+}
+
+func (aa AssetApproval) Bytes() []byte {
+	return sc.EncodedBytes(aa)
+}
+
+// AssetMetadata The metadata of an asset.
+type AssetMetadata struct {
+	// The balance deposited for this metadata. This pays for the data stored in this struct.
+	Deposit Balance
+	// The user friendly name of this asset.
+	Name sc.Sequence[sc.U8]
+	// The ticker symbol for this asset.
+	Symbol sc.Sequence[sc.U8]
+	// The number of decimals this asset uses to represent one unit.
+	Decimals sc.U8
+	// Whether the asset metadata may be changed by a non Force origin.
+	IsFrozen sc.Bool
+}
+
+func (am AssetMetadata) Encode(buffer *bytes.Buffer) {
+	am.Deposit.Encode(buffer)
+	am.Name.Encode(buffer)
+	am.Symbol.Encode(buffer)
+	am.Decimals.Encode(buffer)
+	am.IsFrozen.Encode(buffer)
+}
+
+func DecodeAssetMetadata(buffer *bytes.Buffer) AssetMetadata {
+	return AssetMetadata{
+		Deposit:  sc.DecodeU128(buffer),
+		Name:     sc.DecodeSequence[sc.U8](buffer),
+		Symbol:   sc.DecodeSequence[sc.U8](buffer),
+		Decimals: sc.DecodeU8(buffer),
+		IsFrozen: sc.DecodeBool(buffer),
+	}
+}
+
+func (am AssetMetadata) Bytes() []byte {
+	return sc.EncodedBytes(am)
+}
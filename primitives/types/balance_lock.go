@@ -0,0 +1,48 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// LockIdentifier An identifier for a lock. Used for disambiguating different locks so that
+// they can be individually replaced or removed.
+type LockIdentifier = sc.FixedSequence[sc.U8]
+
+func NewLockIdentifier(values ...sc.U8) LockIdentifier {
+	return sc.NewFixedSequence[sc.U8](8, values...)
+}
+
+func DecodeLockIdentifier(buffer *bytes.Buffer) LockIdentifier {
+	return sc.DecodeFixedSequence[sc.U8](8, buffer)
+}
+
+// BalanceLock A single lock on a balance. There can be many of these on an account and they "overlap", so the
+// same balance is frozen by multiple locks.
+type BalanceLock struct {
+	// An identifier for this lock. Only one lock may be in existence for each identifier.
+	Id LockIdentifier
+	// The amount which the free balance may not drop below when this lock is in effect.
+	Amount Balance
+	// If true, then the lock remains in effect even for payment of transaction fees.
+	Reasons Reasons
+}
+
+func (bl BalanceLock) Encode(buffer *bytes.Buffer) {
+	bl.Id.Encode(buffer)
+	bl.Amount.Encode(buffer)
+	sc.U8(bl.Reasons).Encode(buffer)
+}
+
+func DecodeBalanceLock(buffer *bytes.Buffer) BalanceLock {
+	return BalanceLock{
+		Id:      DecodeLockIdentifier(buffer),
+		Amount:  sc.DecodeU128(buffer),
+		Reasons: Reasons(sc.DecodeU8(buffer)),
+	}
+}
+
+func (bl BalanceLock) Bytes() []byte {
+	return sc.EncodedBytes(bl)
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+// MaxBoundedInlineLen The maximum length of data which is stored inline rather than by hash.
+const MaxBoundedInlineLen = 128
+
+// MaxBoundedEncodedLen is the maximum encoded length of a Bounded, reached when its data is stored inline.
+const MaxBoundedEncodedLen = 1 + 2 + MaxBoundedInlineLen
+
+const (
+	BoundedLegacy sc.U8 = iota
+	BoundedInline
+	BoundedLookup
+)
+
+// Bounded A reference to encoded data (e.g. a call) which is either stored inline or
+// by its hash in the preimage module.
+type Bounded struct {
+	sc.VaryingData
+}
+
+// NewBoundedLegacy A hash with no length information, which is looked up in the preimage module.
+func NewBoundedLegacy(hash H256) Bounded {
+	return Bounded{sc.NewVaryingData(BoundedLegacy, hash)}
+}
+
+// NewBoundedInline Data which is small enough to be stored directly.
+func NewBoundedInline(data sc.Sequence[sc.U8]) Bounded {
+	if len(data) > MaxBoundedInlineLen {
+		log.Critical("inline data exceeds MaxBoundedInlineLen")
+	}
+	return Bounded{sc.NewVaryingData(BoundedInline, data)}
+}
+
+// NewBoundedLookup A hash and the length of the data it refers to, which is looked up in the preimage module.
+func NewBoundedLookup(hash H256, length sc.U32) Bounded {
+	return Bounded{sc.NewVaryingData(BoundedLookup, hash, length)}
+}
+
+func DecodeBounded(buffer *bytes.Buffer) Bounded {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case BoundedLegacy:
+		return NewBoundedLegacy(DecodeH256(buffer))
+	case BoundedInline:
+		return NewBoundedInline(sc.DecodeSequence[sc.U8](buffer))
+	case BoundedLookup:
+		hash := DecodeH256(buffer)
+		length := sc.DecodeU32(buffer)
+		return NewBoundedLookup(hash, length)
+	default:
+		log.Critical("invalid Bounded type")
+	}
+
+	panic("unreachable")
+}
+
+func (b Bounded) IsInline() sc.Bool {
+	return b.VaryingData[0] == BoundedInline
+}
+
+func (b Bounded) AsInline() sc.Sequence[sc.U8] {
+	if !b.IsInline() {
+		log.Critical("not an inline Bounded type")
+	}
+
+	return b.VaryingData[1].(sc.Sequence[sc.U8])
+}
+
+// Hash Returns the hash of the referenced data.
+func (b Bounded) Hash() H256 {
+	switch b.VaryingData[0] {
+	case BoundedLegacy, BoundedLookup:
+		return b.VaryingData[1].(H256)
+	default:
+		hash := hashing.Blake256(sc.SequenceU8ToBytes(b.AsInline()))
+		return NewH256(sc.BytesToSequenceU8(hash)...)
+	}
+}
+
+// Len Returns the length of the referenced data, if known.
+func (b Bounded) Len() sc.Option[sc.U32] {
+	switch b.VaryingData[0] {
+	case BoundedInline:
+		return sc.NewOption[sc.U32](sc.U32(len(b.AsInline())))
+	case BoundedLookup:
+		return sc.NewOption[sc.U32](b.VaryingData[2].(sc.U32))
+	default:
+		return sc.NewOption[sc.U32](nil)
+	}
+}
+
+// LookupNeeded Returns whether the data has to be fetched from the preimage module.
+func (b Bounded) LookupNeeded() sc.Bool {
+	return !b.IsInline()
+}
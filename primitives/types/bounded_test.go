@@ -0,0 +1,95 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	boundedHashBytes = bytes.Repeat([]byte{0x01}, 32)
+	boundedHash      = NewH256(sc.BytesToSequenceU8(boundedHashBytes)...)
+)
+
+func Test_EncodeBounded(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		input       Bounded
+		expectation []byte
+	}{
+		{
+			label:       "Encode Bounded(Legacy)",
+			input:       NewBoundedLegacy(boundedHash),
+			expectation: append([]byte{0x00}, boundedHashBytes...),
+		},
+		{
+			label:       "Encode Bounded(Inline)",
+			input:       NewBoundedInline(sc.Sequence[sc.U8]{0x04, 0x00}),
+			expectation: []byte{0x01, 0x08, 0x04, 0x00},
+		},
+		{
+			label:       "Encode Bounded(Lookup)",
+			input:       NewBoundedLookup(boundedHash, 300),
+			expectation: append(append([]byte{0x02}, boundedHashBytes...), 0x2c, 0x01, 0x00, 0x00),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			testExample.input.Encode(buffer)
+
+			assert.Equal(t, testExample.expectation, buffer.Bytes())
+		})
+	}
+}
+
+func Test_Bounded_MaxEncodedLen(t *testing.T) {
+	data := sc.BytesToSequenceU8(bytes.Repeat([]byte{0x01}, MaxBoundedInlineLen))
+
+	assert.Equal(t, MaxBoundedEncodedLen, len(NewBoundedInline(data).Bytes()))
+}
+
+func Test_DecodeBounded(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		input       []byte
+		expectation Bounded
+	}{
+		{
+			label:       "Decode Bounded(Legacy)",
+			input:       append([]byte{0x00}, boundedHashBytes...),
+			expectation: NewBoundedLegacy(boundedHash),
+		},
+		{
+			label:       "Decode Bounded(Inline)",
+			input:       []byte{0x01, 0x08, 0x04, 0x00},
+			expectation: NewBoundedInline(sc.Sequence[sc.U8]{0x04, 0x00}),
+		},
+		{
+			label:       "Decode Bounded(Lookup)",
+			input:       append(append([]byte{0x02}, boundedHashBytes...), 0x2c, 0x01, 0x00, 0x00),
+			expectation: NewBoundedLookup(boundedHash, 300),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+			buffer.Write(testExample.input)
+
+			result := DecodeBounded(buffer)
+
+			assert.Equal(t, testExample.expectation, result)
+		})
+	}
+}
+
+func Test_Bounded_Len(t *testing.T) {
+	assert.Equal(t, sc.NewOption[sc.U32](nil), NewBoundedLegacy(boundedHash).Len())
+	assert.Equal(t, sc.NewOption[sc.U32](sc.U32(2)), NewBoundedInline(sc.Sequence[sc.U8]{0x04, 0x00}).Len())
+	assert.Equal(t, sc.NewOption[sc.U32](sc.U32(300)), NewBoundedLookup(boundedHash, 300).Len())
+}
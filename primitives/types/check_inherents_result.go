@@ -120,3 +120,7 @@ func DecodeCheckInherentsResult(buffer *bytes.Buffer) CheckInherentsResult {
 		Errors:     *errors,
 	}
 }
+
+func (cir CheckInherentsResult) Bytes() []byte {
+	return sc.EncodedBytes(cir)
+}
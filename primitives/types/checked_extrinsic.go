@@ -7,18 +7,18 @@ import (
 )
 
 type AccountIdExtra struct {
-	Address32
+	AccountId
 	SignedExtra
 }
 
 func (ae AccountIdExtra) Encode(buffer *bytes.Buffer) {
-	ae.Address32.Encode(buffer)
+	ae.AccountId.Encode(buffer)
 	ae.SignedExtra.Encode(buffer)
 }
 
 func DecodeAccountIdExtra(buffer *bytes.Buffer) AccountIdExtra {
 	ae := AccountIdExtra{}
-	ae.Address32 = DecodeAddress32(buffer)
+	ae.AccountId = DecodeAccountId(buffer)
 	ae.SignedExtra = DecodeExtra(buffer)
 	return ae
 }
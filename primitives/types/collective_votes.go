@@ -0,0 +1,43 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// CollectiveVotes Info for keeping track of a motion being voted on.
+type CollectiveVotes struct {
+	// The proposal's unique index.
+	Index sc.U32
+	// The number of approval votes that are needed to pass the motion.
+	Threshold sc.U32
+	// The current set of voters that approved it.
+	Ayes sc.Sequence[AccountId]
+	// The current set of voters that rejected it.
+	Nays sc.Sequence[AccountId]
+	// The hard end time of this vote.
+	End BlockNumber
+}
+
+func (cv CollectiveVotes) Encode(buffer *bytes.Buffer) {
+	cv.Index.Encode(buffer)
+	cv.Threshold.Encode(buffer)
+	cv.Ayes.Encode(buffer)
+	cv.Nays.Encode(buffer)
+	cv.End.Encode(buffer)
+}
+
+func DecodeCollectiveVotes(buffer *bytes.Buffer) CollectiveVotes {
+	return CollectiveVotes{
+		Index:     sc.DecodeU32(buffer),
+		Threshold: sc.DecodeU32(buffer),
+		Ayes:      sc.DecodeSequenceWith(buffer, DecodeAccountId),
+		Nays:      sc.DecodeSequenceWith(buffer, DecodeAccountId),
+		End:       sc.DecodeU32(buffer),
+	}
+}
+
+func (cv CollectiveVotes) Bytes() []byte {
+	return sc.EncodedBytes(cv)
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+// Conviction A value denoting the strength of conviction of a vote.
+type Conviction = sc.U8
+
+const (
+	// ConvictionNone 0.1x votes, unlocked.
+	ConvictionNone Conviction = iota
+	// ConvictionLocked1x 1x votes, locked for an enactment period following a successful vote.
+	ConvictionLocked1x
+	// ConvictionLocked2x 2x votes, locked for 2x enactment periods following a successful vote.
+	ConvictionLocked2x
+	// ConvictionLocked3x 3x votes, locked for 4x...
+	ConvictionLocked3x
+	// ConvictionLocked4x 4x votes, locked for 8x...
+	ConvictionLocked4x
+	// ConvictionLocked5x 5x votes, locked for 16x...
+	ConvictionLocked5x
+	// ConvictionLocked6x 6x votes, locked for 32x...
+	ConvictionLocked6x
+)
+
+func DecodeConviction(buffer *bytes.Buffer) Conviction {
+	value := sc.DecodeU8(buffer)
+	if value > ConvictionLocked6x {
+		log.Critical("invalid conviction type")
+	}
+
+	return value
+}
+
+// ConvictionLockPeriods returns the number of vote locking periods a vote with the given conviction is locked for.
+func ConvictionLockPeriods(conviction Conviction) sc.U32 {
+	if conviction == ConvictionNone {
+		return 0
+	}
+
+	return 1 << (conviction - 1)
+}
+
+// ConvictionVotes returns the voting power of `capital` with the given conviction.
+func ConvictionVotes(conviction Conviction, capital Balance) Delegations {
+	c := capital.ToBigInt()
+
+	var votes *big.Int
+	if conviction == ConvictionNone {
+		votes = new(big.Int).Div(c, big.NewInt(10))
+	} else {
+		votes = new(big.Int).Mul(c, big.NewInt(int64(conviction)))
+	}
+
+	return Delegations{
+		Votes:   sc.NewU128FromBigInt(votes),
+		Capital: capital,
+	}
+}
+
+// Delegations Amount of votes and capital placed in delegation for an account.
+type Delegations struct {
+	// The number of votes (this is post-conviction).
+	Votes Balance
+	// The amount of raw capital, used for the turnout.
+	Capital Balance
+}
+
+func (d Delegations) Encode(buffer *bytes.Buffer) {
+	d.Votes.Encode(buffer)
+	d.Capital.Encode(buffer)
+}
+
+func DecodeDelegations(buffer *bytes.Buffer) Delegations {
+	return Delegations{
+		Votes:   sc.DecodeU128(buffer),
+		Capital: sc.DecodeU128(buffer),
+	}
+}
+
+func (d Delegations) Bytes() []byte {
+	return sc.EncodedBytes(d)
+}
+
+// Add returns the sum of both delegations.
+func (d Delegations) Add(other Delegations) Delegations {
+	return Delegations{
+		Votes:   addBalance(d.Votes, other.Votes),
+		Capital: addBalance(d.Capital, other.Capital),
+	}
+}
+
+// SaturatingSub returns the difference of both delegations, saturating at zero.
+func (d Delegations) SaturatingSub(other Delegations) Delegations {
+	return Delegations{
+		Votes:   saturatingSubBalance(d.Votes, other.Votes),
+		Capital: saturatingSubBalance(d.Capital, other.Capital),
+	}
+}
+
+func saturatingSubBalance(a, b Balance) Balance {
+	result := new(big.Int).Sub(a.ToBigInt(), b.ToBigInt())
+	if result.Sign() < 0 {
+		return sc.NewU128FromUint64(0)
+	}
+
+	return sc.NewU128FromBigInt(result)
+}
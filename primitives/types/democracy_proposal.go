@@ -0,0 +1,77 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// PublicProposal A proposal made by a public account, waiting to be tabled as a referendum.
+type PublicProposal struct {
+	Index    sc.U32
+	Proposal Bounded
+	Proposer AccountId
+}
+
+func (pp PublicProposal) Encode(buffer *bytes.Buffer) {
+	pp.Index.Encode(buffer)
+	pp.Proposal.Encode(buffer)
+	pp.Proposer.Encode(buffer)
+}
+
+func DecodePublicProposal(buffer *bytes.Buffer) PublicProposal {
+	return PublicProposal{
+		Index:    sc.DecodeU32(buffer),
+		Proposal: DecodeBounded(buffer),
+		Proposer: DecodeAccountId(buffer),
+	}
+}
+
+func (pp PublicProposal) Bytes() []byte {
+	return sc.EncodedBytes(pp)
+}
+
+// ProposalDeposit The accounts backing a public proposal and the deposit each of them reserved.
+type ProposalDeposit struct {
+	Depositors sc.Sequence[AccountId]
+	Deposit    Balance
+}
+
+func (pd ProposalDeposit) Encode(buffer *bytes.Buffer) {
+	pd.Depositors.Encode(buffer)
+	pd.Deposit.Encode(buffer)
+}
+
+func DecodeProposalDeposit(buffer *bytes.Buffer) ProposalDeposit {
+	return ProposalDeposit{
+		Depositors: sc.DecodeSequenceWith(buffer, DecodeAccountId),
+		Deposit:    sc.DecodeU128(buffer),
+	}
+}
+
+func (pd ProposalDeposit) Bytes() []byte {
+	return sc.EncodedBytes(pd)
+}
+
+// ExternalProposal The proposal which will be tabled next from the external queue,
+// along with its vote threshold.
+type ExternalProposal struct {
+	Proposal  Bounded
+	Threshold VoteThreshold
+}
+
+func (ep ExternalProposal) Encode(buffer *bytes.Buffer) {
+	ep.Proposal.Encode(buffer)
+	ep.Threshold.Encode(buffer)
+}
+
+func DecodeExternalProposal(buffer *bytes.Buffer) ExternalProposal {
+	return ExternalProposal{
+		Proposal:  DecodeBounded(buffer),
+		Threshold: DecodeVoteThreshold(buffer),
+	}
+}
+
+func (ep ExternalProposal) Bytes() []byte {
+	return sc.EncodedBytes(ep)
+}
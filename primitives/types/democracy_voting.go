@@ -0,0 +1,197 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+// PriorLock A "prior" lock, i.e. a lock for some now-forgotten reason.
+type PriorLock struct {
+	Until  BlockNumber
+	Amount Balance
+}
+
+func (pl PriorLock) Encode(buffer *bytes.Buffer) {
+	pl.Until.Encode(buffer)
+	pl.Amount.Encode(buffer)
+}
+
+func DecodePriorLock(buffer *bytes.Buffer) PriorLock {
+	return PriorLock{
+		Until:  sc.DecodeU32(buffer),
+		Amount: sc.DecodeU128(buffer),
+	}
+}
+
+func (pl PriorLock) Bytes() []byte {
+	return sc.EncodedBytes(pl)
+}
+
+// Accumulate extends the lock so that it covers at least `amount` until at least `until`.
+func (pl *PriorLock) Accumulate(until BlockNumber, amount Balance) {
+	if until > pl.Until {
+		pl.Until = until
+	}
+	if amount.ToBigInt().Cmp(pl.Amount.ToBigInt()) > 0 {
+		pl.Amount = amount
+	}
+}
+
+// Locked returns the amount that is currently locked.
+func (pl PriorLock) Locked() Balance {
+	return pl.Amount
+}
+
+// Rejig clears the lock if it has expired at `now`.
+func (pl *PriorLock) Rejig(now BlockNumber) {
+	if now >= pl.Until {
+		pl.Amount = sc.NewU128FromUint64(0)
+		pl.Until = 0
+	}
+}
+
+// ReferendumVote A vote cast by an account in a referendum.
+type ReferendumVote struct {
+	Index sc.U32
+	Vote  AccountVote
+}
+
+func (rv ReferendumVote) Encode(buffer *bytes.Buffer) {
+	rv.Index.Encode(buffer)
+	rv.Vote.Encode(buffer)
+}
+
+func DecodeReferendumVote(buffer *bytes.Buffer) ReferendumVote {
+	return ReferendumVote{
+		Index: sc.DecodeU32(buffer),
+		Vote:  DecodeAccountVote(buffer),
+	}
+}
+
+func (rv ReferendumVote) Bytes() []byte {
+	return sc.EncodedBytes(rv)
+}
+
+const (
+	DemocracyVotingDirect sc.U8 = iota
+	DemocracyVotingDelegating
+)
+
+// DemocracyVoting An indicator for what an account is doing; it can either be delegating or voting.
+//
+// Direct: the account is voting directly. Votes are kept sorted by referendum index.
+//
+// Delegating: the account is delegating `Balance` of its voting power to `Target` with `Conviction`.
+type DemocracyVoting struct {
+	IsDelegating sc.Bool
+	// Direct
+	Votes sc.Sequence[ReferendumVote]
+	// Delegating
+	Balance    Balance
+	Target     AccountId
+	Conviction Conviction
+	// Common
+	Delegations Delegations
+	Prior       PriorLock
+}
+
+func NewDemocracyVotingDirect(votes sc.Sequence[ReferendumVote], delegations Delegations, prior PriorLock) DemocracyVoting {
+	return DemocracyVoting{
+		IsDelegating: false,
+		Votes:        votes,
+		Delegations:  delegations,
+		Prior:        prior,
+	}
+}
+
+func NewDemocracyVotingDelegating(balance Balance, target AccountId, conviction Conviction, delegations Delegations, prior PriorLock) DemocracyVoting {
+	return DemocracyVoting{
+		IsDelegating: true,
+		Balance:      balance,
+		Target:       target,
+		Conviction:   conviction,
+		Delegations:  delegations,
+		Prior:        prior,
+	}
+}
+
+// DefaultDemocracyVoting returns the value of an account that has never voted nor delegated.
+func DefaultDemocracyVoting() DemocracyVoting {
+	return NewDemocracyVotingDirect(
+		sc.Sequence[ReferendumVote]{},
+		Delegations{Votes: sc.NewU128FromUint64(0), Capital: sc.NewU128FromUint64(0)},
+		PriorLock{Until: 0, Amount: sc.NewU128FromUint64(0)},
+	)
+}
+
+func (dv DemocracyVoting) Encode(buffer *bytes.Buffer) {
+	if !dv.IsDelegating {
+		DemocracyVotingDirect.Encode(buffer)
+		dv.Votes.Encode(buffer)
+		dv.Delegations.Encode(buffer)
+		dv.Prior.Encode(buffer)
+		return
+	}
+
+	DemocracyVotingDelegating.Encode(buffer)
+	dv.Balance.Encode(buffer)
+	dv.Target.Encode(buffer)
+	dv.Conviction.Encode(buffer)
+	dv.Delegations.Encode(buffer)
+	dv.Prior.Encode(buffer)
+}
+
+func DecodeDemocracyVoting(buffer *bytes.Buffer) DemocracyVoting {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case DemocracyVotingDirect:
+		votes := sc.DecodeSequenceWith(buffer, DecodeReferendumVote)
+		delegations := DecodeDelegations(buffer)
+		prior := DecodePriorLock(buffer)
+		return NewDemocracyVotingDirect(votes, delegations, prior)
+	case DemocracyVotingDelegating:
+		balance := sc.DecodeU128(buffer)
+		target := DecodeAccountId(buffer)
+		conviction := DecodeConviction(buffer)
+		delegations := DecodeDelegations(buffer)
+		prior := DecodePriorLock(buffer)
+		return NewDemocracyVotingDelegating(balance, target, conviction, delegations, prior)
+	default:
+		log.Critical("invalid DemocracyVoting type")
+	}
+
+	panic("unreachable")
+}
+
+func (dv DemocracyVoting) Bytes() []byte {
+	return sc.EncodedBytes(dv)
+}
+
+// Rejig clears the prior lock if it has expired at `now`.
+func (dv *DemocracyVoting) Rejig(now BlockNumber) {
+	dv.Prior.Rejig(now)
+}
+
+// LockedBalance returns the amount of balance locked by the account's votes or delegation.
+func (dv DemocracyVoting) LockedBalance() Balance {
+	locked := dv.Prior.Locked()
+
+	if dv.IsDelegating {
+		if dv.Balance.ToBigInt().Cmp(locked.ToBigInt()) > 0 {
+			return dv.Balance
+		}
+		return locked
+	}
+
+	for _, v := range dv.Votes {
+		total := v.Vote.TotalBalance()
+		if total.ToBigInt().Cmp(locked.ToBigInt()) > 0 {
+			locked = total
+		}
+	}
+
+	return locked
+}
@@ -18,12 +18,16 @@ type SignedExtra struct {
 
 	// a compact integer containing the transactor pay including tip.
 	Fee sc.U128 // encode as Compact
+
+	// the asset in which the transaction fee is paid, or the native currency if none.
+	AssetId sc.Option[AssetId]
 }
 
 func (e SignedExtra) Encode(buffer *bytes.Buffer) {
 	e.Era.Encode(buffer)
 	sc.ToCompact(e.Nonce).Encode(buffer)
 	sc.Compact(e.Fee).Encode(buffer)
+	e.AssetId.Encode(buffer)
 }
 
 func DecodeExtra(buffer *bytes.Buffer) SignedExtra {
@@ -31,6 +35,7 @@ func DecodeExtra(buffer *bytes.Buffer) SignedExtra {
 	e.Era = DecodeEra(buffer)
 	e.Nonce = sc.U32(sc.U128(sc.DecodeCompact(buffer)).ToBigInt().Uint64())
 	e.Fee = sc.U128(sc.DecodeCompact(buffer))
+	e.AssetId = sc.DecodeOption[AssetId](buffer)
 	return e
 }
 
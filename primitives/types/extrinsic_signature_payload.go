@@ -37,11 +37,15 @@ type AdditionalSigned struct {
 func (sp SignedPayload) Encode(buffer *bytes.Buffer) {
 	sp.Call.Encode(buffer)
 	sp.Extra.Encode(buffer)
-	sp.SpecVersion.Encode(buffer)
-	sp.TransactionVersion.Encode(buffer)
-	// sp.FormatVersion.Encode(buffer)
-	sp.GenesisHash.Encode(buffer)
-	sp.BlockHash.Encode(buffer)
+	sp.AdditionalSigned.Encode(buffer)
+}
+
+func (as AdditionalSigned) Encode(buffer *bytes.Buffer) {
+	as.SpecVersion.Encode(buffer)
+	as.TransactionVersion.Encode(buffer)
+	// as.FormatVersion.Encode(buffer)
+	as.GenesisHash.Encode(buffer)
+	as.BlockHash.Encode(buffer)
 }
 
 func (sp SignedPayload) Bytes() []byte {
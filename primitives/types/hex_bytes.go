@@ -0,0 +1,37 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+var errInvalidHexBytes = errors.New("expected a 0x-prefixed hex string")
+
+// HexBytes are bytes which are encoded in JSON as a 0x-prefixed hex string, as in genesis configs.
+type HexBytes []byte
+
+func (h HexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal("0x" + hex.EncodeToString(h))
+}
+
+func (h *HexBytes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(s, "0x") {
+		return errInvalidHexBytes
+	}
+
+	b, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return errInvalidHexBytes
+	}
+
+	*h = b
+
+	return nil
+}
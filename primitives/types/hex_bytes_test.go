@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_HexBytes_MarshalJSON(t *testing.T) {
+	result, err := json.Marshal(HexBytes{0x01, 0xab, 0xff})
+
+	assert.NoError(t, err)
+	assert.Equal(t, `"0x01abff"`, string(result))
+}
+
+func Test_HexBytes_UnmarshalJSON(t *testing.T) {
+	var result HexBytes
+
+	err := json.Unmarshal([]byte(`"0x01abff"`), &result)
+
+	assert.NoError(t, err)
+	assert.Equal(t, HexBytes{0x01, 0xab, 0xff}, result)
+}
+
+func Test_HexBytes_UnmarshalJSON_Empty(t *testing.T) {
+	var result HexBytes
+
+	err := json.Unmarshal([]byte(`"0x"`), &result)
+
+	assert.NoError(t, err)
+	assert.Equal(t, HexBytes{}, result)
+}
+
+func Test_HexBytes_UnmarshalJSON_NoPrefix(t *testing.T) {
+	var result HexBytes
+
+	err := json.Unmarshal([]byte(`"01abff"`), &result)
+
+	assert.Equal(t, errInvalidHexBytes, err)
+}
+
+func Test_HexBytes_UnmarshalJSON_InvalidHex(t *testing.T) {
+	var result HexBytes
+
+	err := json.Unmarshal([]byte(`"0x0g"`), &result)
+
+	assert.Equal(t, errInvalidHexBytes, err)
+}
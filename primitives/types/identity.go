@@ -0,0 +1,342 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+const (
+	IdentityDataNone sc.U8 = 0
+	// IdentityDataRaw is the variant of raw data of length 0, raw data of length `n` has the variant `IdentityDataRaw + n`.
+	IdentityDataRaw         sc.U8 = 1
+	IdentityDataBlakeTwo256 sc.U8 = 34
+	IdentityDataSha256      sc.U8 = 35
+	IdentityDataKeccak256   sc.U8 = 36
+	IdentityDataShaThree256 sc.U8 = 37
+)
+
+// IdentityDataMaxRawLength is the maximum length of raw identity data.
+const IdentityDataMaxRawLength = 32
+
+// IdentityData Either nothing, up to 32 bytes of raw data or a 32 byte hash of the data.
+type IdentityData struct {
+	Variant sc.U8
+	Value   sc.Sequence[sc.U8]
+}
+
+func NewIdentityDataNone() IdentityData {
+	return IdentityData{Variant: IdentityDataNone, Value: sc.Sequence[sc.U8]{}}
+}
+
+func NewIdentityDataRaw(value sc.Sequence[sc.U8]) IdentityData {
+	if len(value) > IdentityDataMaxRawLength {
+		log.Critical("raw IdentityData should be at most 32 bytes")
+	}
+	return IdentityData{Variant: IdentityDataRaw + sc.U8(len(value)), Value: value}
+}
+
+// Encode encodes the variant followed by the data itself, without a length prefix.
+func (id IdentityData) Encode(buffer *bytes.Buffer) {
+	id.Variant.Encode(buffer)
+	for _, b := range id.Value {
+		b.Encode(buffer)
+	}
+}
+
+func DecodeIdentityData(buffer *bytes.Buffer) IdentityData {
+	b := sc.DecodeU8(buffer)
+
+	switch {
+	case b == IdentityDataNone:
+		return NewIdentityDataNone()
+	case b >= IdentityDataRaw && b <= IdentityDataRaw+IdentityDataMaxRawLength:
+		return IdentityData{Variant: b, Value: decodeIdentityDataValue(buffer, int(b-IdentityDataRaw))}
+	case b >= IdentityDataBlakeTwo256 && b <= IdentityDataShaThree256:
+		return IdentityData{Variant: b, Value: decodeIdentityDataValue(buffer, 32)}
+	default:
+		log.Critical("invalid IdentityData type")
+	}
+
+	panic("unreachable")
+}
+
+func (id IdentityData) Bytes() []byte {
+	return sc.EncodedBytes(id)
+}
+
+func decodeIdentityDataValue(buffer *bytes.Buffer, length int) sc.Sequence[sc.U8] {
+	value := make(sc.Sequence[sc.U8], length)
+	for i := range value {
+		value[i] = sc.DecodeU8(buffer)
+	}
+	return value
+}
+
+// IdentityAdditionalField An additional key-value field of an identity.
+type IdentityAdditionalField struct {
+	Key   IdentityData
+	Value IdentityData
+}
+
+func (iaf IdentityAdditionalField) Encode(buffer *bytes.Buffer) {
+	iaf.Key.Encode(buffer)
+	iaf.Value.Encode(buffer)
+}
+
+func DecodeIdentityAdditionalField(buffer *bytes.Buffer) IdentityAdditionalField {
+	return IdentityAdditionalField{
+		Key:   DecodeIdentityData(buffer),
+		Value: DecodeIdentityData(buffer),
+	}
+}
+
+func (iaf IdentityAdditionalField) Bytes() []byte {
+	return sc.EncodedBytes(iaf)
+}
+
+// Identity fields which a registrar can check, used as bit flags.
+const (
+	IdentityFieldDisplay        sc.U64 = 1 << 0
+	IdentityFieldLegal          sc.U64 = 1 << 1
+	IdentityFieldWeb            sc.U64 = 1 << 2
+	IdentityFieldRiot           sc.U64 = 1 << 3
+	IdentityFieldEmail          sc.U64 = 1 << 4
+	IdentityFieldPgpFingerprint sc.U64 = 1 << 5
+	IdentityFieldImage          sc.U64 = 1 << 6
+	IdentityFieldTwitter        sc.U64 = 1 << 7
+)
+
+// IdentityInfo The information of an identity.
+type IdentityInfo struct {
+	// Additional fields of the identity that are not catered for with the struct's explicit fields.
+	Additional sc.Sequence[IdentityAdditionalField]
+	// A reasonable display name for the controller of the account.
+	Display IdentityData
+	// The full legal name in the local jurisdiction of the entity.
+	Legal IdentityData
+	// A representative website held by the controller of the account.
+	Web IdentityData
+	// The Riot/Matrix handle held by the controller of the account.
+	Riot IdentityData
+	// The email address of the controller of the account.
+	Email IdentityData
+	// The PGP/GPG public key fingerprint (20 bytes) of the controller of the account.
+	PgpFingerprint sc.Option[sc.FixedSequence[sc.U8]]
+	// A graphic image representing the controller of the account.
+	Image IdentityData
+	// The Twitter identity of the controller of the account.
+	Twitter IdentityData
+}
+
+func (ii IdentityInfo) Encode(buffer *bytes.Buffer) {
+	ii.Additional.Encode(buffer)
+	ii.Display.Encode(buffer)
+	ii.Legal.Encode(buffer)
+	ii.Web.Encode(buffer)
+	ii.Riot.Encode(buffer)
+	ii.Email.Encode(buffer)
+	ii.PgpFingerprint.Encode(buffer)
+	ii.Image.Encode(buffer)
+	ii.Twitter.Encode(buffer)
+}
+
+func DecodeIdentityInfo(buffer *bytes.Buffer) IdentityInfo {
+	return IdentityInfo{
+		Additional: sc.DecodeSequenceWith(buffer, DecodeIdentityAdditionalField),
+		Display:    DecodeIdentityData(buffer),
+		Legal:      DecodeIdentityData(buffer),
+		Web:        DecodeIdentityData(buffer),
+		Riot:       DecodeIdentityData(buffer),
+		Email:      DecodeIdentityData(buffer),
+		PgpFingerprint: sc.DecodeOptionWith(buffer, func(buffer *bytes.Buffer) sc.FixedSequence[sc.U8] {
+			return sc.DecodeFixedSequence[sc.U8](20, buffer)
+		}),
+		Image:   DecodeIdentityData(buffer),
+		Twitter: DecodeIdentityData(buffer),
+	}
+}
+
+func (ii IdentityInfo) Bytes() []byte {
+	return sc.EncodedBytes(ii)
+}
+
+const (
+	JudgementUnknown sc.U8 = iota
+	JudgementFeePaid
+	JudgementReasonable
+	JudgementKnownGood
+	JudgementOutOfDate
+	JudgementLowQuality
+	JudgementErroneous
+)
+
+// Judgement The judgement of a registrar on an identity. A FeePaid judgement
+// is a pending request, with the fee reserved from the requester.
+type Judgement struct {
+	Variant sc.U8
+	Fee     Balance
+}
+
+func NewJudgement(variant sc.U8) Judgement {
+	return Judgement{Variant: variant}
+}
+
+func NewJudgementFeePaid(fee Balance) Judgement {
+	return Judgement{Variant: JudgementFeePaid, Fee: fee}
+}
+
+func (j Judgement) Encode(buffer *bytes.Buffer) {
+	j.Variant.Encode(buffer)
+	if j.Variant == JudgementFeePaid {
+		j.Fee.Encode(buffer)
+	}
+}
+
+func DecodeJudgement(buffer *bytes.Buffer) Judgement {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case JudgementFeePaid:
+		return NewJudgementFeePaid(sc.DecodeU128(buffer))
+	case JudgementUnknown, JudgementReasonable, JudgementKnownGood, JudgementOutOfDate, JudgementLowQuality, JudgementErroneous:
+		return NewJudgement(b)
+	default:
+		log.Critical("invalid Judgement type")
+	}
+
+	panic("unreachable")
+}
+
+func (j Judgement) Bytes() []byte {
+	return sc.EncodedBytes(j)
+}
+
+// IsSticky returns whether the judgement is kept when the identity is changed.
+func (j Judgement) IsSticky() bool {
+	return j.Variant == JudgementFeePaid || j.Variant == JudgementErroneous
+}
+
+// RegistrarJudgement The judgement on an identity by the registrar with the given index.
+type RegistrarJudgement struct {
+	RegistrarIndex sc.U32
+	Judgement      Judgement
+}
+
+func (rj RegistrarJudgement) Encode(buffer *bytes.Buffer) {
+	rj.RegistrarIndex.Encode(buffer)
+	rj.Judgement.Encode(buffer)
+}
+
+func DecodeRegistrarJudgement(buffer *bytes.Buffer) RegistrarJudgement {
+	return RegistrarJudgement{
+		RegistrarIndex: sc.DecodeU32(buffer),
+		Judgement:      DecodeJudgement(buffer),
+	}
+}
+
+func (rj RegistrarJudgement) Bytes() []byte {
+	return sc.EncodedBytes(rj)
+}
+
+// Registration The identity of an account, with the judgements on it and the deposit reserved for it.
+type Registration struct {
+	// Judgements from the registrars on this identity, ordered by registrar index.
+	Judgements sc.Sequence[RegistrarJudgement]
+	// Amount held on deposit for this information.
+	Deposit Balance
+	// Information on the identity.
+	Info IdentityInfo
+}
+
+func (r Registration) Encode(buffer *bytes.Buffer) {
+	r.Judgements.Encode(buffer)
+	r.Deposit.Encode(buffer)
+	r.Info.Encode(buffer)
+}
+
+func DecodeRegistration(buffer *bytes.Buffer) Registration {
+	return Registration{
+		Judgements: sc.DecodeSequenceWith(buffer, DecodeRegistrarJudgement),
+		Deposit:    sc.DecodeU128(buffer),
+		Info:       DecodeIdentityInfo(buffer),
+	}
+}
+
+func (r Registration) Bytes() []byte {
+	return sc.EncodedBytes(r)
+}
+
+// RegistrarInfo The information of a registrar.
+type RegistrarInfo struct {
+	// The account of the registrar.
+	Account AccountId
+	// Amount required to be given to the registrar for them to provide judgement.
+	Fee Balance
+	// The identity fields which the registrar checks, as bit flags.
+	Fields sc.U64
+}
+
+func (ri RegistrarInfo) Encode(buffer *bytes.Buffer) {
+	ri.Account.Encode(buffer)
+	ri.Fee.Encode(buffer)
+	ri.Fields.Encode(buffer)
+}
+
+func DecodeRegistrarInfo(buffer *bytes.Buffer) RegistrarInfo {
+	return RegistrarInfo{
+		Account: DecodeAccountId(buffer),
+		Fee:     sc.DecodeU128(buffer),
+		Fields:  sc.DecodeU64(buffer),
+	}
+}
+
+func (ri RegistrarInfo) Bytes() []byte {
+	return sc.EncodedBytes(ri)
+}
+
+// IdentitySubAccount An account with a name, either a sub-account of an identity or,
+// when stored for a sub-account, its parent.
+type IdentitySubAccount struct {
+	Account AccountId
+	Name    IdentityData
+}
+
+func (isa IdentitySubAccount) Encode(buffer *bytes.Buffer) {
+	isa.Account.Encode(buffer)
+	isa.Name.Encode(buffer)
+}
+
+func DecodeIdentitySubAccount(buffer *bytes.Buffer) IdentitySubAccount {
+	return IdentitySubAccount{
+		Account: DecodeAccountId(buffer),
+		Name:    DecodeIdentityData(buffer),
+	}
+}
+
+func (isa IdentitySubAccount) Bytes() []byte {
+	return sc.EncodedBytes(isa)
+}
+
+// IdentitySubs The sub-accounts of an identity and the total deposit reserved for them.
+type IdentitySubs struct {
+	Deposit  Balance
+	Accounts sc.Sequence[AccountId]
+}
+
+func (is IdentitySubs) Encode(buffer *bytes.Buffer) {
+	is.Deposit.Encode(buffer)
+	is.Accounts.Encode(buffer)
+}
+
+func DecodeIdentitySubs(buffer *bytes.Buffer) IdentitySubs {
+	return IdentitySubs{
+		Deposit:  sc.DecodeU128(buffer),
+		Accounts: sc.DecodeSequenceWith(buffer, DecodeAccountId),
+	}
+}
+
+func (is IdentitySubs) Bytes() []byte {
+	return sc.EncodedBytes(is)
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// Heartbeat is sent by an authority to prove that it is online in the current session.
+type Heartbeat struct {
+	// BlockNumber is the block number at the time the heartbeat is created.
+	BlockNumber BlockNumber
+	// SessionIndex is the index of the current session.
+	SessionIndex sc.U32
+	// AuthorityIndex is the index of the authority in the authorities of the session.
+	AuthorityIndex sc.U32
+	// ValidatorsLen is the number of authorities of the session.
+	ValidatorsLen sc.U32
+}
+
+func (h Heartbeat) Encode(buffer *bytes.Buffer) {
+	h.BlockNumber.Encode(buffer)
+	h.SessionIndex.Encode(buffer)
+	h.AuthorityIndex.Encode(buffer)
+	h.ValidatorsLen.Encode(buffer)
+}
+
+func DecodeHeartbeat(buffer *bytes.Buffer) Heartbeat {
+	return Heartbeat{
+		BlockNumber:    sc.DecodeU32(buffer),
+		SessionIndex:   sc.DecodeU32(buffer),
+		AuthorityIndex: sc.DecodeU32(buffer),
+		ValidatorsLen:  sc.DecodeU32(buffer),
+	}
+}
+
+func (h Heartbeat) Bytes() []byte {
+	return sc.EncodedBytes(h)
+}
@@ -38,12 +38,12 @@ package types
 //	// that are stale or incorrect.
 //	//
 //	// Make sure to perform the same checks in `pre_dispatch` function.
-//	Validate(_who *Address32, _call *Call, _info *DispatchInfo, _length sc.Compact) (ok ValidTransaction, err TransactionValidityError)
+//	Validate(_who *AccountId, _call *Call, _info *DispatchInfo, _length sc.Compact) (ok ValidTransaction, err TransactionValidityError)
 //
 //	// Do any pre-flight stuff for a signed transaction.
 //	//
 //	// Make sure to perform the same checks as in [`Self::validate`].
-//	PreDispatch(e SignedExtra, who *Address32, call *Call, info *DispatchInfo, length sc.Compact) (ok Pre, err TransactionValidityError)
+//	PreDispatch(e SignedExtra, who *AccountId, call *Call, info *DispatchInfo, length sc.Compact) (ok Pre, err TransactionValidityError)
 //
 //	// Validate an unsigned transaction for the transaction queue.
 //	//
@@ -12,34 +12,30 @@ func DefaultAccountIdLookup() AccountIdLookup {
 }
 
 // TODO: MultiAddress[AccountId, AccountIndex]
-func (l AccountIdLookup) Lookup(a MultiAddress) (Address32, TransactionValidityError) {
+func (l AccountIdLookup) Lookup(a MultiAddress) (AccountId, TransactionValidityError) {
 	address := LookupAddress(a)
 	if address.HasValue {
 		return address.Value, nil
 	}
 
-	return Address32{}, NewTransactionValidityError(NewUnknownTransactionCannotLookup())
+	return AccountId{}, NewTransactionValidityError(NewUnknownTransactionCannotLookup())
 }
 
 // LookupAddress Lookup an address to get an Id, if there's one there.
-func LookupAddress(a MultiAddress) sc.Option[Address32] { // TODO: MultiAddress[AccountId, AccountIndex]
+func LookupAddress(a MultiAddress) sc.Option[AccountId] { // TODO: MultiAddress[AccountId, AccountIndex]
 	if a.IsAccountId() {
-		return sc.NewOption[Address32](a.AsAccountId().Address32)
-	}
-
-	if a.IsAddress32() {
-		return sc.NewOption[Address32](a.AsAddress32())
+		return sc.NewOption[AccountId](a.AsAccountId())
 	}
 
 	if a.IsAccountIndex() {
-		return sc.NewOption[Address32](LookupIndex(a.AsAccountIndex()))
+		return LookupIndex(a.AsAccountIndex())
 	}
 
-	return sc.NewOption[Address32](nil)
+	return lookupAccountAddress(a)
 }
 
 // LookupIndex Lookup an T::AccountIndex to get an Id, if there's one there.
-func LookupIndex(index AccountIndex) sc.Option[Address32] {
+func LookupIndex(index AccountIndex) sc.Option[AccountId] {
 	// TODO:
-	return sc.NewOption[Address32](nil)
+	return sc.NewOption[AccountId](nil)
 }
@@ -0,0 +1,315 @@
+package types
+
+import (
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+type primitiveTypeInfo struct {
+	primitive MetadataDefinitionPrimitive
+}
+
+func (pti primitiveTypeInfo) TypeInfo(_ *MetadataTypeRegistry) MetadataTypeInfo {
+	return MetadataTypeInfo{
+		Path:       sc.Sequence[sc.Str]{},
+		Params:     sc.Sequence[MetadataTypeParameter]{},
+		Definition: NewMetadataTypeDefinitionPrimitive(pti.primitive),
+		Docs:       sc.Sequence[sc.Str]{},
+	}
+}
+
+// The type info of the primitive types.
+var (
+	TypeInfoBool   TypeInfo = primitiveTypeInfo{MetadataDefinitionPrimitiveBoolean}
+	TypeInfoString TypeInfo = primitiveTypeInfo{MetadataDefinitionPrimitiveString}
+	TypeInfoU8     TypeInfo = primitiveTypeInfo{MetadataDefinitionPrimitiveU8}
+	TypeInfoU16    TypeInfo = primitiveTypeInfo{MetadataDefinitionPrimitiveU16}
+	TypeInfoU32    TypeInfo = primitiveTypeInfo{MetadataDefinitionPrimitiveU32}
+	TypeInfoU64    TypeInfo = primitiveTypeInfo{MetadataDefinitionPrimitiveU64}
+	TypeInfoU128   TypeInfo = primitiveTypeInfo{MetadataDefinitionPrimitiveU128}
+	TypeInfoI32    TypeInfo = primitiveTypeInfo{MetadataDefinitionPrimitiveI32}
+	TypeInfoI64    TypeInfo = primitiveTypeInfo{MetadataDefinitionPrimitiveI64}
+)
+
+// TypeInfoPermill is the type info of Permill.
+var TypeInfoPermill = NewCompositeTypeInfo(sc.Sequence[sc.Str]{"sp_arithmetic", "per_things", "Permill"},
+	"A fixed point representation of a fraction in parts per million.",
+	NewFieldTypeInfo("", "u32", TypeInfoU32),
+)
+
+type compactTypeInfo struct {
+	of TypeInfo
+}
+
+// NewCompactTypeInfo returns the type info of the compact encoding of `of`.
+func NewCompactTypeInfo(of TypeInfo) TypeInfo {
+	return compactTypeInfo{of}
+}
+
+func (cti compactTypeInfo) TypeInfo(registry *MetadataTypeRegistry) MetadataTypeInfo {
+	return MetadataTypeInfo{
+		Path:       sc.Sequence[sc.Str]{},
+		Params:     sc.Sequence[MetadataTypeParameter]{},
+		Definition: NewMetadataTypeDefinitionCompact(sc.ToCompact(registry.Register(cti.of))),
+		Docs:       sc.Sequence[sc.Str]{},
+	}
+}
+
+type sequenceTypeInfo struct {
+	of TypeInfo
+}
+
+// NewSequenceTypeInfo returns the type info of a sequence of `of`.
+func NewSequenceTypeInfo(of TypeInfo) TypeInfo {
+	return sequenceTypeInfo{of}
+}
+
+func (sti sequenceTypeInfo) TypeInfo(registry *MetadataTypeRegistry) MetadataTypeInfo {
+	return MetadataTypeInfo{
+		Path:       sc.Sequence[sc.Str]{},
+		Params:     sc.Sequence[MetadataTypeParameter]{},
+		Definition: NewMetadataTypeDefinitionSequence(sc.ToCompact(registry.Register(sti.of))),
+		Docs:       sc.Sequence[sc.Str]{},
+	}
+}
+
+type fixedSequenceTypeInfo struct {
+	length sc.U32
+	of     TypeInfo
+}
+
+// NewFixedSequenceTypeInfo returns the type info of a fixed sequence of `length` elements of `of`.
+func NewFixedSequenceTypeInfo(length sc.U32, of TypeInfo) TypeInfo {
+	return fixedSequenceTypeInfo{length, of}
+}
+
+func (fsti fixedSequenceTypeInfo) TypeInfo(registry *MetadataTypeRegistry) MetadataTypeInfo {
+	return MetadataTypeInfo{
+		Path:       sc.Sequence[sc.Str]{},
+		Params:     sc.Sequence[MetadataTypeParameter]{},
+		Definition: NewMetadataTypeDefinitionFixedSequence(fsti.length, sc.ToCompact(registry.Register(fsti.of))),
+		Docs:       sc.Sequence[sc.Str]{},
+	}
+}
+
+type tupleTypeInfo struct {
+	of []TypeInfo
+}
+
+// NewTupleTypeInfo returns the type info of a tuple of `of`. The empty tuple has no elements.
+func NewTupleTypeInfo(of ...TypeInfo) TypeInfo {
+	return tupleTypeInfo{of}
+}
+
+func (tti tupleTypeInfo) TypeInfo(registry *MetadataTypeRegistry) MetadataTypeInfo {
+	ids := sc.Sequence[sc.Compact]{}
+	for _, info := range tti.of {
+		ids = append(ids, sc.ToCompact(registry.Register(info)))
+	}
+
+	return MetadataTypeInfo{
+		Path:       sc.Sequence[sc.Str]{},
+		Params:     sc.Sequence[MetadataTypeParameter]{},
+		Definition: NewMetadataTypeDefinitionTuple(ids),
+		Docs:       sc.Sequence[sc.Str]{},
+	}
+}
+
+type optionTypeInfo struct {
+	of TypeInfo
+}
+
+// NewOptionTypeInfo returns the type info of an optional `of`.
+func NewOptionTypeInfo(of TypeInfo) TypeInfo {
+	return optionTypeInfo{of}
+}
+
+func (oti optionTypeInfo) TypeInfo(registry *MetadataTypeRegistry) MetadataTypeInfo {
+	id := registry.Register(oti.of)
+
+	return MetadataTypeInfo{
+		Path:   sc.Sequence[sc.Str]{"Option"},
+		Params: sc.Sequence[MetadataTypeParameter]{NewMetadataTypeParameter(id, "T")},
+		Definition: NewMetadataTypeDefinitionVariant(sc.Sequence[MetadataDefinitionVariant]{
+			NewMetadataDefinitionVariant("None", sc.Sequence[MetadataTypeDefinitionField]{}, 0, "Option.None"),
+			NewMetadataDefinitionVariant("Some", sc.Sequence[MetadataTypeDefinitionField]{NewMetadataTypeDefinitionField(id)}, 1, "Option.Some"),
+		}),
+		Docs: sc.Sequence[sc.Str]{},
+	}
+}
+
+type resultTypeInfo struct {
+	ok  TypeInfo
+	err TypeInfo
+}
+
+// NewResultTypeInfo returns the type info of a result of `ok` or `err`.
+func NewResultTypeInfo(ok TypeInfo, err TypeInfo) TypeInfo {
+	return resultTypeInfo{ok, err}
+}
+
+func (rti resultTypeInfo) TypeInfo(registry *MetadataTypeRegistry) MetadataTypeInfo {
+	okId := registry.Register(rti.ok)
+	errId := registry.Register(rti.err)
+
+	return MetadataTypeInfo{
+		Path: sc.Sequence[sc.Str]{"Result"},
+		Params: sc.Sequence[MetadataTypeParameter]{
+			NewMetadataTypeParameter(okId, "T"),
+			NewMetadataTypeParameter(errId, "E"),
+		},
+		Definition: NewMetadataTypeDefinitionVariant(sc.Sequence[MetadataDefinitionVariant]{
+			NewMetadataDefinitionVariant("Ok", sc.Sequence[MetadataTypeDefinitionField]{NewMetadataTypeDefinitionField(okId)}, 0, "Result.Ok"),
+			NewMetadataDefinitionVariant("Err", sc.Sequence[MetadataTypeDefinitionField]{NewMetadataTypeDefinitionField(errId)}, 1, "Result.Err"),
+		}),
+		Docs: sc.Sequence[sc.Str]{},
+	}
+}
+
+// FieldTypeInfo describes a field of a composite type or of a variant.
+type FieldTypeInfo struct {
+	Name     string
+	TypeName string
+	Type     TypeInfo
+}
+
+// NewFieldTypeInfo returns a named field of type `info`, with the `typeName` it has in Substrate.
+// Fields of tuple structs and variants have no name, and may have no type name either.
+func NewFieldTypeInfo(name string, typeName string, info TypeInfo) FieldTypeInfo {
+	return FieldTypeInfo{
+		Name:     name,
+		TypeName: typeName,
+		Type:     info,
+	}
+}
+
+func (fti FieldTypeInfo) field(registry *MetadataTypeRegistry) MetadataTypeDefinitionField {
+	id := registry.Register(fti.Type)
+
+	if fti.Name == "" && fti.TypeName == "" {
+		return NewMetadataTypeDefinitionField(id)
+	}
+
+	if fti.Name == "" {
+		return NewMetadataTypeDefinitionFieldWithName(id, sc.Str(fti.TypeName))
+	}
+
+	return NewMetadataTypeDefinitionFieldWithNames(id, sc.Str(fti.Name), sc.Str(fti.TypeName))
+}
+
+func fields(registry *MetadataTypeRegistry, infos []FieldTypeInfo) sc.Sequence[MetadataTypeDefinitionField] {
+	fields := sc.Sequence[MetadataTypeDefinitionField]{}
+	for _, info := range infos {
+		fields = append(fields, info.field(registry))
+	}
+
+	return fields
+}
+
+type compositeTypeInfo struct {
+	path   sc.Sequence[sc.Str]
+	fields []FieldTypeInfo
+	docs   string
+}
+
+// NewCompositeTypeInfo returns the type info of a struct with the given path and fields.
+func NewCompositeTypeInfo(path sc.Sequence[sc.Str], docs string, fields ...FieldTypeInfo) TypeInfo {
+	return compositeTypeInfo{path, fields, docs}
+}
+
+func (cti compositeTypeInfo) TypeInfo(registry *MetadataTypeRegistry) MetadataTypeInfo {
+	return MetadataTypeInfo{
+		Path:       cti.path,
+		Params:     sc.Sequence[MetadataTypeParameter]{},
+		Definition: NewMetadataTypeDefinitionComposite(fields(registry, cti.fields)),
+		Docs:       docs(cti.docs),
+	}
+}
+
+// VariantTypeInfo describes a variant of an enum.
+type VariantTypeInfo struct {
+	Name   string
+	Index  sc.U8
+	Fields []FieldTypeInfo
+	Docs   string
+}
+
+// NewVariantTypeInfo returns the variant with the given name, index and fields.
+func NewVariantTypeInfo(name string, index sc.U8, docs string, fields ...FieldTypeInfo) VariantTypeInfo {
+	return VariantTypeInfo{
+		Name:   name,
+		Index:  index,
+		Fields: fields,
+		Docs:   docs,
+	}
+}
+
+type enumTypeInfo struct {
+	path     sc.Sequence[sc.Str]
+	variants []VariantTypeInfo
+	docs     string
+}
+
+// NewEnumTypeInfo returns the type info of an enum with the given path and variants.
+// The variants are ordered by index.
+func NewEnumTypeInfo(path sc.Sequence[sc.Str], docs string, variants ...VariantTypeInfo) TypeInfo {
+	sorted := append([]VariantTypeInfo{}, variants...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Index < sorted[j].Index })
+
+	return enumTypeInfo{path, sorted, docs}
+}
+
+func (eti enumTypeInfo) TypeInfo(registry *MetadataTypeRegistry) MetadataTypeInfo {
+	variants := sc.Sequence[MetadataDefinitionVariant]{}
+	for _, variant := range eti.variants {
+		variants = append(variants, NewMetadataDefinitionVariant(variant.Name, fields(registry, variant.Fields), variant.Index, variant.Docs))
+	}
+
+	return MetadataTypeInfo{
+		Path:       eti.path,
+		Params:     sc.Sequence[MetadataTypeParameter]{},
+		Definition: NewMetadataTypeDefinitionVariant(variants),
+		Docs:       docs(eti.docs),
+	}
+}
+
+// CallInfo describes a call in the metadata.
+type CallInfo struct {
+	Name string
+	Args []FieldTypeInfo
+	Docs string
+}
+
+// DescribedCall is implemented by the calls which describe their name and arguments, so that the
+// call type of their module is generated from the calls themselves.
+type DescribedCall interface {
+	CallInfo() CallInfo
+}
+
+// NewCallsTypeInfo returns the type info of the calls of a module, generated from `functions`.
+// All functions must implement `DescribedCall`.
+func NewCallsTypeInfo(path sc.Sequence[sc.Str], functions map[sc.U8]Call) TypeInfo {
+	variants := make([]VariantTypeInfo, 0, len(functions))
+
+	for index, call := range functions {
+		described, ok := call.(DescribedCall)
+		if !ok {
+			log.Critical("call is not described")
+		}
+
+		info := described.CallInfo()
+		variants = append(variants, NewVariantTypeInfo(info.Name, index, info.Docs, info.Args...))
+	}
+
+	return NewEnumTypeInfo(path, "Contains a variant per dispatchable extrinsic that this module has.", variants...)
+}
+
+func docs(docs string) sc.Sequence[sc.Str] {
+	if docs == "" {
+		return sc.Sequence[sc.Str]{}
+	}
+
+	return sc.Sequence[sc.Str]{sc.Str(docs)}
+}
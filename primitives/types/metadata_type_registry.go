@@ -0,0 +1,120 @@
+package types
+
+import (
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// TypeInfo is implemented by the types which describe themselves in the metadata.
+type TypeInfo interface {
+	// TypeInfo returns the path, generic parameters and definition of the type.
+	// The types it refers to are registered in `registry`.
+	TypeInfo(registry *MetadataTypeRegistry) MetadataTypeInfo
+}
+
+// MetadataTypeInfo is a metadata type, before an id is assigned to it.
+type MetadataTypeInfo struct {
+	Path       sc.Sequence[sc.Str]
+	Params     sc.Sequence[MetadataTypeParameter]
+	Definition MetadataTypeDefinition
+	Docs       sc.Sequence[sc.Str]
+}
+
+// key identifies the type in the registry. Types with the same path, parameters and definition
+// are the same type, regardless of their docs.
+func (mti MetadataTypeInfo) key() string {
+	return string(mti.Path.Bytes()) + string(mti.Params.Bytes()) + string(mti.Definition.Bytes())
+}
+
+// TypeId refers to a type with a hand-assigned id, added to the registry with `Add`.
+type TypeId int
+
+func (ti TypeId) TypeInfo(registry *MetadataTypeRegistry) MetadataTypeInfo {
+	mt := registry.types[int(ti)]
+
+	return MetadataTypeInfo{
+		Path:       mt.Path,
+		Params:     mt.Params,
+		Definition: mt.Definition,
+		Docs:       mt.Docs,
+	}
+}
+
+// MetadataTypeRegistry assigns ids to the types of the metadata and deduplicates them.
+// It emits the portable registry of the metadata. It holds both the types registered from their
+// TypeInfo and the types with hand-assigned ids of the modules which are not migrated to TypeInfo.
+type MetadataTypeRegistry struct {
+	nextId int
+	types  map[int]MetadataType
+	ids    map[string]int
+}
+
+// NewMetadataTypeRegistry returns an empty registry, which assigns ids starting from `firstId`.
+// The ids below `firstId` are left for the types with hand-assigned ids.
+func NewMetadataTypeRegistry(firstId int) *MetadataTypeRegistry {
+	return &MetadataTypeRegistry{
+		nextId: firstId,
+		types:  map[int]MetadataType{},
+		ids:    map[string]int{},
+	}
+}
+
+// Add adds types with hand-assigned ids to the registry. Registered types that are the same as
+// any of them are deduplicated to their ids.
+func (r *MetadataTypeRegistry) Add(types ...MetadataType) {
+	for _, mt := range types {
+		id := int(mt.Id.ToBigInt().Int64())
+		r.types[id] = mt
+
+		key := MetadataTypeInfo{Path: mt.Path, Params: mt.Params, Definition: mt.Definition}.key()
+		if _, ok := r.ids[key]; !ok {
+			r.ids[key] = id
+		}
+	}
+}
+
+// Register returns the id of the type described by `info`, assigning a new one if the type is
+// not in the registry yet.
+func (r *MetadataTypeRegistry) Register(info TypeInfo) int {
+	if id, ok := info.(TypeId); ok {
+		return int(id)
+	}
+
+	mti := info.TypeInfo(r)
+
+	key := mti.key()
+	if id, ok := r.ids[key]; ok {
+		return id
+	}
+
+	id := r.nextId
+	r.nextId++
+
+	r.types[id] = MetadataType{
+		Id:         sc.ToCompact(id),
+		Path:       mti.Path,
+		Params:     mti.Params,
+		Definition: mti.Definition,
+		Docs:       mti.Docs,
+	}
+	r.ids[key] = id
+
+	return id
+}
+
+// Types returns the portable registry, with the types ordered by id.
+func (r *MetadataTypeRegistry) Types() sc.Sequence[MetadataType] {
+	ids := make([]int, 0, len(r.types))
+	for id := range r.types {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	types := make(sc.Sequence[MetadataType], 0, len(ids))
+	for _, id := range ids {
+		types = append(types, r.types[id])
+	}
+
+	return types
+}
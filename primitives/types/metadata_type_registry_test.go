@@ -0,0 +1,147 @@
+package types
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+type describedCall struct {
+	Call
+	info CallInfo
+}
+
+func (dc describedCall) CallInfo() CallInfo {
+	return dc.info
+}
+
+func Test_MetadataTypeRegistry_Register(t *testing.T) {
+	registry := NewMetadataTypeRegistry(10)
+
+	assert.Equal(t, 10, registry.Register(TypeInfoU64))
+	assert.Equal(t, 11, registry.Register(NewCompactTypeInfo(TypeInfoU64)))
+	assert.Equal(t, 10, registry.Register(TypeInfoU64))
+	assert.Equal(t, 11, registry.Register(NewCompactTypeInfo(TypeInfoU64)))
+
+	types := registry.Types()
+	assert.Equal(t, 2, len(types))
+	assert.Equal(t, NewMetadataTypeDefinitionCompact(sc.ToCompact(10)), types[1].Definition)
+}
+
+func Test_MetadataTypeRegistry_Register_Nested(t *testing.T) {
+	registry := NewMetadataTypeRegistry(0)
+
+	id := registry.Register(NewSequenceTypeInfo(NewTupleTypeInfo(TypeInfoU8, TypeInfoU32)))
+
+	types := registry.Types()
+	assert.Equal(t, 3, id)
+	assert.Equal(t, NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(0), sc.ToCompact(1)}), types[2].Definition)
+	assert.Equal(t, NewMetadataTypeDefinitionSequence(sc.ToCompact(2)), types[3].Definition)
+}
+
+func Test_MetadataTypeRegistry_Add(t *testing.T) {
+	registry := NewMetadataTypeRegistry(100)
+	registry.Add(
+		NewMetadataType(5, "U128", NewMetadataTypeDefinitionPrimitive(MetadataDefinitionPrimitiveU128)),
+		NewMetadataType(2, "bool", NewMetadataTypeDefinitionPrimitive(MetadataDefinitionPrimitiveBoolean)),
+	)
+
+	assert.Equal(t, 5, registry.Register(TypeInfoU128))
+	assert.Equal(t, 2, registry.Register(TypeInfoBool))
+	assert.Equal(t, 7, registry.Register(TypeId(7)))
+	assert.Equal(t, 100, registry.Register(NewCompactTypeInfo(TypeInfoU128)))
+
+	types := registry.Types()
+	assert.Equal(t, 3, len(types))
+	assert.Equal(t, sc.ToCompact(2), types[0].Id)
+	assert.Equal(t, sc.ToCompact(5), types[1].Id)
+	assert.Equal(t, sc.ToCompact(100), types[2].Id)
+	assert.Equal(t, NewMetadataTypeDefinitionCompact(sc.ToCompact(5)), types[2].Definition)
+}
+
+func Test_MetadataTypeRegistry_Register_Option(t *testing.T) {
+	registry := NewMetadataTypeRegistry(0)
+
+	optionU32 := registry.Register(NewOptionTypeInfo(TypeInfoU32))
+	optionU64 := registry.Register(NewOptionTypeInfo(TypeInfoU64))
+
+	assert.NotEqual(t, optionU32, optionU64)
+	assert.Equal(t, optionU32, registry.Register(NewOptionTypeInfo(TypeInfoU32)))
+}
+
+func Test_MetadataTypeRegistry_Register_DocsIgnored(t *testing.T) {
+	registry := NewMetadataTypeRegistry(0)
+	path := sc.Sequence[sc.Str]{"pallet", "Thing"}
+
+	id := registry.Register(NewCompositeTypeInfo(path, "A thing.", NewFieldTypeInfo("value", "u32", TypeInfoU32)))
+
+	assert.Equal(t, id, registry.Register(NewCompositeTypeInfo(path, "", NewFieldTypeInfo("value", "u32", TypeInfoU32))))
+	assert.Equal(t, sc.Sequence[sc.Str]{"A thing."}, registry.Types()[id].Docs)
+}
+
+func Test_NewCompositeTypeInfo_Fields(t *testing.T) {
+	registry := NewMetadataTypeRegistry(0)
+
+	id := registry.Register(NewCompositeTypeInfo(sc.Sequence[sc.Str]{"pallet", "Thing"}, "",
+		NewFieldTypeInfo("value", "u32", TypeInfoU32),
+		NewFieldTypeInfo("", "u32", TypeInfoU32),
+		NewFieldTypeInfo("", "", TypeInfoU32),
+	))
+
+	assert.Equal(t,
+		NewMetadataTypeDefinitionComposite(sc.Sequence[MetadataTypeDefinitionField]{
+			NewMetadataTypeDefinitionFieldWithNames(0, "value", "u32"),
+			NewMetadataTypeDefinitionFieldWithName(0, "u32"),
+			NewMetadataTypeDefinitionField(0),
+		}),
+		registry.Types()[id].Definition)
+}
+
+func Test_NewEnumTypeInfo_SortsVariants(t *testing.T) {
+	registry := NewMetadataTypeRegistry(0)
+
+	id := registry.Register(NewEnumTypeInfo(sc.Sequence[sc.Str]{"pallet", "Error"}, "",
+		NewVariantTypeInfo("Second", 1, "The second."),
+		NewVariantTypeInfo("First", 0, "The first."),
+	))
+
+	assert.Equal(t,
+		NewMetadataTypeDefinitionVariant(sc.Sequence[MetadataDefinitionVariant]{
+			NewMetadataDefinitionVariant("First", sc.Sequence[MetadataTypeDefinitionField]{}, 0, "The first."),
+			NewMetadataDefinitionVariant("Second", sc.Sequence[MetadataTypeDefinitionField]{}, 1, "The second."),
+		}),
+		registry.Types()[id].Definition)
+}
+
+func Test_NewCallsTypeInfo(t *testing.T) {
+	registry := NewMetadataTypeRegistry(0)
+	functions := map[sc.U8]Call{
+		1: describedCall{info: CallInfo{
+			Name: "remark",
+			Args: []FieldTypeInfo{NewFieldTypeInfo("remark", "Vec<u8>", NewSequenceTypeInfo(TypeInfoU8))},
+			Docs: "Make some on-chain remark.",
+		}},
+		0: describedCall{info: CallInfo{
+			Name: "set",
+			Args: []FieldTypeInfo{NewFieldTypeInfo("now", "T::Moment", NewCompactTypeInfo(TypeInfoU64))},
+			Docs: "Set the current time.",
+		}},
+	}
+
+	id := registry.Register(NewCallsTypeInfo(sc.Sequence[sc.Str]{"pallet", "Call"}, functions))
+
+	types := registry.Types()
+	assert.Equal(t, 4, id)
+	assert.Equal(t, sc.Sequence[sc.Str]{"pallet", "Call"}, types[id].Path)
+	assert.Equal(t,
+		NewMetadataTypeDefinitionVariant(sc.Sequence[MetadataDefinitionVariant]{
+			NewMetadataDefinitionVariant("set", sc.Sequence[MetadataTypeDefinitionField]{
+				NewMetadataTypeDefinitionFieldWithNames(1, "now", "T::Moment"),
+			}, 0, "Set the current time."),
+			NewMetadataDefinitionVariant("remark", sc.Sequence[MetadataTypeDefinitionField]{
+				NewMetadataTypeDefinitionFieldWithNames(3, "remark", "Vec<u8>"),
+			}, 1, "Make some on-chain remark."),
+		}),
+		types[id].Definition)
+}
@@ -0,0 +1,333 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	MetadataVersion15 sc.U8 = 15
+)
+
+// MetadataVersions are the metadata versions supported by the runtime.
+var MetadataVersions = sc.Sequence[sc.U32]{sc.U32(MetadataVersion), sc.U32(MetadataVersion15)}
+
+type MetadataV15 struct {
+	Data RuntimeMetadataV15
+}
+
+func NewMetadataV15(data RuntimeMetadataV15) MetadataV15 {
+	return MetadataV15{Data: data}
+}
+
+func (m MetadataV15) Encode(buffer *bytes.Buffer) {
+	MetadataReserved.Encode(buffer)
+	MetadataVersion15.Encode(buffer)
+	m.Data.Encode(buffer)
+}
+
+func DecodeMetadataV15(buffer *bytes.Buffer) (MetadataV15, error) {
+	metaReserved := sc.DecodeU32(buffer)
+	if metaReserved != MetadataReserved {
+		return MetadataV15{}, errors.New(fmt.Sprintf("metadata reserved mismatch: expect [%d], actual [%d]", MetadataReserved, metaReserved))
+	}
+
+	version := sc.DecodeU8(buffer)
+	if version != MetadataVersion15 {
+		return MetadataV15{}, errors.New(fmt.Sprintf("metadata version mismatch: expect [%d], actual [%d]", MetadataVersion15, version))
+	}
+
+	return MetadataV15{
+		Data: DecodeRuntimeMetadataV15(buffer),
+	}, nil
+}
+
+func (m MetadataV15) Bytes() []byte {
+	return sc.EncodedBytes(m)
+}
+
+type RuntimeMetadataV15 struct {
+	Types      sc.Sequence[MetadataType]
+	Modules    sc.Sequence[MetadataModuleV15]
+	Extrinsic  MetadataExtrinsicV15
+	Type       sc.Compact
+	Apis       sc.Sequence[RuntimeApiMetadata]
+	OuterEnums OuterEnums
+	Custom     CustomMetadata
+}
+
+func (rm RuntimeMetadataV15) Encode(buffer *bytes.Buffer) {
+	rm.Types.Encode(buffer)
+	rm.Modules.Encode(buffer)
+	rm.Extrinsic.Encode(buffer)
+	rm.Type.Encode(buffer)
+	rm.Apis.Encode(buffer)
+	rm.OuterEnums.Encode(buffer)
+	rm.Custom.Encode(buffer)
+}
+
+func DecodeRuntimeMetadataV15(buffer *bytes.Buffer) RuntimeMetadataV15 {
+	return RuntimeMetadataV15{
+		Types:      sc.DecodeSequenceWith(buffer, DecodeMetadataType),
+		Modules:    sc.DecodeSequenceWith(buffer, DecodeMetadataModuleV15),
+		Extrinsic:  DecodeMetadataExtrinsicV15(buffer),
+		Type:       sc.DecodeCompact(buffer),
+		Apis:       sc.DecodeSequenceWith(buffer, DecodeRuntimeApiMetadata),
+		OuterEnums: DecodeOuterEnums(buffer),
+		Custom:     DecodeCustomMetadata(buffer),
+	}
+}
+
+func (rm RuntimeMetadataV15) Bytes() []byte {
+	return sc.EncodedBytes(rm)
+}
+
+type MetadataModuleV15 struct {
+	Name      sc.Str
+	Storage   sc.Option[MetadataModuleStorage]
+	Call      sc.Option[sc.Compact]
+	Event     sc.Option[sc.Compact]
+	Constants sc.Sequence[MetadataModuleConstant]
+	Error     sc.Option[sc.Compact]
+	Index     sc.U8
+	Docs      sc.Sequence[sc.Str]
+}
+
+func NewMetadataModuleV15(module MetadataModule, docs sc.Sequence[sc.Str]) MetadataModuleV15 {
+	return MetadataModuleV15{
+		Name:      module.Name,
+		Storage:   module.Storage,
+		Call:      module.Call,
+		Event:     module.Event,
+		Constants: module.Constants,
+		Error:     module.Error,
+		Index:     module.Index,
+		Docs:      docs,
+	}
+}
+
+func (mm MetadataModuleV15) Encode(buffer *bytes.Buffer) {
+	mm.Name.Encode(buffer)
+	mm.Storage.Encode(buffer)
+	mm.Call.Encode(buffer)
+	mm.Event.Encode(buffer)
+	mm.Constants.Encode(buffer)
+	mm.Error.Encode(buffer)
+	mm.Index.Encode(buffer)
+	mm.Docs.Encode(buffer)
+}
+
+func DecodeMetadataModuleV15(buffer *bytes.Buffer) MetadataModuleV15 {
+	return MetadataModuleV15{
+		Name:      sc.DecodeStr(buffer),
+		Storage:   sc.DecodeOptionWith(buffer, DecodeMetadataModuleStorage),
+		Call:      sc.DecodeOption[sc.Compact](buffer),
+		Event:     sc.DecodeOption[sc.Compact](buffer),
+		Constants: sc.DecodeSequenceWith(buffer, DecodeMetadataModuleConstant),
+		Error:     sc.DecodeOption[sc.Compact](buffer),
+		Index:     sc.DecodeU8(buffer),
+		Docs:      sc.DecodeSequence[sc.Str](buffer),
+	}
+}
+
+func (mm MetadataModuleV15) Bytes() []byte {
+	return sc.EncodedBytes(mm)
+}
+
+type MetadataExtrinsicV15 struct {
+	Version          sc.U8
+	Address          sc.Compact
+	Call             sc.Compact
+	Signature        sc.Compact
+	Extra            sc.Compact
+	SignedExtensions sc.Sequence[MetadataSignedExtension]
+}
+
+func (me MetadataExtrinsicV15) Encode(buffer *bytes.Buffer) {
+	me.Version.Encode(buffer)
+	me.Address.Encode(buffer)
+	me.Call.Encode(buffer)
+	me.Signature.Encode(buffer)
+	me.Extra.Encode(buffer)
+	me.SignedExtensions.Encode(buffer)
+}
+
+func DecodeMetadataExtrinsicV15(buffer *bytes.Buffer) MetadataExtrinsicV15 {
+	return MetadataExtrinsicV15{
+		Version:          sc.DecodeU8(buffer),
+		Address:          sc.DecodeCompact(buffer),
+		Call:             sc.DecodeCompact(buffer),
+		Signature:        sc.DecodeCompact(buffer),
+		Extra:            sc.DecodeCompact(buffer),
+		SignedExtensions: sc.DecodeSequenceWith(buffer, DecodeMetadataSignedExtension),
+	}
+}
+
+func (me MetadataExtrinsicV15) Bytes() []byte {
+	return sc.EncodedBytes(me)
+}
+
+// RuntimeApiMetadata describes a runtime API and its methods.
+type RuntimeApiMetadata struct {
+	Name    sc.Str
+	Methods sc.Sequence[RuntimeApiMethodMetadata]
+	Docs    sc.Sequence[sc.Str]
+}
+
+func NewRuntimeApiMetadata(name string, methods sc.Sequence[RuntimeApiMethodMetadata], docs string) RuntimeApiMetadata {
+	return RuntimeApiMetadata{
+		Name:    sc.Str(name),
+		Methods: methods,
+		Docs:    sc.Sequence[sc.Str]{sc.Str(docs)},
+	}
+}
+
+func (ram RuntimeApiMetadata) Encode(buffer *bytes.Buffer) {
+	ram.Name.Encode(buffer)
+	ram.Methods.Encode(buffer)
+	ram.Docs.Encode(buffer)
+}
+
+func DecodeRuntimeApiMetadata(buffer *bytes.Buffer) RuntimeApiMetadata {
+	return RuntimeApiMetadata{
+		Name:    sc.DecodeStr(buffer),
+		Methods: sc.DecodeSequenceWith(buffer, DecodeRuntimeApiMethodMetadata),
+		Docs:    sc.DecodeSequence[sc.Str](buffer),
+	}
+}
+
+func (ram RuntimeApiMetadata) Bytes() []byte {
+	return sc.EncodedBytes(ram)
+}
+
+// RuntimeApiMethodMetadata describes a method of a runtime API with its inputs and output.
+type RuntimeApiMethodMetadata struct {
+	Name   sc.Str
+	Inputs sc.Sequence[RuntimeApiMethodParamMetadata]
+	Output sc.Compact
+	Docs   sc.Sequence[sc.Str]
+}
+
+func NewRuntimeApiMethodMetadata(name string, inputs sc.Sequence[RuntimeApiMethodParamMetadata], output int, docs string) RuntimeApiMethodMetadata {
+	return RuntimeApiMethodMetadata{
+		Name:   sc.Str(name),
+		Inputs: inputs,
+		Output: sc.ToCompact(output),
+		Docs:   sc.Sequence[sc.Str]{sc.Str(docs)},
+	}
+}
+
+func (ramm RuntimeApiMethodMetadata) Encode(buffer *bytes.Buffer) {
+	ramm.Name.Encode(buffer)
+	ramm.Inputs.Encode(buffer)
+	ramm.Output.Encode(buffer)
+	ramm.Docs.Encode(buffer)
+}
+
+func DecodeRuntimeApiMethodMetadata(buffer *bytes.Buffer) RuntimeApiMethodMetadata {
+	return RuntimeApiMethodMetadata{
+		Name:   sc.DecodeStr(buffer),
+		Inputs: sc.DecodeSequenceWith(buffer, DecodeRuntimeApiMethodParamMetadata),
+		Output: sc.DecodeCompact(buffer),
+		Docs:   sc.DecodeSequence[sc.Str](buffer),
+	}
+}
+
+func (ramm RuntimeApiMethodMetadata) Bytes() []byte {
+	return sc.EncodedBytes(ramm)
+}
+
+type RuntimeApiMethodParamMetadata struct {
+	Name sc.Str
+	Type sc.Compact
+}
+
+func NewRuntimeApiMethodParamMetadata(name string, id int) RuntimeApiMethodParamMetadata {
+	return RuntimeApiMethodParamMetadata{
+		Name: sc.Str(name),
+		Type: sc.ToCompact(id),
+	}
+}
+
+func (rampm RuntimeApiMethodParamMetadata) Encode(buffer *bytes.Buffer) {
+	rampm.Name.Encode(buffer)
+	rampm.Type.Encode(buffer)
+}
+
+func DecodeRuntimeApiMethodParamMetadata(buffer *bytes.Buffer) RuntimeApiMethodParamMetadata {
+	return RuntimeApiMethodParamMetadata{
+		Name: sc.DecodeStr(buffer),
+		Type: sc.DecodeCompact(buffer),
+	}
+}
+
+func (rampm RuntimeApiMethodParamMetadata) Bytes() []byte {
+	return sc.EncodedBytes(rampm)
+}
+
+// OuterEnums are the types of the enums aggregating the calls, events and errors of all modules.
+type OuterEnums struct {
+	Call  sc.Compact
+	Event sc.Compact
+	Error sc.Compact
+}
+
+func (oe OuterEnums) Encode(buffer *bytes.Buffer) {
+	oe.Call.Encode(buffer)
+	oe.Event.Encode(buffer)
+	oe.Error.Encode(buffer)
+}
+
+func DecodeOuterEnums(buffer *bytes.Buffer) OuterEnums {
+	return OuterEnums{
+		Call:  sc.DecodeCompact(buffer),
+		Event: sc.DecodeCompact(buffer),
+		Error: sc.DecodeCompact(buffer),
+	}
+}
+
+func (oe OuterEnums) Bytes() []byte {
+	return sc.EncodedBytes(oe)
+}
+
+// CustomMetadata is a map of custom values, encoded as a sequence of entries ordered by name.
+type CustomMetadata = sc.Sequence[CustomValueMetadata]
+
+func DecodeCustomMetadata(buffer *bytes.Buffer) CustomMetadata {
+	return sc.DecodeSequenceWith(buffer, DecodeCustomValueMetadata)
+}
+
+type CustomValueMetadata struct {
+	Name  sc.Str
+	Type  sc.Compact
+	Value sc.Sequence[sc.U8]
+}
+
+func NewCustomValueMetadata(name string, id int, value sc.Sequence[sc.U8]) CustomValueMetadata {
+	return CustomValueMetadata{
+		Name:  sc.Str(name),
+		Type:  sc.ToCompact(id),
+		Value: value,
+	}
+}
+
+func (cvm CustomValueMetadata) Encode(buffer *bytes.Buffer) {
+	cvm.Name.Encode(buffer)
+	cvm.Type.Encode(buffer)
+	cvm.Value.Encode(buffer)
+}
+
+func DecodeCustomValueMetadata(buffer *bytes.Buffer) CustomValueMetadata {
+	return CustomValueMetadata{
+		Name:  sc.DecodeStr(buffer),
+		Type:  sc.DecodeCompact(buffer),
+		Value: sc.DecodeSequence[sc.U8](buffer),
+	}
+}
+
+func (cvm CustomValueMetadata) Bytes() []byte {
+	return sc.EncodedBytes(cvm)
+}
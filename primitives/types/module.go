@@ -6,5 +6,55 @@ type Module interface {
 	Functions() map[sc.U8]Call
 	PreDispatch(call Call) (sc.Empty, TransactionValidityError)
 	ValidateUnsigned(source TransactionSource, call Call) (ValidTransaction, TransactionValidityError)
-	Metadata() (sc.Sequence[MetadataType], MetadataModule)
+	// Metadata returns the metadata of the module. The types of the module are registered in `registry`.
+	Metadata(registry *MetadataTypeRegistry) MetadataModule
+}
+
+// OnInitializeModule is implemented by the modules that run a task at the start of each block,
+// before its extrinsics are applied.
+type OnInitializeModule interface {
+	// OnInitialize returns the weight consumed by the task, which is registered as mandatory
+	// weight of the block.
+	OnInitialize(n BlockNumber) Weight
+}
+
+// OnFinalizeModule is implemented by the modules that run a task at the end of each block,
+// after its extrinsics are applied.
+type OnFinalizeModule interface {
+	OnFinalize(n BlockNumber)
+}
+
+// OffchainWorkerModule is implemented by the modules that run a task in the offchain worker
+// of each imported block.
+type OffchainWorkerModule interface {
+	OffchainWorker(n BlockNumber)
+}
+
+// GenesisBuilderModule is implemented by the modules that have genesis state. The genesis config
+// of a module is a JSON object under the camel case name of the module in the runtime genesis config.
+type GenesisBuilderModule interface {
+	// CreateDefaultConfig returns the default JSON genesis config of the module.
+	CreateDefaultConfig() ([]byte, error)
+	// BuildConfig sets the genesis storage of the module from its JSON genesis config.
+	BuildConfig(config []byte) error
+}
+
+// DocumentedModule is implemented by the modules that are documented in the metadata.
+type DocumentedModule interface {
+	Docs() sc.Sequence[sc.Str]
+}
+
+// StorageInfoModule is implemented by the modules that describe the maximum encoded length of their
+// storage items, from which the proof size of the benchmarked dispatchables is estimated.
+type StorageInfoModule interface {
+	StorageInfo() []StorageInfo
+}
+
+// VersionedModule is implemented by the modules that track the version of their storage layout.
+// The version of the module is stored at genesis and is updated by the migrations of the module.
+type VersionedModule interface {
+	// Name returns the name of the module, which prefixes the keys of its storage.
+	Name() sc.Str
+	// StorageVersion returns the version of the storage layout the module is written for.
+	StorageVersion() StorageVersion
 }
@@ -7,15 +7,6 @@ import (
 	"github.com/LimeChain/gosemble/primitives/log"
 )
 
-// AccountId It's an account ID (pubkey).
-type AccountId struct {
-	Address32 // TODO: Varies depending on Signature (32 for ed25519 and sr25519, 33 for ecdsa)
-}
-
-func DecodeAccountId(buffer *bytes.Buffer) AccountId {
-	return AccountId{DecodeAddress32(buffer)} // TODO: length 32 or 33 depending on algorithm
-}
-
 // AccountIndex It's an account index.
 type AccountIndex = sc.U32
 
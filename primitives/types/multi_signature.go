@@ -11,6 +11,7 @@ const (
 	MultiSignatureEd25519 sc.U8 = iota
 	MultiSignatureSr25519
 	MultiSignatureEcdsa
+	MultiSignatureEthereum
 )
 
 type MultiSignature struct {
@@ -29,6 +30,10 @@ func NewMultiSignatureEcdsa(signature Ecdsa) MultiSignature {
 	return MultiSignature{sc.NewVaryingData(MultiSignatureEcdsa, signature)}
 }
 
+func NewMultiSignatureEthereum(signature EthereumSignature) MultiSignature {
+	return MultiSignature{sc.NewVaryingData(MultiSignatureEthereum, signature)}
+}
+
 func (s MultiSignature) IsEd25519() sc.Bool {
 	switch s.VaryingData[0] {
 	case MultiSignatureEd25519:
@@ -78,7 +83,7 @@ func (s MultiSignature) IsEcdsa() sc.Bool {
 
 func (s MultiSignature) AsEcdsa() Ecdsa {
 	if s.IsEcdsa() {
-		return s.VaryingData[0].(Ecdsa)
+		return s.VaryingData[1].(Ecdsa)
 	} else {
 		log.Critical("not a Ecdsa signature type")
 	}
@@ -86,6 +91,25 @@ func (s MultiSignature) AsEcdsa() Ecdsa {
 	panic("unreachable")
 }
 
+func (s MultiSignature) IsEthereum() sc.Bool {
+	switch s.VaryingData[0] {
+	case MultiSignatureEthereum:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s MultiSignature) AsEthereum() EthereumSignature {
+	if s.IsEthereum() {
+		return s.VaryingData[1].(EthereumSignature)
+	} else {
+		log.Critical("not an Ethereum signature type")
+	}
+
+	panic("unreachable")
+}
+
 func DecodeMultiSignature(buffer *bytes.Buffer) MultiSignature {
 	b := sc.DecodeU8(buffer)
 
@@ -96,6 +120,8 @@ func DecodeMultiSignature(buffer *bytes.Buffer) MultiSignature {
 		return NewMultiSignatureSr25519(DecodeSr25519(buffer))
 	case MultiSignatureEcdsa:
 		return NewMultiSignatureEcdsa(DecodeEcdsa(buffer))
+	case MultiSignatureEthereum:
+		return NewMultiSignatureEthereum(DecodeEthereumSignature(buffer))
 	default:
 		log.Critical("invalid MultiSignature type in Decode: " + string(b))
 	}
@@ -103,21 +129,17 @@ func DecodeMultiSignature(buffer *bytes.Buffer) MultiSignature {
 	panic("unreachable")
 }
 
-func (s MultiSignature) Verify(msg sc.Sequence[sc.U8], signer Address32) sc.Bool {
+// Verify checks that `msg` is signed by `signer`. Ed25519 and sr25519 signatures are verified against
+// the account as a public key, so they are valid only for 32 byte account ids.
+func (s MultiSignature) Verify(msg sc.Sequence[sc.U8], signer AccountId) sc.Bool {
 	if s.IsEd25519() {
-		return s.AsEd25519().Verify(msg, signer)
+		return s.AsEd25519().Verify(msg, signer.FixedSequence)
 	} else if s.IsSr25519() {
-		return s.AsSr25519().Verify(msg, signer)
+		return s.AsSr25519().Verify(msg, signer.FixedSequence)
 	} else if s.IsEcdsa() {
-		// TODO:
-		return true
-		// let m = sp_io::hashing::blake2_256(msg.get());
-		// match sp_io::crypto::secp256k1_ecdsa_recover_compressed(sig.as_ref(), &m) {
-		// 	Ok(pubkey) =>
-		// 		&sp_io::hashing::blake2_256(pubkey.as_ref()) ==
-		// 			<dyn AsRef<[u8; 32]>>::as_ref(who),
-		// 	_ => false,
-		// }
+		return s.AsEcdsa().Verify(msg, signer)
+	} else if s.IsEthereum() {
+		return s.AsEthereum().Verify(msg, signer)
 	} else {
 		log.Critical("invalid MultiSignature type in Verify")
 	}
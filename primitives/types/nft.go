@@ -0,0 +1,182 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+type CollectionId = sc.U32
+
+type ItemId = sc.U32
+
+// CollectionDetails The details of a collection of non-fungible items.
+type CollectionDetails struct {
+	// Can change `Owner`, `Issuer`, `Admin` and `Freezer` accounts.
+	Owner AccountId
+	// Can mint items.
+	Issuer AccountId
+	// Can burn items and set the metadata and attributes of the collection and its items.
+	Admin AccountId
+	// Can lock the transfer of items.
+	Freezer AccountId
+	// The total balance deposited by the owner for the collection, its metadata and attributes.
+	OwnerDeposit Balance
+	// The total number of items in the collection.
+	Items sc.U32
+	// The total number of items with metadata.
+	ItemMetadatas sc.U32
+	// The total number of attributes of the collection and its items.
+	Attributes sc.U32
+}
+
+func (cd CollectionDetails) Encode(buffer *bytes.Buffer) {
+	cd.Owner.Encode(buffer)
+	cd.Issuer.Encode(buffer)
+	cd.Admin.Encode(buffer)
+	cd.Freezer.Encode(buffer)
+	cd.OwnerDeposit.Encode(buffer)
+	cd.Items.Encode(buffer)
+	cd.ItemMetadatas.Encode(buffer)
+	cd.Attributes.Encode(buffer)
+}
+
+func DecodeCollectionDetails(buffer *bytes.Buffer) CollectionDetails {
+	return CollectionDetails{
+		Owner:         DecodeAccountId(buffer),
+		Issuer:        DecodeAccountId(buffer),
+		Admin:         DecodeAccountId(buffer),
+		Freezer:       DecodeAccountId(buffer),
+		OwnerDeposit:  sc.DecodeU128(buffer),
+		Items:         sc.DecodeU32(buffer),
+		ItemMetadatas: sc.DecodeU32(buffer),
+		Attributes:    sc.DecodeU32(buffer),
+	}
+}
+
+func (cd CollectionDetails) Bytes() []byte {
+	return sc.EncodedBytes(cd)
+}
+
+// ItemApproval An account approved to transfer an item, optionally until a given block.
+type ItemApproval struct {
+	Delegate AccountId
+	Deadline sc.Option[BlockNumber]
+}
+
+func (ia ItemApproval) Encode(buffer *bytes.Buffer) {
+	ia.Delegate.Encode(buffer)
+	ia.Deadline.Encode(buffer)
+}
+
+func DecodeItemApproval(buffer *bytes.Buffer) ItemApproval {
+	return ItemApproval{
+		Delegate: DecodeAccountId(buffer),
+		Deadline: sc.DecodeOption[BlockNumber](buffer),
+	}
+}
+
+func (ia ItemApproval) Bytes() []byte {
+	return sc.EncodedBytes(ia)
+}
+
+// ItemDeposit The balance deposited for an item and the account it was reserved from.
+type ItemDeposit struct {
+	Account AccountId
+	Amount  Balance
+}
+
+func (id ItemDeposit) Encode(buffer *bytes.Buffer) {
+	id.Account.Encode(buffer)
+	id.Amount.Encode(buffer)
+}
+
+func DecodeItemDeposit(buffer *bytes.Buffer) ItemDeposit {
+	return ItemDeposit{
+		Account: DecodeAccountId(buffer),
+		Amount:  sc.DecodeU128(buffer),
+	}
+}
+
+func (id ItemDeposit) Bytes() []byte {
+	return sc.EncodedBytes(id)
+}
+
+// ItemDetails The details of a non-fungible item.
+type ItemDetails struct {
+	// The owner of the item.
+	Owner AccountId
+	// The accounts approved to transfer the item.
+	Approvals sc.Sequence[ItemApproval]
+	// The balance deposited for the item.
+	Deposit ItemDeposit
+	// Whether the transfer of the item is locked.
+	IsTransferLocked sc.Bool
+}
+
+func (id ItemDetails) Encode(buffer *bytes.Buffer) {
+	id.Owner.Encode(buffer)
+	id.Approvals.Encode(buffer)
+	id.Deposit.Encode(buffer)
+	id.IsTransferLocked.Encode(buffer)
+}
+
+func DecodeItemDetails(buffer *bytes.Buffer) ItemDetails {
+	return ItemDetails{
+		Owner:            DecodeAccountId(buffer),
+		Approvals:        sc.DecodeSequenceWith(buffer, DecodeItemApproval),
+		Deposit:          DecodeItemDeposit(buffer),
+		IsTransferLocked: sc.DecodeBool(buffer),
+	}
+}
+
+func (id ItemDetails) Bytes() []byte {
+	return sc.EncodedBytes(id)
+}
+
+// NftMetadata The metadata of a collection or an item.
+type NftMetadata struct {
+	// The balance deposited for the metadata.
+	Deposit Balance
+	// General information, usually a link to an off-chain JSON document.
+	Data sc.Sequence[sc.U8]
+}
+
+func (nm NftMetadata) Encode(buffer *bytes.Buffer) {
+	nm.Deposit.Encode(buffer)
+	nm.Data.Encode(buffer)
+}
+
+func DecodeNftMetadata(buffer *bytes.Buffer) NftMetadata {
+	return NftMetadata{
+		Deposit: sc.DecodeU128(buffer),
+		Data:    sc.DecodeSequence[sc.U8](buffer),
+	}
+}
+
+func (nm NftMetadata) Bytes() []byte {
+	return sc.EncodedBytes(nm)
+}
+
+// NftAttribute The value of an attribute of a collection or an item.
+type NftAttribute struct {
+	Value sc.Sequence[sc.U8]
+	// The balance deposited for the attribute.
+	Deposit Balance
+}
+
+func (na NftAttribute) Encode(buffer *bytes.Buffer) {
+	na.Value.Encode(buffer)
+	na.Deposit.Encode(buffer)
+}
+
+func DecodeNftAttribute(buffer *bytes.Buffer) NftAttribute {
+	return NftAttribute{
+		Value:   sc.DecodeSequence[sc.U8](buffer),
+		Deposit: sc.DecodeU128(buffer),
+	}
+}
+
+func (na NftAttribute) Bytes() []byte {
+	return sc.EncodedBytes(na)
+}
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+// OffchainStorageKind is the kind of the offchain local storage.
+type OffchainStorageKind = sc.U32
+
+const (
+	// OffchainStorageKindPersistent is shared between all offchain workers and persisted
+	// across chain reorganisations.
+	OffchainStorageKindPersistent OffchainStorageKind = iota + 1
+	// OffchainStorageKindLocal is reverted when the block it was written in is reverted.
+	OffchainStorageKindLocal
+)
+
+// HttpRequestId is the opaque identifier of an HTTP request started by the offchain worker.
+type HttpRequestId = sc.U16
+
+const (
+	HttpErrorDeadlineReached sc.U8 = iota + 1
+	HttpErrorIoError
+	HttpErrorInvalid
+)
+
+// HttpError is an error of an HTTP request made by the offchain worker.
+type HttpError struct {
+	sc.VaryingData
+}
+
+func NewHttpErrorDeadlineReached() HttpError {
+	return HttpError{sc.NewVaryingData(HttpErrorDeadlineReached)}
+}
+
+func NewHttpErrorIoError() HttpError {
+	return HttpError{sc.NewVaryingData(HttpErrorIoError)}
+}
+
+func NewHttpErrorInvalid() HttpError {
+	return HttpError{sc.NewVaryingData(HttpErrorInvalid)}
+}
+
+func DecodeHttpError(buffer *bytes.Buffer) HttpError {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case HttpErrorDeadlineReached:
+		return NewHttpErrorDeadlineReached()
+	case HttpErrorIoError:
+		return NewHttpErrorIoError()
+	case HttpErrorInvalid:
+		return NewHttpErrorInvalid()
+	default:
+		log.Critical("invalid HttpError type")
+	}
+
+	panic("unreachable")
+}
+
+func (he HttpError) Bytes() []byte {
+	return sc.EncodedBytes(he)
+}
+
+func (he HttpError) Error() string {
+	switch he.VaryingData[0] {
+	case HttpErrorDeadlineReached:
+		return "The deadline was reached."
+	case HttpErrorIoError:
+		return "There was an IO error while processing the request."
+	case HttpErrorInvalid:
+		return "The ID of the request is invalid."
+	}
+
+	panic("unreachable")
+}
+
+const (
+	HttpRequestStatusDeadlineReached sc.U8 = iota
+	HttpRequestStatusIoError
+	HttpRequestStatusInvalid
+	HttpRequestStatusFinished
+)
+
+// HttpRequestStatus is the status of an HTTP request, as returned when waiting for its response.
+//
+// DeadlineReached: the deadline was reached before the response was received.
+// IoError: an error occurred while sending the request or receiving the response.
+// Invalid: the ID of the request is invalid.
+// Finished: the response was received, with the given status code.
+type HttpRequestStatus struct {
+	sc.VaryingData
+}
+
+func NewHttpRequestStatusDeadlineReached() HttpRequestStatus {
+	return HttpRequestStatus{sc.NewVaryingData(HttpRequestStatusDeadlineReached)}
+}
+
+func NewHttpRequestStatusIoError() HttpRequestStatus {
+	return HttpRequestStatus{sc.NewVaryingData(HttpRequestStatusIoError)}
+}
+
+func NewHttpRequestStatusInvalid() HttpRequestStatus {
+	return HttpRequestStatus{sc.NewVaryingData(HttpRequestStatusInvalid)}
+}
+
+func NewHttpRequestStatusFinished(code sc.U16) HttpRequestStatus {
+	return HttpRequestStatus{sc.NewVaryingData(HttpRequestStatusFinished, code)}
+}
+
+func DecodeHttpRequestStatus(buffer *bytes.Buffer) HttpRequestStatus {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case HttpRequestStatusDeadlineReached:
+		return NewHttpRequestStatusDeadlineReached()
+	case HttpRequestStatusIoError:
+		return NewHttpRequestStatusIoError()
+	case HttpRequestStatusInvalid:
+		return NewHttpRequestStatusInvalid()
+	case HttpRequestStatusFinished:
+		return NewHttpRequestStatusFinished(sc.DecodeU16(buffer))
+	default:
+		log.Critical("invalid HttpRequestStatus type")
+	}
+
+	panic("unreachable")
+}
+
+func (hrs HttpRequestStatus) Bytes() []byte {
+	return sc.EncodedBytes(hrs)
+}
+
+func (hrs HttpRequestStatus) IsFinished() bool {
+	return hrs.VaryingData[0] == HttpRequestStatusFinished
+}
+
+func (hrs HttpRequestStatus) AsFinished() sc.U16 {
+	if !hrs.IsFinished() {
+		log.Critical("not a Finished type")
+	}
+
+	return hrs.VaryingData[1].(sc.U16)
+}
+
+// AsError returns the HttpError that corresponds to a status other than Finished.
+func (hrs HttpRequestStatus) AsError() HttpError {
+	switch hrs.VaryingData[0] {
+	case HttpRequestStatusDeadlineReached:
+		return NewHttpErrorDeadlineReached()
+	case HttpRequestStatusIoError:
+		return NewHttpErrorIoError()
+	case HttpRequestStatusInvalid:
+		return NewHttpErrorInvalid()
+	default:
+		log.Critical("not an error type")
+	}
+
+	panic("unreachable")
+}
+
+// HttpHeader is a header of an HTTP response.
+type HttpHeader struct {
+	Name  sc.Sequence[sc.U8]
+	Value sc.Sequence[sc.U8]
+}
+
+func (hh HttpHeader) Encode(buffer *bytes.Buffer) {
+	hh.Name.Encode(buffer)
+	hh.Value.Encode(buffer)
+}
+
+func DecodeHttpHeader(buffer *bytes.Buffer) HttpHeader {
+	return HttpHeader{
+		Name:  sc.DecodeSequence[sc.U8](buffer),
+		Value: sc.DecodeSequence[sc.U8](buffer),
+	}
+}
+
+func (hh HttpHeader) Bytes() []byte {
+	return sc.EncodedBytes(hh)
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DecodeHttpRequestStatus(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		input       []byte
+		expectation HttpRequestStatus
+	}{
+		{
+			label:       "Decode HttpRequestStatus(DeadlineReached)",
+			input:       []byte{0x00},
+			expectation: NewHttpRequestStatusDeadlineReached(),
+		},
+		{
+			label:       "Decode HttpRequestStatus(IoError)",
+			input:       []byte{0x01},
+			expectation: NewHttpRequestStatusIoError(),
+		},
+		{
+			label:       "Decode HttpRequestStatus(Invalid)",
+			input:       []byte{0x02},
+			expectation: NewHttpRequestStatusInvalid(),
+		},
+		{
+			label:       "Decode HttpRequestStatus(Finished(200))",
+			input:       []byte{0x03, 0xc8, 0x00},
+			expectation: NewHttpRequestStatusFinished(200),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := bytes.NewBuffer(testExample.input)
+
+			result := DecodeHttpRequestStatus(buffer)
+
+			assert.Equal(t, testExample.expectation, result)
+			assert.Equal(t, testExample.input, result.Bytes())
+		})
+	}
+}
+
+func Test_HttpRequestStatus_AsFinished(t *testing.T) {
+	status := NewHttpRequestStatusFinished(404)
+
+	assert.True(t, status.IsFinished())
+	assert.Equal(t, sc.U16(404), status.AsFinished())
+}
+
+func Test_HttpRequestStatus_AsError(t *testing.T) {
+	assert.Equal(t, NewHttpErrorDeadlineReached(), NewHttpRequestStatusDeadlineReached().AsError())
+	assert.Equal(t, NewHttpErrorIoError(), NewHttpRequestStatusIoError().AsError())
+	assert.Equal(t, NewHttpErrorInvalid(), NewHttpRequestStatusInvalid().AsError())
+}
+
+func Test_DecodeHttpError(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		input       []byte
+		expectation HttpError
+	}{
+		{
+			label:       "Decode HttpError(DeadlineReached)",
+			input:       []byte{0x01},
+			expectation: NewHttpErrorDeadlineReached(),
+		},
+		{
+			label:       "Decode HttpError(IoError)",
+			input:       []byte{0x02},
+			expectation: NewHttpErrorIoError(),
+		},
+		{
+			label:       "Decode HttpError(Invalid)",
+			input:       []byte{0x03},
+			expectation: NewHttpErrorInvalid(),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := bytes.NewBuffer(testExample.input)
+
+			result := DecodeHttpError(buffer)
+
+			assert.Equal(t, testExample.expectation, result)
+			assert.Equal(t, testExample.input, result.Bytes())
+		})
+	}
+}
+
+func Test_DecodeHttpHeader(t *testing.T) {
+	input := []byte{0x10, 'H', 'o', 's', 't', 0x24, 'l', 'o', 'c', 'a', 'l', 'h', 'o', 's', 't'}
+	expectation := HttpHeader{
+		Name:  sc.BytesToSequenceU8([]byte("Host")),
+		Value: sc.BytesToSequenceU8([]byte("localhost")),
+	}
+
+	result := DecodeHttpHeader(bytes.NewBuffer(input))
+
+	assert.Equal(t, expectation, result)
+	assert.Equal(t, input, result.Bytes())
+}
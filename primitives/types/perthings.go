@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"math/big"
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/primitives/log"
@@ -40,3 +41,36 @@ func (p Perbill) Mul(v sc.Encodable) sc.Encodable {
 
 	panic("unreachable")
 }
+
+// Permill is a fixed point representation of a fraction in parts per million.
+type Permill struct {
+	Parts sc.U32
+}
+
+// NewPermillFromPercent creates a Permill from a percentage in the range [0, 100].
+func NewPermillFromPercent(percent sc.U32) Permill {
+	if percent > 100 {
+		percent = 100
+	}
+	return Permill{Parts: percent * 10_000}
+}
+
+func (p Permill) Encode(buffer *bytes.Buffer) {
+	p.Parts.Encode(buffer)
+}
+
+func DecodePermill(buffer *bytes.Buffer) Permill {
+	p := Permill{}
+	p.Parts = sc.DecodeU32(buffer)
+	return p
+}
+
+func (p Permill) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+// MulFloor multiplies `value` by the fraction, rounding down.
+func (p Permill) MulFloor(value *big.Int) *big.Int {
+	result := new(big.Int).Mul(value, big.NewInt(int64(p.Parts)))
+	return result.Div(result, big.NewInt(1_000_000))
+}
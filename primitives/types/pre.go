@@ -9,14 +9,17 @@ import (
 // Pre is the type that encodes information that can be passed from pre_dispatch to post-dispatch.
 type Pre struct {
 	Tip       Balance
-	Who       Address32
+	Who       AccountId
 	Imbalance sc.Option[Balance]
+	// The asset in which the fee was withdrawn, if it was not paid in the native currency.
+	AssetId sc.Option[AssetId]
 }
 
 func (p Pre) Encode(buffer *bytes.Buffer) {
 	p.Tip.Encode(buffer)
 	p.Who.Encode(buffer)
 	p.Imbalance.Encode(buffer)
+	p.AssetId.Encode(buffer)
 }
 
 func (p Pre) Bytes() []byte {
@@ -0,0 +1,9 @@
+package types
+
+// Randomness is a source of on-chain randomness.
+type Randomness interface {
+	// Random returns a random hash for `subject`, and the block number since which the hash
+	// could have been determined by chain observers. Values that are known before that block
+	// must not be relied on.
+	Random(subject []byte) (H256, BlockNumber)
+}
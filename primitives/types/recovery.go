@@ -0,0 +1,67 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// RecoveryConfig is the configuration for recovering an account.
+type RecoveryConfig struct {
+	// The number of blocks after a recovery is initiated that it can be claimed.
+	DelayPeriod sc.U32
+	// The amount reserved from the account for storing the configuration.
+	Deposit Balance
+	// The friends which can vouch for a recovery, sorted.
+	Friends sc.Sequence[AccountId]
+	// The number of vouches required to claim a recovery.
+	Threshold sc.U16
+}
+
+func (rc RecoveryConfig) Encode(buffer *bytes.Buffer) {
+	rc.DelayPeriod.Encode(buffer)
+	rc.Deposit.Encode(buffer)
+	rc.Friends.Encode(buffer)
+	rc.Threshold.Encode(buffer)
+}
+
+func DecodeRecoveryConfig(buffer *bytes.Buffer) RecoveryConfig {
+	return RecoveryConfig{
+		DelayPeriod: sc.DecodeU32(buffer),
+		Deposit:     sc.DecodeU128(buffer),
+		Friends:     sc.DecodeSequenceWith(buffer, DecodeAccountId),
+		Threshold:   sc.DecodeU16(buffer),
+	}
+}
+
+func (rc RecoveryConfig) Bytes() []byte {
+	return sc.EncodedBytes(rc)
+}
+
+// ActiveRecovery is an ongoing recovery of an account by a rescuer.
+type ActiveRecovery struct {
+	// The block number when the recovery was initiated.
+	Created sc.U32
+	// The amount reserved from the rescuer for initiating the recovery.
+	Deposit Balance
+	// The friends which have vouched for the rescuer, sorted.
+	Friends sc.Sequence[AccountId]
+}
+
+func (ar ActiveRecovery) Encode(buffer *bytes.Buffer) {
+	ar.Created.Encode(buffer)
+	ar.Deposit.Encode(buffer)
+	ar.Friends.Encode(buffer)
+}
+
+func DecodeActiveRecovery(buffer *bytes.Buffer) ActiveRecovery {
+	return ActiveRecovery{
+		Created: sc.DecodeU32(buffer),
+		Deposit: sc.DecodeU128(buffer),
+		Friends: sc.DecodeSequenceWith(buffer, DecodeAccountId),
+	}
+}
+
+func (ar ActiveRecovery) Bytes() []byte {
+	return sc.EncodedBytes(ar)
+}
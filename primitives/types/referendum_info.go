@@ -0,0 +1,133 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+// VoteThreshold A means of determining if a vote is past pass threshold.
+type VoteThreshold = sc.U8
+
+const (
+	// VoteThresholdSuperMajorityApprove A supermajority of approvals is needed to pass this vote.
+	VoteThresholdSuperMajorityApprove VoteThreshold = iota
+	// VoteThresholdSuperMajorityAgainst A supermajority of rejects is needed to fail this vote.
+	VoteThresholdSuperMajorityAgainst
+	// VoteThresholdSimpleMajority A simple majority of approvals is needed to pass this vote.
+	VoteThresholdSimpleMajority
+)
+
+func DecodeVoteThreshold(buffer *bytes.Buffer) VoteThreshold {
+	value := sc.DecodeU8(buffer)
+	switch value {
+	case VoteThresholdSuperMajorityApprove, VoteThresholdSuperMajorityAgainst, VoteThresholdSimpleMajority:
+		return value
+	default:
+		log.Critical("invalid vote threshold type")
+	}
+
+	panic("unreachable")
+}
+
+const (
+	ReferendumInfoOngoing sc.U8 = iota
+	ReferendumInfoFinished
+)
+
+// ReferendumStatus Info regarding an ongoing referendum.
+type ReferendumStatus struct {
+	// When voting on this referendum will end.
+	End BlockNumber
+	// The proposal being voted on.
+	Proposal Bounded
+	// The thresholding mechanism to determine whether it passed.
+	Threshold VoteThreshold
+	// The delay (in blocks) to wait after a successful referendum before deploying.
+	Delay BlockNumber
+	// The current tally of votes in this referendum.
+	Tally Tally
+}
+
+func (rs ReferendumStatus) Encode(buffer *bytes.Buffer) {
+	rs.End.Encode(buffer)
+	rs.Proposal.Encode(buffer)
+	rs.Threshold.Encode(buffer)
+	rs.Delay.Encode(buffer)
+	rs.Tally.Encode(buffer)
+}
+
+func DecodeReferendumStatus(buffer *bytes.Buffer) ReferendumStatus {
+	return ReferendumStatus{
+		End:       sc.DecodeU32(buffer),
+		Proposal:  DecodeBounded(buffer),
+		Threshold: DecodeVoteThreshold(buffer),
+		Delay:     sc.DecodeU32(buffer),
+		Tally:     DecodeTally(buffer),
+	}
+}
+
+func (rs ReferendumStatus) Bytes() []byte {
+	return sc.EncodedBytes(rs)
+}
+
+// ReferendumInfo Info regarding a referendum, present or past.
+//
+// Ongoing: the referendum is still being voted on, its status is kept.
+//
+// Finished: the referendum has been baked, only its outcome and end are kept.
+type ReferendumInfo struct {
+	IsFinished sc.Bool
+	Ongoing    ReferendumStatus
+	Approved   sc.Bool
+	End        BlockNumber
+}
+
+func NewReferendumInfoOngoing(status ReferendumStatus) ReferendumInfo {
+	return ReferendumInfo{
+		IsFinished: false,
+		Ongoing:    status,
+	}
+}
+
+func NewReferendumInfoFinished(approved sc.Bool, end BlockNumber) ReferendumInfo {
+	return ReferendumInfo{
+		IsFinished: true,
+		Approved:   approved,
+		End:        end,
+	}
+}
+
+func (ri ReferendumInfo) Encode(buffer *bytes.Buffer) {
+	if !ri.IsFinished {
+		ReferendumInfoOngoing.Encode(buffer)
+		ri.Ongoing.Encode(buffer)
+		return
+	}
+
+	ReferendumInfoFinished.Encode(buffer)
+	ri.Approved.Encode(buffer)
+	ri.End.Encode(buffer)
+}
+
+func DecodeReferendumInfo(buffer *bytes.Buffer) ReferendumInfo {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case ReferendumInfoOngoing:
+		return NewReferendumInfoOngoing(DecodeReferendumStatus(buffer))
+	case ReferendumInfoFinished:
+		approved := sc.DecodeBool(buffer)
+		end := sc.DecodeU32(buffer)
+		return NewReferendumInfoFinished(approved, end)
+	default:
+		log.Critical("invalid ReferendumInfo type")
+	}
+
+	panic("unreachable")
+}
+
+func (ri ReferendumInfo) Bytes() []byte {
+	return sc.EncodedBytes(ri)
+}
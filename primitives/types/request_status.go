@@ -0,0 +1,105 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+const (
+	RequestStatusUnrequested sc.U8 = iota
+	RequestStatusRequested
+)
+
+// AccountDeposit An amount held in reserve from an account.
+type AccountDeposit struct {
+	Who    AccountId
+	Amount Balance
+}
+
+func (ad AccountDeposit) Encode(buffer *bytes.Buffer) {
+	ad.Who.Encode(buffer)
+	ad.Amount.Encode(buffer)
+}
+
+func DecodeAccountDeposit(buffer *bytes.Buffer) AccountDeposit {
+	return AccountDeposit{
+		Who:    DecodeAccountId(buffer),
+		Amount: sc.DecodeU128(buffer),
+	}
+}
+
+func (ad AccountDeposit) Bytes() []byte {
+	return sc.EncodedBytes(ad)
+}
+
+// RequestStatus A type to note whether a preimage is owned by a user or the system.
+//
+// Unrequested: the preimage is noted by a depositor and nobody requested it.
+// The deposit and the length are always known.
+//
+// Requested: there are a non-zero number of outstanding requests for the preimage.
+// The preimage may be noted (then `Len` is known) and may have a deposit from a
+// depositor who noted it before it was requested.
+type RequestStatus struct {
+	IsRequested sc.Bool
+	Deposit     sc.Option[AccountDeposit]
+	Count       sc.U32
+	Len         sc.Option[sc.U32]
+}
+
+func NewRequestStatusUnrequested(deposit AccountDeposit, length sc.U32) RequestStatus {
+	return RequestStatus{
+		IsRequested: false,
+		Deposit:     sc.NewOption[AccountDeposit](deposit),
+		Len:         sc.NewOption[sc.U32](length),
+	}
+}
+
+func NewRequestStatusRequested(deposit sc.Option[AccountDeposit], count sc.U32, length sc.Option[sc.U32]) RequestStatus {
+	return RequestStatus{
+		IsRequested: true,
+		Deposit:     deposit,
+		Count:       count,
+		Len:         length,
+	}
+}
+
+func (rs RequestStatus) Encode(buffer *bytes.Buffer) {
+	if !rs.IsRequested {
+		RequestStatusUnrequested.Encode(buffer)
+		rs.Deposit.Value.Encode(buffer)
+		rs.Len.Value.Encode(buffer)
+		return
+	}
+
+	RequestStatusRequested.Encode(buffer)
+	rs.Deposit.Encode(buffer)
+	rs.Count.Encode(buffer)
+	rs.Len.Encode(buffer)
+}
+
+func DecodeRequestStatus(buffer *bytes.Buffer) RequestStatus {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case RequestStatusUnrequested:
+		deposit := DecodeAccountDeposit(buffer)
+		length := sc.DecodeU32(buffer)
+		return NewRequestStatusUnrequested(deposit, length)
+	case RequestStatusRequested:
+		deposit := sc.DecodeOptionWith(buffer, DecodeAccountDeposit)
+		count := sc.DecodeU32(buffer)
+		length := sc.DecodeOption[sc.U32](buffer)
+		return NewRequestStatusRequested(deposit, count, length)
+	default:
+		log.Critical("invalid RequestStatus type")
+	}
+
+	panic("unreachable")
+}
+
+func (rs RequestStatus) Bytes() []byte {
+	return sc.EncodedBytes(rs)
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	depositorBytes = bytes.Repeat([]byte{0x02}, 32)
+	depositor      = AccountDeposit{
+		Who:    NewAddress32(sc.BytesToSequenceU8(depositorBytes)...),
+		Amount: sc.NewU128FromUint64(5),
+	}
+	encodedDepositor = append(depositorBytes, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00)
+)
+
+func Test_EncodeRequestStatus(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		input       RequestStatus
+		expectation []byte
+	}{
+		{
+			label:       "Encode RequestStatus(Unrequested)",
+			input:       NewRequestStatusUnrequested(depositor, 10),
+			expectation: append(append([]byte{0x00}, encodedDepositor...), 0x0a, 0x00, 0x00, 0x00),
+		},
+		{
+			label:       "Encode RequestStatus(Requested) without deposit",
+			input:       NewRequestStatusRequested(sc.NewOption[AccountDeposit](nil), 1, sc.NewOption[sc.U32](nil)),
+			expectation: []byte{0x01, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			label:       "Encode RequestStatus(Requested) with deposit",
+			input:       NewRequestStatusRequested(sc.NewOption[AccountDeposit](depositor), 2, sc.NewOption[sc.U32](sc.U32(10))),
+			expectation: append(append([]byte{0x01, 0x01}, encodedDepositor...), 0x02, 0x00, 0x00, 0x00, 0x01, 0x0a, 0x00, 0x00, 0x00),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			testExample.input.Encode(buffer)
+
+			assert.Equal(t, testExample.expectation, buffer.Bytes())
+		})
+	}
+}
+
+func Test_DecodeRequestStatus(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		input       []byte
+		expectation RequestStatus
+	}{
+		{
+			label:       "Decode RequestStatus(Unrequested)",
+			input:       append(append([]byte{0x00}, encodedDepositor...), 0x0a, 0x00, 0x00, 0x00),
+			expectation: NewRequestStatusUnrequested(depositor, 10),
+		},
+		{
+			label:       "Decode RequestStatus(Requested) without deposit",
+			input:       []byte{0x01, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00},
+			expectation: NewRequestStatusRequested(sc.NewOption[AccountDeposit](nil), 1, sc.NewOption[sc.U32](nil)),
+		},
+		{
+			label:       "Decode RequestStatus(Requested) with deposit",
+			input:       append(append([]byte{0x01, 0x01}, encodedDepositor...), 0x02, 0x00, 0x00, 0x00, 0x01, 0x0a, 0x00, 0x00, 0x00),
+			expectation: NewRequestStatusRequested(sc.NewOption[AccountDeposit](depositor), 2, sc.NewOption[sc.U32](sc.U32(10))),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+			buffer.Write(testExample.input)
+
+			result := DecodeRequestStatus(buffer)
+
+			assert.Equal(t, testExample.expectation, result)
+		})
+	}
+}
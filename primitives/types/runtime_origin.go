@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/primitives/log"
 )
@@ -11,6 +13,7 @@ const (
 	RawOriginNone
 )
 
+// RawOrigin The origin of a call as defined by the system module.
 type RawOrigin struct {
 	sc.VaryingData // [T AccountId]
 }
@@ -19,7 +22,7 @@ func NewRawOriginRoot() RawOrigin {
 	return RawOrigin{sc.NewVaryingData(RawOriginRoot)}
 }
 
-func NewRawOriginSigned(account Address32) RawOrigin {
+func NewRawOriginSigned(account AccountId) RawOrigin {
 	return RawOrigin{sc.NewVaryingData(RawOriginSigned, account)}
 }
 
@@ -27,7 +30,24 @@ func NewRawOriginNone() RawOrigin {
 	return RawOrigin{sc.NewVaryingData(RawOriginNone)}
 }
 
-func RawOriginFrom(a sc.Option[Address32]) RawOrigin {
+func DecodeRawOrigin(buffer *bytes.Buffer) RawOrigin {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case RawOriginRoot:
+		return NewRawOriginRoot()
+	case RawOriginSigned:
+		return NewRawOriginSigned(DecodeAccountId(buffer))
+	case RawOriginNone:
+		return NewRawOriginNone()
+	default:
+		log.Critical("invalid RawOrigin type")
+	}
+
+	panic("unreachable")
+}
+
+func RawOriginFrom(a sc.Option[AccountId]) RawOrigin {
 	if a.HasValue {
 		return NewRawOriginSigned(a.Value)
 	} else {
@@ -47,12 +67,156 @@ func (o RawOrigin) IsNoneOrigin() sc.Bool {
 	return o.VaryingData[0] == RawOriginNone
 }
 
-func (o RawOrigin) AsSigned() Address32 {
+func (o RawOrigin) AsSigned() AccountId {
 	if !o.IsSignedOrigin() {
 		log.Critical("not a signed origin")
 	}
 
-	return o.VaryingData[1].(Address32)
+	return o.VaryingData[1].(AccountId)
 }
 
-type RuntimeOrigin = RawOrigin
+// OriginCallerSystem is the index of the `OriginCaller` variant which holds a system `RawOrigin`.
+// It matches the index of the system module.
+const OriginCallerSystem sc.U8 = 0
+
+// originCallerDecoders holds the decoders of the origins defined by modules, by module index.
+var originCallerDecoders = map[sc.U8]func(buffer *bytes.Buffer) sc.Encodable{}
+
+// RegisterOriginCaller adds a module specific origin to the runtime origin.
+// `decoder` is used to decode the origins whose caller is the module with the given index.
+func RegisterOriginCaller(module sc.U8, decoder func(buffer *bytes.Buffer) sc.Encodable) {
+	if module == OriginCallerSystem {
+		log.Critical("cannot override the system origin")
+	}
+
+	originCallerDecoders[module] = decoder
+}
+
+// CallFilter Returns whether `call` is allowed to be dispatched.
+type CallFilter func(call Call) bool
+
+// RuntimeOrigin The origin of a dispatched call.
+//
+// Its caller is encoded as the runtime's `OriginCaller`, a variant indexed by the module which
+// defines the origin. It holds either a system `RawOrigin` or a module specific origin,
+// such as the members of a collective.
+//
+// Filters added to the origin restrict the calls it is allowed to dispatch.
+type RuntimeOrigin struct {
+	Module  sc.U8
+	Caller  sc.Encodable
+	filters []CallFilter
+}
+
+// NewRuntimeOrigin creates an origin whose caller is defined by the module with the given index.
+func NewRuntimeOrigin(module sc.U8, caller sc.Encodable) RuntimeOrigin {
+	return RuntimeOrigin{
+		Module: module,
+		Caller: caller,
+	}
+}
+
+// NewRuntimeOriginSystem creates an origin from a system `RawOrigin`.
+func NewRuntimeOriginSystem(origin RawOrigin) RuntimeOrigin {
+	return NewRuntimeOrigin(OriginCallerSystem, origin)
+}
+
+func NewRuntimeOriginRoot() RuntimeOrigin {
+	return NewRuntimeOriginSystem(NewRawOriginRoot())
+}
+
+func NewRuntimeOriginSigned(account AccountId) RuntimeOrigin {
+	return NewRuntimeOriginSystem(NewRawOriginSigned(account))
+}
+
+func NewRuntimeOriginNone() RuntimeOrigin {
+	return NewRuntimeOriginSystem(NewRawOriginNone())
+}
+
+// RuntimeOriginFrom creates a signed origin if an account is given, or a none origin otherwise.
+func RuntimeOriginFrom(a sc.Option[AccountId]) RuntimeOrigin {
+	return NewRuntimeOriginSystem(RawOriginFrom(a))
+}
+
+func (o RuntimeOrigin) Encode(buffer *bytes.Buffer) {
+	o.Module.Encode(buffer)
+	o.Caller.Encode(buffer)
+}
+
+func DecodeRuntimeOrigin(buffer *bytes.Buffer) RuntimeOrigin {
+	module := sc.DecodeU8(buffer)
+
+	if module == OriginCallerSystem {
+		return NewRuntimeOriginSystem(DecodeRawOrigin(buffer))
+	}
+
+	decoder, ok := originCallerDecoders[module]
+	if !ok {
+		log.Critical("invalid OriginCaller type")
+	}
+
+	return NewRuntimeOrigin(module, decoder(buffer))
+}
+
+func (o RuntimeOrigin) Bytes() []byte {
+	return sc.EncodedBytes(o)
+}
+
+// IsSystemOrigin returns whether the caller is a system `RawOrigin`.
+func (o RuntimeOrigin) IsSystemOrigin() sc.Bool {
+	return o.Module == OriginCallerSystem
+}
+
+// AsSystem returns the system `RawOrigin` of the caller.
+func (o RuntimeOrigin) AsSystem() RawOrigin {
+	if !o.IsSystemOrigin() {
+		log.Critical("not a system origin")
+	}
+
+	return o.Caller.(RawOrigin)
+}
+
+func (o RuntimeOrigin) IsRootOrigin() sc.Bool {
+	return o.IsSystemOrigin() && o.AsSystem().IsRootOrigin()
+}
+
+func (o RuntimeOrigin) IsSignedOrigin() sc.Bool {
+	return o.IsSystemOrigin() && o.AsSystem().IsSignedOrigin()
+}
+
+func (o RuntimeOrigin) IsNoneOrigin() sc.Bool {
+	return o.IsSystemOrigin() && o.AsSystem().IsNoneOrigin()
+}
+
+func (o RuntimeOrigin) AsSigned() AccountId {
+	if !o.IsSystemOrigin() {
+		log.Critical("not a signed origin")
+	}
+
+	return o.AsSystem().AsSigned()
+}
+
+// AddFilter returns a copy of the origin which is further restricted by `filter`.
+func (o RuntimeOrigin) AddFilter(filter CallFilter) RuntimeOrigin {
+	filters := make([]CallFilter, 0, len(o.filters)+1)
+	filters = append(filters, o.filters...)
+	o.filters = append(filters, filter)
+
+	return o
+}
+
+// FilterCall returns whether `call` passes all filters added to the origin.
+func (o RuntimeOrigin) FilterCall(call Call) bool {
+	for _, filter := range o.filters {
+		if !filter(call) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EnsureOrigin Checks whether an origin is allowed to dispatch a call.
+type EnsureOrigin interface {
+	EnsureOrigin(origin RuntimeOrigin) DispatchError
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EncodeRuntimeOrigin(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		input       RuntimeOrigin
+		expectation []byte
+	}{
+		{
+			label:       "Encode RuntimeOrigin(Root)",
+			input:       NewRuntimeOriginRoot(),
+			expectation: []byte{0x00, 0x00},
+		},
+		{
+			label:       "Encode RuntimeOrigin(None)",
+			input:       NewRuntimeOriginNone(),
+			expectation: []byte{0x00, 0x02},
+		},
+		{
+			label:       "Encode RuntimeOrigin(module origin)",
+			input:       NewRuntimeOrigin(7, sc.U32(1)),
+			expectation: []byte{0x07, 0x01, 0x00, 0x00, 0x00},
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			testExample.input.Encode(buffer)
+
+			assert.Equal(t, testExample.expectation, buffer.Bytes())
+		})
+	}
+}
+
+func Test_DecodeRuntimeOrigin(t *testing.T) {
+	RegisterOriginCaller(7, func(buffer *bytes.Buffer) sc.Encodable {
+		return sc.DecodeU32(buffer)
+	})
+
+	var testExamples = []struct {
+		label       string
+		input       []byte
+		expectation RuntimeOrigin
+	}{
+		{
+			label:       "Decode RuntimeOrigin(Root)",
+			input:       []byte{0x00, 0x00},
+			expectation: NewRuntimeOriginRoot(),
+		},
+		{
+			label:       "Decode RuntimeOrigin(module origin)",
+			input:       []byte{0x07, 0x01, 0x00, 0x00, 0x00},
+			expectation: NewRuntimeOrigin(7, sc.U32(1)),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+			buffer.Write(testExample.input)
+
+			result := DecodeRuntimeOrigin(buffer)
+
+			assert.Equal(t, testExample.expectation, result)
+		})
+	}
+}
+
+func Test_RuntimeOrigin_FilterCall(t *testing.T) {
+	origin := NewRuntimeOriginSigned(NewAddress32(make([]sc.U8, 32)...))
+	assert.True(t, origin.FilterCall(nil))
+
+	filtered := origin.AddFilter(func(call Call) bool { return false })
+
+	assert.False(t, filtered.FilterCall(nil))
+	assert.True(t, origin.FilterCall(nil))
+	assert.Equal(t, sc.Bool(false), NewRuntimeOrigin(7, sc.U32(1)).IsRootOrigin())
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// TaskName The name of a scheduled task, used to cancel or reschedule it.
+type TaskName = sc.FixedSequence[sc.U8]
+
+func NewTaskName(values ...sc.U8) TaskName {
+	return sc.NewFixedSequence[sc.U8](32, values...)
+}
+
+func DecodeTaskName(buffer *bytes.Buffer) TaskName {
+	return sc.DecodeFixedSequence[sc.U8](32, buffer)
+}
+
+// TaskAddress The block number and the index in the agenda at which a task is scheduled.
+type TaskAddress struct {
+	When  BlockNumber
+	Index sc.U32
+}
+
+func (ta TaskAddress) Encode(buffer *bytes.Buffer) {
+	ta.When.Encode(buffer)
+	ta.Index.Encode(buffer)
+}
+
+func DecodeTaskAddress(buffer *bytes.Buffer) TaskAddress {
+	return TaskAddress{
+		When:  sc.DecodeU32(buffer),
+		Index: sc.DecodeU32(buffer),
+	}
+}
+
+func (ta TaskAddress) Bytes() []byte {
+	return sc.EncodedBytes(ta)
+}
+
+// SchedulePeriod A task which is repeated every `Period` blocks, `Count` times in total.
+type SchedulePeriod struct {
+	Period BlockNumber
+	Count  sc.U32
+}
+
+func (sp SchedulePeriod) Encode(buffer *bytes.Buffer) {
+	sp.Period.Encode(buffer)
+	sp.Count.Encode(buffer)
+}
+
+func DecodeSchedulePeriod(buffer *bytes.Buffer) SchedulePeriod {
+	return SchedulePeriod{
+		Period: sc.DecodeU32(buffer),
+		Count:  sc.DecodeU32(buffer),
+	}
+}
+
+func (sp SchedulePeriod) Bytes() []byte {
+	return sc.EncodedBytes(sp)
+}
+
+// Scheduled Information regarding an item to be executed in the future.
+type Scheduled struct {
+	// The unique identity for this task, if there is one.
+	MaybeId sc.Option[TaskName]
+	// This task's priority. Lower values mean higher priority.
+	Priority sc.U8
+	// The call to be dispatched.
+	Call Bounded
+	// If the call is periodic, then this points to the information concerning that.
+	MaybePeriodic sc.Option[SchedulePeriod]
+	// The origin with which to dispatch the call.
+	Origin RuntimeOrigin
+}
+
+func (s Scheduled) Encode(buffer *bytes.Buffer) {
+	s.MaybeId.Encode(buffer)
+	s.Priority.Encode(buffer)
+	s.Call.Encode(buffer)
+	s.MaybePeriodic.Encode(buffer)
+	s.Origin.Encode(buffer)
+}
+
+func DecodeScheduled(buffer *bytes.Buffer) Scheduled {
+	return Scheduled{
+		MaybeId:       sc.DecodeOptionWith(buffer, DecodeTaskName),
+		Priority:      sc.DecodeU8(buffer),
+		Call:          DecodeBounded(buffer),
+		MaybePeriodic: sc.DecodeOptionWith(buffer, DecodeSchedulePeriod),
+		Origin:        DecodeRuntimeOrigin(buffer),
+	}
+}
+
+func (s Scheduled) Bytes() []byte {
+	return sc.EncodedBytes(s)
+}
@@ -5,6 +5,7 @@ import (
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/primitives/crypto"
+	"github.com/LimeChain/gosemble/primitives/hashing"
 )
 
 type Ed25519 struct {
@@ -15,10 +16,14 @@ func NewEd25519(values ...sc.U8) Ed25519 {
 	return Ed25519{NewH512(values...)}
 }
 
-func (s Ed25519) Verify(msg sc.Sequence[sc.U8], signer Address32) sc.Bool {
+func (s Ed25519) Verify(msg sc.Sequence[sc.U8], signer PublicKey) sc.Bool {
+	if len(signer) != 32 {
+		return false
+	}
+
 	sig := sc.FixedSequenceU8ToBytes(s.H512.FixedSequence)
 	message := sc.SequenceU8ToBytes(msg)
-	key := sc.FixedSequenceU8ToBytes(signer.FixedSequence)
+	key := sc.FixedSequenceU8ToBytes(signer)
 	return sc.Bool(crypto.ExtCryptoEd25519VerifyVersion1(sig, message, key))
 }
 
@@ -44,10 +49,14 @@ func NewSr25519(values ...sc.U8) Sr25519 {
 	return Sr25519{NewH512(values...)}
 }
 
-func (s Sr25519) Verify(msg sc.Sequence[sc.U8], signer Address32) sc.Bool {
+func (s Sr25519) Verify(msg sc.Sequence[sc.U8], signer PublicKey) sc.Bool {
+	if len(signer) != 32 {
+		return false
+	}
+
 	sig := sc.FixedSequenceU8ToBytes(s.H512.FixedSequence)
 	message := sc.SequenceU8ToBytes(msg)
-	key := sc.FixedSequenceU8ToBytes(signer.FixedSequence)
+	key := sc.FixedSequenceU8ToBytes(signer)
 	return sc.Bool(crypto.ExtCryptoSr25519VerifyVersion2(sig, message, key))
 }
 
@@ -73,9 +82,19 @@ func NewEcdsa(values ...sc.U8) Ecdsa {
 	return Ecdsa{sc.NewFixedSequence(65, values...)}
 }
 
-func (s Ecdsa) Verify(msg sc.Sequence[sc.U8], signer Address32) sc.Bool {
-	// TODO:
-	return true
+// Verify checks that the signer of the blake2 256 hash of `msg` is the account `signer`, which is
+// the blake2 256 hash of the compressed public key.
+func (s Ecdsa) Verify(msg sc.Sequence[sc.U8], signer AccountId) sc.Bool {
+	hash := hashing.Blake256(sc.SequenceU8ToBytes(msg))
+
+	publicKey := crypto.ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2(sc.FixedSequenceU8ToBytes(s.FixedSequence), hash)
+	if !publicKey.HasValue {
+		return false
+	}
+
+	account := hashing.Blake256(sc.FixedSequenceU8ToBytes(publicKey.Value))
+
+	return sc.Bool(bytes.Equal(account, sc.FixedSequenceU8ToBytes(signer.FixedSequence)))
 }
 
 func (s Ecdsa) Encode(buffer *bytes.Buffer) {
@@ -91,3 +110,48 @@ func DecodeEcdsa(buffer *bytes.Buffer) Ecdsa {
 func (s Ecdsa) Bytes() []byte {
 	return sc.EncodedBytes(s)
 }
+
+// EthereumSignature is a 65 byte recoverable secp256k1 signature of the keccak 256 hash of a
+// message, as produced by Ethereum wallets.
+type EthereumSignature struct {
+	sc.FixedSequence[sc.U8] // size 65
+}
+
+func NewEthereumSignature(values ...sc.U8) EthereumSignature {
+	return EthereumSignature{sc.NewFixedSequence(65, values...)}
+}
+
+// Verify checks that the signer of the keccak 256 hash of `msg` is the account `signer`, which is
+// the last 20 bytes of the keccak 256 hash of the uncompressed public key.
+func (s EthereumSignature) Verify(msg sc.Sequence[sc.U8], signer AccountId) sc.Bool {
+	hash := hashing.Keccak256(sc.SequenceU8ToBytes(msg))
+
+	publicKey := crypto.ExtCryptoSecp256k1EcdsaRecoverVersion2(sc.FixedSequenceU8ToBytes(s.FixedSequence), hash)
+	if !publicKey.HasValue {
+		return false
+	}
+
+	address := EthereumAddress(sc.FixedSequenceU8ToBytes(publicKey.Value))
+
+	return sc.Bool(bytes.Equal(address, sc.FixedSequenceU8ToBytes(signer.FixedSequence)))
+}
+
+func (s EthereumSignature) Encode(buffer *bytes.Buffer) {
+	s.FixedSequence.Encode(buffer)
+}
+
+func DecodeEthereumSignature(buffer *bytes.Buffer) EthereumSignature {
+	s := EthereumSignature{}
+	s.FixedSequence = sc.DecodeFixedSequence[sc.U8](65, buffer)
+	return s
+}
+
+func (s EthereumSignature) Bytes() []byte {
+	return sc.EncodedBytes(s)
+}
+
+// EthereumAddress returns the 20 byte Ethereum address of a 64 byte uncompressed secp256k1 public key,
+// without the 0x04 prefix.
+func EthereumAddress(publicKey []byte) []byte {
+	return hashing.Keccak256(publicKey)[12:]
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"encoding/hex"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// Known vectors for the message "gosemble", signed with the secp256k1 secret keys 0x..01 and
+// 0x..02 and the ed25519 seed 0x0101..01.
+var (
+	signatureMessage = sc.BytesToSequenceU8([]byte("gosemble"))
+
+	ecdsaSignatureKey1 = "2634829bbd8a4cdd7fd84d506703d019e49f31ee9d55ca9c7bb22af3413d07bc63ff0589bf73db73a046df60113cfeb11e89aa79271ef9106eeb655670f7cb1f01"
+	ecdsaAccountKey1   = "2975f1d28b92b6e84499b83b0797ef5235553eeb7edaa0cea243c1128c2fe737"
+	ecdsaAccountKey2   = "c08897cdf88a1d9a12667b5bebcf74c06d02e95d38fb5ae44d5fc0f41699cd01"
+
+	ethereumSignatureKey1 = "687a9abc52a7c31e83255d2db95b8f949d5d8f98411fb59e231574b9bd343a59180f06340bf9ab9a953334ba7095bb4157f787709d817959972eb3240f15645c01"
+	ethereumAddressKey1   = "7e5f4552091a69125d5dfcb7b8c2659029395bdf"
+	ethereumAddressKey2   = "2b5ad5c4795c026514f8317c7a215e218dccd6cf"
+
+	ed25519Signature = "8581223e800d779a0a2e5c13f98fa612a73710ada9d0884e792e0df6fa93500b08457dbb481db58342691bd4a85935dd4bee9056f080681b6c07eb4246f1760a"
+	ed25519PublicKey = "8a88e3dd7409f195fd52db2d3cba5d72ca6709bf1d94121bf3748801b40f6f5c"
+)
+
+func hexToSequenceU8(value string) sc.Sequence[sc.U8] {
+	b, err := hex.DecodeString(value)
+	if err != nil {
+		panic(err)
+	}
+	return sc.BytesToSequenceU8(b)
+}
+
+// withRecoveryId returns a copy of a 65 byte signature with the recovery id `v`.
+func withRecoveryId(signature string, v sc.U8) sc.Sequence[sc.U8] {
+	s := hexToSequenceU8(signature)
+	s[64] = v
+	return s
+}
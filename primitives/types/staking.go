@@ -0,0 +1,386 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+const (
+	// RewardDestinationStaked Pay into the stash account, increasing the amount at stake accordingly.
+	RewardDestinationStaked sc.U8 = iota
+	// RewardDestinationStash Pay into the stash account, not increasing the amount at stake.
+	RewardDestinationStash
+	// RewardDestinationController Pay into the controller account. Stashes are their own controllers,
+	// so this is the same as paying into the stash account.
+	RewardDestinationController
+	// RewardDestinationAccount Pay into a specified account.
+	RewardDestinationAccount
+	// RewardDestinationNone Receive no reward.
+	RewardDestinationNone
+)
+
+// RewardDestination A destination account for payment.
+type RewardDestination struct {
+	Variant sc.U8
+	Account AccountId
+}
+
+func NewRewardDestination(variant sc.U8) RewardDestination {
+	return RewardDestination{Variant: variant}
+}
+
+func NewRewardDestinationAccount(account AccountId) RewardDestination {
+	return RewardDestination{Variant: RewardDestinationAccount, Account: account}
+}
+
+func (rd RewardDestination) Encode(buffer *bytes.Buffer) {
+	rd.Variant.Encode(buffer)
+	if rd.Variant == RewardDestinationAccount {
+		rd.Account.Encode(buffer)
+	}
+}
+
+func DecodeRewardDestination(buffer *bytes.Buffer) RewardDestination {
+	variant := sc.DecodeU8(buffer)
+
+	switch variant {
+	case RewardDestinationStaked, RewardDestinationStash, RewardDestinationController, RewardDestinationNone:
+		return NewRewardDestination(variant)
+	case RewardDestinationAccount:
+		return NewRewardDestinationAccount(DecodeAccountId(buffer))
+	default:
+		log.Critical("invalid RewardDestination type")
+	}
+
+	panic("unreachable")
+}
+
+func (rd RewardDestination) Bytes() []byte {
+	return sc.EncodedBytes(rd)
+}
+
+// UnlockChunk Funds that are unbonded and become withdrawable at the start of `Era`.
+type UnlockChunk struct {
+	Value Balance
+	Era   sc.U32
+}
+
+func (uc UnlockChunk) Encode(buffer *bytes.Buffer) {
+	sc.ToCompact(uc.Value).Encode(buffer)
+	sc.ToCompact(uc.Era).Encode(buffer)
+}
+
+func DecodeUnlockChunk(buffer *bytes.Buffer) UnlockChunk {
+	return UnlockChunk{
+		Value: sc.U128(sc.DecodeCompact(buffer)),
+		Era:   sc.U32(sc.U128(sc.DecodeCompact(buffer)).ToBigInt().Uint64()),
+	}
+}
+
+func (uc UnlockChunk) Bytes() []byte {
+	return sc.EncodedBytes(uc)
+}
+
+// StakingLedger The ledger of a bonded stash.
+type StakingLedger struct {
+	// The stash account whose balance is actually locked and at stake.
+	Stash AccountId
+	// The total amount of the stash's balance that is locked, including the unbonding funds.
+	Total Balance
+	// The amount of the stash's balance that is at stake in any forthcoming era.
+	Active Balance
+	// The funds that are unbonded, but still locked until their era is reached.
+	Unlocking sc.Sequence[UnlockChunk]
+	// The eras for which the rewards of the stash were claimed.
+	ClaimedRewards sc.Sequence[sc.U32]
+}
+
+func (sl StakingLedger) Encode(buffer *bytes.Buffer) {
+	sl.Stash.Encode(buffer)
+	sc.ToCompact(sl.Total).Encode(buffer)
+	sc.ToCompact(sl.Active).Encode(buffer)
+	sl.Unlocking.Encode(buffer)
+	sl.ClaimedRewards.Encode(buffer)
+}
+
+func DecodeStakingLedger(buffer *bytes.Buffer) StakingLedger {
+	return StakingLedger{
+		Stash:          DecodeAccountId(buffer),
+		Total:          sc.U128(sc.DecodeCompact(buffer)),
+		Active:         sc.U128(sc.DecodeCompact(buffer)),
+		Unlocking:      sc.DecodeSequenceWith(buffer, DecodeUnlockChunk),
+		ClaimedRewards: sc.DecodeSequence[sc.U32](buffer),
+	}
+}
+
+func (sl StakingLedger) Bytes() []byte {
+	return sc.EncodedBytes(sl)
+}
+
+// ValidatorPrefs The preferences of a validator.
+type ValidatorPrefs struct {
+	// The fraction of the rewards that the validator takes before they are shared with its nominators.
+	Commission Permill
+	// Whether the validator is blocked from further nominations.
+	Blocked sc.Bool
+}
+
+func (vp ValidatorPrefs) Encode(buffer *bytes.Buffer) {
+	sc.ToCompact(vp.Commission.Parts).Encode(buffer)
+	vp.Blocked.Encode(buffer)
+}
+
+func DecodeValidatorPrefs(buffer *bytes.Buffer) ValidatorPrefs {
+	return ValidatorPrefs{
+		Commission: Permill{Parts: sc.U32(sc.U128(sc.DecodeCompact(buffer)).ToBigInt().Uint64())},
+		Blocked:    sc.DecodeBool(buffer),
+	}
+}
+
+func (vp ValidatorPrefs) Bytes() []byte {
+	return sc.EncodedBytes(vp)
+}
+
+// Nominations The validators a nominator backs.
+type Nominations struct {
+	// The validators whose stake is backed.
+	Targets sc.Sequence[AccountId]
+	// The era in which the nominations were submitted.
+	SubmittedIn sc.U32
+	// Whether the nominations were suppressed by a slash.
+	Suppressed sc.Bool
+}
+
+func (n Nominations) Encode(buffer *bytes.Buffer) {
+	n.Targets.Encode(buffer)
+	n.SubmittedIn.Encode(buffer)
+	n.Suppressed.Encode(buffer)
+}
+
+func DecodeNominations(buffer *bytes.Buffer) Nominations {
+	return Nominations{
+		Targets:     sc.DecodeSequenceWith(buffer, DecodeAccountId),
+		SubmittedIn: sc.DecodeU32(buffer),
+		Suppressed:  sc.DecodeBool(buffer),
+	}
+}
+
+func (n Nominations) Bytes() []byte {
+	return sc.EncodedBytes(n)
+}
+
+// ActiveEraInfo The index of the active era and the timestamp at which it started.
+type ActiveEraInfo struct {
+	Index sc.U32
+	// The start of the era in milliseconds. It is set in the `on_finalize` of the era's first block.
+	Start sc.Option[sc.U64]
+}
+
+func (aei ActiveEraInfo) Encode(buffer *bytes.Buffer) {
+	aei.Index.Encode(buffer)
+	aei.Start.Encode(buffer)
+}
+
+func DecodeActiveEraInfo(buffer *bytes.Buffer) ActiveEraInfo {
+	return ActiveEraInfo{
+		Index: sc.DecodeU32(buffer),
+		Start: sc.DecodeOption[sc.U64](buffer),
+	}
+}
+
+func (aei ActiveEraInfo) Bytes() []byte {
+	return sc.EncodedBytes(aei)
+}
+
+// IndividualExposure The stake of a nominator exposed to a validator.
+type IndividualExposure struct {
+	Who   AccountId
+	Value Balance
+}
+
+func (ie IndividualExposure) Encode(buffer *bytes.Buffer) {
+	ie.Who.Encode(buffer)
+	sc.ToCompact(ie.Value).Encode(buffer)
+}
+
+func DecodeIndividualExposure(buffer *bytes.Buffer) IndividualExposure {
+	return IndividualExposure{
+		Who:   DecodeAccountId(buffer),
+		Value: sc.U128(sc.DecodeCompact(buffer)),
+	}
+}
+
+func (ie IndividualExposure) Bytes() []byte {
+	return sc.EncodedBytes(ie)
+}
+
+// Exposure The stake backing an elected validator.
+type Exposure struct {
+	// The total stake backing the validator.
+	Total Balance
+	// The validator's own stake.
+	Own Balance
+	// The stake of the nominators backing the validator.
+	Others sc.Sequence[IndividualExposure]
+}
+
+func (e Exposure) Encode(buffer *bytes.Buffer) {
+	sc.ToCompact(e.Total).Encode(buffer)
+	sc.ToCompact(e.Own).Encode(buffer)
+	e.Others.Encode(buffer)
+}
+
+func DecodeExposure(buffer *bytes.Buffer) Exposure {
+	return Exposure{
+		Total:  sc.U128(sc.DecodeCompact(buffer)),
+		Own:    sc.U128(sc.DecodeCompact(buffer)),
+		Others: sc.DecodeSequenceWith(buffer, DecodeIndividualExposure),
+	}
+}
+
+func (e Exposure) Bytes() []byte {
+	return sc.EncodedBytes(e)
+}
+
+// ValidatorRewardPoints The reward points earned by a validator in an era.
+type ValidatorRewardPoints struct {
+	Validator AccountId
+	Points    sc.U32
+}
+
+func (vrp ValidatorRewardPoints) Encode(buffer *bytes.Buffer) {
+	vrp.Validator.Encode(buffer)
+	vrp.Points.Encode(buffer)
+}
+
+func DecodeValidatorRewardPoints(buffer *bytes.Buffer) ValidatorRewardPoints {
+	return ValidatorRewardPoints{
+		Validator: DecodeAccountId(buffer),
+		Points:    sc.DecodeU32(buffer),
+	}
+}
+
+func (vrp ValidatorRewardPoints) Bytes() []byte {
+	return sc.EncodedBytes(vrp)
+}
+
+// EraRewardPoints The reward points of an era. The era payout is split between the validators
+// in proportion to their points.
+type EraRewardPoints struct {
+	// The total number of points.
+	Total sc.U32
+	// The points of each validator, sorted by account.
+	Individual sc.Sequence[ValidatorRewardPoints]
+}
+
+func (erp EraRewardPoints) Encode(buffer *bytes.Buffer) {
+	erp.Total.Encode(buffer)
+	erp.Individual.Encode(buffer)
+}
+
+func DecodeEraRewardPoints(buffer *bytes.Buffer) EraRewardPoints {
+	return EraRewardPoints{
+		Total:      sc.DecodeU32(buffer),
+		Individual: sc.DecodeSequenceWith(buffer, DecodeValidatorRewardPoints),
+	}
+}
+
+func (erp EraRewardPoints) Bytes() []byte {
+	return sc.EncodedBytes(erp)
+}
+
+// Forcing The mode of era forcing.
+type Forcing = sc.U8
+
+const (
+	// ForcingNotForcing Not forcing anything, eras start after `SessionsPerEra` sessions.
+	ForcingNotForcing Forcing = iota
+	// ForcingForceNew Force a new era on the next session, then reset to `ForcingNotForcing`.
+	ForcingForceNew
+	// ForcingForceNone Avoid a new era indefinitely.
+	ForcingForceNone
+	// ForcingForceAlways Force a new era at the end of every session.
+	ForcingForceAlways
+)
+
+// StakingSlash An amount slashed from an account.
+type StakingSlash struct {
+	Who   AccountId
+	Value Balance
+}
+
+func (ss StakingSlash) Encode(buffer *bytes.Buffer) {
+	ss.Who.Encode(buffer)
+	ss.Value.Encode(buffer)
+}
+
+func DecodeStakingSlash(buffer *bytes.Buffer) StakingSlash {
+	return StakingSlash{
+		Who:   DecodeAccountId(buffer),
+		Value: sc.DecodeU128(buffer),
+	}
+}
+
+func (ss StakingSlash) Bytes() []byte {
+	return sc.EncodedBytes(ss)
+}
+
+// UnappliedSlash A slash of a validator and its nominators, waiting to be applied.
+type UnappliedSlash struct {
+	// The slashed validator.
+	Validator AccountId
+	// The amount slashed from the validator's own stake.
+	Own Balance
+	// The amounts slashed from the validator's nominators.
+	Others sc.Sequence[StakingSlash]
+	// The accounts that reported the offence.
+	Reporters sc.Sequence[AccountId]
+	// The amount paid out to the reporters.
+	Payout Balance
+}
+
+func (us UnappliedSlash) Encode(buffer *bytes.Buffer) {
+	us.Validator.Encode(buffer)
+	us.Own.Encode(buffer)
+	us.Others.Encode(buffer)
+	us.Reporters.Encode(buffer)
+	us.Payout.Encode(buffer)
+}
+
+func DecodeUnappliedSlash(buffer *bytes.Buffer) UnappliedSlash {
+	return UnappliedSlash{
+		Validator: DecodeAccountId(buffer),
+		Own:       sc.DecodeU128(buffer),
+		Others:    sc.DecodeSequenceWith(buffer, DecodeStakingSlash),
+		Reporters: sc.DecodeSequenceWith(buffer, DecodeAccountId),
+		Payout:    sc.DecodeU128(buffer),
+	}
+}
+
+func (us UnappliedSlash) Bytes() []byte {
+	return sc.EncodedBytes(us)
+}
+
+// BondedEra An era that is still within the bonding duration and the session it started at.
+type BondedEra struct {
+	Era          sc.U32
+	StartSession sc.U32
+}
+
+func (be BondedEra) Encode(buffer *bytes.Buffer) {
+	be.Era.Encode(buffer)
+	be.StartSession.Encode(buffer)
+}
+
+func DecodeBondedEra(buffer *bytes.Buffer) BondedEra {
+	return BondedEra{
+		Era:          sc.DecodeU32(buffer),
+		StartSession: sc.DecodeU32(buffer),
+	}
+}
+
+func (be BondedEra) Bytes() []byte {
+	return sc.EncodedBytes(be)
+}
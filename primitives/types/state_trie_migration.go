@@ -0,0 +1,178 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+// MigrationLimits are the limits of migrating the state trie in a block, or in a call.
+type MigrationLimits struct {
+	// The maximum total size of the values migrated.
+	Size sc.U32
+	// The maximum number of keys migrated.
+	Item sc.U32
+}
+
+func (ml MigrationLimits) Encode(buffer *bytes.Buffer) {
+	ml.Size.Encode(buffer)
+	ml.Item.Encode(buffer)
+}
+
+func DecodeMigrationLimits(buffer *bytes.Buffer) MigrationLimits {
+	return MigrationLimits{
+		Size: sc.DecodeU32(buffer),
+		Item: sc.DecodeU32(buffer),
+	}
+}
+
+func (ml MigrationLimits) Bytes() []byte {
+	return sc.EncodedBytes(ml)
+}
+
+const (
+	MigrationProgressToStart sc.U8 = iota
+	MigrationProgressLastKey
+	MigrationProgressComplete
+)
+
+// MigrationProgress is the progress of migrating the keys of a trie.
+type MigrationProgress struct {
+	sc.VaryingData
+}
+
+// NewMigrationProgressToStart No key of the trie has been migrated.
+func NewMigrationProgressToStart() MigrationProgress {
+	return MigrationProgress{sc.NewVaryingData(MigrationProgressToStart)}
+}
+
+// NewMigrationProgressLastKey The keys of the trie up to and including `key` have been migrated.
+func NewMigrationProgressLastKey(key sc.Sequence[sc.U8]) MigrationProgress {
+	return MigrationProgress{sc.NewVaryingData(MigrationProgressLastKey, key)}
+}
+
+// NewMigrationProgressComplete All keys of the trie have been migrated.
+func NewMigrationProgressComplete() MigrationProgress {
+	return MigrationProgress{sc.NewVaryingData(MigrationProgressComplete)}
+}
+
+func DecodeMigrationProgress(buffer *bytes.Buffer) MigrationProgress {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case MigrationProgressToStart:
+		return NewMigrationProgressToStart()
+	case MigrationProgressLastKey:
+		return NewMigrationProgressLastKey(sc.DecodeSequence[sc.U8](buffer))
+	case MigrationProgressComplete:
+		return NewMigrationProgressComplete()
+	default:
+		log.Critical("invalid MigrationProgress type")
+	}
+
+	panic("unreachable")
+}
+
+func (mp MigrationProgress) IsToStart() bool {
+	return mp.VaryingData[0] == MigrationProgressToStart
+}
+
+func (mp MigrationProgress) IsLastKey() bool {
+	return mp.VaryingData[0] == MigrationProgressLastKey
+}
+
+func (mp MigrationProgress) IsComplete() bool {
+	return mp.VaryingData[0] == MigrationProgressComplete
+}
+
+func (mp MigrationProgress) AsLastKey() sc.Sequence[sc.U8] {
+	if !mp.IsLastKey() {
+		log.Critical("not a LastKey MigrationProgress type")
+	}
+
+	return mp.VaryingData[1].(sc.Sequence[sc.U8])
+}
+
+// MigrationTask is the state of the migration of the state trie to the current state version.
+type MigrationTask struct {
+	// The progress of migrating the top trie.
+	ProgressTop MigrationProgress
+	// The progress of migrating the child trie whose root is at the last key of the top trie.
+	ProgressChild MigrationProgress
+	// The total size of the values migrated.
+	Size sc.U32
+	// The number of keys of the top trie migrated.
+	TopItems sc.U32
+	// The number of keys of the child tries migrated.
+	ChildItems sc.U32
+}
+
+// NewMigrationTask returns the state of a migration which has not started.
+func NewMigrationTask() MigrationTask {
+	return MigrationTask{
+		ProgressTop:   NewMigrationProgressToStart(),
+		ProgressChild: NewMigrationProgressToStart(),
+	}
+}
+
+func (mt MigrationTask) Encode(buffer *bytes.Buffer) {
+	mt.ProgressTop.Encode(buffer)
+	mt.ProgressChild.Encode(buffer)
+	mt.Size.Encode(buffer)
+	mt.TopItems.Encode(buffer)
+	mt.ChildItems.Encode(buffer)
+}
+
+func DecodeMigrationTask(buffer *bytes.Buffer) MigrationTask {
+	return MigrationTask{
+		ProgressTop:   DecodeMigrationProgress(buffer),
+		ProgressChild: DecodeMigrationProgress(buffer),
+		Size:          sc.DecodeU32(buffer),
+		TopItems:      sc.DecodeU32(buffer),
+		ChildItems:    sc.DecodeU32(buffer),
+	}
+}
+
+func (mt MigrationTask) Bytes() []byte {
+	return sc.EncodedBytes(mt)
+}
+
+// Finished returns whether all keys of the state trie have been migrated.
+func (mt MigrationTask) Finished() bool {
+	return mt.ProgressTop.IsComplete()
+}
+
+const (
+	// MigrationComputeSigned The keys were migrated by a signed call.
+	MigrationComputeSigned sc.U8 = iota
+
+	// MigrationComputeAuto The keys were migrated automatically at the start of a block.
+	MigrationComputeAuto
+)
+
+// MigrationCompute tells how keys of the state trie were migrated.
+type MigrationCompute = sc.VaryingData
+
+func NewMigrationComputeSigned() MigrationCompute {
+	return sc.NewVaryingData(MigrationComputeSigned)
+}
+
+func NewMigrationComputeAuto() MigrationCompute {
+	return sc.NewVaryingData(MigrationComputeAuto)
+}
+
+func DecodeMigrationCompute(buffer *bytes.Buffer) MigrationCompute {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case MigrationComputeSigned:
+		return NewMigrationComputeSigned()
+	case MigrationComputeAuto:
+		return NewMigrationComputeAuto()
+	default:
+		log.Critical("invalid MigrationCompute type")
+	}
+
+	panic("unreachable")
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EncodeMigrationTask(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		input       MigrationTask
+		expectation []byte
+	}{
+		{
+			label:       "Encode MigrationTask(ToStart, ToStart)",
+			input:       NewMigrationTask(),
+			expectation: []byte{0x00, 0x00, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			label: "Encode MigrationTask(LastKey, Complete)",
+			input: MigrationTask{
+				ProgressTop:   NewMigrationProgressLastKey(sc.Sequence[sc.U8]{0xaa, 0xbb}),
+				ProgressChild: NewMigrationProgressComplete(),
+				Size:          300,
+				TopItems:      2,
+				ChildItems:    1,
+			},
+			expectation: []byte{0x01, 0x08, 0xaa, 0xbb, 0x02, 0x2c, 0x01, 0, 0, 2, 0, 0, 0, 1, 0, 0, 0},
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			testExample.input.Encode(buffer)
+
+			assert.Equal(t, testExample.expectation, buffer.Bytes())
+		})
+	}
+}
+
+func Test_DecodeMigrationTask(t *testing.T) {
+	task := MigrationTask{
+		ProgressTop:   NewMigrationProgressLastKey(sc.Sequence[sc.U8]{0xaa, 0xbb}),
+		ProgressChild: NewMigrationProgressLastKey(sc.Sequence[sc.U8]{0xcc}),
+		Size:          300,
+		TopItems:      2,
+		ChildItems:    1,
+	}
+
+	result := DecodeMigrationTask(bytes.NewBuffer(task.Bytes()))
+
+	assert.Equal(t, task, result)
+	assert.Equal(t, sc.Sequence[sc.U8]{0xaa, 0xbb}, result.ProgressTop.AsLastKey())
+	assert.False(t, result.Finished())
+}
+
+func Test_MigrationTask_Finished(t *testing.T) {
+	task := NewMigrationTask()
+	assert.False(t, task.Finished())
+
+	task.ProgressTop = NewMigrationProgressComplete()
+	assert.True(t, task.Finished())
+}
+
+func Test_EncodeMigrationLimits(t *testing.T) {
+	limits := MigrationLimits{Size: 1024, Item: 10}
+
+	assert.Equal(t, []byte{0x00, 0x04, 0, 0, 10, 0, 0, 0}, limits.Bytes())
+	assert.Equal(t, limits, DecodeMigrationLimits(bytes.NewBuffer(limits.Bytes())))
+}
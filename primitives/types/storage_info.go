@@ -0,0 +1,79 @@
+package types
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+)
+
+// WorstCaseMapValues is the number of values assumed to be stored in a map without a bound on its
+// number of values, when estimating the depth of its values in the trie.
+const WorstCaseMapValues sc.U32 = 1_000_000
+
+// storageProofLayerSize is the size of a layer of the trie in a storage proof: the 15 sibling
+// hashes of a branch node, each with its prefix.
+const storageProofLayerSize sc.U64 = 15 * 33
+
+// StorageInfo describes a storage item of a module with the maximum encoded length of its keys
+// and values, from which the size of the proof of accessing the item is estimated.
+type StorageInfo struct {
+	Module sc.Str
+	Name   sc.Str
+	// Prefix is the prefix of the storage keys of the item, Twox128(module) ++ Twox128(name).
+	Prefix []byte
+	// MaxValues is the maximum number of values of the item, if bounded. Plain items have a single value.
+	MaxValues sc.Option[sc.U32]
+	// MaxSize is the maximum encoded length of the key, without the prefix, and the value of an entry of the item.
+	MaxSize sc.U32
+}
+
+// NewStorageValueInfo returns the info of the plain storage item `name` of `module`, whose value
+// has a maximum encoded length of `maxSize`.
+func NewStorageValueInfo(module string, name string, maxSize sc.U32) StorageInfo {
+	return NewStorageMapInfo(module, name, sc.NewOption[sc.U32](sc.U32(1)), maxSize)
+}
+
+// NewStorageMapInfo returns the info of the storage map `name` of `module`, with at most `maxValues`
+// entries if bounded. `maxSize` is the maximum encoded length of the hashed key and the value of an entry.
+func NewStorageMapInfo(module string, name string, maxValues sc.Option[sc.U32], maxSize sc.U32) StorageInfo {
+	return StorageInfo{
+		Module:    sc.Str(module),
+		Name:      sc.Str(name),
+		Prefix:    append(hashing.Twox128([]byte(module)), hashing.Twox128([]byte(name))...),
+		MaxValues: maxValues,
+		MaxSize:   maxSize,
+	}
+}
+
+// ProofSize returns the worst-case size of the proof of accessing an entry of the item.
+func (si StorageInfo) ProofSize() sc.U64 {
+	return StorageProofSize(si.MaxValues, si.MaxSize)
+}
+
+// StorageProofSize returns the worst-case size of the proof of accessing a value of `maxSize` bytes
+// of a storage item with at most `maxValues` values. The proof contains the value and a layer of
+// the trie for each nibble needed to tell apart the values of the item, at least one.
+// Items without a bound on their values are assumed to have WorstCaseMapValues values.
+func StorageProofSize(maxValues sc.Option[sc.U32], maxSize sc.U32) sc.U64 {
+	values := WorstCaseMapValues
+	if maxValues.HasValue {
+		values = maxValues.Value
+	}
+
+	layers := sc.U64(1)
+	for capacity := sc.U64(16); capacity < sc.U64(values); capacity *= 16 {
+		layers++
+	}
+
+	return sc.U64(maxSize).SaturatingAdd(storageProofLayerSize.SaturatingMul(layers))
+}
+
+// ProofWeight returns the weight of the worst-case proof of accessing an entry of each of `items`.
+// An item accessed at several keys is listed once per key.
+func ProofWeight(items ...StorageInfo) Weight {
+	size := sc.U64(0)
+	for _, item := range items {
+		size = size.SaturatingAdd(item.ProofSize())
+	}
+
+	return WeightFromParts(0, size)
+}
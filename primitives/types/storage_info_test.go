@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StorageProofSize_Value(t *testing.T) {
+	assert.Equal(t, sc.U64(503), StorageProofSize(sc.NewOption[sc.U32](sc.U32(1)), 8))
+}
+
+func Test_StorageProofSize_BoundedMap(t *testing.T) {
+	assert.Equal(t, sc.U64(32+495), StorageProofSize(sc.NewOption[sc.U32](sc.U32(16)), 32))
+	assert.Equal(t, sc.U64(32+2*495), StorageProofSize(sc.NewOption[sc.U32](sc.U32(17)), 32))
+}
+
+func Test_StorageProofSize_UnboundedMap(t *testing.T) {
+	assert.Equal(t, sc.U64(2603), StorageProofSize(sc.NewOption[sc.U32](nil), 128))
+}
+
+func Test_StorageInfo_ProofSize(t *testing.T) {
+	info := StorageInfo{MaxValues: sc.NewOption[sc.U32](nil), MaxSize: 128}
+
+	assert.Equal(t, sc.U64(2603), info.ProofSize())
+}
+
+func Test_ProofWeight(t *testing.T) {
+	value := StorageInfo{MaxValues: sc.NewOption[sc.U32](sc.U32(1)), MaxSize: 8}
+	entry := StorageInfo{MaxValues: sc.NewOption[sc.U32](nil), MaxSize: 128}
+
+	assert.Equal(t, WeightFromParts(0, 503+2*2603), ProofWeight(value, entry, entry))
+	assert.Equal(t, WeightZero(), ProofWeight())
+}
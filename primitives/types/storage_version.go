@@ -0,0 +1,23 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// StorageVersion is the version of the storage layout of a module. It is incremented by the
+// migrations which change the layout.
+type StorageVersion sc.U16
+
+func (sv StorageVersion) Encode(buffer *bytes.Buffer) {
+	sc.U16(sv).Encode(buffer)
+}
+
+func (sv StorageVersion) Bytes() []byte {
+	return sc.EncodedBytes(sv)
+}
+
+func DecodeStorageVersion(buffer *bytes.Buffer) StorageVersion {
+	return StorageVersion(sc.DecodeU16(buffer))
+}
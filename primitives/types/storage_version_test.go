@@ -0,0 +1,18 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StorageVersion_Encode(t *testing.T) {
+	assert.Equal(t, []byte{0x02, 0x01}, StorageVersion(258).Bytes())
+}
+
+func Test_DecodeStorageVersion(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{0x02, 0x01})
+
+	assert.Equal(t, StorageVersion(258), DecodeStorageVersion(buffer))
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// Tally Info regarding an ongoing referendum.
+type Tally struct {
+	// The number of aye votes, expressed in terms of post-conviction lock-vote.
+	Ayes Balance
+	// The number of nay votes, expressed in terms of post-conviction lock-vote.
+	Nays Balance
+	// The amount of funds currently expressing its opinion. Pre-conviction.
+	Turnout Balance
+}
+
+func NewTally() Tally {
+	return Tally{
+		Ayes:    sc.NewU128FromUint64(0),
+		Nays:    sc.NewU128FromUint64(0),
+		Turnout: sc.NewU128FromUint64(0),
+	}
+}
+
+func (t Tally) Encode(buffer *bytes.Buffer) {
+	t.Ayes.Encode(buffer)
+	t.Nays.Encode(buffer)
+	t.Turnout.Encode(buffer)
+}
+
+func DecodeTally(buffer *bytes.Buffer) Tally {
+	return Tally{
+		Ayes:    sc.DecodeU128(buffer),
+		Nays:    sc.DecodeU128(buffer),
+		Turnout: sc.DecodeU128(buffer),
+	}
+}
+
+func (t Tally) Bytes() []byte {
+	return sc.EncodedBytes(t)
+}
+
+// Add adds an account's vote into the tally.
+func (t *Tally) Add(vote AccountVote) {
+	if vote.IsSplit {
+		aye := ConvictionVotes(ConvictionNone, vote.Aye)
+		nay := ConvictionVotes(ConvictionNone, vote.Nay)
+		t.Turnout = addBalance(t.Turnout, aye.Capital)
+		t.Turnout = addBalance(t.Turnout, nay.Capital)
+		t.Ayes = addBalance(t.Ayes, aye.Votes)
+		t.Nays = addBalance(t.Nays, nay.Votes)
+		return
+	}
+
+	t.Increase(vote.Vote.Aye, ConvictionVotes(vote.Vote.Conviction, vote.Balance))
+}
+
+// Remove removes an account's vote from the tally.
+func (t *Tally) Remove(vote AccountVote) {
+	if vote.IsSplit {
+		aye := ConvictionVotes(ConvictionNone, vote.Aye)
+		nay := ConvictionVotes(ConvictionNone, vote.Nay)
+		t.Turnout = saturatingSubBalance(t.Turnout, aye.Capital)
+		t.Turnout = saturatingSubBalance(t.Turnout, nay.Capital)
+		t.Ayes = saturatingSubBalance(t.Ayes, aye.Votes)
+		t.Nays = saturatingSubBalance(t.Nays, nay.Votes)
+		return
+	}
+
+	t.Reduce(vote.Vote.Aye, ConvictionVotes(vote.Vote.Conviction, vote.Balance))
+}
+
+// Increase adds delegated votes to the tally.
+func (t *Tally) Increase(approve sc.Bool, delegations Delegations) {
+	t.Turnout = addBalance(t.Turnout, delegations.Capital)
+	if approve {
+		t.Ayes = addBalance(t.Ayes, delegations.Votes)
+	} else {
+		t.Nays = addBalance(t.Nays, delegations.Votes)
+	}
+}
+
+// Reduce removes delegated votes from the tally.
+func (t *Tally) Reduce(approve sc.Bool, delegations Delegations) {
+	t.Turnout = saturatingSubBalance(t.Turnout, delegations.Capital)
+	if approve {
+		t.Ayes = saturatingSubBalance(t.Ayes, delegations.Votes)
+	} else {
+		t.Nays = saturatingSubBalance(t.Nays, delegations.Votes)
+	}
+}
+
+func addBalance(a, b Balance) Balance {
+	return sc.NewU128FromBigInt(new(big.Int).Add(a.ToBigInt(), b.ToBigInt()))
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// TreasuryProposal A spending proposal.
+type TreasuryProposal struct {
+	// The account proposing it.
+	Proposer AccountId
+	// The (total) amount that should be paid if the proposal is accepted.
+	Value Balance
+	// The account to whom the payment should be made if the proposal is accepted.
+	Beneficiary AccountId
+	// The amount held on deposit (reserved) for making this proposal.
+	Bond Balance
+}
+
+func (tp TreasuryProposal) Encode(buffer *bytes.Buffer) {
+	tp.Proposer.Encode(buffer)
+	tp.Value.Encode(buffer)
+	tp.Beneficiary.Encode(buffer)
+	tp.Bond.Encode(buffer)
+}
+
+func DecodeTreasuryProposal(buffer *bytes.Buffer) TreasuryProposal {
+	return TreasuryProposal{
+		Proposer:    DecodeAccountId(buffer),
+		Value:       sc.DecodeU128(buffer),
+		Beneficiary: DecodeAccountId(buffer),
+		Bond:        sc.DecodeU128(buffer),
+	}
+}
+
+func (tp TreasuryProposal) Bytes() []byte {
+	return sc.EncodedBytes(tp)
+}
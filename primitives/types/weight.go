@@ -8,8 +8,8 @@ import (
 
 // RuntimeDbWeight The weight of database operations that the runtime can invoke.
 //
-// NOTE: This is currently only measured in computational time, and will probably
-// be updated all together once proof size is accounted for.
+// NOTE: This is only measured in computational time. The proof size of storage accesses
+// is estimated from the StorageInfo of the accessed items.
 type RuntimeDbWeight struct {
 	Read  sc.U64
 	Write sc.U64
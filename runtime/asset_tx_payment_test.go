@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyAssetsAccountHash, _ = common.Twox128Hash(constants.KeyAccount)
+)
+
+func Test_AssetTxPayment_ChargesFeeInAsset(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	metadata := runtimeMetadata(t, rt)
+
+	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
+	assert.NoError(t, err)
+
+	id := sc.U32(1)
+	alice := primitives.NewAddress32(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...)
+	assetBalance := sc.NewU128FromUint64(1_000_000_000)
+
+	idHash, err := common.Blake2b128(id.Bytes())
+	assert.NoError(t, err)
+	aliceHash, err := common.Blake2b128(signature.TestKeyringPairAlice.PublicKey)
+	assert.NoError(t, err)
+
+	// Set a sufficient asset, held by Alice
+	keyAsset := append(keyAssetsHash, keyAssetHash...)
+	keyAsset = append(keyAsset, idHash...)
+	keyAsset = append(keyAsset, id.Bytes()...)
+
+	details := primitives.AssetDetails{
+		Owner:        alice,
+		Issuer:       alice,
+		Admin:        alice,
+		Freezer:      alice,
+		Supply:       assetBalance,
+		Deposit:      sc.NewU128FromUint64(0),
+		MinBalance:   sc.NewU128FromUint64(1_000_000),
+		IsSufficient: true,
+		Accounts:     1,
+		Sufficients:  1,
+		Status:       primitives.AssetStatusLive,
+	}
+	err = (*storage).Put(keyAsset, details.Bytes())
+	assert.NoError(t, err)
+
+	keyAssetAccount := append(keyAssetsHash, keyAssetsAccountHash...)
+	keyAssetAccount = append(keyAssetAccount, idHash...)
+	keyAssetAccount = append(keyAssetAccount, id.Bytes()...)
+	keyAssetAccount = append(keyAssetAccount, aliceHash...)
+	keyAssetAccount = append(keyAssetAccount, signature.TestKeyringPairAlice.PublicKey...)
+
+	account := primitives.AssetAccount{
+		Balance: assetBalance,
+		Reason:  primitives.ExistenceReasonSufficient,
+	}
+	err = (*storage).Put(keyAssetAccount, account.Bytes())
+	assert.NoError(t, err)
+
+	// Create the extrinsic, paying the fee in the asset
+	ext := newExtrinsic(call)
+	ext.AssetId = ctypes.NewOptionU32(ctypes.U32(id))
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(0),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+
+	// Set Account Info
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	keyStorageAccountAlice, aliceAccountInfo := setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	// Sign the transaction using Alice's default account
+	err = ext.Sign(signature.TestKeyringPairAlice, o)
+	assert.NoError(t, err)
+
+	extEnc := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&extEnc)
+	err = ext.Encode(*encoder)
+	assert.NoError(t, err)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t,
+		primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(),
+		res,
+	)
+
+	// The fee is paid in the asset
+	account = primitives.DecodeAssetAccount(bytes.NewBuffer((*storage).Get(keyAssetAccount)))
+	assert.Equal(t, -1, account.Balance.ToBigInt().Cmp(assetBalance.ToBigInt()))
+
+	// The native balance is left untouched
+	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
+	err = scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(balance), aliceAccountInfo.Data.Free)
+}
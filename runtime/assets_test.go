@@ -0,0 +1,308 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/runtime"
+	"github.com/ChainSafe/gossamer/lib/runtime/wasmer"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyAssetsHash, _ = common.Twox128Hash(constants.KeyAssets)
+	keyAssetHash, _  = common.Twox128Hash(constants.KeyAsset)
+)
+
+var okResult = primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes()
+
+func Test_Assets_Create_Success(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	metadata := runtimeMetadata(t, rt)
+
+	bob, err := ctypes.NewMultiAddressFromAccountID(testKeyringPairBob.PublicKey)
+	assert.NoError(t, err)
+
+	id := sc.U32(1)
+	minBalance := ctypes.NewU128(*big.NewInt(10))
+
+	call, err := ctypes.NewCall(metadata, "Assets.create", ctypes.NewUCompactFromUInt(uint64(id)), bob, minBalance)
+	assert.NoError(t, err)
+
+	// Create the extrinsic
+	ext := newExtrinsic(call)
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(0),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+
+	// Set Account Info
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	keyStorageAccountAlice, aliceAccountInfo := setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	// Sign the transaction using Alice's default account
+	err = ext.Sign(signature.TestKeyringPairAlice, o)
+	assert.NoError(t, err)
+
+	extEnc := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&extEnc)
+	err = ext.Encode(*encoder)
+	assert.NoError(t, err)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t,
+		primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(),
+		res,
+	)
+
+	idHash, err := common.Blake2b128(id.Bytes())
+	assert.NoError(t, err)
+
+	keyAsset := append(keyAssetsHash, keyAssetHash...)
+	keyAsset = append(keyAsset, idHash...)
+	keyAsset = append(keyAsset, id.Bytes()...)
+
+	alice := primitives.NewAddress32(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...)
+	admin := primitives.NewAddress32(sc.BytesToSequenceU8(testKeyringPairBob.PublicKey)...)
+	expectedDetails := primitives.AssetDetails{
+		Owner:      alice,
+		Issuer:     admin,
+		Admin:      admin,
+		Freezer:    admin,
+		Supply:     sc.NewU128FromUint64(0),
+		Deposit:    sc.NewU128FromBigInt(assets.AssetDeposit),
+		MinBalance: sc.NewU128FromUint64(10),
+		Status:     primitives.AssetStatusLive,
+	}
+	assert.Equal(t, expectedDetails.Bytes(), (*storage).Get(keyAsset))
+
+	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
+	err = scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(assets.AssetDeposit), aliceAccountInfo.Data.Reserved)
+}
+
+func Test_Assets_FreezeThaw(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	setProvidedStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey)
+	setProvidedStorageAccountInfo(t, storage, testKeyringPairBob.PublicKey)
+
+	id := ctypes.NewUCompactFromUInt(1)
+	alice, err := ctypes.NewMultiAddressFromAccountID(signature.TestKeyringPairAlice.PublicKey)
+	assert.NoError(t, err)
+	bob, err := ctypes.NewMultiAddressFromAccountID(testKeyringPairBob.PublicKey)
+	assert.NoError(t, err)
+
+	initializeBlock(t, rt, blockNumber)
+	createAndMintAsset(t, rt, metadata, id, bob, 100)
+
+	freeze, err := ctypes.NewCall(metadata, "Assets.freeze", id, bob)
+	assert.NoError(t, err)
+	thaw, err := ctypes.NewCall(metadata, "Assets.thaw", id, bob)
+	assert.NoError(t, err)
+	freezeAsset, err := ctypes.NewCall(metadata, "Assets.freeze_asset", id)
+	assert.NoError(t, err)
+	thawAsset, err := ctypes.NewCall(metadata, "Assets.thaw_asset", id)
+	assert.NoError(t, err)
+	transfer, err := ctypes.NewCall(metadata, "Assets.transfer", id, alice, ctypes.NewUCompactFromUInt(10))
+	assert.NoError(t, err)
+
+	// Only the freezer can freeze accounts.
+	res := applySignedExtrinsic(t, rt, freeze, testKeyringPairBob, 0)
+	assert.Equal(t, moduleErrorResult(assets.ModuleIndex, errors.ErrorNoPermission), res)
+
+	res = applySignedExtrinsic(t, rt, freeze, signature.TestKeyringPairAlice, 2)
+	assert.Equal(t, okResult, res)
+
+	res = applySignedExtrinsic(t, rt, transfer, testKeyringPairBob, 1)
+	assert.Equal(t, moduleErrorResult(assets.ModuleIndex, errors.ErrorFrozen), res)
+
+	// Only the admin can thaw accounts.
+	res = applySignedExtrinsic(t, rt, thaw, testKeyringPairBob, 2)
+	assert.Equal(t, moduleErrorResult(assets.ModuleIndex, errors.ErrorNoPermission), res)
+
+	res = applySignedExtrinsic(t, rt, thaw, signature.TestKeyringPairAlice, 3)
+	assert.Equal(t, okResult, res)
+
+	res = applySignedExtrinsic(t, rt, transfer, testKeyringPairBob, 3)
+	assert.Equal(t, okResult, res)
+
+	// Freezing the asset blocks the transfers of all accounts.
+	res = applySignedExtrinsic(t, rt, freezeAsset, signature.TestKeyringPairAlice, 4)
+	assert.Equal(t, okResult, res)
+
+	res = applySignedExtrinsic(t, rt, transfer, testKeyringPairBob, 4)
+	assert.Equal(t, moduleErrorResult(assets.ModuleIndex, errors.ErrorFrozen), res)
+
+	res = applySignedExtrinsic(t, rt, freezeAsset, signature.TestKeyringPairAlice, 5)
+	assert.Equal(t, moduleErrorResult(assets.ModuleIndex, errors.ErrorAssetNotLive), res)
+
+	res = applySignedExtrinsic(t, rt, thawAsset, signature.TestKeyringPairAlice, 6)
+	assert.Equal(t, okResult, res)
+
+	res = applySignedExtrinsic(t, rt, thawAsset, signature.TestKeyringPairAlice, 7)
+	assert.Equal(t, moduleErrorResult(assets.ModuleIndex, errors.ErrorNotFrozen), res)
+
+	res = applySignedExtrinsic(t, rt, transfer, testKeyringPairBob, 5)
+	assert.Equal(t, okResult, res)
+
+	expectedAccount := primitives.AssetAccount{
+		Balance:  sc.NewU128FromUint64(80),
+		IsFrozen: false,
+		Reason:   primitives.ExistenceReasonConsumer,
+	}
+	assert.Equal(t, expectedAccount.Bytes(), (*storage).Get(keyAssetAccount(1, testKeyringPairBob.PublicKey)))
+}
+
+func Test_Assets_Destroy(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	keyStorageAccountAlice, aliceAccountInfo := setProvidedStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey)
+	setProvidedStorageAccountInfo(t, storage, testKeyringPairBob.PublicKey)
+
+	id := ctypes.NewUCompactFromUInt(1)
+	bob, err := ctypes.NewMultiAddressFromAccountID(testKeyringPairBob.PublicKey)
+	assert.NoError(t, err)
+
+	initializeBlock(t, rt, blockNumber)
+	createAndMintAsset(t, rt, metadata, id, bob, 100)
+
+	startDestroy, err := ctypes.NewCall(metadata, "Assets.start_destroy", id)
+	assert.NoError(t, err)
+	destroyAccounts, err := ctypes.NewCall(metadata, "Assets.destroy_accounts", id)
+	assert.NoError(t, err)
+	destroyApprovals, err := ctypes.NewCall(metadata, "Assets.destroy_approvals", id)
+	assert.NoError(t, err)
+	finishDestroy, err := ctypes.NewCall(metadata, "Assets.finish_destroy", id)
+	assert.NoError(t, err)
+	mint, err := ctypes.NewCall(metadata, "Assets.mint", id, bob, ctypes.NewUCompactFromUInt(100))
+	assert.NoError(t, err)
+	freeze, err := ctypes.NewCall(metadata, "Assets.freeze", id, bob)
+	assert.NoError(t, err)
+
+	// Only the owner can start the destruction.
+	res := applySignedExtrinsic(t, rt, startDestroy, testKeyringPairBob, 0)
+	assert.Equal(t, moduleErrorResult(assets.ModuleIndex, errors.ErrorNoPermission), res)
+
+	res = applySignedExtrinsic(t, rt, finishDestroy, signature.TestKeyringPairAlice, 2)
+	assert.Equal(t, moduleErrorResult(assets.ModuleIndex, errors.ErrorIncorrectStatus), res)
+
+	res = applySignedExtrinsic(t, rt, startDestroy, signature.TestKeyringPairAlice, 3)
+	assert.Equal(t, okResult, res)
+
+	res = applySignedExtrinsic(t, rt, mint, signature.TestKeyringPairAlice, 4)
+	assert.Equal(t, moduleErrorResult(assets.ModuleIndex, errors.ErrorAssetNotLive), res)
+
+	res = applySignedExtrinsic(t, rt, freeze, signature.TestKeyringPairAlice, 5)
+	assert.Equal(t, moduleErrorResult(assets.ModuleIndex, errors.ErrorIncorrectStatus), res)
+
+	// The accounts of the asset must be removed first.
+	res = applySignedExtrinsic(t, rt, finishDestroy, signature.TestKeyringPairAlice, 6)
+	assert.Equal(t, moduleErrorResult(assets.ModuleIndex, errors.ErrorInUse), res)
+
+	res = applySignedExtrinsic(t, rt, destroyAccounts, signature.TestKeyringPairAlice, 7)
+	assert.Equal(t, okResult, res)
+	assert.Nil(t, (*storage).Get(keyAssetAccount(1, testKeyringPairBob.PublicKey)))
+
+	res = applySignedExtrinsic(t, rt, destroyApprovals, signature.TestKeyringPairAlice, 8)
+	assert.Equal(t, okResult, res)
+
+	res = applySignedExtrinsic(t, rt, finishDestroy, signature.TestKeyringPairAlice, 9)
+	assert.Equal(t, okResult, res)
+
+	assert.Nil(t, (*storage).Get(keyAssetDetails(1)))
+
+	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
+	err = scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(big.NewInt(0)), aliceAccountInfo.Data.Reserved)
+}
+
+// createAndMintAsset creates asset `id`, owned and administered by Alice, and mints `amount` to `beneficiary`.
+// Uses the nonces 0 and 1 of Alice.
+func createAndMintAsset(t *testing.T, rt *wasmer.Instance, metadata *ctypes.Metadata, id ctypes.UCompact, beneficiary ctypes.MultiAddress, amount uint64) {
+	alice, err := ctypes.NewMultiAddressFromAccountID(signature.TestKeyringPairAlice.PublicKey)
+	assert.NoError(t, err)
+
+	create, err := ctypes.NewCall(metadata, "Assets.create", id, alice, ctypes.NewU128(*big.NewInt(1)))
+	assert.NoError(t, err)
+	res := applySignedExtrinsic(t, rt, create, signature.TestKeyringPairAlice, 0)
+	assert.Equal(t, okResult, res)
+
+	mint, err := ctypes.NewCall(metadata, "Assets.mint", id, beneficiary, ctypes.NewUCompactFromUInt(amount))
+	assert.NoError(t, err)
+	res = applySignedExtrinsic(t, rt, mint, signature.TestKeyringPairAlice, 1)
+	assert.Equal(t, okResult, res)
+}
+
+// setProvidedStorageAccountInfo sets a funded account with a provider reference,
+// which is required to hold an account of a non-sufficient asset.
+func setProvidedStorageAccountInfo(t *testing.T, storage *runtime.Storage, account []byte) ([]byte, gossamertypes.AccountInfo) {
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	keyStorageAccount, accountInfo := setStorageAccountInfo(t, storage, account, balance, 0)
+	accountInfo.Producers = 1
+
+	bytesStorage, err := scale.Marshal(accountInfo)
+	assert.NoError(t, err)
+
+	err = (*storage).Put(keyStorageAccount, bytesStorage)
+	assert.NoError(t, err)
+
+	return keyStorageAccount, accountInfo
+}
+
+func keyAssetDetails(id sc.U32) []byte {
+	idHash, _ := common.Blake2b128(id.Bytes())
+
+	key := append(keyAssetsHash, keyAssetHash...)
+	key = append(key, idHash...)
+	return append(key, id.Bytes()...)
+}
+
+func keyAssetAccount(id sc.U32, who []byte) []byte {
+	idHash, _ := common.Blake2b128(id.Bytes())
+	whoHash, _ := common.Blake2b128(who)
+
+	key := append(keyAssetsHash, keyAccountHash...)
+	key = append(key, idHash...)
+	key = append(key, id.Bytes()...)
+	key = append(key, whoHash...)
+	return append(key, who...)
+}
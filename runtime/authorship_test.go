@@ -0,0 +1,68 @@
+package main
+
+import (
+	"testing"
+
+	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/aura"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyAuthorshipHash, _ = common.Twox128Hash(constants.KeyAuthorship)
+	keyAuthorHash, _     = common.Twox128Hash(constants.KeyAuthor)
+)
+
+func Test_Authorship_Author_FromAuraDigest(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	bob := common.MustHexToBytes("0x90b5ab205c6974c9ea841be688864633dc9ca8a357843eeacf2314649965fe22")
+
+	var alice [32]byte
+	copy(alice[:], signature.TestKeyringPairAlice.PublicKey)
+	var bobKey [32]byte
+	copy(bobKey[:], bob)
+
+	bytesAuthorities, err := scale.Marshal([][32]byte{bobKey, alice})
+	assert.NoError(t, err)
+
+	err = (*storage).Put(append(keyAuraHash, keyAuthoritiesHash...), bytesAuthorities)
+	assert.NoError(t, err)
+
+	// slot 5 with 2 authorities is authored by the authority at index 1
+	slot := sc.U64(5)
+	preRuntimeDigest := gossamertypes.PreRuntimeDigest{
+		ConsensusEngineID: aura.EngineId,
+		Data:              slot.Bytes(),
+	}
+
+	digest := gossamertypes.NewDigest()
+	assert.NoError(t, digest.Add(preRuntimeDigest))
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, digest)
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	assert.Equal(t, signature.TestKeyringPairAlice.PublicKey, (*storage).Get(append(keyAuthorshipHash, keyAuthorHash...)))
+}
+
+func Test_Authorship_Author_NoDigest(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	assert.Empty(t, (*storage).Get(append(keyAuthorshipHash, keyAuthorHash...)))
+}
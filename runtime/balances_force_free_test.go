@@ -27,7 +27,7 @@ func Test_Balances_ForceFree_BadOrigin(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Create the extrinsic
-	ext := ctypes.NewExtrinsic(call)
+	ext := newExtrinsic(call)
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
 		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
@@ -29,7 +29,7 @@ func Test_Balances_SetBalance_BadOrigin(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Create the extrinsic
-	ext := ctypes.NewExtrinsic(call)
+	ext := newExtrinsic(call)
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
 		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
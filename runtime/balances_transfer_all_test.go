@@ -33,7 +33,7 @@ func Test_Balances_TransferAll_Success_AllowDeath(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Create the extrinsic
-	ext := ctypes.NewExtrinsic(call)
+	ext := newExtrinsic(call)
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
 		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
@@ -136,7 +136,7 @@ func Test_Balances_TransferAll_Success_KeepAlive(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Create the extrinsic
-	ext := ctypes.NewExtrinsic(call)
+	ext := newExtrinsic(call)
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
 		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
@@ -98,7 +98,7 @@ func Test_ApplyExtrinsic_DispatchOutcome(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
@@ -153,7 +153,7 @@ func Test_ApplyExtrinsic_Unsigned_DispatchOutcome(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 
 	extEnc := bytes.Buffer{}
 	encoder := cscale.NewEncoder(&extEnc)
@@ -192,7 +192,7 @@ func Test_ApplyExtrinsic_DispatchError_BadProofError(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
@@ -253,7 +253,7 @@ func Test_ApplyExtrinsic_ExhaustsResourcesError(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", args)
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
@@ -316,7 +316,7 @@ func Test_ApplyExtrinsic_FutureError_InvalidNonce(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
 		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
@@ -363,7 +363,7 @@ func Test_ApplyExtrinsic_InvalidLengthPrefix(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"testing"
+
+	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/runtime"
+	"github.com/ChainSafe/gossamer/lib/runtime/wasmer"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/collective"
+	"github.com/LimeChain/gosemble/frame/collective/errors"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyCouncilHash, _ = common.Twox128Hash(constants.KeyCouncil)
+	keyMembersHash, _ = common.Twox128Hash(constants.KeyMembers)
+)
+
+func Test_Council_DisapproveProposal_BadOrigin(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	metadata := runtimeMetadata(t, rt)
+
+	call, err := ctypes.NewCall(metadata, "Council.disapprove_proposal", ctypes.NewHash(make([]byte, 32)))
+	assert.NoError(t, err)
+
+	// Create the extrinsic
+	ext := newExtrinsic(call)
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(0),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+
+	// Set Account Info
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	// Sign the transaction using Alice's default account
+	err = ext.Sign(signature.TestKeyringPairAlice, o)
+	assert.NoError(t, err)
+
+	extEnc := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&extEnc)
+	err = ext.Encode(*encoder)
+	assert.NoError(t, err)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+
+	expectedResult :=
+		primitives.NewApplyExtrinsicResult(
+			primitives.NewDispatchOutcome(
+				primitives.NewDispatchErrorBadOrigin()))
+
+	assert.Equal(t, expectedResult.Bytes(), res)
+}
+
+func Test_Council_Execute_NotMember(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	setCouncilMembers(t, storage, testKeyringPairBob)
+
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+	setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	remark := councilRemark(t, metadata)
+	call, err := ctypes.NewCall(metadata, "Council.execute", remark.call, ctypes.NewUCompactFromUInt(uint64(len(remark.bytes))))
+	assert.NoError(t, err)
+
+	initializeBlock(t, rt, blockNumber)
+
+	res := applySignedExtrinsic(t, rt, call, signature.TestKeyringPairAlice, 0)
+
+	assert.Equal(t, moduleErrorResult(collective.ModuleIndex, errors.ErrorNotMember), res)
+}
+
+func Test_Council_Propose_WrongProposalLength(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	setCouncilMembers(t, storage, signature.TestKeyringPairAlice, testKeyringPairBob)
+
+	remark := councilRemark(t, metadata)
+	call, err := ctypes.NewCall(metadata, "Council.propose", ctypes.NewUCompactFromUInt(2), remark.call, ctypes.NewUCompactFromUInt(uint64(len(remark.bytes)-1)))
+	assert.NoError(t, err)
+
+	initializeBlock(t, rt, blockNumber)
+
+	res := applySignedExtrinsic(t, rt, call, signature.TestKeyringPairAlice, 0)
+
+	assert.Equal(t, moduleErrorResult(collective.ModuleIndex, errors.ErrorWrongProposalLength), res)
+	assert.Nil(t, (*storage).Get(append(keyCouncilHash, keyProposalsHash...)))
+}
+
+func Test_Council_Vote_Failures(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	setCouncilMembers(t, storage, signature.TestKeyringPairAlice, testKeyringPairBob)
+
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+	setStorageAccountInfo(t, storage, testKeyringPairCharlie.PublicKey, balance, 0)
+
+	remark := councilRemark(t, metadata)
+	initializeBlock(t, rt, blockNumber)
+	proposeToCouncil(t, rt, metadata, remark, 2, signature.TestKeyringPairAlice, 0)
+
+	wrongIndex, err := ctypes.NewCall(metadata, "Council.vote", remark.hash, ctypes.NewUCompactFromUInt(1), ctypes.NewBool(true))
+	assert.NoError(t, err)
+	res := applySignedExtrinsic(t, rt, wrongIndex, signature.TestKeyringPairAlice, 1)
+	assert.Equal(t, moduleErrorResult(collective.ModuleIndex, errors.ErrorWrongIndex), res)
+
+	missing, err := ctypes.NewCall(metadata, "Council.vote", ctypes.NewHash(make([]byte, 32)), ctypes.NewUCompactFromUInt(0), ctypes.NewBool(true))
+	assert.NoError(t, err)
+	res = applySignedExtrinsic(t, rt, missing, signature.TestKeyringPairAlice, 2)
+	assert.Equal(t, moduleErrorResult(collective.ModuleIndex, errors.ErrorProposalMissing), res)
+
+	aye, err := ctypes.NewCall(metadata, "Council.vote", remark.hash, ctypes.NewUCompactFromUInt(0), ctypes.NewBool(true))
+	assert.NoError(t, err)
+	res = applySignedExtrinsic(t, rt, aye, signature.TestKeyringPairAlice, 3)
+	assert.Equal(t, primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(), res)
+
+	res = applySignedExtrinsic(t, rt, aye, signature.TestKeyringPairAlice, 4)
+	assert.Equal(t, moduleErrorResult(collective.ModuleIndex, errors.ErrorDuplicateVote), res)
+
+	res = applySignedExtrinsic(t, rt, aye, testKeyringPairCharlie, 0)
+	assert.Equal(t, moduleErrorResult(collective.ModuleIndex, errors.ErrorNotMember), res)
+}
+
+func Test_Council_Close_Failures(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	setCouncilMembers(t, storage, signature.TestKeyringPairAlice, testKeyringPairBob, testKeyringPairCharlie)
+
+	remark := councilRemark(t, metadata)
+	initializeBlock(t, rt, blockNumber)
+	proposeToCouncil(t, rt, metadata, remark, 2, signature.TestKeyringPairAlice, 0)
+
+	remarkWeight := ctypes.NewWeight(ctypes.NewUCompactFromUInt(1_000_000_000), ctypes.NewUCompactFromUInt(1_000_000))
+	closeCall, err := ctypes.NewCall(metadata, "Council.close", remark.hash, ctypes.NewUCompactFromUInt(0), remarkWeight, ctypes.NewUCompactFromUInt(uint64(len(remark.bytes))))
+	assert.NoError(t, err)
+
+	// Neither approved nor disapproved before the end of the motion.
+	res := applySignedExtrinsic(t, rt, closeCall, signature.TestKeyringPairAlice, 1)
+	assert.Equal(t, moduleErrorResult(collective.ModuleIndex, errors.ErrorTooEarly), res)
+
+	aye, err := ctypes.NewCall(metadata, "Council.vote", remark.hash, ctypes.NewUCompactFromUInt(0), ctypes.NewBool(true))
+	assert.NoError(t, err)
+	res = applySignedExtrinsic(t, rt, aye, signature.TestKeyringPairAlice, 2)
+	assert.Equal(t, primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(), res)
+	res = applySignedExtrinsic(t, rt, aye, testKeyringPairBob, 0)
+	assert.Equal(t, primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(), res)
+
+	// Approved, but the weight bound does not cover the weight of the proposal.
+	zeroWeight := ctypes.NewWeight(ctypes.NewUCompactFromUInt(0), ctypes.NewUCompactFromUInt(0))
+	underweight, err := ctypes.NewCall(metadata, "Council.close", remark.hash, ctypes.NewUCompactFromUInt(0), zeroWeight, ctypes.NewUCompactFromUInt(uint64(len(remark.bytes))))
+	assert.NoError(t, err)
+	res = applySignedExtrinsic(t, rt, underweight, signature.TestKeyringPairAlice, 3)
+	assert.Equal(t, moduleErrorResult(collective.ModuleIndex, errors.ErrorWrongProposalWeight), res)
+
+	// Approved, but the length bound is shorter than the proposal.
+	short, err := ctypes.NewCall(metadata, "Council.close", remark.hash, ctypes.NewUCompactFromUInt(0), remarkWeight, ctypes.NewUCompactFromUInt(uint64(len(remark.bytes)-1)))
+	assert.NoError(t, err)
+	res = applySignedExtrinsic(t, rt, short, signature.TestKeyringPairAlice, 4)
+	assert.Equal(t, moduleErrorResult(collective.ModuleIndex, errors.ErrorWrongProposalLength), res)
+
+	// The failed closes keep the motion open.
+	proposals := sc.Sequence[primitives.H256]{primitives.NewH256(sc.BytesToSequenceU8(remark.hash[:])...)}
+	assert.Equal(t, proposals.Bytes(), (*storage).Get(append(keyCouncilHash, keyProposalsHash...)))
+
+	res = applySignedExtrinsic(t, rt, closeCall, signature.TestKeyringPairAlice, 5)
+	assert.Equal(t, primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(), res)
+	assert.Equal(t, sc.Sequence[primitives.H256]{}.Bytes(), (*storage).Get(append(keyCouncilHash, keyProposalsHash...)))
+}
+
+// councilProposal is a proposal of the council, along with its encoding and hash.
+type councilProposal struct {
+	call  ctypes.Call
+	bytes []byte
+	hash  ctypes.Hash
+}
+
+func councilRemark(t *testing.T, metadata *ctypes.Metadata) councilProposal {
+	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
+	assert.NoError(t, err)
+
+	encoded, err := codec.Encode(call)
+	assert.NoError(t, err)
+
+	hash, err := common.Blake2bHash(encoded)
+	assert.NoError(t, err)
+
+	return councilProposal{call: call, bytes: encoded, hash: ctypes.NewHash(hash.ToBytes())}
+}
+
+// setCouncilMembers sets the sorted members of the council and funds their accounts.
+func setCouncilMembers(t *testing.T, storage *runtime.Storage, members ...signature.KeyringPair) {
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	accounts := sc.Sequence[primitives.AccountId]{}
+	for _, member := range members {
+		setStorageAccountInfo(t, storage, member.PublicKey, balance, 0)
+		accounts = append(accounts, primitives.NewAccountId(sc.BytesToSequenceU8(member.PublicKey)...))
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return bytes.Compare(accounts[i].Bytes(), accounts[j].Bytes()) < 0
+	})
+
+	err := (*storage).Put(append(keyCouncilHash, keyMembersHash...), accounts.Bytes())
+	assert.NoError(t, err)
+}
+
+func proposeToCouncil(t *testing.T, rt *wasmer.Instance, metadata *ctypes.Metadata, proposal councilProposal, threshold uint64, proposer signature.KeyringPair, nonce uint64) {
+	call, err := ctypes.NewCall(metadata, "Council.propose", ctypes.NewUCompactFromUInt(threshold), proposal.call, ctypes.NewUCompactFromUInt(uint64(len(proposal.bytes))))
+	assert.NoError(t, err)
+
+	res := applySignedExtrinsic(t, rt, call, proposer, nonce)
+	assert.Equal(t, primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(), res)
+}
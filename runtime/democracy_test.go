@@ -0,0 +1,237 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/democracy"
+	"github.com/LimeChain/gosemble/frame/democracy/errors"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyDemocracyHash, _       = common.Twox128Hash(constants.KeyDemocracy)
+	keyPublicPropCountHash, _ = common.Twox128Hash(constants.KeyPublicPropCount)
+	keyDepositOfHash, _       = common.Twox128Hash(constants.KeyDepositOf)
+	keyVotingOfHash, _        = common.Twox128Hash(constants.KeyVotingOf)
+)
+
+func Test_Democracy_Propose_Success(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	metadata := runtimeMetadata(t, rt)
+
+	remark, err := ctypes.NewCall(metadata, "System.remark", []byte{})
+	assert.NoError(t, err)
+
+	proposalEnc := bytes.Buffer{}
+	err = cscale.NewEncoder(&proposalEnc).Encode(remark)
+	assert.NoError(t, err)
+
+	value := big.NewInt(0).Set(democracy.MinimumDeposit)
+
+	// The proposal is passed as Bounded(Inline)
+	call, err := ctypes.NewCall(metadata, "Democracy.propose", ctypes.U8(primitives.BoundedInline), proposalEnc.Bytes(), ctypes.NewUCompact(value))
+	assert.NoError(t, err)
+
+	// Create the extrinsic
+	ext := newExtrinsic(call)
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(0),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+
+	// Set Account Info
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	keyStorageAccountAlice, aliceAccountInfo := setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	// Sign the transaction using Alice's default account
+	err = ext.Sign(signature.TestKeyringPairAlice, o)
+	assert.NoError(t, err)
+
+	extEnc := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&extEnc)
+	err = ext.Encode(*encoder)
+	assert.NoError(t, err)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t,
+		primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(),
+		res,
+	)
+
+	keyPublicPropCount := append(keyDemocracyHash, keyPublicPropCountHash...)
+	assert.Equal(t, sc.U32(1).Bytes(), (*storage).Get(keyPublicPropCount))
+
+	index := sc.U32(0)
+	indexHash, err := common.Twox64(index.Bytes())
+	assert.NoError(t, err)
+
+	keyDepositOf := append(keyDemocracyHash, keyDepositOfHash...)
+	keyDepositOf = append(keyDepositOf, indexHash...)
+	keyDepositOf = append(keyDepositOf, index.Bytes()...)
+
+	alice := primitives.NewAddress32(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...)
+	expectedDeposit := primitives.ProposalDeposit{
+		Depositors: sc.Sequence[primitives.Address32]{alice},
+		Deposit:    sc.NewU128FromBigInt(value),
+	}
+	assert.Equal(t, expectedDeposit.Bytes(), (*storage).Get(keyDepositOf))
+
+	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
+	err = scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(value), aliceAccountInfo.Data.Reserved)
+}
+
+func Test_Democracy_Delegate_Conviction(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+	setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+	setStorageAccountInfo(t, storage, testKeyringPairBob.PublicKey, balance, 0)
+
+	alice := primitives.NewAccountId(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...)
+	bob := primitives.NewAccountId(sc.BytesToSequenceU8(testKeyringPairBob.PublicKey)...)
+	capital := sc.NewU128FromUint64(1_000)
+	noDelegations := primitives.Delegations{Votes: sc.NewU128FromUint64(0), Capital: sc.NewU128FromUint64(0)}
+	noPrior := primitives.PriorLock{Until: 0, Amount: sc.NewU128FromUint64(0)}
+
+	to, err := ctypes.NewMultiAddressFromAccountID(testKeyringPairBob.PublicKey)
+	assert.NoError(t, err)
+
+	delegate, err := ctypes.NewCall(metadata, "Democracy.delegate", to, ctypes.U8(primitives.ConvictionLocked3x), ctypes.NewU128(*big.NewInt(1_000)))
+	assert.NoError(t, err)
+	undelegate, err := ctypes.NewCall(metadata, "Democracy.undelegate")
+	assert.NoError(t, err)
+
+	initializeBlock(t, rt, blockNumber)
+
+	res := applySignedExtrinsic(t, rt, delegate, signature.TestKeyringPairAlice, 0)
+	assert.Equal(t, primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(), res)
+
+	// 3x conviction triples the votes of the delegated capital.
+	expectedTarget := primitives.NewDemocracyVotingDirect(
+		sc.Sequence[primitives.ReferendumVote]{},
+		primitives.Delegations{Votes: sc.NewU128FromUint64(3_000), Capital: capital},
+		noPrior,
+	)
+	assert.Equal(t, expectedTarget.Bytes(), (*storage).Get(keyDemocracyVotingOf(bob)))
+
+	expectedDelegator := primitives.NewDemocracyVotingDelegating(capital, bob, primitives.ConvictionLocked3x, noDelegations, noPrior)
+	assert.Equal(t, expectedDelegator.Bytes(), (*storage).Get(keyDemocracyVotingOf(alice)))
+
+	res = applySignedExtrinsic(t, rt, delegate, signature.TestKeyringPairAlice, 1)
+	assert.Equal(t, moduleErrorResult(democracy.ModuleIndex, errors.ErrorAlreadyDelegating), res)
+
+	res = applySignedExtrinsic(t, rt, undelegate, signature.TestKeyringPairAlice, 2)
+	assert.Equal(t, primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(), res)
+
+	expectedTarget = primitives.NewDemocracyVotingDirect(sc.Sequence[primitives.ReferendumVote]{}, noDelegations, noPrior)
+	assert.Equal(t, expectedTarget.Bytes(), (*storage).Get(keyDemocracyVotingOf(bob)))
+
+	// The delegated capital stays locked for 4 vote locking periods, which is the lock of 3x conviction.
+	prior := primitives.PriorLock{
+		Until:  sc.U32(blockNumber) + 4*democracy.VoteLockingPeriod,
+		Amount: capital,
+	}
+	expectedDelegator = primitives.NewDemocracyVotingDirect(sc.Sequence[primitives.ReferendumVote]{}, noDelegations, prior)
+	assert.Equal(t, expectedDelegator.Bytes(), (*storage).Get(keyDemocracyVotingOf(alice)))
+
+	res = applySignedExtrinsic(t, rt, undelegate, signature.TestKeyringPairAlice, 3)
+	assert.Equal(t, moduleErrorResult(democracy.ModuleIndex, errors.ErrorNotDelegating), res)
+}
+
+func Test_Democracy_Delegate_Failures(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+	setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	self, err := ctypes.NewMultiAddressFromAccountID(signature.TestKeyringPairAlice.PublicKey)
+	assert.NoError(t, err)
+	bob, err := ctypes.NewMultiAddressFromAccountID(testKeyringPairBob.PublicKey)
+	assert.NoError(t, err)
+
+	toSelf, err := ctypes.NewCall(metadata, "Democracy.delegate", self, ctypes.U8(primitives.ConvictionLocked1x), ctypes.NewU128(*big.NewInt(1_000)))
+	assert.NoError(t, err)
+
+	overBalance := big.NewInt(0).Add(balance, big.NewInt(1))
+	insufficient, err := ctypes.NewCall(metadata, "Democracy.delegate", bob, ctypes.U8(primitives.ConvictionLocked1x), ctypes.NewU128(*overBalance))
+	assert.NoError(t, err)
+
+	initializeBlock(t, rt, blockNumber)
+
+	res := applySignedExtrinsic(t, rt, toSelf, signature.TestKeyringPairAlice, 0)
+	assert.Equal(t, moduleErrorResult(democracy.ModuleIndex, errors.ErrorNonsense), res)
+
+	res = applySignedExtrinsic(t, rt, insufficient, signature.TestKeyringPairAlice, 1)
+	assert.Equal(t, moduleErrorResult(democracy.ModuleIndex, errors.ErrorInsufficientFunds), res)
+
+	alice := primitives.NewAccountId(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...)
+	assert.Nil(t, (*storage).Get(keyDemocracyVotingOf(alice)))
+}
+
+func Test_Democracy_Vote_ReferendumInvalid(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+	setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	vote := primitives.NewAccountVoteStandard(
+		primitives.Vote{Aye: true, Conviction: primitives.ConvictionLocked6x},
+		sc.NewU128FromUint64(1_000),
+	)
+
+	call, err := ctypes.NewCall(metadata, "Democracy.vote", ctypes.NewUCompactFromUInt(0))
+	assert.NoError(t, err)
+	call.Args = append(call.Args, vote.Bytes()...)
+
+	initializeBlock(t, rt, blockNumber)
+
+	res := applySignedExtrinsic(t, rt, call, signature.TestKeyringPairAlice, 0)
+	assert.Equal(t, moduleErrorResult(democracy.ModuleIndex, errors.ErrorReferendumInvalid), res)
+}
+
+func keyDemocracyVotingOf(who primitives.AccountId) []byte {
+	whoBytes := sc.FixedSequenceU8ToBytes(who.FixedSequence)
+	whoHash, _ := common.Twox64(whoBytes)
+
+	key := append(keyDemocracyHash, keyVotingOfHash...)
+	key = append(key, whoHash...)
+	return append(key, whoBytes...)
+}
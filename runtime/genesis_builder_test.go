@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+
+	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GenesisBuilder_CreateDefaultConfig(t *testing.T) {
+	rt, _ := newTestRuntime(t)
+
+	result, err := rt.Exec("GenesisBuilder_create_default_config", []byte{})
+	assert.NoError(t, err)
+
+	gcJson := sc.DecodeSequence[sc.U8](bytes.NewBuffer(result))
+
+	expected := `{"system":{"code":"0x"},"aura":{"authorities":[]},"grandpa":{"authorities":[]},"balances":{"balances":[]}}`
+	assert.JSONEq(t, expected, string(sc.SequenceU8ToBytes(gcJson)))
+}
+
+func Test_GenesisBuilder_BuildConfig(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	alice := signature.TestKeyringPairAlice.PublicKey
+	bob := testKeyringPairBob.PublicKey
+	code := []byte{0, 97, 115, 109}
+
+	gcJson := fmt.Sprintf(`{
+		"system": {"code": "0x%x"},
+		"aura": {"authorities": ["0x%x"]},
+		"grandpa": {"authorities": [["0x%x", 1]]},
+		"balances": {"balances": [["0x%x", 1000000000000000000], ["0x%x", 2000]]}
+	}`, code, alice, alice, alice, bob)
+
+	result, err := rt.Exec("GenesisBuilder_build_config", sc.BytesToSequenceU8([]byte(gcJson)).Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0}, result)
+
+	assert.Equal(t, code, (*storage).Get(constants.KeyCode))
+	assert.Equal(t, bytes.Repeat([]byte{69}, 32), (*storage).Get(append(append(keySystemHash, keyBlockHash...), append(hashing.Twox64(sc.U32(0).Bytes()), sc.U32(0).Bytes()...)...)))
+
+	assert.Equal(t, sc.Sequence[types.PublicKey]{sc.BytesToFixedSequenceU8(alice)}.Bytes(), (*storage).Get(append(keyAuraHash, keyAuthoritiesHash...)))
+
+	expectedAuthorities := types.VersionedAuthorityList{
+		Version:       grandpa.AuthorityVersion,
+		AuthorityList: sc.Sequence[types.Authority]{{Id: sc.BytesToFixedSequenceU8(alice), Weight: 1}},
+	}
+	assert.Equal(t, expectedAuthorities.Bytes(), (*storage).Get(constants.KeyGrandpaAuthorities))
+
+	aliceFree, _ := new(big.Int).SetString("1000000000000000000", 10)
+	aliceAccountInfo := gossamertypes.AccountInfo{}
+	err = scale.Unmarshal((*storage).Get(accountKey(alice)), &aliceAccountInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), aliceAccountInfo.Producers)
+	assert.Equal(t, scale.MustNewUint128(aliceFree), aliceAccountInfo.Data.Free)
+
+	totalIssuance := new(big.Int).Add(aliceFree, big.NewInt(2000))
+	keyTotalIssuance := append(hashing.Twox128(constants.KeyBalances), hashing.Twox128(constants.KeyTotalIssuance)...)
+	assert.Equal(t, sc.NewU128FromBigInt(totalIssuance).Bytes(), (*storage).Get(keyTotalIssuance))
+
+	keyBalancesStorageVersion := append(hashing.Twox128(constants.KeyBalances), hashing.Twox128(constants.KeyStorageVersion)...)
+	assert.Equal(t, types.StorageVersion(balances.StorageVersion).Bytes(), (*storage).Get(keyBalancesStorageVersion))
+}
+
+func Test_GenesisBuilder_BuildConfig_DefaultModuleConfig(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	result, err := rt.Exec("GenesisBuilder_build_config", sc.BytesToSequenceU8([]byte(`{}`)).Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0}, result)
+
+	assert.Nil(t, (*storage).Get(constants.KeyCode))
+	assert.Equal(t, []byte{0}, (*storage).Get(append(keyAuraHash, keyAuthoritiesHash...)))
+}
+
+func Test_GenesisBuilder_BuildConfig_UnknownModule(t *testing.T) {
+	rt, _ := newTestRuntime(t)
+
+	result, err := rt.Exec("GenesisBuilder_build_config", sc.BytesToSequenceU8([]byte(`{"sudo": {"key": "0x00"}}`)).Bytes())
+	assert.NoError(t, err)
+
+	expected := sc.Result[sc.Encodable]{HasError: true, Value: sc.Str("unknown module in genesis config: sudo")}
+	assert.Equal(t, expected.Bytes(), result)
+}
+
+func Test_GenesisBuilder_BuildConfig_InvalidAccount(t *testing.T) {
+	rt, _ := newTestRuntime(t)
+
+	gcJson := fmt.Sprintf(`{"balances": {"balances": [["0x%s", 1000]]}}`, hex.EncodeToString([]byte{1, 2, 3}))
+
+	result, err := rt.Exec("GenesisBuilder_build_config", sc.BytesToSequenceU8([]byte(gcJson)).Bytes())
+	assert.NoError(t, err)
+
+	expected := sc.Result[sc.Encodable]{HasError: true, Value: sc.Str("balances: invalid account id")}
+	assert.Equal(t, expected.Bytes(), result)
+}
+
+func accountKey(account []byte) []byte {
+	key := append(keySystemHash, keyAccountHash...)
+	key = append(key, hashing.Blake128(account)...)
+	return append(key, account...)
+}
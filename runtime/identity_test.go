@@ -0,0 +1,337 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/runtime"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/identity"
+	"github.com/LimeChain/gosemble/frame/identity/errors"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyIdentityHash, _   = common.Twox128Hash(constants.KeyIdentity)
+	keyIdentityOfHash, _ = common.Twox128Hash(constants.KeyIdentityOf)
+	keyRegistrarsHash, _ = common.Twox128Hash(constants.KeyRegistrars)
+	keySuperOfHash, _    = common.Twox128Hash(constants.KeySuperOf)
+)
+
+func Test_Identity_SetIdentity_Success(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	metadata := runtimeMetadata(t, rt)
+
+	info := primitives.IdentityInfo{
+		Additional:     sc.Sequence[primitives.IdentityAdditionalField]{},
+		Display:        primitives.NewIdentityDataRaw(sc.BytesToSequenceU8([]byte("Alice"))),
+		Legal:          primitives.NewIdentityDataNone(),
+		Web:            primitives.NewIdentityDataNone(),
+		Riot:           primitives.NewIdentityDataNone(),
+		Email:          primitives.NewIdentityDataRaw(sc.BytesToSequenceU8([]byte("alice@example.com"))),
+		PgpFingerprint: sc.NewOption[sc.FixedSequence[sc.U8]](nil),
+		Image:          primitives.NewIdentityDataNone(),
+		Twitter:        primitives.NewIdentityDataNone(),
+	}
+
+	call, err := ctypes.NewCall(metadata, "Identity.set_identity")
+	assert.NoError(t, err)
+	call.Args = append(call.Args, info.Bytes()...)
+
+	// Create the extrinsic
+	ext := newExtrinsic(call)
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(0),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+
+	// Set Account Info
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	keyStorageAccountAlice, aliceAccountInfo := setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	// Sign the transaction using Alice's default account
+	err = ext.Sign(signature.TestKeyringPairAlice, o)
+	assert.NoError(t, err)
+
+	extEnc := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&extEnc)
+	err = ext.Encode(*encoder)
+	assert.NoError(t, err)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t,
+		primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(),
+		res,
+	)
+
+	deposit := identityDeposit(info)
+
+	expectedRegistration := primitives.Registration{
+		Judgements: sc.Sequence[primitives.RegistrarJudgement]{},
+		Deposit:    sc.NewU128FromBigInt(deposit),
+		Info:       info,
+	}
+	assert.Equal(t, expectedRegistration.Bytes(), (*storage).Get(keyIdentityOf(signature.TestKeyringPairAlice.PublicKey)))
+
+	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
+	err = scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(deposit), aliceAccountInfo.Data.Reserved)
+}
+
+func Test_Identity_StickyJudgement(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+	setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+	setStorageAccountInfo(t, storage, testKeyringPairBob.PublicKey, balance, 0)
+	setStorageAccountInfo(t, storage, testKeyringPairCharlie.PublicKey, balance, 0)
+	setIdentityRegistrar(t, storage, testKeyringPairBob.PublicKey)
+
+	info := identityInfo("Alice")
+	newInfo := identityInfo("Alice Liddell")
+
+	initializeBlock(t, rt, blockNumber)
+
+	res := applySignedExtrinsic(t, rt, setIdentityCall(t, metadata, info), signature.TestKeyringPairAlice, 0)
+	assert.Equal(t, okResult, res)
+
+	// Only the registrar at the given index can give judgements.
+	erroneous := primitives.NewJudgement(primitives.JudgementErroneous)
+	res = applySignedExtrinsic(t, rt, provideJudgementCall(t, metadata, erroneous, info), testKeyringPairCharlie, 0)
+	assert.Equal(t, moduleErrorResult(identity.ModuleIndex, errors.ErrorInvalidIndex), res)
+
+	feePaid := primitives.NewJudgementFeePaid(sc.NewU128FromUint64(0))
+	res = applySignedExtrinsic(t, rt, provideJudgementCall(t, metadata, feePaid, info), testKeyringPairBob, 0)
+	assert.Equal(t, moduleErrorResult(identity.ModuleIndex, errors.ErrorInvalidJudgement), res)
+
+	res = applySignedExtrinsic(t, rt, provideJudgementCall(t, metadata, erroneous, info), testKeyringPairBob, 1)
+	assert.Equal(t, okResult, res)
+
+	// An erroneous judgement can not be replaced by a new request.
+	requestJudgement, err := ctypes.NewCall(metadata, "Identity.request_judgement", ctypes.NewUCompactFromUInt(0), ctypes.NewUCompactFromUInt(0))
+	assert.NoError(t, err)
+	res = applySignedExtrinsic(t, rt, requestJudgement, signature.TestKeyringPairAlice, 1)
+	assert.Equal(t, moduleErrorResult(identity.ModuleIndex, errors.ErrorStickyJudgement), res)
+
+	// An erroneous judgement is kept when the identity changes.
+	res = applySignedExtrinsic(t, rt, setIdentityCall(t, metadata, newInfo), signature.TestKeyringPairAlice, 2)
+	assert.Equal(t, okResult, res)
+
+	expectedRegistration := primitives.Registration{
+		Judgements: sc.Sequence[primitives.RegistrarJudgement]{
+			{RegistrarIndex: 0, Judgement: erroneous},
+		},
+		Deposit: sc.NewU128FromBigInt(identityDeposit(newInfo)),
+		Info:    newInfo,
+	}
+	assert.Equal(t, expectedRegistration.Bytes(), (*storage).Get(keyIdentityOf(signature.TestKeyringPairAlice.PublicKey)))
+
+	// Judgements must be given on the current identity.
+	reasonable := primitives.NewJudgement(primitives.JudgementReasonable)
+	res = applySignedExtrinsic(t, rt, provideJudgementCall(t, metadata, reasonable, info), testKeyringPairBob, 2)
+	assert.Equal(t, moduleErrorResult(identity.ModuleIndex, errors.ErrorJudgementForDifferentIdentity), res)
+
+	res = applySignedExtrinsic(t, rt, provideJudgementCall(t, metadata, reasonable, newInfo), testKeyringPairBob, 3)
+	assert.Equal(t, okResult, res)
+
+	// A reasonable judgement is removed when the identity changes.
+	res = applySignedExtrinsic(t, rt, setIdentityCall(t, metadata, info), signature.TestKeyringPairAlice, 3)
+	assert.Equal(t, okResult, res)
+
+	expectedRegistration = primitives.Registration{
+		Judgements: sc.Sequence[primitives.RegistrarJudgement]{},
+		Deposit:    sc.NewU128FromBigInt(identityDeposit(info)),
+		Info:       info,
+	}
+	assert.Equal(t, expectedRegistration.Bytes(), (*storage).Get(keyIdentityOf(signature.TestKeyringPairAlice.PublicKey)))
+}
+
+func Test_Identity_SubAccountDeposits(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+	keyStorageAccountAlice, aliceAccountInfo := setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+	setStorageAccountInfo(t, storage, testKeyringPairBob.PublicKey, balance, 0)
+	setStorageAccountInfo(t, storage, testKeyringPairCharlie.PublicKey, balance, 0)
+
+	bob := primitives.NewAccountId(sc.BytesToSequenceU8(testKeyringPairBob.PublicKey)...)
+	charlie := primitives.NewAccountId(sc.BytesToSequenceU8(testKeyringPairCharlie.PublicKey)...)
+	bobAddress, err := ctypes.NewMultiAddressFromAccountID(testKeyringPairBob.PublicKey)
+	assert.NoError(t, err)
+	charlieAddress, err := ctypes.NewMultiAddressFromAccountID(testKeyringPairCharlie.PublicKey)
+	assert.NoError(t, err)
+
+	info := identityInfo("Alice")
+	name := primitives.NewIdentityDataRaw(sc.BytesToSequenceU8([]byte("sub")))
+
+	addSub, err := ctypes.NewCall(metadata, "Identity.add_sub", bobAddress)
+	assert.NoError(t, err)
+	addSub.Args = append(addSub.Args, name.Bytes()...)
+	removeSub, err := ctypes.NewCall(metadata, "Identity.remove_sub", charlieAddress)
+	assert.NoError(t, err)
+	quitSub, err := ctypes.NewCall(metadata, "Identity.quit_sub")
+	assert.NoError(t, err)
+
+	initializeBlock(t, rt, blockNumber)
+
+	// Sub-accounts can only be added to an identity.
+	res := applySignedExtrinsic(t, rt, addSub, signature.TestKeyringPairAlice, 0)
+	assert.Equal(t, moduleErrorResult(identity.ModuleIndex, errors.ErrorNoIdentity), res)
+
+	res = applySignedExtrinsic(t, rt, setIdentityCall(t, metadata, info), signature.TestKeyringPairAlice, 1)
+	assert.Equal(t, okResult, res)
+
+	res = applySignedExtrinsic(t, rt, addSub, signature.TestKeyringPairAlice, 2)
+	assert.Equal(t, okResult, res)
+	assertReserved(t, storage, keyStorageAccountAlice, aliceAccountInfo, new(big.Int).Add(identityDeposit(info), identity.SubAccountDeposit))
+
+	res = applySignedExtrinsic(t, rt, addSub, signature.TestKeyringPairAlice, 3)
+	assert.Equal(t, moduleErrorResult(identity.ModuleIndex, errors.ErrorAlreadyClaimed), res)
+
+	res = applySignedExtrinsic(t, rt, removeSub, signature.TestKeyringPairAlice, 4)
+	assert.Equal(t, moduleErrorResult(identity.ModuleIndex, errors.ErrorNotOwned), res)
+
+	res = applySignedExtrinsic(t, rt, quitSub, testKeyringPairCharlie, 0)
+	assert.Equal(t, moduleErrorResult(identity.ModuleIndex, errors.ErrorNotSub), res)
+
+	// The deposit of a sub-account which quits is paid to it.
+	res = applySignedExtrinsic(t, rt, quitSub, testKeyringPairBob, 0)
+	assert.Equal(t, okResult, res)
+	assert.Nil(t, (*storage).Get(keySuperOf(testKeyringPairBob.PublicKey)))
+	assertReserved(t, storage, keyStorageAccountAlice, aliceAccountInfo, identityDeposit(info))
+
+	subs := sc.Sequence[primitives.IdentitySubAccount]{
+		{Account: bob, Name: name},
+		{Account: charlie, Name: name},
+	}
+	setSubs, err := ctypes.NewCall(metadata, "Identity.set_subs")
+	assert.NoError(t, err)
+	setSubs.Args = append(setSubs.Args, subs.Bytes()...)
+
+	res = applySignedExtrinsic(t, rt, setSubs, signature.TestKeyringPairAlice, 5)
+	assert.Equal(t, okResult, res)
+	subsDeposit := new(big.Int).Mul(identity.SubAccountDeposit, big.NewInt(2))
+	assertReserved(t, storage, keyStorageAccountAlice, aliceAccountInfo, new(big.Int).Add(identityDeposit(info), subsDeposit))
+
+	clearSubs, err := ctypes.NewCall(metadata, "Identity.set_subs")
+	assert.NoError(t, err)
+	clearSubs.Args = append(clearSubs.Args, sc.Sequence[primitives.IdentitySubAccount]{}.Bytes()...)
+
+	res = applySignedExtrinsic(t, rt, clearSubs, signature.TestKeyringPairAlice, 6)
+	assert.Equal(t, okResult, res)
+	assert.Nil(t, (*storage).Get(keySuperOf(testKeyringPairCharlie.PublicKey)))
+	assertReserved(t, storage, keyStorageAccountAlice, aliceAccountInfo, identityDeposit(info))
+}
+
+func identityInfo(display string) primitives.IdentityInfo {
+	return primitives.IdentityInfo{
+		Additional:     sc.Sequence[primitives.IdentityAdditionalField]{},
+		Display:        primitives.NewIdentityDataRaw(sc.BytesToSequenceU8([]byte(display))),
+		Legal:          primitives.NewIdentityDataNone(),
+		Web:            primitives.NewIdentityDataNone(),
+		Riot:           primitives.NewIdentityDataNone(),
+		Email:          primitives.NewIdentityDataNone(),
+		PgpFingerprint: sc.NewOption[sc.FixedSequence[sc.U8]](nil),
+		Image:          primitives.NewIdentityDataNone(),
+		Twitter:        primitives.NewIdentityDataNone(),
+	}
+}
+
+func identityDeposit(info primitives.IdentityInfo) *big.Int {
+	byteDeposit := new(big.Int).Mul(identity.ByteDeposit, big.NewInt(int64(len(info.Bytes()))))
+	return new(big.Int).Add(identity.BasicDeposit, byteDeposit)
+}
+
+func setIdentityCall(t *testing.T, metadata *ctypes.Metadata, info primitives.IdentityInfo) ctypes.Call {
+	call, err := ctypes.NewCall(metadata, "Identity.set_identity")
+	assert.NoError(t, err)
+	call.Args = append(call.Args, info.Bytes()...)
+
+	return call
+}
+
+// provideJudgementCall returns a judgement of registrar 0 on the identity of Alice, which must be `info`.
+func provideJudgementCall(t *testing.T, metadata *ctypes.Metadata, judgement primitives.Judgement, info primitives.IdentityInfo) ctypes.Call {
+	alice, err := ctypes.NewMultiAddressFromAccountID(signature.TestKeyringPairAlice.PublicKey)
+	assert.NoError(t, err)
+
+	identityHash, err := common.Blake2bHash(info.Bytes())
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Identity.provide_judgement", ctypes.NewUCompactFromUInt(0), alice)
+	assert.NoError(t, err)
+	call.Args = append(call.Args, judgement.Bytes()...)
+	call.Args = append(call.Args, identityHash.ToBytes()...)
+
+	return call
+}
+
+// setIdentityRegistrar sets `account` as the only registrar, at index 0.
+func setIdentityRegistrar(t *testing.T, storage *runtime.Storage, account []byte) {
+	registrars := sc.Sequence[sc.Option[primitives.RegistrarInfo]]{
+		sc.NewOption[primitives.RegistrarInfo](primitives.RegistrarInfo{
+			Account: primitives.NewAccountId(sc.BytesToSequenceU8(account)...),
+			Fee:     sc.NewU128FromUint64(0),
+			Fields:  0,
+		}),
+	}
+
+	err := (*storage).Put(append(keyIdentityHash, keyRegistrarsHash...), registrars.Bytes())
+	assert.NoError(t, err)
+}
+
+func assertReserved(t *testing.T, storage *runtime.Storage, keyStorageAccount []byte, accountInfo gossamertypes.AccountInfo, expected *big.Int) {
+	err := scale.Unmarshal((*storage).Get(keyStorageAccount), &accountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(expected), accountInfo.Data.Reserved)
+}
+
+func keyIdentityOf(who []byte) []byte {
+	whoHash, _ := common.Blake2b128(who)
+
+	key := append(keyIdentityHash, keyIdentityOfHash...)
+	key = append(key, whoHash...)
+	return append(key, who...)
+}
+
+func keySuperOf(who []byte) []byte {
+	whoHash, _ := common.Blake2b128(who)
+
+	key := append(keyIdentityHash, keySuperOfHash...)
+	key = append(key, whoHash...)
+	return append(key, who...)
+}
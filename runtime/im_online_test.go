@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/keystore"
+	"github.com/ChainSafe/gossamer/lib/runtime"
+	"github.com/ChainSafe/gossamer/lib/runtime/wasmer"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/im_online"
+	"github.com/LimeChain/gosemble/constants/staking"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyImOnlineHash, _   = common.Twox128Hash(constants.KeyImOnline)
+	keyKeysHash, _       = common.Twox128Hash(constants.KeyKeys)
+	keyBondedErasHash, _ = common.Twox128Hash(constants.KeyBondedEras)
+)
+
+func Test_ImOnline_ValidateHeartbeat_Success(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	setImOnlineKeys(t, storage, signature.TestKeyringPairAlice.PublicKey)
+
+	heartbeat := primitives.Heartbeat{
+		BlockNumber:    sc.U32(blockNumber),
+		SessionIndex:   0,
+		AuthorityIndex: 0,
+		ValidatorsLen:  1,
+	}
+
+	res := validateHeartbeat(t, rt, metadata, heartbeat, signature.TestKeyringPairAlice)
+
+	expectedValidity := primitives.ValidTransaction{
+		Priority:  im_online.UnsignedPriority,
+		Requires:  sc.Sequence[primitives.TransactionTag]{},
+		Provides:  sc.Sequence[primitives.TransactionTag]{sc.BytesToSequenceU8(append(sc.U32(0).Bytes(), sc.U32(0).Bytes()...))},
+		Longevity: primitives.TransactionLongevity(staking.SessionPeriod / 2),
+		Propagate: true,
+	}
+	assert.Equal(t, primitives.NewTransactionValidityResult(expectedValidity).Bytes(), res)
+}
+
+func Test_ImOnline_ValidateHeartbeat_BadProof(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	setImOnlineKeys(t, storage, signature.TestKeyringPairAlice.PublicKey)
+
+	heartbeat := primitives.Heartbeat{
+		BlockNumber:    sc.U32(blockNumber),
+		SessionIndex:   0,
+		AuthorityIndex: 0,
+		ValidatorsLen:  1,
+	}
+
+	res := validateHeartbeat(t, rt, metadata, heartbeat, testKeyringPairBob)
+
+	assert.Equal(t,
+		primitives.NewTransactionValidityResult(
+			primitives.NewTransactionValidityError(primitives.NewInvalidTransactionBadProof()),
+		).Bytes(),
+		res,
+	)
+}
+
+func Test_ImOnline_OffchainWorker_SubmitsHeartbeat(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	keyring, err := keystore.NewSr25519Keyring()
+	assert.NoError(t, err)
+
+	ctx := rt.GetContext()
+	ctx.Validator = true
+	err = ctx.Keystore.Aura.Insert(keyring.Alice())
+	assert.NoError(t, err)
+
+	setImOnlineKeys(t, storage, signature.TestKeyringPairAlice.PublicKey)
+
+	extrinsics := runOffchainWorker(t, rt)
+	assert.Equal(t, 1, len(extrinsics))
+
+	res := validateTransaction(t, rt, extrinsics[0])
+
+	expectedValidity := primitives.ValidTransaction{
+		Priority:  im_online.UnsignedPriority,
+		Requires:  sc.Sequence[primitives.TransactionTag]{},
+		Provides:  sc.Sequence[primitives.TransactionTag]{sc.BytesToSequenceU8(append(sc.U32(0).Bytes(), sc.U32(0).Bytes()...))},
+		Longevity: primitives.TransactionLongevity(staking.SessionPeriod / 2),
+		Propagate: true,
+	}
+	assert.Equal(t, primitives.NewTransactionValidityResult(expectedValidity).Bytes(), res)
+}
+
+func Test_ImOnline_EndSession_ReportsKeyAccounts(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	// Alice's key is the account of an elected stash, while Bob's key is not a stash.
+	alice := setStakingStash(t, storage, signature.TestKeyringPairAlice.PublicKey, 10)
+	setStakingValidator(t, storage, alice)
+	setImOnlineKeys(t, storage, signature.TestKeyringPairAlice.PublicKey, testKeyringPairBob.PublicKey)
+
+	exposure := primitives.Exposure{
+		Total:  stakingDollars(10),
+		Own:    stakingDollars(10),
+		Others: sc.Sequence[primitives.IndividualExposure]{},
+	}
+	putStakingStorage(t, storage, append(keyStakingHash, keyActiveEraHash...), primitives.ActiveEraInfo{Index: 0, Start: sc.NewOption[sc.U64](nil)}.Bytes())
+	putStakingStorage(t, storage, append(keyStakingHash, keyCurrentEraHash...), sc.NewOption[sc.U32](sc.U32(0)).Bytes())
+	putStakingStorage(t, storage, keyStakingMap(keyErasStartSessionIndexHash, sc.U32(0).Bytes()), sc.U32(0).Bytes())
+	putStakingStorage(t, storage, append(keyStakingHash, keyBondedErasHash...), sc.Sequence[primitives.BondedEra]{{Era: 0, StartSession: 0}}.Bytes())
+	putStakingStorage(t, storage, keyStakingMap(keyErasStakersHash, sc.U32(0).Bytes(), alice.Bytes()), exposure.Bytes())
+
+	// No heartbeats are received in session 0.
+	initializeBlock(t, rt, uint(staking.SessionPeriod))
+
+	// Both authorities are reported with the account of their key, but only Alice is exposed in
+	// the era, so only her slash is deferred. The slash is the maximum, as both authorities are
+	// offline and only one validator is elected.
+	expectedSlashes := sc.Sequence[primitives.UnappliedSlash]{
+		{
+			Validator: alice,
+			Own:       sc.NewU128FromUint64(7 * constants.Dollar / 10),
+			Others:    sc.Sequence[primitives.StakingSlash]{},
+			Reporters: sc.Sequence[primitives.AccountId]{},
+			Payout:    sc.NewU128FromUint64(0),
+		},
+	}
+	assert.Equal(t, expectedSlashes.Bytes(), (*storage).Get(keyStakingMap(keyUnappliedSlashesHash, staking.SlashDeferDuration.Bytes())))
+	assert.Nil(t, (*storage).Get(keyStakingMap(keyValidatorsHash, alice.Bytes())))
+}
+
+func setImOnlineKeys(t *testing.T, storage *runtime.Storage, keys ...[]byte) {
+	sequence := sc.Sequence[primitives.Address32]{}
+	for _, key := range keys {
+		sequence = append(sequence, primitives.NewAddress32(sc.BytesToSequenceU8(key)...))
+	}
+
+	err := (*storage).Put(append(keyImOnlineHash, keyKeysHash...), sequence.Bytes())
+	assert.NoError(t, err)
+}
+
+func validateHeartbeat(t *testing.T, rt *wasmer.Instance, metadata *ctypes.Metadata, heartbeat primitives.Heartbeat, signer signature.KeyringPair) []byte {
+	heartbeatSignature, err := signature.Sign(heartbeat.Bytes(), signer.URI)
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "ImOnline.heartbeat")
+	assert.NoError(t, err)
+	call.Args = append(call.Args, heartbeat.Bytes()...)
+	call.Args = append(call.Args, heartbeatSignature...)
+
+	extrinsic := newExtrinsic(call)
+
+	buffer := &bytes.Buffer{}
+	primitives.NewTransactionSourceExternal().Encode(buffer)
+
+	encoder := cscale.NewEncoder(buffer)
+	err = extrinsic.Encode(*encoder)
+	assert.NoError(t, err)
+
+	sc.BytesToFixedSequenceU8(parentHash.ToBytes()).Encode(buffer)
+
+	res, err := rt.Exec("TaggedTransactionQueue_validate_transaction", buffer.Bytes())
+	assert.NoError(t, err)
+
+	return res
+}
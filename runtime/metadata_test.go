@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	metadataconstants "github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/primitives/types"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
 	"github.com/stretchr/testify/assert"
@@ -38,3 +40,74 @@ func Test_Metadata_Encoding_Success(t *testing.T) {
 
 	assert.Equal(t, metadata.Bytes(), bGossamerMetadata)
 }
+
+func Test_Metadata_Versions(t *testing.T) {
+	runtime, _ := newTestRuntime(t)
+
+	result, err := runtime.Exec("Metadata_metadata_versions", []byte{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, sc.Sequence[sc.U32]{14, 15}.Bytes(), result)
+}
+
+func Test_Metadata_AtVersion_14(t *testing.T) {
+	runtime, _ := newTestRuntime(t)
+
+	bMetadata, err := runtime.Metadata()
+	assert.NoError(t, err)
+
+	result, err := runtime.Exec("Metadata_metadata_at_version", sc.U32(14).Bytes())
+	assert.NoError(t, err)
+
+	assert.Equal(t, append([]byte{1}, bMetadata...), result)
+}
+
+func Test_Metadata_AtVersion_15(t *testing.T) {
+	runtime, _ := newTestRuntime(t)
+
+	result, err := runtime.Exec("Metadata_metadata_at_version", sc.U32(15).Bytes())
+	assert.NoError(t, err)
+
+	buffer := bytes.NewBuffer(result)
+
+	option := sc.DecodeOptionWith(buffer, sc.DecodeSequence[sc.U8])
+	assert.True(t, option.HasValue)
+
+	bMetadata := sc.SequenceU8ToBytes(option.Value)
+	metadata, err := types.DecodeMetadataV15(bytes.NewBuffer(bMetadata))
+	assert.NoError(t, err)
+
+	// Assert encoding of previously decoded
+	assert.Equal(t, bMetadata, metadata.Bytes())
+
+	apis := map[sc.Str]types.RuntimeApiMetadata{}
+	for _, api := range metadata.Data.Apis {
+		apis[api.Name] = api
+	}
+	assert.Len(t, apis, len(constants.RuntimeVersion.Apis))
+
+	var methods []string
+	for _, method := range apis["Metadata"].Methods {
+		methods = append(methods, string(method.Name))
+	}
+	assert.Equal(t, []string{"metadata", "metadata_at_version", "metadata_versions"}, methods)
+
+	assert.Equal(t, sc.ToCompact(metadataconstants.RuntimeCall), metadata.Data.OuterEnums.Call)
+	assert.Equal(t, sc.ToCompact(metadataconstants.TypesRuntimeEvent), metadata.Data.OuterEnums.Event)
+	assert.Equal(t, sc.ToCompact(metadataconstants.TypesRuntimeError), metadata.Data.OuterEnums.Error)
+
+	for _, module := range metadata.Data.Modules {
+		assert.NotEmpty(t, module.Docs, string(module.Name))
+	}
+
+	assert.Equal(t, types.CustomMetadata{types.NewCustomValueMetadata("SS58Prefix", metadataconstants.PrimitiveTypesU16, sc.BytesToSequenceU8(sc.U16(42).Bytes()))}, metadata.Data.Custom)
+}
+
+func Test_Metadata_AtVersion_Unsupported(t *testing.T) {
+	runtime, _ := newTestRuntime(t)
+
+	result, err := runtime.Exec("Metadata_metadata_at_version", sc.U32(16).Bytes())
+	assert.NoError(t, err)
+
+	assert.Equal(t, []byte{0}, result)
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/runtime"
+	"github.com/ChainSafe/gossamer/lib/runtime/wasmer"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/nfts"
+	"github.com/LimeChain/gosemble/frame/nfts/errors"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyNftsHash, _             = common.Twox128Hash(constants.KeyNfts)
+	keyCollectionHash, _       = common.Twox128Hash(constants.KeyCollection)
+	keyNextCollectionIdHash, _ = common.Twox128Hash(constants.KeyNextCollectionId)
+	keyItemHash, _             = common.Twox128Hash(constants.KeyItem)
+)
+
+func Test_Nfts_Create_Success(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	metadata := runtimeMetadata(t, rt)
+
+	bob, err := ctypes.NewMultiAddressFromAccountID(testKeyringPairBob.PublicKey)
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Nfts.create", bob)
+	assert.NoError(t, err)
+
+	// Create the extrinsic
+	ext := newExtrinsic(call)
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(0),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+
+	// Set Account Info
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	keyStorageAccountAlice, aliceAccountInfo := setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	// Sign the transaction using Alice's default account
+	err = ext.Sign(signature.TestKeyringPairAlice, o)
+	assert.NoError(t, err)
+
+	extEnc := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&extEnc)
+	err = ext.Encode(*encoder)
+	assert.NoError(t, err)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t,
+		primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(),
+		res,
+	)
+
+	collection := sc.U32(0)
+	collectionHash, err := common.Blake2b128(collection.Bytes())
+	assert.NoError(t, err)
+
+	keyCollection := append(keyNftsHash, keyCollectionHash...)
+	keyCollection = append(keyCollection, collectionHash...)
+	keyCollection = append(keyCollection, collection.Bytes()...)
+
+	alice := primitives.NewAddress32(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...)
+	admin := primitives.NewAddress32(sc.BytesToSequenceU8(testKeyringPairBob.PublicKey)...)
+	expectedDetails := primitives.CollectionDetails{
+		Owner:        alice,
+		Issuer:       admin,
+		Admin:        admin,
+		Freezer:      admin,
+		OwnerDeposit: sc.NewU128FromBigInt(nfts.CollectionDeposit),
+	}
+	assert.Equal(t, expectedDetails.Bytes(), (*storage).Get(keyCollection))
+
+	keyNextCollectionId := append(keyNftsHash, keyNextCollectionIdHash...)
+	assert.Equal(t, sc.U32(1).Bytes(), (*storage).Get(keyNextCollectionId))
+
+	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
+	err = scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(nfts.CollectionDeposit), aliceAccountInfo.Data.Reserved)
+}
+
+func Test_Nfts_Transfer_ApprovalExpired(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	fundNftsAccounts(t, storage)
+
+	bob, err := ctypes.NewMultiAddressFromAccountID(testKeyringPairBob.PublicKey)
+	assert.NoError(t, err)
+
+	initializeBlock(t, rt, blockNumber)
+	createAndMintNft(t, rt, metadata, signature.TestKeyringPairAlice.PublicKey)
+
+	approve, err := ctypes.NewCall(metadata, "Nfts.approve_transfer", ctypes.NewU32(0), ctypes.NewU32(1), bob, ctypes.NewOptionU32(ctypes.NewU32(5)))
+	assert.NoError(t, err)
+	transfer, err := ctypes.NewCall(metadata, "Nfts.transfer", ctypes.NewU32(0), ctypes.NewU32(1), bob)
+	assert.NoError(t, err)
+
+	res := applySignedExtrinsic(t, rt, approve, signature.TestKeyringPairAlice, 2)
+	assert.Equal(t, okResult, res)
+
+	// Only the owner and approved delegates can transfer.
+	res = applySignedExtrinsic(t, rt, transfer, testKeyringPairCharlie, 0)
+	assert.Equal(t, moduleErrorResult(nfts.ModuleIndex, errors.ErrorNoPermission), res)
+
+	// The approval given at block 1 expires after block 6.
+	initializeBlock(t, rt, 7)
+
+	res = applySignedExtrinsic(t, rt, transfer, testKeyringPairBob, 0)
+	assert.Equal(t, moduleErrorResult(nfts.ModuleIndex, errors.ErrorApprovalExpired), res)
+
+	approveForever, err := ctypes.NewCall(metadata, "Nfts.approve_transfer", ctypes.NewU32(0), ctypes.NewU32(1), bob, ctypes.NewOptionU32Empty())
+	assert.NoError(t, err)
+
+	res = applySignedExtrinsic(t, rt, approveForever, signature.TestKeyringPairAlice, 3)
+	assert.Equal(t, okResult, res)
+
+	res = applySignedExtrinsic(t, rt, transfer, testKeyringPairBob, 1)
+	assert.Equal(t, okResult, res)
+
+	expectedItem := nftItemDetails(testKeyringPairBob.PublicKey, false)
+	assert.Equal(t, expectedItem.Bytes(), (*storage).Get(keyNftItem(0, 1)))
+}
+
+func Test_Nfts_Transfer_ItemLocked(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	fundNftsAccounts(t, storage)
+
+	charlie, err := ctypes.NewMultiAddressFromAccountID(testKeyringPairCharlie.PublicKey)
+	assert.NoError(t, err)
+
+	initializeBlock(t, rt, blockNumber)
+	createAndMintNft(t, rt, metadata, testKeyringPairBob.PublicKey)
+
+	lock, err := ctypes.NewCall(metadata, "Nfts.lock_item_transfer", ctypes.NewU32(0), ctypes.NewU32(1))
+	assert.NoError(t, err)
+	unlock, err := ctypes.NewCall(metadata, "Nfts.unlock_item_transfer", ctypes.NewU32(0), ctypes.NewU32(1))
+	assert.NoError(t, err)
+	transfer, err := ctypes.NewCall(metadata, "Nfts.transfer", ctypes.NewU32(0), ctypes.NewU32(1), charlie)
+	assert.NoError(t, err)
+	approve, err := ctypes.NewCall(metadata, "Nfts.approve_transfer", ctypes.NewU32(0), ctypes.NewU32(1), charlie, ctypes.NewOptionU32Empty())
+	assert.NoError(t, err)
+
+	// Only the freezer of the collection can lock items, not their owner.
+	res := applySignedExtrinsic(t, rt, lock, testKeyringPairBob, 0)
+	assert.Equal(t, moduleErrorResult(nfts.ModuleIndex, errors.ErrorNoPermission), res)
+
+	res = applySignedExtrinsic(t, rt, lock, signature.TestKeyringPairAlice, 2)
+	assert.Equal(t, okResult, res)
+
+	res = applySignedExtrinsic(t, rt, transfer, testKeyringPairBob, 1)
+	assert.Equal(t, moduleErrorResult(nfts.ModuleIndex, errors.ErrorItemLocked), res)
+
+	res = applySignedExtrinsic(t, rt, approve, testKeyringPairBob, 2)
+	assert.Equal(t, moduleErrorResult(nfts.ModuleIndex, errors.ErrorItemLocked), res)
+
+	expectedItem := nftItemDetails(testKeyringPairBob.PublicKey, true)
+	assert.Equal(t, expectedItem.Bytes(), (*storage).Get(keyNftItem(0, 1)))
+
+	res = applySignedExtrinsic(t, rt, unlock, signature.TestKeyringPairAlice, 3)
+	assert.Equal(t, okResult, res)
+
+	res = applySignedExtrinsic(t, rt, transfer, testKeyringPairBob, 3)
+	assert.Equal(t, okResult, res)
+
+	expectedItem = nftItemDetails(testKeyringPairCharlie.PublicKey, false)
+	assert.Equal(t, expectedItem.Bytes(), (*storage).Get(keyNftItem(0, 1)))
+}
+
+func fundNftsAccounts(t *testing.T, storage *runtime.Storage) {
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+	setStorageAccountInfo(t, storage, testKeyringPairBob.PublicKey, balance, 0)
+	setStorageAccountInfo(t, storage, testKeyringPairCharlie.PublicKey, balance, 0)
+}
+
+// createAndMintNft creates collection 0, owned and administered by Alice, and mints item 1 to `owner`.
+// Uses the nonces 0 and 1 of Alice.
+func createAndMintNft(t *testing.T, rt *wasmer.Instance, metadata *ctypes.Metadata, owner []byte) {
+	alice, err := ctypes.NewMultiAddressFromAccountID(signature.TestKeyringPairAlice.PublicKey)
+	assert.NoError(t, err)
+	mintTo, err := ctypes.NewMultiAddressFromAccountID(owner)
+	assert.NoError(t, err)
+
+	create, err := ctypes.NewCall(metadata, "Nfts.create", alice)
+	assert.NoError(t, err)
+	res := applySignedExtrinsic(t, rt, create, signature.TestKeyringPairAlice, 0)
+	assert.Equal(t, okResult, res)
+
+	mint, err := ctypes.NewCall(metadata, "Nfts.mint", ctypes.NewU32(0), ctypes.NewU32(1), mintTo)
+	assert.NoError(t, err)
+	res = applySignedExtrinsic(t, rt, mint, signature.TestKeyringPairAlice, 1)
+	assert.Equal(t, okResult, res)
+}
+
+func nftItemDetails(owner []byte, isTransferLocked sc.Bool) primitives.ItemDetails {
+	return primitives.ItemDetails{
+		Owner:     primitives.NewAccountId(sc.BytesToSequenceU8(owner)...),
+		Approvals: sc.Sequence[primitives.ItemApproval]{},
+		Deposit: primitives.ItemDeposit{
+			Account: primitives.NewAccountId(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...),
+			Amount:  sc.NewU128FromBigInt(nfts.ItemDeposit),
+		},
+		IsTransferLocked: isTransferLocked,
+	}
+}
+
+func keyNftItem(collection sc.U32, item sc.U32) []byte {
+	collectionHash, _ := common.Blake2b128(collection.Bytes())
+	itemHash, _ := common.Blake2b128(item.Bytes())
+
+	key := append(keyNftsHash, keyItemHash...)
+	key = append(key, collectionHash...)
+	key = append(key, collection.Bytes()...)
+	key = append(key, itemHash...)
+	return append(key, item.Bytes()...)
+}
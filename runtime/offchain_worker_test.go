@@ -2,16 +2,24 @@ package main
 
 import (
 	"bytes"
+	"math/big"
 	"testing"
 	"time"
 
 	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
 	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/keystore"
+	"github.com/ChainSafe/gossamer/lib/runtime/wasmer"
+	"github.com/ChainSafe/gossamer/lib/transaction"
 	"github.com/ChainSafe/gossamer/pkg/scale"
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/aura"
+	"github.com/LimeChain/gosemble/constants/testable"
 	"github.com/LimeChain/gosemble/primitives/types"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -78,3 +86,112 @@ func Test_Offchain_Worker(t *testing.T) {
 
 	assert.Equal(t, expectedBlockHash.ToBytes(), (*storage).Get(blockHashKey))
 }
+
+func Test_Offchain_Worker_SubmitsSignedTransaction(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	metadata := runtimeMetadata(t, rt)
+
+	balance, e := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, e)
+	setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	keyring, err := keystore.NewSr25519Keyring()
+	assert.NoError(t, err)
+
+	ctx := rt.GetContext()
+	err = ctx.Keystore.Acco.Insert(keyring.Alice())
+	assert.NoError(t, err)
+
+	remark := []byte("offchain remark")
+	err = ctx.NodeStorage.PersistentStorage.Put(testable.OffchainRemarkKey, remark)
+	assert.NoError(t, err)
+
+	extrinsics := runOffchainWorker(t, rt)
+	assert.Equal(t, 1, len(extrinsics))
+
+	// The remark is submitted once.
+	_, err = ctx.NodeStorage.PersistentStorage.Get(testable.OffchainRemarkKey)
+	assert.Error(t, err)
+
+	// The extrinsic is encoded as the one signed by gsrpc, except for the sr25519 signature,
+	// which is not deterministic. Its signature is checked by the validation.
+	call, err := ctypes.NewCall(metadata, "System.remark", remark)
+	assert.NoError(t, err)
+
+	expectedExtrinsic := newExtrinsic(call)
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(0),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+	err = expectedExtrinsic.Sign(signature.TestKeyringPairAlice, o)
+	assert.NoError(t, err)
+
+	// The signature follows the version, and the variant and account of the signer.
+	signatureOffset := 1 + 33 + 1
+	expectedExtrinsic.Signature.Signature.AsSr25519 = ctypes.NewSignature(extrinsics[0][signatureOffset : signatureOffset+64])
+
+	buffer := &bytes.Buffer{}
+	err = expectedExtrinsic.Encode(*cscale.NewEncoder(buffer))
+	assert.NoError(t, err)
+	assert.Equal(t, buffer.Bytes(), sc.BytesToSequenceU8(extrinsics[0]).Bytes())
+
+	buffer = bytes.NewBuffer(validateTransaction(t, rt, extrinsics[0]))
+	assert.Equal(t, sc.Bool(true), types.DecodeTransactionValidityResult(buffer).IsValidTransaction())
+}
+
+func Test_Offchain_Worker_NoLocalAccount(t *testing.T) {
+	rt, _ := newTestRuntime(t)
+
+	err := rt.GetContext().NodeStorage.PersistentStorage.Put(testable.OffchainRemarkKey, []byte("offchain remark"))
+	assert.NoError(t, err)
+
+	extrinsics := runOffchainWorker(t, rt)
+	assert.Equal(t, 0, len(extrinsics))
+}
+
+// testTransactionPool records the extrinsics that the offchain workers submit.
+type testTransactionPool struct {
+	extrinsics [][]byte
+}
+
+func (tp *testTransactionPool) AddToPool(vt *transaction.ValidTransaction) common.Hash {
+	tp.extrinsics = append(tp.extrinsics, vt.Extrinsic)
+	return common.Hash{}
+}
+
+// runOffchainWorker runs the offchain workers of the block and returns the extrinsics they submit.
+func runOffchainWorker(t *testing.T, rt *wasmer.Instance) [][]byte {
+	pool := &testTransactionPool{}
+	rt.GetContext().Transaction = pool
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("OffchainWorkerApi_offchain_worker", encodedHeader)
+	assert.NoError(t, err)
+
+	return pool.extrinsics
+}
+
+// validateTransaction validates an extrinsic submitted by an offchain worker. The host strips the
+// length prefix of the submitted extrinsics, so it is added back.
+func validateTransaction(t *testing.T, rt *wasmer.Instance, extrinsic []byte) []byte {
+	buffer := &bytes.Buffer{}
+	types.NewTransactionSourceLocal().Encode(buffer)
+	buffer.Write(sc.BytesToSequenceU8(extrinsic).Bytes())
+	sc.BytesToFixedSequenceU8(parentHash.ToBytes()).Encode(buffer)
+
+	res, err := rt.Exec("TaggedTransactionQueue_validate_transaction", buffer.Bytes())
+	assert.NoError(t, err)
+
+	return res
+}
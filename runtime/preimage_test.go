@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/preimage"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyPreimageHash, _    = common.Twox128Hash(constants.KeyPreimage)
+	keyStatusForHash, _   = common.Twox128Hash(constants.KeyStatusFor)
+	keyPreimageForHash, _ = common.Twox128Hash(constants.KeyPreimageFor)
+)
+
+func Test_Preimage_NotePreimage_Success(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	metadata := runtimeMetadata(t, rt)
+
+	preimageBytes := []byte("preimage of a call")
+
+	call, err := ctypes.NewCall(metadata, "Preimage.note_preimage", preimageBytes)
+	assert.NoError(t, err)
+
+	// Create the extrinsic
+	ext := newExtrinsic(call)
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(0),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+
+	// Set Account Info
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	keyStorageAccountAlice, aliceAccountInfo := setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	// Sign the transaction using Alice's default account
+	err = ext.Sign(signature.TestKeyringPairAlice, o)
+	assert.NoError(t, err)
+
+	extEnc := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&extEnc)
+	err = ext.Encode(*encoder)
+	assert.NoError(t, err)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t,
+		primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(),
+		res,
+	)
+
+	length := sc.U32(len(preimageBytes))
+	deposit := new(big.Int).Add(
+		preimage.BaseDeposit,
+		new(big.Int).Mul(preimage.ByteDeposit, big.NewInt(int64(length))),
+	)
+
+	hash, err := common.Blake2bHash(preimageBytes)
+	assert.NoError(t, err)
+
+	keyStatusFor := append(keyPreimageHash, keyStatusForHash...)
+	keyStatusFor = append(keyStatusFor, hash.ToBytes()...)
+
+	expectedStatus := primitives.NewRequestStatusUnrequested(
+		primitives.AccountDeposit{
+			Who:    primitives.NewAddress32(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...),
+			Amount: sc.NewU128FromBigInt(deposit),
+		},
+		length,
+	)
+	assert.Equal(t, expectedStatus.Bytes(), (*storage).Get(keyStatusFor))
+
+	keyPreimageFor := append(keyPreimageHash, keyPreimageForHash...)
+	keyPreimageFor = append(keyPreimageFor, hash.ToBytes()...)
+	keyPreimageFor = append(keyPreimageFor, length.Bytes()...)
+
+	assert.Equal(t, sc.BytesToSequenceU8(preimageBytes).Bytes(), (*storage).Get(keyPreimageFor))
+
+	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
+	err = scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(deposit), aliceAccountInfo.Data.Reserved)
+}
+
+func Test_Preimage_RequestPreimage_BadOrigin(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	metadata := runtimeMetadata(t, rt)
+
+	hash, err := common.Blake2bHash([]byte("preimage of a call"))
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Preimage.request_preimage", ctypes.NewHash(hash.ToBytes()))
+	assert.NoError(t, err)
+
+	// Create the extrinsic
+	ext := newExtrinsic(call)
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(0),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+
+	// Set Account Info
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	// Sign the transaction using Alice's default account
+	err = ext.Sign(signature.TestKeyringPairAlice, o)
+	assert.NoError(t, err)
+
+	extEnc := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&extEnc)
+	err = ext.Encode(*encoder)
+	assert.NoError(t, err)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+
+	expectedResult :=
+		primitives.NewApplyExtrinsicResult(
+			primitives.NewDispatchOutcome(
+				primitives.NewDispatchErrorBadOrigin()))
+
+	assert.Equal(t, expectedResult.Bytes(), res)
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"testing"
+
+	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyRandomnessCollectiveFlipHash, _ = common.Twox128Hash(constants.KeyRandomnessCollectiveFlip)
+	keyRandomMaterialHash, _           = common.Twox128Hash(constants.KeyRandomMaterial)
+)
+
+func Test_RandomnessCollectiveFlip_RandomMaterial(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	expectedMaterial := sc.Sequence[primitives.H256]{primitives.NewH256(sc.BytesToSequenceU8(parentHash.ToBytes())...)}
+
+	assert.Equal(t, expectedMaterial.Bytes(), (*storage).Get(append(keyRandomnessCollectiveFlipHash, keyRandomMaterialHash...)))
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/runtime"
+	"github.com/ChainSafe/gossamer/lib/runtime/wasmer"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/recovery"
+	"github.com/LimeChain/gosemble/frame/recovery/errors"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyRecoveryHash, _    = common.Twox128Hash(constants.KeyRecovery)
+	keyRecoverableHash, _ = common.Twox128Hash(constants.KeyRecoverable)
+	keyProxyHash, _       = common.Twox128Hash(constants.KeyProxy)
+)
+
+func Test_Recovery_CreateRecovery_Success(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	metadata := runtimeMetadata(t, rt)
+
+	bob := primitives.NewAddress32(sc.BytesToSequenceU8(testKeyringPairBob.PublicKey)...)
+	friends := sc.Sequence[primitives.Address32]{bob}
+	threshold := sc.U16(1)
+	delayPeriod := sc.U32(10)
+
+	call, err := ctypes.NewCall(metadata, "Recovery.create_recovery")
+	assert.NoError(t, err)
+	call.Args = append(call.Args, friends.Bytes()...)
+	call.Args = append(call.Args, threshold.Bytes()...)
+	call.Args = append(call.Args, delayPeriod.Bytes()...)
+
+	// Create the extrinsic
+	ext := newExtrinsic(call)
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(0),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+
+	// Set Account Info
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	keyStorageAccountAlice, aliceAccountInfo := setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	// Sign the transaction using Alice's default account
+	err = ext.Sign(signature.TestKeyringPairAlice, o)
+	assert.NoError(t, err)
+
+	extEnc := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&extEnc)
+	err = ext.Encode(*encoder)
+	assert.NoError(t, err)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t,
+		primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(),
+		res,
+	)
+
+	aliceHash, err := common.Twox64(signature.TestKeyringPairAlice.PublicKey)
+	assert.NoError(t, err)
+
+	keyRecoverable := append(keyRecoveryHash, keyRecoverableHash...)
+	keyRecoverable = append(keyRecoverable, aliceHash...)
+	keyRecoverable = append(keyRecoverable, signature.TestKeyringPairAlice.PublicKey...)
+
+	friendDeposit := new(big.Int).Mul(recovery.FriendDepositFactor, big.NewInt(int64(len(friends))))
+	deposit := new(big.Int).Add(recovery.ConfigDepositBase, friendDeposit)
+
+	expectedConfig := primitives.RecoveryConfig{
+		DelayPeriod: delayPeriod,
+		Deposit:     sc.NewU128FromBigInt(deposit),
+		Friends:     friends,
+		Threshold:   threshold,
+	}
+	assert.Equal(t, expectedConfig.Bytes(), (*storage).Get(keyRecoverable))
+
+	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
+	err = scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(deposit), aliceAccountInfo.Data.Reserved)
+}
+
+func Test_Recovery_ClaimRecovery_DelayAndThreshold(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	fundRecoveryAccounts(t, storage)
+
+	alice, err := ctypes.NewMultiAddressFromAccountID(signature.TestKeyringPairAlice.PublicKey)
+	assert.NoError(t, err)
+	charlie, err := ctypes.NewMultiAddressFromAccountID(testKeyringPairCharlie.PublicKey)
+	assert.NoError(t, err)
+
+	vouch, err := ctypes.NewCall(metadata, "Recovery.vouch_recovery", alice, charlie)
+	assert.NoError(t, err)
+	claim, err := ctypes.NewCall(metadata, "Recovery.claim_recovery", alice)
+	assert.NoError(t, err)
+
+	initializeBlock(t, rt, 1)
+	createAliceRecovery(t, rt, metadata, 2, 10)
+	initiateRecoveryOfAlice(t, rt, metadata)
+
+	// Only the friends of Alice can vouch, and only once.
+	assert.Equal(t, moduleErrorResult(recovery.ModuleIndex, errors.ErrorNotFriend), applySignedExtrinsic(t, rt, vouch, signature.TestKeyringPairAlice, 1))
+	assert.Equal(t, okResult, applySignedExtrinsic(t, rt, vouch, testKeyringPairBob, 0))
+	assert.Equal(t, moduleErrorResult(recovery.ModuleIndex, errors.ErrorAlreadyVouched), applySignedExtrinsic(t, rt, vouch, testKeyringPairBob, 1))
+
+	// The recovery cannot be claimed before the delay period has passed.
+	assert.Equal(t, moduleErrorResult(recovery.ModuleIndex, errors.ErrorDelayPeriod), applySignedExtrinsic(t, rt, claim, testKeyringPairCharlie, 1))
+
+	initializeBlock(t, rt, 10)
+	assert.Equal(t, moduleErrorResult(recovery.ModuleIndex, errors.ErrorDelayPeriod), applySignedExtrinsic(t, rt, claim, testKeyringPairCharlie, 2))
+
+	// Once it has passed, the threshold of 2 vouches must still be reached.
+	initializeBlock(t, rt, 11)
+	assert.Equal(t, moduleErrorResult(recovery.ModuleIndex, errors.ErrorThreshold), applySignedExtrinsic(t, rt, claim, testKeyringPairCharlie, 3))
+
+	assert.Equal(t, okResult, applySignedExtrinsic(t, rt, vouch, testKeyringPairCharlie, 4))
+	assert.Equal(t, okResult, applySignedExtrinsic(t, rt, claim, testKeyringPairCharlie, 5))
+	assert.Equal(t, moduleErrorResult(recovery.ModuleIndex, errors.ErrorAlreadyProxy), applySignedExtrinsic(t, rt, claim, testKeyringPairCharlie, 6))
+
+	assert.Equal(t, primitives.NewAddress32(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...).Bytes(), (*storage).Get(keyRecoveryProxy(testKeyringPairCharlie.PublicKey)))
+}
+
+func Test_Recovery_AsRecovered_Permission(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	fundRecoveryAccounts(t, storage)
+
+	alice, err := ctypes.NewMultiAddressFromAccountID(signature.TestKeyringPairAlice.PublicKey)
+	assert.NoError(t, err)
+	charlie, err := ctypes.NewMultiAddressFromAccountID(testKeyringPairCharlie.PublicKey)
+	assert.NoError(t, err)
+
+	// The transfer is made to a new account, so that its balance is only the transferred amount.
+	dave := common.MustHexToBytes("0x306721211d5404bd9da88e0204360a1a9ab8b87c66c1bc2fcdd37f3c2222cc20")
+	daveAddress, err := ctypes.NewMultiAddressFromAccountID(dave)
+	assert.NoError(t, err)
+
+	amount := new(big.Int).SetUint64(constants.Dollar)
+	transfer, err := ctypes.NewCall(metadata, "Balances.transfer", daveAddress, ctypes.NewUCompact(amount))
+	assert.NoError(t, err)
+	transferEnc := bytes.Buffer{}
+	err = cscale.NewEncoder(&transferEnc).Encode(transfer)
+	assert.NoError(t, err)
+
+	asRecovered, err := ctypes.NewCall(metadata, "Recovery.as_recovered", alice)
+	assert.NoError(t, err)
+	asRecovered.Args = append(asRecovered.Args, transferEnc.Bytes()...)
+
+	vouch, err := ctypes.NewCall(metadata, "Recovery.vouch_recovery", alice, charlie)
+	assert.NoError(t, err)
+	claim, err := ctypes.NewCall(metadata, "Recovery.claim_recovery", alice)
+	assert.NoError(t, err)
+	cancel, err := ctypes.NewCall(metadata, "Recovery.cancel_recovered", alice)
+	assert.NoError(t, err)
+
+	initializeBlock(t, rt, 1)
+	createAliceRecovery(t, rt, metadata, 1, 0)
+	initiateRecoveryOfAlice(t, rt, metadata)
+
+	// Charlie cannot act on behalf of Alice before claiming the recovery.
+	assert.Equal(t, moduleErrorResult(recovery.ModuleIndex, errors.ErrorNotAllowed), applySignedExtrinsic(t, rt, asRecovered, testKeyringPairCharlie, 1))
+
+	assert.Equal(t, okResult, applySignedExtrinsic(t, rt, vouch, testKeyringPairBob, 0))
+	assert.Equal(t, okResult, applySignedExtrinsic(t, rt, claim, testKeyringPairCharlie, 2))
+
+	// Bob vouched for the recovery, but only Charlie, who claimed it, can act on behalf of Alice.
+	assert.Equal(t, moduleErrorResult(recovery.ModuleIndex, errors.ErrorNotAllowed), applySignedExtrinsic(t, rt, asRecovered, testKeyringPairBob, 1))
+
+	assert.Equal(t, okResult, applySignedExtrinsic(t, rt, asRecovered, testKeyringPairCharlie, 3))
+	assert.Equal(t, scale.MustNewUint128(amount), freeBalance(t, storage, dave))
+
+	// Once cancelled, the recovered account can no longer be used.
+	assert.Equal(t, okResult, applySignedExtrinsic(t, rt, cancel, testKeyringPairCharlie, 4))
+	assert.Equal(t, moduleErrorResult(recovery.ModuleIndex, errors.ErrorNotAllowed), applySignedExtrinsic(t, rt, asRecovered, testKeyringPairCharlie, 5))
+	assert.Nil(t, (*storage).Get(keyRecoveryProxy(testKeyringPairCharlie.PublicKey)))
+}
+
+// fundRecoveryAccounts funds Alice, Bob and Charlie. The accounts are provided, as the rescuer
+// becomes a consumer of its account when it claims a recovery.
+func fundRecoveryAccounts(t *testing.T, storage *runtime.Storage) {
+	setProvidedStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey)
+	setProvidedStorageAccountInfo(t, storage, testKeyringPairBob.PublicKey)
+	setProvidedStorageAccountInfo(t, storage, testKeyringPairCharlie.PublicKey)
+}
+
+// createAliceRecovery makes Alice recoverable by `threshold` of Bob and Charlie, signed with her nonce 0.
+func createAliceRecovery(t *testing.T, rt *wasmer.Instance, metadata *ctypes.Metadata, threshold sc.U16, delayPeriod sc.U32) {
+	friends := sc.Sequence[primitives.Address32]{
+		primitives.NewAddress32(sc.BytesToSequenceU8(testKeyringPairBob.PublicKey)...),
+		primitives.NewAddress32(sc.BytesToSequenceU8(testKeyringPairCharlie.PublicKey)...),
+	}
+
+	call, err := ctypes.NewCall(metadata, "Recovery.create_recovery")
+	assert.NoError(t, err)
+	call.Args = append(call.Args, friends.Bytes()...)
+	call.Args = append(call.Args, threshold.Bytes()...)
+	call.Args = append(call.Args, delayPeriod.Bytes()...)
+
+	assert.Equal(t, okResult, applySignedExtrinsic(t, rt, call, signature.TestKeyringPairAlice, 0))
+}
+
+// initiateRecoveryOfAlice starts the recovery of Alice by Charlie, signed with his nonce 0.
+func initiateRecoveryOfAlice(t *testing.T, rt *wasmer.Instance, metadata *ctypes.Metadata) {
+	alice, err := ctypes.NewMultiAddressFromAccountID(signature.TestKeyringPairAlice.PublicKey)
+	assert.NoError(t, err)
+
+	call, err := ctypes.NewCall(metadata, "Recovery.initiate_recovery", alice)
+	assert.NoError(t, err)
+
+	assert.Equal(t, okResult, applySignedExtrinsic(t, rt, call, testKeyringPairCharlie, 0))
+}
+
+func keyRecoveryProxy(who []byte) []byte {
+	whoHash, _ := common.Blake2b128(who)
+
+	key := append(append([]byte{}, keyRecoveryHash...), keyProxyHash...)
+	key = append(key, whoHash...)
+	return append(key, who...)
+}
@@ -3,126 +3,10 @@ Targets WebAssembly MVP
 */
 package main
 
-import (
-	"github.com/LimeChain/gosemble/frame/account_nonce"
-	"github.com/LimeChain/gosemble/frame/aura"
-	blockbuilder "github.com/LimeChain/gosemble/frame/block_builder"
-	"github.com/LimeChain/gosemble/frame/core"
-	"github.com/LimeChain/gosemble/frame/grandpa"
-	"github.com/LimeChain/gosemble/frame/metadata"
-	"github.com/LimeChain/gosemble/frame/offchain_worker"
-	"github.com/LimeChain/gosemble/frame/session_keys"
-	taggedtransactionqueue "github.com/LimeChain/gosemble/frame/tagged_transaction_queue"
-	"github.com/LimeChain/gosemble/frame/transaction_payment"
-)
+// The functions exported for the runtime APIs are generated in apis.go and apis_benchmarks.go.
+//go:generate go run -tags nonwasmenv ../cmd/gosemble-apis -dir .
 
 // TODO:
 // remove the _start export and find a way to call it from the runtime to initialize the memory.
 // TinyGo requires to have a main function to compile to Wasm.
 func main() {}
-
-//go:export Core_version
-func CoreVersion(_ int32, _ int32) int64 {
-	return core.Version()
-}
-
-//go:export Core_initialize_block
-func CoreInitializeBlock(dataPtr int32, dataLen int32) int64 {
-	core.InitializeBlock(dataPtr, dataLen)
-
-	return 0
-}
-
-//go:export Core_execute_block
-func CoreExecuteBlock(dataPtr int32, dataLen int32) int64 {
-	core.ExecuteBlock(dataPtr, dataLen)
-
-	return 0
-}
-
-//go:export BlockBuilder_apply_extrinsic
-func BlockBuilderApplyExtrinsic(dataPtr int32, dataLen int32) int64 {
-	return blockbuilder.ApplyExtrinsic(dataPtr, dataLen)
-}
-
-//go:export BlockBuilder_finalize_block
-func BlockBuilderFinalizeBlock(_, _ int32) int64 {
-	return blockbuilder.FinalizeBlock()
-}
-
-//go:export BlockBuilder_inherent_extrinsics
-func BlockBuilderInherentExtrinsics(dataPtr int32, dataLen int32) int64 {
-	return blockbuilder.InherentExtrinsics(dataPtr, dataLen)
-}
-
-//go:export BlockBuilder_check_inherents
-func BlockBuilderCheckInherents(dataPtr int32, dataLen int32) int64 {
-	return blockbuilder.CheckInherents(dataPtr, dataLen)
-}
-
-//go:export TaggedTransactionQueue_validate_transaction
-func TaggedTransactionQueueValidateTransaction(dataPtr int32, dataLen int32) int64 {
-	return taggedtransactionqueue.ValidateTransaction(dataPtr, dataLen)
-}
-
-//go:export AuraApi_slot_duration
-func AuraApiSlotDuration(_, _ int32) int64 {
-	return aura.SlotDuration()
-}
-
-//go:export AuraApi_authorities
-func AuraApiAuthorities(_, _ int32) int64 {
-	return aura.Authorities()
-}
-
-//go:export AccountNonceApi_account_nonce
-func AccountNonceApiAccountNonce(dataPtr int32, dataLen int32) int64 {
-	return account_nonce.AccountNonce(dataPtr, dataLen)
-}
-
-//go:export TransactionPaymentApi_query_info
-func TransactionPaymentApiQueryInfo(dataPtr int32, dataLen int32) int64 {
-	return transaction_payment.QueryInfo(dataPtr, dataLen)
-}
-
-//go:export TransactionPaymentApi_query_fee_details
-func TransactionPaymentApiQueryFeeDetails(dataPtr int32, dataLen int32) int64 {
-	return transaction_payment.QueryFeeDetails(dataPtr, dataLen)
-}
-
-//go:export TransactionPaymentCallApi_query_call_info
-func TransactionPaymentCallApiQueryCallInfo(dataPtr int32, dataLan int32) int64 {
-	return transaction_payment.QueryCallInfo(dataPtr, dataLan)
-}
-
-//go:export TransactionPaymentCallApi_query_call_fee_details
-func TransactionPaymentCallApiQueryCallFeeDetails(dataPtr int32, dataLen int32) int64 {
-	return transaction_payment.QueryCallFeeDetails(dataPtr, dataLen)
-}
-
-//go:export Metadata_metadata
-func Metadata(_, _ int32) int64 {
-	return metadata.Metadata()
-}
-
-//go:export SessionKeys_generate_session_keys
-func SessionKeysGenerateSessionKeys(dataPtr int32, dataLen int32) int64 {
-	return session_keys.GenerateSessionKeys(dataPtr, dataLen)
-}
-
-//go:export SessionKeys_decode_session_keys
-func SessionKeysDecodeSessionKeys(dataPtr int32, dataLen int32) int64 {
-	return session_keys.DecodeSessionKeys(dataPtr, dataLen)
-}
-
-//go:export GrandpaApi_grandpa_authorities
-func GrandpaApiAuthorities(_, _ int32) int64 {
-	return grandpa.Authorities()
-}
-
-//go:export OffchainWorkerApi_offchain_worker
-func OffchainWorkerApiOffchainWorker(dataPtr int32, dataLen int32) int64 {
-	offchain_worker.OffchainWorker(dataPtr, dataLen)
-
-	return 0
-}
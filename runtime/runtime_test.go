@@ -15,6 +15,8 @@ import (
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/primitives/hashing"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
 	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
 	"github.com/stretchr/testify/assert"
@@ -62,6 +64,20 @@ var (
 	}
 )
 
+// Development accounts other than Alice, which are not provided by gsrpc.
+var (
+	testKeyringPairBob = signature.KeyringPair{
+		URI:       "//Bob",
+		PublicKey: common.MustHexToBytes("0x8eaf04151687736326c9fea17e25fc5287613693c912909cb226aa4794f26a48"),
+		Address:   "5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty",
+	}
+	testKeyringPairCharlie = signature.KeyringPair{
+		URI:       "//Charlie",
+		PublicKey: common.MustHexToBytes("0x90b5ab205c6974c9ea841be688864633dc9ca8a357843eeacf2314649965fe22"),
+		Address:   "5FLSigC9HGRKVhB9FiEo4Y3koPsNmBmLJbpXg2mp1hXcS59Y",
+	}
+)
+
 func newTestRuntime(t *testing.T) (*wasmer.Instance, *runtime.Storage) {
 	runtime := wasmer.NewTestInstanceWithTrie(t, WASM_RUNTIME, trie.NewEmptyTrie())
 	storage := &runtime.GetContext().Storage
@@ -135,3 +151,125 @@ func getQueryInfo(t *testing.T, runtime *wasmer.Instance, extrinsic []byte) prim
 
 	return primitives.DecodeRuntimeDispatchInfo(buffer)
 }
+
+// initializeBlock initializes a block at `number` with the default test header.
+func initializeBlock(t *testing.T, rt *wasmer.Instance, number uint) {
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, number, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+}
+
+// applySignedExtrinsic signs `call` by `signer` with the given `nonce` and applies it to the current block.
+// Returns the encoded ApplyExtrinsicResult.
+func applySignedExtrinsic(t *testing.T, rt *wasmer.Instance, call ctypes.Call, signer signature.KeyringPair, nonce uint64) []byte {
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	ext := newExtrinsic(call)
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(nonce),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+
+	err = ext.Sign(signer, o)
+	assert.NoError(t, err)
+
+	extEnc := bytes.Buffer{}
+	err = ext.Encode(*cscale.NewEncoder(&extEnc))
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+
+	return res
+}
+
+// moduleErrorResult returns the encoded ApplyExtrinsicResult of a dispatch that failed with the given module error.
+func moduleErrorResult(moduleIndex sc.U8, err sc.U8) []byte {
+	return primitives.NewApplyExtrinsicResult(
+		primitives.NewDispatchOutcome(
+			primitives.NewDispatchErrorModule(
+				primitives.CustomModuleError{
+					Index:   moduleIndex,
+					Error:   sc.U32(err),
+					Message: sc.NewOption[sc.Str](nil),
+				}))).Bytes()
+}
+
+// extrinsic is a V4 extrinsic, whose signed extra includes the asset id of ChargeAssetTxPayment.
+// The asset id is not supported by the extrinsic of gsrpc, which only covers the era, nonce and tip.
+type extrinsic struct {
+	ctypes.Extrinsic
+	AssetId ctypes.OptionU32
+}
+
+func newExtrinsic(call ctypes.Call) extrinsic {
+	return extrinsic{Extrinsic: ctypes.NewExtrinsic(call)}
+}
+
+func (e *extrinsic) Sign(signer signature.KeyringPair, o ctypes.SignatureOptions) error {
+	method, err := codec.Encode(e.Method)
+	if err != nil {
+		return err
+	}
+
+	era := o.Era
+	if !o.Era.IsMortalEra {
+		era = ctypes.ExtrinsicEra{IsImmortalEra: true}
+	}
+
+	payload := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&payload)
+	for _, value := range []interface{}{ctypes.BytesBare(method), era, o.Nonce, o.Tip, e.AssetId, o.SpecVersion, o.TransactionVersion, o.GenesisHash, o.BlockHash} {
+		err = encoder.Encode(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	sig, err := signature.Sign(payload.Bytes(), signer.URI)
+	if err != nil {
+		return err
+	}
+
+	signerAddress, err := ctypes.NewMultiAddressFromAccountID(signer.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	e.Signature = ctypes.ExtrinsicSignatureV4{
+		Signer:    signerAddress,
+		Signature: ctypes.MultiSignature{IsSr25519: true, AsSr25519: ctypes.NewSignature(sig)},
+		Era:       era,
+		Nonce:     o.Nonce,
+		Tip:       o.Tip,
+	}
+	e.Version |= ctypes.ExtrinsicBitSigned
+
+	return nil
+}
+
+func (e extrinsic) Encode(encoder cscale.Encoder) error {
+	if !e.IsSigned() {
+		return e.Extrinsic.Encode(encoder)
+	}
+
+	buffer := bytes.Buffer{}
+	bufferEncoder := cscale.NewEncoder(&buffer)
+	for _, value := range []interface{}{e.Version, e.Signature.Signer, e.Signature.Signature, e.Signature.Era, e.Signature.Nonce, e.Signature.Tip, e.AssetId, e.Method} {
+		err := bufferEncoder.Encode(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return encoder.Encode(buffer.Bytes())
+}
@@ -0,0 +1,500 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/runtime"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/staking"
+	"github.com/LimeChain/gosemble/frame/staking/errors"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyStakingHash, _               = common.Twox128Hash(constants.KeyStaking)
+	keyLedgerHash, _                = common.Twox128Hash(constants.KeyLedger)
+	keyPayeeHash, _                 = common.Twox128Hash(constants.KeyPayee)
+	keyValidatorsHash, _            = common.Twox128Hash(constants.KeyValidators)
+	keyNominatorsHash, _            = common.Twox128Hash(constants.KeyNominators)
+	keyValidatorCountHash, _        = common.Twox128Hash(constants.KeyValidatorCount)
+	keyCurrentEraHash, _            = common.Twox128Hash(constants.KeyCurrentEra)
+	keyActiveEraHash, _             = common.Twox128Hash(constants.KeyActiveEra)
+	keyForceEraHash, _              = common.Twox128Hash(constants.KeyForceEra)
+	keyErasStakersHash, _           = common.Twox128Hash(constants.KeyErasStakers)
+	keyErasStartSessionIndexHash, _ = common.Twox128Hash(constants.KeyErasStartSessionIndex)
+	keyErasTotalStakeHash, _        = common.Twox128Hash(constants.KeyErasTotalStake)
+	keyErasValidatorPrefsHash, _    = common.Twox128Hash(constants.KeyErasValidatorPrefs)
+	keyErasValidatorRewardHash, _   = common.Twox128Hash(constants.KeyErasValidatorReward)
+	keyErasRewardPointsHash, _      = common.Twox128Hash(constants.KeyErasRewardPoints)
+	keyUnappliedSlashesHash, _      = common.Twox128Hash(constants.KeyUnappliedSlashes)
+	keySchedulerHash, _             = common.Twox128Hash(constants.KeyScheduler)
+	keyAgendaHash, _                = common.Twox128Hash(constants.KeyAgenda)
+)
+
+func Test_Staking_Bond_Success(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	metadata := runtimeMetadata(t, rt)
+
+	value := big.NewInt(0).SetUint64(10 * constants.Dollar)
+	payee := primitives.NewRewardDestination(primitives.RewardDestinationStaked)
+
+	call, err := ctypes.NewCall(metadata, "Staking.bond", ctypes.NewUCompact(value))
+	assert.NoError(t, err)
+	call.Args = append(call.Args, payee.Bytes()...)
+
+	// Create the extrinsic
+	ext := newExtrinsic(call)
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(0),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+
+	// Set Account Info
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	keyStorageAccountAlice, aliceAccountInfo := setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	// Sign the transaction using Alice's default account
+	err = ext.Sign(signature.TestKeyringPairAlice, o)
+	assert.NoError(t, err)
+
+	extEnc := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&extEnc)
+	err = ext.Encode(*encoder)
+	assert.NoError(t, err)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t,
+		primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(),
+		res,
+	)
+
+	alice := primitives.NewAddress32(sc.BytesToSequenceU8(signature.TestKeyringPairAlice.PublicKey)...)
+	aliceHash, err := common.Blake2b128(signature.TestKeyringPairAlice.PublicKey)
+	assert.NoError(t, err)
+
+	keyLedger := append(keyStakingHash, keyLedgerHash...)
+	keyLedger = append(keyLedger, aliceHash...)
+	keyLedger = append(keyLedger, signature.TestKeyringPairAlice.PublicKey...)
+
+	expectedLedger := primitives.StakingLedger{
+		Stash:          alice,
+		Total:          sc.NewU128FromBigInt(value),
+		Active:         sc.NewU128FromBigInt(value),
+		Unlocking:      sc.Sequence[primitives.UnlockChunk]{},
+		ClaimedRewards: sc.Sequence[sc.U32]{},
+	}
+	assert.Equal(t, expectedLedger.Bytes(), (*storage).Get(keyLedger))
+
+	aliceTwox64, err := common.Twox64(signature.TestKeyringPairAlice.PublicKey)
+	assert.NoError(t, err)
+
+	keyPayee := append(keyStakingHash, keyPayeeHash...)
+	keyPayee = append(keyPayee, aliceTwox64...)
+	keyPayee = append(keyPayee, signature.TestKeyringPairAlice.PublicKey...)
+
+	assert.Equal(t, payee.Bytes(), (*storage).Get(keyPayee))
+
+	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
+	err = scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(value), aliceAccountInfo.Data.MiscFrozen)
+	assert.Equal(t, scale.MustNewUint128(value), aliceAccountInfo.Data.FeeFrozen)
+}
+
+func Test_Staking_Election_SequentialPhragmen(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	alice := setStakingStash(t, storage, signature.TestKeyringPairAlice.PublicKey, 10)
+	bob := setStakingStash(t, storage, testKeyringPairBob.PublicKey, 20)
+	charlie := setStakingStash(t, storage, testKeyringPairCharlie.PublicKey, 30)
+
+	setStakingValidator(t, storage, alice)
+	setStakingValidator(t, storage, bob)
+	nominations := primitives.Nominations{Targets: sc.Sequence[primitives.AccountId]{alice, bob}}
+	putStakingStorage(t, storage, keyStakingMap(keyNominatorsHash, charlie.Bytes()), nominations.Bytes())
+	putStakingStorage(t, storage, append(keyStakingHash, keyValidatorCountHash...), sc.U32(2).Bytes())
+
+	// The first era is planned at the end of the first session.
+	initializeBlock(t, rt, uint(staking.SessionPeriod))
+
+	assert.Equal(t, sc.NewOption[sc.U32](sc.U32(0)).Bytes(), (*storage).Get(append(keyStakingHash, keyCurrentEraHash...)))
+	assert.Equal(t, sc.U32(2).Bytes(), (*storage).Get(keyStakingMap(keyErasStartSessionIndexHash, sc.U32(0).Bytes())))
+
+	// Bob is elected first, with the highest approval. Charlie's stake is then split between
+	// both validators in proportion to the load each of them added.
+	expectedAlice := primitives.Exposure{
+		Total: stakingDollars(25),
+		Own:   stakingDollars(10),
+		Others: sc.Sequence[primitives.IndividualExposure]{
+			{Who: charlie, Value: stakingDollars(15)},
+		},
+	}
+	expectedBob := primitives.Exposure{
+		Total: stakingDollars(35),
+		Own:   stakingDollars(20),
+		Others: sc.Sequence[primitives.IndividualExposure]{
+			{Who: charlie, Value: stakingDollars(15)},
+		},
+	}
+	assert.Equal(t, expectedAlice.Bytes(), (*storage).Get(keyStakingMap(keyErasStakersHash, sc.U32(0).Bytes(), alice.Bytes())))
+	assert.Equal(t, expectedBob.Bytes(), (*storage).Get(keyStakingMap(keyErasStakersHash, sc.U32(0).Bytes(), bob.Bytes())))
+	assert.Equal(t, stakingDollars(60).Bytes(), (*storage).Get(keyStakingMap(keyErasTotalStakeHash, sc.U32(0).Bytes())))
+	assert.Nil(t, (*storage).Get(keyStakingMap(keyErasStakersHash, sc.U32(0).Bytes(), charlie.Bytes())))
+}
+
+func Test_Staking_ForceEra(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	alice := setStakingStash(t, storage, signature.TestKeyringPairAlice.PublicKey, 10)
+	setStakingValidator(t, storage, alice)
+	putStakingStorage(t, storage, append(keyStakingHash, keyValidatorCountHash...), sc.U32(1).Bytes())
+
+	keyCurrentEra := append(keyStakingHash, keyCurrentEraHash...)
+	keyActiveEra := append(keyStakingHash, keyActiveEraHash...)
+	keyForceEra := append(keyStakingHash, keyForceEraHash...)
+
+	// Era 0 is planned at the end of session 1 and becomes active in session 2.
+	initializeBlock(t, rt, uint(staking.SessionPeriod))
+	initializeBlock(t, rt, uint(2*staking.SessionPeriod))
+	assert.Equal(t, primitives.ActiveEraInfo{Index: 0, Start: sc.NewOption[sc.U64](nil)}.Bytes(), (*storage).Get(keyActiveEra))
+
+	// A signed origin cannot force a new era.
+	forceNewEra, err := ctypes.NewCall(metadata, "Staking.force_new_era")
+	assert.NoError(t, err)
+
+	initializeBlock(t, rt, uint(2*staking.SessionPeriod+1))
+	res := applySignedExtrinsic(t, rt, forceNewEra, signature.TestKeyringPairAlice, 0)
+	assert.Equal(t, primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(primitives.NewDispatchErrorBadOrigin())).Bytes(), res)
+
+	scheduleRootCall(t, storage, 2*staking.SessionPeriod+2, forceNewEra)
+	initializeBlock(t, rt, uint(2*staking.SessionPeriod+2))
+	assert.Equal(t, primitives.ForcingForceNew.Bytes(), (*storage).Get(keyForceEra))
+
+	// ForceNew plans era 1 at the end of the next session, before `SessionsPerEra` have passed,
+	// and is then reset.
+	initializeBlock(t, rt, uint(3*staking.SessionPeriod))
+	assert.Equal(t, sc.NewOption[sc.U32](sc.U32(1)).Bytes(), (*storage).Get(keyCurrentEra))
+	assert.Equal(t, sc.U32(4).Bytes(), (*storage).Get(keyStakingMap(keyErasStartSessionIndexHash, sc.U32(1).Bytes())))
+	assert.Equal(t, primitives.ForcingNotForcing.Bytes(), (*storage).Get(keyForceEra))
+
+	// ForceNone does not plan a new era, even after `SessionsPerEra` have passed.
+	putStakingStorage(t, storage, keyForceEra, primitives.ForcingForceNone.Bytes())
+	initializeBlock(t, rt, uint(4*staking.SessionPeriod))
+	assert.Equal(t, primitives.ActiveEraInfo{Index: 1, Start: sc.NewOption[sc.U64](nil)}.Bytes(), (*storage).Get(keyActiveEra))
+
+	initializeBlock(t, rt, uint((4+staking.SessionsPerEra)*staking.SessionPeriod))
+	assert.Equal(t, sc.NewOption[sc.U32](sc.U32(1)).Bytes(), (*storage).Get(keyCurrentEra))
+
+	// ForceAlways plans a new era at the end of every session, and is kept.
+	putStakingStorage(t, storage, keyForceEra, primitives.ForcingForceAlways.Bytes())
+	initializeBlock(t, rt, uint((5+staking.SessionsPerEra)*staking.SessionPeriod))
+	assert.Equal(t, sc.NewOption[sc.U32](sc.U32(2)).Bytes(), (*storage).Get(keyCurrentEra))
+
+	initializeBlock(t, rt, uint((6+staking.SessionsPerEra)*staking.SessionPeriod))
+	assert.Equal(t, primitives.ActiveEraInfo{Index: 2, Start: sc.NewOption[sc.U64](nil)}.Bytes(), (*storage).Get(keyActiveEra))
+	assert.Equal(t, sc.NewOption[sc.U32](sc.U32(3)).Bytes(), (*storage).Get(keyCurrentEra))
+	assert.Equal(t, primitives.ForcingForceAlways.Bytes(), (*storage).Get(keyForceEra))
+}
+
+func Test_Staking_DeferredSlash_AppliedAndCancelled(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	bob := setStakingStash(t, storage, testKeyringPairBob.PublicKey, 100)
+	charlie := setStakingStash(t, storage, testKeyringPairCharlie.PublicKey, 100)
+
+	// Era 0 is active and era 1 starts with session 1.
+	putStakingStorage(t, storage, append(keyStakingHash, keyActiveEraHash...), primitives.ActiveEraInfo{Index: 0, Start: sc.NewOption[sc.U64](nil)}.Bytes())
+	putStakingStorage(t, storage, append(keyStakingHash, keyCurrentEraHash...), sc.NewOption[sc.U32](sc.U32(1)).Bytes())
+	putStakingStorage(t, storage, keyStakingMap(keyErasStartSessionIndexHash, sc.U32(1).Bytes()), sc.U32(1).Bytes())
+
+	slashBob := primitives.UnappliedSlash{
+		Validator: bob,
+		Own:       stakingDollars(10),
+		Others: sc.Sequence[primitives.StakingSlash]{
+			{Who: charlie, Value: stakingDollars(5)},
+		},
+		Reporters: sc.Sequence[primitives.AccountId]{},
+		Payout:    sc.NewU128FromUint64(0),
+	}
+	slashCharlie := primitives.UnappliedSlash{
+		Validator: charlie,
+		Own:       stakingDollars(20),
+		Others:    sc.Sequence[primitives.StakingSlash]{},
+		Reporters: sc.Sequence[primitives.AccountId]{},
+		Payout:    sc.NewU128FromUint64(0),
+	}
+	keyUnappliedSlashes := keyStakingMap(keyUnappliedSlashesHash, sc.U32(1).Bytes())
+	putStakingStorage(t, storage, keyUnappliedSlashes, sc.Sequence[primitives.UnappliedSlash]{slashBob, slashCharlie}.Bytes())
+
+	cancel, err := ctypes.NewCall(metadata, "Staking.cancel_deferred_slash", ctypes.NewU32(1), []ctypes.U32{1})
+	assert.NoError(t, err)
+	invalidIndex, err := ctypes.NewCall(metadata, "Staking.cancel_deferred_slash", ctypes.NewU32(1), []ctypes.U32{2})
+	assert.NoError(t, err)
+
+	// A signed origin cannot cancel a slash.
+	initializeBlock(t, rt, 1)
+	res := applySignedExtrinsic(t, rt, cancel, testKeyringPairBob, 0)
+	assert.Equal(t, primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(primitives.NewDispatchErrorBadOrigin())).Bytes(), res)
+
+	scheduleRootCall(t, storage, 2, invalidIndex)
+	initializeBlock(t, rt, 2)
+	assert.Equal(t, sc.Sequence[primitives.UnappliedSlash]{slashBob, slashCharlie}.Bytes(), (*storage).Get(keyUnappliedSlashes))
+
+	scheduleRootCall(t, storage, 3, cancel)
+	initializeBlock(t, rt, 3)
+	assert.Equal(t, sc.Sequence[primitives.UnappliedSlash]{slashBob}.Bytes(), (*storage).Get(keyUnappliedSlashes))
+
+	// The remaining slash is applied when era 1 starts.
+	initializeBlock(t, rt, uint(staking.SessionPeriod))
+	assert.Nil(t, (*storage).Get(keyUnappliedSlashes))
+
+	bobLedger := stakingLedger(t, storage, bob)
+	assert.Equal(t, stakingDollars(90), bobLedger.Active)
+	assert.Equal(t, stakingDollars(90), bobLedger.Total)
+
+	charlieLedger := stakingLedger(t, storage, charlie)
+	assert.Equal(t, stakingDollars(95), charlieLedger.Active)
+	assert.Equal(t, stakingDollars(95), charlieLedger.Total)
+}
+
+func Test_Staking_PayoutStakers_Commission(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	alice := setStakingStash(t, storage, signature.TestKeyringPairAlice.PublicKey, 20)
+	bob := setStakingStash(t, storage, testKeyringPairBob.PublicKey, 30)
+	setStorageAccountInfo(t, storage, testKeyringPairCharlie.PublicKey, stakingFreeBalance(0, 0), 0)
+
+	exposure := primitives.Exposure{
+		Total: stakingDollars(50),
+		Own:   stakingDollars(20),
+		Others: sc.Sequence[primitives.IndividualExposure]{
+			{Who: bob, Value: stakingDollars(30)},
+		},
+	}
+	setStakingEraReward(t, storage, alice, exposure, primitives.NewPermillFromPercent(10), 1_000_000_000)
+
+	call := payoutStakersCall(t, metadata, alice, 0)
+
+	initializeBlock(t, rt, blockNumber)
+	res := applySignedExtrinsic(t, rt, call, testKeyringPairCharlie, 0)
+	assert.Equal(t, okResult, res)
+
+	// The validator takes its commission of 10%, and the rest is shared in proportion to the exposure.
+	assert.Equal(t, scale.MustNewUint128(stakingFreeBalance(20, 460_000_000)), freeBalance(t, storage, signature.TestKeyringPairAlice.PublicKey))
+	assert.Equal(t, scale.MustNewUint128(stakingFreeBalance(30, 540_000_000)), freeBalance(t, storage, testKeyringPairBob.PublicKey))
+
+	res = applySignedExtrinsic(t, rt, call, testKeyringPairCharlie, 1)
+	assert.Equal(t, moduleErrorResult(staking.ModuleIndex, errors.ErrorAlreadyClaimed), res)
+}
+
+func Test_Staking_PayoutStakers_RewardedNominatorsCap(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	metadata := runtimeMetadata(t, rt)
+
+	alice := setStakingStash(t, storage, signature.TestKeyringPairAlice.PublicKey, 0)
+	setStorageAccountInfo(t, storage, testKeyringPairCharlie.PublicKey, stakingFreeBalance(0, 0), 0)
+
+	// One nominator more than are rewarded, with the lowest stake in the middle of the exposure.
+	nominators := [][]byte{}
+	exposure := primitives.Exposure{
+		Total:  sc.NewU128FromUint64(0),
+		Own:    sc.NewU128FromUint64(0),
+		Others: sc.Sequence[primitives.IndividualExposure]{},
+	}
+	for i := 0; i <= staking.MaxNominatorRewardedPerValidator; i++ {
+		nominator := make([]byte, 32)
+		nominator[0], nominator[1] = 0xff, byte(i)
+		nominator[2] = byte(i >> 8)
+		nominators = append(nominators, nominator)
+
+		setStorageAccountInfo(t, storage, nominator, stakingFreeBalance(0, 0), 0)
+
+		stake := stakingDollars(2)
+		if i == staking.MaxNominatorRewardedPerValidator/2 {
+			stake = stakingDollars(1)
+		}
+		exposure.Others = append(exposure.Others, primitives.IndividualExposure{
+			Who:   primitives.NewAccountId(sc.BytesToSequenceU8(nominator)...),
+			Value: stake,
+		})
+		exposure.Total = sc.NewU128FromBigInt(new(big.Int).Add(exposure.Total.ToBigInt(), stake.ToBigInt()))
+	}
+	setStakingEraReward(t, storage, alice, exposure, primitives.NewPermillFromPercent(0), 1_000_000_000_000)
+
+	initializeBlock(t, rt, blockNumber)
+	res := applySignedExtrinsic(t, rt, payoutStakersCall(t, metadata, alice, 0), testKeyringPairCharlie, 0)
+	assert.Equal(t, okResult, res)
+
+	// The nominator with the lowest stake is exposed, but not rewarded.
+	total := exposure.Total.ToBigInt()
+	for i, nominator := range nominators {
+		if i == staking.MaxNominatorRewardedPerValidator/2 {
+			assert.Equal(t, scale.MustNewUint128(stakingFreeBalance(0, 0)), freeBalance(t, storage, nominator))
+			continue
+		}
+
+		reward := new(big.Int).Mul(big.NewInt(1_000_000_000_000), stakingDollars(2).ToBigInt())
+		reward.Div(reward, total)
+		assert.Equal(t, scale.MustNewUint128(stakingFreeBalance(0, reward.Uint64())), freeBalance(t, storage, nominator))
+	}
+}
+
+// setStakingStash funds `account` and bonds `dollars` of it, paying the rewards into the stash.
+func setStakingStash(t *testing.T, storage *runtime.Storage, account []byte, dollars uint64) primitives.AccountId {
+	setStorageAccountInfo(t, storage, account, stakingFreeBalance(dollars, 0), 0)
+
+	stash := primitives.NewAccountId(sc.BytesToSequenceU8(account)...)
+	ledger := primitives.StakingLedger{
+		Stash:          stash,
+		Total:          stakingDollars(dollars),
+		Active:         stakingDollars(dollars),
+		Unlocking:      sc.Sequence[primitives.UnlockChunk]{},
+		ClaimedRewards: sc.Sequence[sc.U32]{},
+	}
+	putStakingStorage(t, storage, keyStakingLedger(account), ledger.Bytes())
+	putStakingStorage(t, storage, keyStakingMap(keyPayeeHash, account), primitives.NewRewardDestination(primitives.RewardDestinationStash).Bytes())
+
+	return stash
+}
+
+func setStakingValidator(t *testing.T, storage *runtime.Storage, stash primitives.AccountId) {
+	prefs := primitives.ValidatorPrefs{Commission: primitives.NewPermillFromPercent(0)}
+	putStakingStorage(t, storage, keyStakingMap(keyValidatorsHash, stash.Bytes()), prefs.Bytes())
+}
+
+// setStakingEraReward sets up era 0 to be paid out, with all reward points earned by `validator`.
+func setStakingEraReward(t *testing.T, storage *runtime.Storage, validator primitives.AccountId, exposure primitives.Exposure, commission primitives.Permill, reward uint64) {
+	era := sc.U32(0).Bytes()
+	points := primitives.EraRewardPoints{
+		Total:      staking.RewardPointsPerBlock,
+		Individual: sc.Sequence[primitives.ValidatorRewardPoints]{{Validator: validator, Points: staking.RewardPointsPerBlock}},
+	}
+
+	putStakingStorage(t, storage, append(keyStakingHash, keyCurrentEraHash...), sc.NewOption[sc.U32](sc.U32(1)).Bytes())
+	putStakingStorage(t, storage, keyStakingMap(keyErasStakersHash, era, validator.Bytes()), exposure.Bytes())
+	putStakingStorage(t, storage, keyStakingMap(keyErasValidatorPrefsHash, era, validator.Bytes()), primitives.ValidatorPrefs{Commission: commission}.Bytes())
+	putStakingStorage(t, storage, keyStakingMap(keyErasValidatorRewardHash, era), sc.NewU128FromUint64(reward).Bytes())
+	putStakingStorage(t, storage, keyStakingMap(keyErasRewardPointsHash, era), points.Bytes())
+}
+
+func payoutStakersCall(t *testing.T, metadata *ctypes.Metadata, validator primitives.AccountId, era sc.U32) ctypes.Call {
+	call, err := ctypes.NewCall(metadata, "Staking.payout_stakers")
+	assert.NoError(t, err)
+	call.Args = append(call.Args, validator.Bytes()...)
+	call.Args = append(call.Args, era.Bytes()...)
+
+	return call
+}
+
+// scheduleRootCall schedules `call` to be dispatched with the root origin at block `when`.
+func scheduleRootCall(t *testing.T, storage *runtime.Storage, when sc.U32, call ctypes.Call) {
+	callEnc := bytes.Buffer{}
+	err := cscale.NewEncoder(&callEnc).Encode(call)
+	assert.NoError(t, err)
+
+	task := primitives.Scheduled{
+		MaybeId:       sc.NewOption[primitives.TaskName](nil),
+		Call:          primitives.NewBoundedInline(sc.BytesToSequenceU8(callEnc.Bytes())),
+		MaybePeriodic: sc.NewOption[primitives.SchedulePeriod](nil),
+		Origin:        primitives.NewRuntimeOriginRoot(),
+	}
+	agenda := sc.Sequence[sc.Option[primitives.Scheduled]]{sc.NewOption[primitives.Scheduled](task)}
+
+	whenHash, err := common.Twox64(when.Bytes())
+	assert.NoError(t, err)
+
+	key := append(keySchedulerHash, keyAgendaHash...)
+	key = append(key, whenHash...)
+	key = append(key, when.Bytes()...)
+
+	putStakingStorage(t, storage, key, agenda.Bytes())
+}
+
+func stakingLedger(t *testing.T, storage *runtime.Storage, stash primitives.AccountId) primitives.StakingLedger {
+	bytesLedger := (*storage).Get(keyStakingLedger(stash.Bytes()))
+	assert.NotNil(t, bytesLedger)
+
+	return primitives.DecodeStakingLedger(bytes.NewBuffer(bytesLedger))
+}
+
+func freeBalance(t *testing.T, storage *runtime.Storage, account []byte) *scale.Uint128 {
+	accountHash, err := common.Blake2b128(account)
+	assert.NoError(t, err)
+
+	key := append(keySystemHash, keyAccountHash...)
+	key = append(key, accountHash...)
+	key = append(key, account...)
+
+	info := gossamertypes.AccountInfo{}
+	err = scale.Unmarshal((*storage).Get(key), &info)
+	assert.NoError(t, err)
+
+	return info.Data.Free
+}
+
+// stakingFreeBalance returns the free balance of a staking test account that bonded `dollars` and received `rewards`.
+func stakingFreeBalance(dollars uint64, rewards uint64) *big.Int {
+	balance := new(big.Int).SetUint64((dollars + 1_000) * constants.Dollar)
+	return balance.Add(balance, new(big.Int).SetUint64(rewards))
+}
+
+func stakingDollars(dollars uint64) sc.U128 {
+	return sc.NewU128FromBigInt(new(big.Int).SetUint64(dollars * constants.Dollar))
+}
+
+func putStakingStorage(t *testing.T, storage *runtime.Storage, key []byte, value []byte) {
+	err := (*storage).Put(key, value)
+	assert.NoError(t, err)
+}
+
+func keyStakingLedger(account []byte) []byte {
+	accountHash, _ := common.Blake2b128(account)
+
+	key := append(append([]byte{}, keyStakingHash...), keyLedgerHash...)
+	key = append(key, accountHash...)
+	return append(key, account...)
+}
+
+// keyStakingMap returns the key of a staking map whose keys all use the twox 64 concat hasher.
+func keyStakingMap(storageHash []byte, keys ...[]byte) []byte {
+	key := append(append([]byte{}, keyStakingHash...), storageHash...)
+	for _, k := range keys {
+		hash, _ := common.Twox64(k)
+		key = append(key, hash...)
+		key = append(key, k...)
+	}
+
+	return key
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/runtime"
+	"github.com/ChainSafe/gossamer/lib/runtime/wasmer"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/state_trie_migration"
+	"github.com/LimeChain/gosemble/frame/state_trie_migration/errors"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/signature"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyStateTrieMigrationHash, _       = common.Twox128Hash(constants.KeyStateTrieMigration)
+	keyMigrationProcessHash, _         = common.Twox128Hash(constants.KeyMigrationProcess)
+	keyAutoLimitsHash, _               = common.Twox128Hash(constants.KeyAutoLimits)
+	keySignedMigrationMaxLimitsHash, _ = common.Twox128Hash(constants.KeySignedMigrationMaxLimits)
+)
+
+func Test_StateTrieMigration_OnInitialize_AutoMigration(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	limits := primitives.MigrationLimits{Size: 1024 * 1024, Item: 2}
+	err := (*storage).Put(append(keyStateTrieMigrationHash, keyAutoLimitsHash...), limits.Bytes())
+	assert.NoError(t, err)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	task := primitives.DecodeMigrationTask(
+		bytes.NewBuffer((*storage).Get(append(keyStateTrieMigrationHash, keyMigrationProcessHash...))),
+	)
+
+	assert.Equal(t, sc.U32(2), task.TopItems)
+	assert.Equal(t, sc.U32(0), task.ChildItems)
+	assert.True(t, task.ProgressTop.IsLastKey())
+	assert.False(t, task.Finished())
+	assert.Equal(t, limits.Bytes(), (*storage).Get(append(keyStateTrieMigrationHash, keyAutoLimitsHash...)))
+}
+
+func Test_StateTrieMigration_ContinueMigrate_Success(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	maxLimits := primitives.MigrationLimits{Size: 1024 * 1024, Item: 10}
+	err := (*storage).Put(append(keyStateTrieMigrationHash, keySignedMigrationMaxLimitsHash...), maxLimits.Bytes())
+	assert.NoError(t, err)
+
+	limits := primitives.MigrationLimits{Size: 1024 * 1024, Item: 1}
+	realSizeUpper := sc.U32(1024 * 1024)
+
+	res, keyStorageAccountAlice, aliceAccountInfo := continueMigrate(t, rt, storage, limits, realSizeUpper, primitives.NewMigrationTask())
+	assert.Equal(t,
+		primitives.NewApplyExtrinsicResult(primitives.NewDispatchOutcome(nil)).Bytes(),
+		res,
+	)
+
+	task := primitives.DecodeMigrationTask(
+		bytes.NewBuffer((*storage).Get(append(keyStateTrieMigrationHash, keyMigrationProcessHash...))),
+	)
+	assert.Equal(t, sc.U32(1), task.TopItems)
+	assert.True(t, task.ProgressTop.IsLastKey())
+
+	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
+	err = scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(big.NewInt(0)), aliceAccountInfo.Data.Reserved)
+}
+
+func Test_StateTrieMigration_ContinueMigrate_BadWitness(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	maxLimits := primitives.MigrationLimits{Size: 1024 * 1024, Item: 10}
+	err := (*storage).Put(append(keyStateTrieMigrationHash, keySignedMigrationMaxLimitsHash...), maxLimits.Bytes())
+	assert.NoError(t, err)
+
+	witness := primitives.NewMigrationTask()
+	witness.TopItems = 5
+
+	limits := primitives.MigrationLimits{Size: 1024 * 1024, Item: 1}
+
+	res, keyStorageAccountAlice, aliceAccountInfo := continueMigrate(t, rt, storage, limits, 1024*1024, witness)
+	assert.Equal(t,
+		primitives.NewApplyExtrinsicResult(
+			primitives.NewDispatchOutcome(
+				primitives.NewDispatchErrorModule(
+					primitives.CustomModuleError{
+						Index: state_trie_migration.ModuleIndex,
+						Error: sc.U32(errors.ErrorBadWitness),
+					}))).Bytes(),
+		res,
+	)
+
+	assert.Nil(t, (*storage).Get(append(keyStateTrieMigrationHash, keyMigrationProcessHash...)))
+
+	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
+	err = scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(big.NewInt(0)), aliceAccountInfo.Data.Reserved)
+}
+
+func Test_StateTrieMigration_MigrateCustomTop_Success(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	putSignedMigrationMaxLimits(t, storage, primitives.MigrationLimits{Size: 1024 * 1024, Item: 1})
+
+	keys := sc.Sequence[sc.Sequence[sc.U8]]{sc.BytesToSequenceU8(append(keyStateTrieMigrationHash, keySignedMigrationMaxLimitsHash...))}
+
+	res, keyStorageAccountAlice, aliceAccountInfo := migrateCustomTop(t, rt, storage, keys, 1024*1024)
+	assert.Equal(t, okResult, res)
+
+	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
+	err := scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(big.NewInt(0)), aliceAccountInfo.Data.Reserved)
+}
+
+func Test_StateTrieMigration_MigrateCustomTop_SignedMigrationNotAllowed(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	keys := sc.Sequence[sc.Sequence[sc.U8]]{sc.BytesToSequenceU8([]byte("key"))}
+
+	res, _, _ := migrateCustomTop(t, rt, storage, keys, 1024*1024)
+	assert.Equal(t, moduleErrorResult(state_trie_migration.ModuleIndex, errors.ErrorSignedMigrationNotAllowed), res)
+}
+
+func Test_StateTrieMigration_MigrateCustomTop_MaxSignedLimits(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	putSignedMigrationMaxLimits(t, storage, primitives.MigrationLimits{Size: 1024 * 1024, Item: 1})
+
+	keys := sc.Sequence[sc.Sequence[sc.U8]]{
+		sc.BytesToSequenceU8([]byte("key1")),
+		sc.BytesToSequenceU8([]byte("key2")),
+	}
+
+	res, keyStorageAccountAlice, aliceAccountInfo := migrateCustomTop(t, rt, storage, keys, 1024*1024)
+	assert.Equal(t, moduleErrorResult(state_trie_migration.ModuleIndex, errors.ErrorMaxSignedLimits), res)
+
+	bytesAliceStorage := (*storage).Get(keyStorageAccountAlice)
+	err := scale.Unmarshal(bytesAliceStorage, &aliceAccountInfo)
+	assert.NoError(t, err)
+
+	assert.Equal(t, scale.MustNewUint128(big.NewInt(0)), aliceAccountInfo.Data.Reserved)
+}
+
+func Test_StateTrieMigration_MigrateCustomChild_SignedMigrationNotAllowed(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	root := sc.BytesToSequenceU8([]byte(":child_storage:default:child"))
+	keys := sc.Sequence[sc.Sequence[sc.U8]]{sc.BytesToSequenceU8([]byte("key"))}
+
+	res, _, _ := migrateCustomChild(t, rt, storage, root, keys, 0)
+	assert.Equal(t, moduleErrorResult(state_trie_migration.ModuleIndex, errors.ErrorSignedMigrationNotAllowed), res)
+}
+
+func Test_StateTrieMigration_MigrateCustomChild_MaxSignedLimits(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+	putSignedMigrationMaxLimits(t, storage, primitives.MigrationLimits{Size: 1024 * 1024, Item: 1})
+
+	root := sc.BytesToSequenceU8([]byte(":child_storage:default:child"))
+	keys := sc.Sequence[sc.Sequence[sc.U8]]{
+		sc.BytesToSequenceU8([]byte("key1")),
+		sc.BytesToSequenceU8([]byte("key2")),
+	}
+
+	res, _, _ := migrateCustomChild(t, rt, storage, root, keys, 0)
+	assert.Equal(t, moduleErrorResult(state_trie_migration.ModuleIndex, errors.ErrorMaxSignedLimits), res)
+}
+
+func Test_StateTrieMigration_MigrationStatus(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	autoLimits := primitives.MigrationLimits{Size: 4096, Item: 8}
+	err := (*storage).Put(append(keyStateTrieMigrationHash, keyAutoLimitsHash...), autoLimits.Bytes())
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("StateTrieMigrationApi_migration_status", []byte{})
+	assert.NoError(t, err)
+
+	buffer := &bytes.Buffer{}
+	primitives.NewMigrationTask().Encode(buffer)
+	sc.NewOption[primitives.MigrationLimits](autoLimits).Encode(buffer)
+	sc.NewOption[primitives.MigrationLimits](nil).Encode(buffer)
+
+	assert.Equal(t, buffer.Bytes(), res)
+}
+
+func continueMigrate(t *testing.T, rt *wasmer.Instance, storage *runtime.Storage, limits primitives.MigrationLimits, realSizeUpper sc.U32, witness primitives.MigrationTask) ([]byte, []byte, gossamertypes.AccountInfo) {
+	args := append(limits.Bytes(), realSizeUpper.Bytes()...)
+	args = append(args, witness.Bytes()...)
+
+	return applySignedMigration(t, rt, storage, "StateTrieMigration.continue_migrate", args)
+}
+
+func migrateCustomTop(t *testing.T, rt *wasmer.Instance, storage *runtime.Storage, keys sc.Sequence[sc.Sequence[sc.U8]], witnessSize sc.U32) ([]byte, []byte, gossamertypes.AccountInfo) {
+	args := append(keys.Bytes(), witnessSize.Bytes()...)
+
+	return applySignedMigration(t, rt, storage, "StateTrieMigration.migrate_custom_top", args)
+}
+
+func migrateCustomChild(t *testing.T, rt *wasmer.Instance, storage *runtime.Storage, root sc.Sequence[sc.U8], keys sc.Sequence[sc.Sequence[sc.U8]], totalSize sc.U32) ([]byte, []byte, gossamertypes.AccountInfo) {
+	args := append(root.Bytes(), keys.Bytes()...)
+	args = append(args, totalSize.Bytes()...)
+
+	return applySignedMigration(t, rt, storage, "StateTrieMigration.migrate_custom_child", args)
+}
+
+// applySignedMigration applies the call `name` with the encoded `args`, signed by Alice.
+// Returns the result, the key of the account of Alice and her account info before the call.
+func applySignedMigration(t *testing.T, rt *wasmer.Instance, storage *runtime.Storage, name string, args []byte) ([]byte, []byte, gossamertypes.AccountInfo) {
+	runtimeVersion, err := rt.Version()
+	assert.NoError(t, err)
+
+	metadata := runtimeMetadata(t, rt)
+
+	call, err := ctypes.NewCall(metadata, name)
+	assert.NoError(t, err)
+	call.Args = append(call.Args, args...)
+
+	ext := newExtrinsic(call)
+	o := ctypes.SignatureOptions{
+		BlockHash:          ctypes.Hash(parentHash),
+		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
+		GenesisHash:        ctypes.Hash(parentHash),
+		Nonce:              ctypes.NewUCompactFromUInt(0),
+		SpecVersion:        ctypes.U32(runtimeVersion.SpecVersion),
+		Tip:                ctypes.NewUCompactFromUInt(0),
+		TransactionVersion: ctypes.U32(runtimeVersion.TransactionVersion),
+	}
+
+	balance, ok := big.NewInt(0).SetString("500000000000000", 10)
+	assert.True(t, ok)
+
+	keyStorageAccountAlice, aliceAccountInfo := setStorageAccountInfo(t, storage, signature.TestKeyringPairAlice.PublicKey, balance, 0)
+
+	err = ext.Sign(signature.TestKeyringPairAlice, o)
+	assert.NoError(t, err)
+
+	extEnc := bytes.Buffer{}
+	encoder := cscale.NewEncoder(&extEnc)
+	err = ext.Encode(*encoder)
+	assert.NoError(t, err)
+
+	header := gossamertypes.NewHeader(parentHash, stateRoot, extrinsicsRoot, blockNumber, gossamertypes.NewDigest())
+	encodedHeader, err := scale.Marshal(*header)
+	assert.NoError(t, err)
+
+	_, err = rt.Exec("Core_initialize_block", encodedHeader)
+	assert.NoError(t, err)
+
+	res, err := rt.Exec("BlockBuilder_apply_extrinsic", extEnc.Bytes())
+	assert.NoError(t, err)
+
+	return res, keyStorageAccountAlice, aliceAccountInfo
+}
+
+func putSignedMigrationMaxLimits(t *testing.T, storage *runtime.Storage, limits primitives.MigrationLimits) {
+	err := (*storage).Put(append(keyStateTrieMigrationHash, keySignedMigrationMaxLimitsHash...), limits.Bytes())
+	assert.NoError(t, err)
+}
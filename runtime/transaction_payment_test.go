@@ -26,7 +26,7 @@ func Test_TransactionPaymentApi_QueryInfo_Signed_Success(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
@@ -74,7 +74,7 @@ func Test_TransactionPaymentApi_QueryInfo_Unsigned_Success(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 
 	buffer := &bytes.Buffer{}
 	encoder := cscale.NewEncoder(buffer)
@@ -111,7 +111,7 @@ func Test_TransactionPaymentApi_QueryFeeDetails_Signed_Success(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
@@ -161,7 +161,7 @@ func Test_TransactionPaymentApi_QueryFeeDetails_Unsigned_Success(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 
 	buffer := &bytes.Buffer{}
 	encoder := cscale.NewEncoder(buffer)
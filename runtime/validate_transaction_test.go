@@ -42,7 +42,7 @@ func Test_ValidateTransaction_Success(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
@@ -102,7 +102,7 @@ func Test_ValidateTransaction_InvalidModuleFunctionIndex(t *testing.T) {
 	// Change function/section index
 	call.CallIndex.SectionIndex = 65
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
@@ -155,7 +155,7 @@ func Test_ValidateTransaction_StaleError_InvalidNonce(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
 		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
@@ -223,7 +223,7 @@ func Test_ValidateTransaction_ExhaustsResourcesError(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", args)
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 	o := ctypes.SignatureOptions{
 		BlockHash:          ctypes.Hash(parentHash),
 		Era:                ctypes.ExtrinsicEra{IsImmortalEra: true},
@@ -294,7 +294,7 @@ func Test_ValidateTransaction_Era(t *testing.T) {
 	call, err := ctypes.NewCall(metadata, "System.remark", []byte{})
 	assert.NoError(t, err)
 
-	extrinsic := ctypes.NewExtrinsic(call)
+	extrinsic := newExtrinsic(call)
 
 	o := ctypes.SignatureOptions{
 		BlockHash: ctypes.Hash(parentHash),
@@ -389,7 +389,7 @@ func Test_ValidateTransaction_NoUnsignedValidator(t *testing.T) {
 		t.Run(test.callName, func(t *testing.T) {
 			call, err := ctypes.NewCall(metadata, test.callName, test.args...)
 
-			extrinsic := ctypes.NewExtrinsic(call)
+			extrinsic := newExtrinsic(call)
 
 			buffer := &bytes.Buffer{}
 			txSource.Encode(buffer)